@@ -10,8 +10,10 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/noxiouz/zapctx/ctxlog"
 	"github.com/pkg/errors"
 	"github.com/sonm-io/core/blockchain/market"
@@ -29,6 +31,9 @@ type API interface {
 	SideToken() TokenAPI
 	TestToken() TestTokenAPI
 	OracleUSD() OracleAPI
+	LiveGatekeeper() GatekeeperAPI
+	SideGatekeeper() GatekeeperAPI
+	Transactions() TransactionsAPI
 }
 
 type ProfileRegistryAPI interface {
@@ -38,6 +43,8 @@ type ProfileRegistryAPI interface {
 
 type EventsAPI interface {
 	GetEvents(ctx context.Context, fromBlockInitial *big.Int) (chan *Event, error)
+	// GetBlockHash returns the hash of the canonical chain block at the given height.
+	GetBlockHash(ctx context.Context, number *big.Int) (common.Hash, error)
 }
 
 type MarketAPI interface {
@@ -55,6 +62,14 @@ type MarketAPI interface {
 	RemoveWorker(ctx context.Context, key *ecdsa.PrivateKey, master, slave common.Address) <-chan error
 	GetMaster(ctx context.Context, slave common.Address) (common.Address, error)
 	GetDealChangeRequestInfo(ctx context.Context, dealID *big.Int) (*pb.DealChangeRequest, error)
+	// CreateChangeRequest requests to change price and duration of the deal.
+	// If the counterparty has a matching request, both are accepted at once,
+	// which is how change requests are approved.
+	// Returns the ID of the created change request.
+	CreateChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, changeRequest *pb.DealChangeRequest) (*big.Int, error)
+	// CancelChangeRequest cancels the caller's own change request or rejects
+	// the one created by the counterparty.
+	CancelChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error
 	GetNumBenchmarks(ctx context.Context) (uint64, error)
 }
 
@@ -98,6 +113,32 @@ type OracleAPI interface {
 	GetCurrentPrice(ctx context.Context) (*big.Int, error)
 }
 
+// GatekeeperAPI moves tokens between the masterchain and the sidechain.
+//
+// Tokens paid in to the gatekeeper of one chain are paid out by the
+// gatekeeper of the other chain to the same address, once the gatekeeper
+// service relays the transfer.
+type GatekeeperAPI interface {
+	// Address returns the address of the gatekeeper contract, which must be
+	// approved to spend tokens before paying them in.
+	Address() common.Address
+	// PayIn locks tokens in the gatekeeper. The gatekeeper must be approved
+	// to spend the value beforehand. Returns the transaction number assigned
+	// by the gatekeeper.
+	PayIn(ctx context.Context, key *ecdsa.PrivateKey, value *big.Int) (*big.Int, error)
+	// Payout pays out tokens paid in on the other chain, proven by the
+	// merkle proof against the given root.
+	Payout(ctx context.Context, key *ecdsa.PrivateKey, proof []byte, root common.Hash, from common.Address, txNumber, value *big.Int) error
+	// PayIns returns transfers paid in by the given address in blocks
+	// starting from fromBlock, along with the number of the last block
+	// searched, so that the next search can continue after it.
+	PayIns(ctx context.Context, from common.Address, fromBlock uint64) ([]*GatekeeperTransfer, uint64, error)
+	// Payouts returns transfers paid in by the given address on the other
+	// chain and paid out in blocks starting from fromBlock, along with the
+	// number of the last block searched.
+	Payouts(ctx context.Context, from common.Address, fromBlock uint64) ([]*GatekeeperTransfer, uint64, error)
+}
+
 type BasicAPI struct {
 	market          MarketAPI
 	liveToken       TokenAPI
@@ -107,6 +148,9 @@ type BasicAPI struct {
 	profileRegistry ProfileRegistryAPI
 	events          EventsAPI
 	oracle          OracleAPI
+	liveGatekeeper  GatekeeperAPI
+	sideGatekeeper  GatekeeperAPI
+	transactions    TransactionsAPI
 }
 
 func NewAPI(opts ...Option) (API, error) {
@@ -115,17 +159,19 @@ func NewAPI(opts ...Option) (API, error) {
 		o(defaults)
 	}
 
-	client, err := initEthClient(defaults.apiEndpoint)
+	client, err := initCustomEthClient(defaults.apiEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	liveToken, err := NewStandardToken(client, market.SNMAddr(), defaults.gasPrice)
+	txManager := NewTxManager(client, "masterchain", defaults.gasPrice, defaults.chainID, defaults.logParsePeriod)
+
+	liveToken, err := NewStandardToken(client, defaults.contracts.SNM, txManager)
 	if err != nil {
 		return nil, err
 	}
 
-	testToken, err := NewTestToken(client, market.SNMAddr(), defaults.gasPrice)
+	testToken, err := NewTestToken(client, defaults.contracts.SNM, txManager)
 	if err != nil {
 		return nil, err
 	}
@@ -140,34 +186,49 @@ func NewAPI(opts ...Option) (API, error) {
 		return nil, err
 	}
 
-	blacklist, err := NewBasicBlacklist(customClientSidechain, market.BlacklistAddr(), defaults.logParsePeriod,
-		defaults.gasPriceSidechain, defaults.blockConfirmations)
+	txManagerSidechain := NewTxManager(customClientSidechain, "sidechain", defaults.gasPriceSidechain, defaults.sidechainID, defaults.logParsePeriod)
+
+	blacklist, err := NewBasicBlacklist(customClientSidechain, defaults.contracts.Blacklist, txManagerSidechain, defaults.blockConfirmations)
 	if err != nil {
 		return nil, err
 	}
 
-	marketApi, err := NewBasicMarket(customClientSidechain, market.MarketAddr(), defaults.gasPriceSidechain, defaults.logParsePeriod, defaults.blockConfirmations)
+	marketApi, err := NewBasicMarket(customClientSidechain, defaults.contracts.Market, txManagerSidechain, defaults.blockConfirmations)
 	if err != nil {
 		return nil, err
 	}
 
-	profileRegistry, err := NewProfileRegistry(clientSidechain, market.ProfileRegistryAddr(), defaults.gasPriceSidechain)
+	profileRegistry, err := NewProfileRegistry(clientSidechain, defaults.contracts.ProfileRegistry, defaults.gasPriceSidechain)
 	if err != nil {
 		return nil, err
 	}
 
-	sideToken, err := NewStandardToken(clientSidechain, market.SNMSidechainAddr(), defaults.gasPriceSidechain)
+	sideToken, err := NewStandardToken(clientSidechain, defaults.contracts.SNMSidechain, txManagerSidechain)
 	if err != nil {
 		return nil, err
 	}
 
 	// fixme: wtf? context.Background for logger?
-	events, err := NewEventsAPI(clientSidechain, ctxlog.GetLogger(context.Background()))
+	events, err := NewEventsAPI(clientSidechain, []common.Address{
+		defaults.contracts.Market,
+		defaults.contracts.Blacklist,
+		defaults.contracts.ProfileRegistry,
+	}, ctxlog.GetLogger(context.Background()))
+	if err != nil {
+		return nil, err
+	}
+
+	oracle, err := NewOracleUSDAPI(defaults.contracts.OracleUSD, clientSidechain, txManagerSidechain)
 	if err != nil {
 		return nil, err
 	}
 
-	oracle, err := NewOracleUSDAPI(market.OracleUsdAddr(), clientSidechain, defaults.gasPriceSidechain)
+	liveGatekeeper, err := NewGatekeeperAPI(client, defaults.contracts.GatekeeperLive, txManager, defaults.blockConfirmations)
+	if err != nil {
+		return nil, err
+	}
+
+	sideGatekeeper, err := NewGatekeeperAPI(customClientSidechain, defaults.contracts.GatekeeperSidechain, txManagerSidechain, defaults.blockConfirmations)
 	if err != nil {
 		return nil, err
 	}
@@ -181,6 +242,12 @@ func NewAPI(opts ...Option) (API, error) {
 		testToken:       testToken,
 		events:          events,
 		oracle:          oracle,
+		liveGatekeeper:  liveGatekeeper,
+		sideGatekeeper:  sideGatekeeper,
+		transactions: &txManagers{
+			managers:      []*TxManager{txManager, txManagerSidechain},
+			confirmations: defaults.blockConfirmations,
+		},
 	}, nil
 }
 
@@ -216,15 +283,26 @@ func (api *BasicAPI) OracleUSD() OracleAPI {
 	return api.oracle
 }
 
+func (api *BasicAPI) LiveGatekeeper() GatekeeperAPI {
+	return api.liveGatekeeper
+}
+
+func (api *BasicAPI) SideGatekeeper() GatekeeperAPI {
+	return api.sideGatekeeper
+}
+
+func (api *BasicAPI) Transactions() TransactionsAPI {
+	return api.transactions
+}
+
 type BasicMarketAPI struct {
 	client             CustomEthereumClient
 	marketContract     *marketAPI.Market
-	gasPrice           int64
-	logParsePeriod     time.Duration
+	txManager          *TxManager
 	blockConfirmations int64
 }
 
-func NewBasicMarket(client CustomEthereumClient, address common.Address, gasPrice int64, logParsePeriod time.Duration, blockConfirmations int64) (MarketAPI, error) {
+func NewBasicMarket(client CustomEthereumClient, address common.Address, txManager *TxManager, blockConfirmations int64) (MarketAPI, error) {
 	marketContract, err := marketAPI.NewMarket(address, client)
 	if err != nil {
 		return nil, err
@@ -233,8 +311,7 @@ func NewBasicMarket(client CustomEthereumClient, address common.Address, gasPric
 	return &BasicMarketAPI{
 		client:             client,
 		marketContract:     marketContract,
-		gasPrice:           gasPrice,
-		logParsePeriod:     logParsePeriod,
+		txManager:          txManager,
 		blockConfirmations: blockConfirmations,
 	}, nil
 }
@@ -246,14 +323,15 @@ func (api *BasicMarketAPI) OpenDeal(ctx context.Context, key *ecdsa.PrivateKey,
 }
 
 func (api *BasicMarketAPI) openDeal(ctx context.Context, key *ecdsa.PrivateKey, askID, bidID *big.Int, ch chan DealOrError) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	tx, err := api.marketContract.OpenDeal(opts, askID, bidID)
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.OpenDeal(opts, askID, bidID)
+	})
 	if err != nil {
 		ch <- DealOrError{nil, err}
 		return
 	}
 
-	receipt, err := WaitTransactionReceipt(ctx, api.client, api.blockConfirmations, api.logParsePeriod, tx)
+	receipt, err := api.txManager.WaitReceipt(ctx, tx, api.blockConfirmations)
 	if err != nil {
 		ch <- DealOrError{nil, err}
 		return
@@ -282,14 +360,15 @@ func (api *BasicMarketAPI) CloseDeal(ctx context.Context, key *ecdsa.PrivateKey,
 }
 
 func (api *BasicMarketAPI) closeDeal(ctx context.Context, key *ecdsa.PrivateKey, dealID *big.Int, blacklisted bool, ch chan error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	tx, err := api.marketContract.CloseDeal(opts, dealID, blacklisted)
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.CloseDeal(opts, dealID, blacklisted)
+	})
 	if err != nil {
 		ch <- err
 		return
 	}
 
-	_, err = waitForTransactionResult(ctx, api.client, api.logParsePeriod, tx, market.DealUpdatedTopic)
+	_, err = waitForTransactionResult(ctx, api.txManager, tx, market.DealUpdatedTopic)
 	if err != nil {
 		ch <- err
 		return
@@ -349,29 +428,29 @@ func (api *BasicMarketAPI) PlaceOrder(ctx context.Context, key *ecdsa.PrivateKey
 }
 
 func (api *BasicMarketAPI) placeOrder(ctx context.Context, key *ecdsa.PrivateKey, order *pb.Order, ch chan OrderOrError) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-
 	fixedNetflags := pb.UintToNetflags(order.Netflags)
 	var fixedTag [32]byte
 	copy(fixedTag[:], order.Tag[:])
 
-	tx, err := api.marketContract.PlaceOrder(opts,
-		uint8(order.OrderType),
-		order.CounterpartyID.Unwrap(),
-		big.NewInt(int64(order.Duration)),
-		order.Price.Unwrap(),
-		fixedNetflags,
-		uint8(order.IdentityLevel),
-		common.HexToAddress(order.Blacklist),
-		fixedTag,
-		order.GetBenchmarks().ToArray(),
-	)
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.PlaceOrder(opts,
+			uint8(order.OrderType),
+			order.CounterpartyID.Unwrap(),
+			big.NewInt(int64(order.Duration)),
+			order.Price.Unwrap(),
+			fixedNetflags,
+			uint8(order.IdentityLevel),
+			common.HexToAddress(order.Blacklist),
+			fixedTag,
+			order.GetBenchmarks().ToArray(),
+		)
+	})
 	if err != nil {
 		ch <- OrderOrError{nil, err}
 		return
 	}
 
-	receipt, err := WaitTransactionReceipt(ctx, api.client, api.blockConfirmations, api.logParsePeriod, tx)
+	receipt, err := api.txManager.WaitReceipt(ctx, tx, api.blockConfirmations)
 	if err != nil {
 		ch <- OrderOrError{nil, err}
 		return
@@ -400,14 +479,15 @@ func (api *BasicMarketAPI) CancelOrder(ctx context.Context, key *ecdsa.PrivateKe
 }
 
 func (api *BasicMarketAPI) cancelOrder(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int, ch chan error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	tx, err := api.marketContract.CancelOrder(opts, id)
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.CancelOrder(opts, id)
+	})
 	if err != nil {
 		ch <- err
 		return
 	}
 
-	if _, err := waitForTransactionResult(ctx, api.client, api.logParsePeriod, tx, market.OrderUpdatedTopic); err != nil {
+	if _, err := waitForTransactionResult(ctx, api.txManager, tx, market.OrderUpdatedTopic); err != nil {
 		ch <- err
 		return
 	}
@@ -468,14 +548,15 @@ func (api *BasicMarketAPI) Bill(ctx context.Context, key *ecdsa.PrivateKey, deal
 }
 
 func (api *BasicMarketAPI) bill(ctx context.Context, key *ecdsa.PrivateKey, dealID *big.Int, ch chan error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	tx, err := api.marketContract.Bill(opts, dealID)
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.Bill(opts, dealID)
+	})
 	if err != nil {
 		ch <- err
 		return
 	}
 
-	if _, err := waitForTransactionResult(ctx, api.client, api.logParsePeriod, tx, market.BilledTopic); err != nil {
+	if _, err := waitForTransactionResult(ctx, api.txManager, tx, market.BilledTopic); err != nil {
 		ch <- err
 		return
 	}
@@ -489,14 +570,15 @@ func (api *BasicMarketAPI) RegisterWorker(ctx context.Context, key *ecdsa.Privat
 }
 
 func (api *BasicMarketAPI) registerWorker(ctx context.Context, key *ecdsa.PrivateKey, master common.Address, ch chan error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	tx, err := api.marketContract.RegisterWorker(opts, master)
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.RegisterWorker(opts, master)
+	})
 	if err != nil {
 		ch <- err
 		return
 	}
 
-	if _, err := waitForTransactionResult(ctx, api.client, api.logParsePeriod, tx, market.WorkerAnnouncedTopic); err != nil {
+	if _, err := waitForTransactionResult(ctx, api.txManager, tx, market.WorkerAnnouncedTopic); err != nil {
 		ch <- err
 		return
 	}
@@ -510,14 +592,15 @@ func (api *BasicMarketAPI) ConfirmWorker(ctx context.Context, key *ecdsa.Private
 }
 
 func (api *BasicMarketAPI) confirmWorker(ctx context.Context, key *ecdsa.PrivateKey, slave common.Address, ch chan error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	tx, err := api.marketContract.ConfirmWorker(opts, slave)
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.ConfirmWorker(opts, slave)
+	})
 	if err != nil {
 		ch <- err
 		return
 	}
 
-	if _, err := waitForTransactionResult(ctx, api.client, api.logParsePeriod, tx, market.WorkerConfirmedTopic); err != nil {
+	if _, err := waitForTransactionResult(ctx, api.txManager, tx, market.WorkerConfirmedTopic); err != nil {
 		ch <- err
 		return
 	}
@@ -531,14 +614,15 @@ func (api *BasicMarketAPI) RemoveWorker(ctx context.Context, key *ecdsa.PrivateK
 }
 
 func (api *BasicMarketAPI) removeWorker(ctx context.Context, key *ecdsa.PrivateKey, master, slave common.Address, ch chan error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	tx, err := api.marketContract.RemoveWorker(opts, master, slave)
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.RemoveWorker(opts, master, slave)
+	})
 	if err != nil {
 		ch <- err
 		return
 	}
 
-	if _, err := waitForTransactionResult(ctx, api.client, api.logParsePeriod, tx, market.WorkerRemovedTopic); err != nil {
+	if _, err := waitForTransactionResult(ctx, api.txManager, tx, market.WorkerRemovedTopic); err != nil {
 		ch <- err
 		return
 	}
@@ -565,6 +649,84 @@ func (api *BasicMarketAPI) GetDealChangeRequestInfo(ctx context.Context, changeR
 	}, nil
 }
 
+func (api *BasicMarketAPI) CreateChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, changeRequest *pb.DealChangeRequest) (*big.Int, error) {
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.CreateChangeRequest(opts,
+			changeRequest.GetDealID().Unwrap(),
+			changeRequest.GetPrice().Unwrap(),
+			big.NewInt(0).SetUint64(changeRequest.GetDuration()),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := api.txManager.WaitReceipt(ctx, tx, api.blockConfirmations)
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, errors.New("transaction failed")
+	}
+
+	deal, err := api.GetDealInfo(ctx, changeRequest.GetDealID().Unwrap())
+	if err != nil {
+		return nil, err
+	}
+
+	requestType := pb.OrderType_BID
+	if deal.GetSupplierID().Unwrap() == crypto.PubkeyToAddress(key.PublicKey) {
+		requestType = pb.OrderType_ASK
+	}
+
+	return findChangeRequestID(ctx, receipt.Logs, deal.GetId().Unwrap(), requestType, api.GetDealChangeRequestInfo)
+}
+
+// findChangeRequestID returns the ID of the change request of the given side
+// of the deal created by the transaction with the given logs.
+func findChangeRequestID(ctx context.Context, logs []*types.Log, dealID *big.Int, requestType pb.OrderType,
+	getInfo func(ctx context.Context, id *big.Int) (*pb.DealChangeRequest, error)) (*big.Int, error) {
+	// The transaction may also update the counterparty's request, hence
+	// the request created is the one made for our side of the deal. Change
+	// requests that are accepted immediately are not announced as sent.
+	for _, topic := range []common.Hash{market.DealChangeRequestSentTopic, market.DealChangeRequestUpdatedTopic} {
+		for _, l := range logs {
+			if len(l.Topics) < 2 || l.Topics[0] != topic {
+				continue
+			}
+
+			id, err := extractBig(l.Topics, 1)
+			if err != nil {
+				return nil, err
+			}
+
+			info, err := getInfo(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			if info.GetDealID().Unwrap().Cmp(dealID) == 0 && info.GetRequestType() == requestType {
+				return id, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("cannot find change request of deal %s in transaction", dealID.String())
+}
+
+func (api *BasicMarketAPI) CancelChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error {
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.marketContract.CancelChangeRequest(opts, id)
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForTransactionResult(ctx, api.txManager, tx, market.DealChangeRequestUpdatedTopic)
+	return err
+}
+
 func (api *BasicMarketAPI) GetNumBenchmarks(ctx context.Context) (uint64, error) {
 	num, err := api.marketContract.GetBenchmarksQuantity(getCallOptions(ctx))
 	if err != nil {
@@ -624,12 +786,11 @@ func (api *ProfileRegistry) GetCertificate(ctx context.Context, certificateID *b
 type BasicBlacklistAPI struct {
 	client             CustomEthereumClient
 	blacklistContract  *marketAPI.Blacklist
-	gasPrice           int64
-	logParsePeriod     time.Duration
+	txManager          *TxManager
 	blockConfirmations int64
 }
 
-func NewBasicBlacklist(client CustomEthereumClient, address common.Address, logParsePeriod time.Duration, gasPrice, blockConfirmations int64) (BlacklistAPI, error) {
+func NewBasicBlacklist(client CustomEthereumClient, address common.Address, txManager *TxManager, blockConfirmations int64) (BlacklistAPI, error) {
 	blacklistContract, err := marketAPI.NewBlacklist(address, client)
 	if err != nil {
 		return nil, err
@@ -638,8 +799,7 @@ func NewBasicBlacklist(client CustomEthereumClient, address common.Address, logP
 	return &BasicBlacklistAPI{
 		client:             client,
 		blacklistContract:  blacklistContract,
-		gasPrice:           gasPrice,
-		logParsePeriod:     logParsePeriod,
+		txManager:          txManager,
 		blockConfirmations: blockConfirmations,
 	}, nil
 }
@@ -649,18 +809,20 @@ func (api *BasicBlacklistAPI) Check(ctx context.Context, who, whom common.Addres
 }
 
 func (api *BasicBlacklistAPI) Add(ctx context.Context, key *ecdsa.PrivateKey, who, whom common.Address) (*types.Transaction, error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	return api.blacklistContract.Add(opts, who, whom)
+	return api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.blacklistContract.Add(opts, who, whom)
+	})
 }
 
 func (api *BasicBlacklistAPI) Remove(ctx context.Context, key *ecdsa.PrivateKey, whom common.Address) error {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	tx, err := api.blacklistContract.Remove(opts, whom)
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.blacklistContract.Remove(opts, whom)
+	})
 	if err != nil {
 		return err
 	}
 
-	rec, err := WaitTransactionReceipt(ctx, api.client, api.blockConfirmations, api.logParsePeriod, tx)
+	rec, err := api.txManager.WaitReceipt(ctx, tx, api.blockConfirmations)
 	if err != nil {
 		return err
 	}
@@ -673,27 +835,30 @@ func (api *BasicBlacklistAPI) Remove(ctx context.Context, key *ecdsa.PrivateKey,
 }
 
 func (api *BasicBlacklistAPI) AddMaster(ctx context.Context, key *ecdsa.PrivateKey, root common.Address) (*types.Transaction, error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	return api.blacklistContract.AddMaster(opts, root)
+	return api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.blacklistContract.AddMaster(opts, root)
+	})
 }
 
 func (api *BasicBlacklistAPI) RemoveMaster(ctx context.Context, key *ecdsa.PrivateKey, root common.Address) (*types.Transaction, error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	return api.blacklistContract.RemoveMaster(opts, root)
+	return api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.blacklistContract.RemoveMaster(opts, root)
+	})
 }
 
 func (api *BasicBlacklistAPI) SetMarketAddress(ctx context.Context, key *ecdsa.PrivateKey, market common.Address) (*types.Transaction, error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	return api.blacklistContract.SetMarketAddress(opts, market)
+	return api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.blacklistContract.SetMarketAddress(opts, market)
+	})
 }
 
 type StandardTokenApi struct {
 	client        EthereumClientBackend
 	tokenContract *marketAPI.StandardToken
-	gasPrice      int64
+	txManager     *TxManager
 }
 
-func NewStandardToken(client EthereumClientBackend, address common.Address, gasPrice int64) (TokenAPI, error) {
+func NewStandardToken(client EthereumClientBackend, address common.Address, txManager *TxManager) (TokenAPI, error) {
 	tokenContract, err := marketAPI.NewStandardToken(address, client)
 	if err != nil {
 		return nil, err
@@ -702,7 +867,7 @@ func NewStandardToken(client EthereumClientBackend, address common.Address, gasP
 	return &StandardTokenApi{
 		client:        client,
 		tokenContract: tokenContract,
-		gasPrice:      gasPrice,
+		txManager:     txManager,
 	}, nil
 }
 
@@ -715,18 +880,21 @@ func (api *StandardTokenApi) AllowanceOf(ctx context.Context, from string, to st
 }
 
 func (api *StandardTokenApi) Approve(ctx context.Context, key *ecdsa.PrivateKey, to string, amount *big.Int) (*types.Transaction, error) {
-	opts := getTxOpts(ctx, key, defaultGasLimit, api.gasPrice)
-	return api.tokenContract.Approve(opts, common.HexToAddress(to), amount)
+	return api.txManager.Transact(ctx, key, defaultGasLimit, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.tokenContract.Approve(opts, common.HexToAddress(to), amount)
+	})
 }
 
 func (api *StandardTokenApi) Transfer(ctx context.Context, key *ecdsa.PrivateKey, to string, amount *big.Int) (*types.Transaction, error) {
-	opts := getTxOpts(ctx, key, defaultGasLimit, api.gasPrice)
-	return api.tokenContract.Transfer(opts, common.HexToAddress(to), amount)
+	return api.txManager.Transact(ctx, key, defaultGasLimit, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.tokenContract.Transfer(opts, common.HexToAddress(to), amount)
+	})
 }
 
 func (api *StandardTokenApi) TransferFrom(ctx context.Context, key *ecdsa.PrivateKey, from string, to string, amount *big.Int) (*types.Transaction, error) {
-	opts := getTxOpts(ctx, key, defaultGasLimit, api.gasPrice)
-	return api.tokenContract.TransferFrom(opts, common.HexToAddress(from), common.HexToAddress(to), amount)
+	return api.txManager.Transact(ctx, key, defaultGasLimit, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.tokenContract.TransferFrom(opts, common.HexToAddress(from), common.HexToAddress(to), amount)
+	})
 }
 
 func (api *StandardTokenApi) TotalSupply(ctx context.Context) (*big.Int, error) {
@@ -736,10 +904,10 @@ func (api *StandardTokenApi) TotalSupply(ctx context.Context) (*big.Int, error)
 type TestTokenApi struct {
 	client        EthereumClientBackend
 	tokenContract *marketAPI.SNMTToken
-	gasPrice      int64
+	txManager     *TxManager
 }
 
-func NewTestToken(client EthereumClientBackend, address common.Address, gasPrice int64) (TestTokenAPI, error) {
+func NewTestToken(client EthereumClientBackend, address common.Address, txManager *TxManager) (TestTokenAPI, error) {
 	tokenContract, err := marketAPI.NewSNMTToken(address, client)
 	if err != nil {
 		return nil, err
@@ -748,23 +916,27 @@ func NewTestToken(client EthereumClientBackend, address common.Address, gasPrice
 	return &TestTokenApi{
 		client:        client,
 		tokenContract: tokenContract,
-		gasPrice:      gasPrice,
+		txManager:     txManager,
 	}, nil
 }
 
 func (api *TestTokenApi) GetTokens(ctx context.Context, key *ecdsa.PrivateKey) (*types.Transaction, error) {
-	opts := getTxOpts(ctx, key, defaultGasLimit, api.gasPrice)
-	return api.tokenContract.GetTokens(opts)
+	return api.txManager.Transact(ctx, key, defaultGasLimit, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.tokenContract.GetTokens(opts)
+	})
 }
 
 type BasicEventsAPI struct {
 	client      EthereumClientBackend
+	addresses   []common.Address
 	logger      *zap.Logger
 	marketABI   abi.ABI
 	profilesABI abi.ABI
 }
 
-func NewEventsAPI(client EthereumClientBackend, logger *zap.Logger) (EventsAPI, error) {
+// NewEventsAPI constructs EventsAPI, which watches for events emitted by
+// contracts with given addresses.
+func NewEventsAPI(client EthereumClientBackend, addresses []common.Address, logger *zap.Logger) (EventsAPI, error) {
 	marketABI, err := abi.JSON(strings.NewReader(marketAPI.MarketABI))
 	if err != nil {
 		return nil, err
@@ -777,6 +949,7 @@ func NewEventsAPI(client EthereumClientBackend, logger *zap.Logger) (EventsAPI,
 
 	return &BasicEventsAPI{
 		client:      client,
+		addresses:   addresses,
 		logger:      logger,
 		marketABI:   marketABI,
 		profilesABI: profilesABI,
@@ -822,11 +995,7 @@ func (api *BasicEventsAPI) GetEvents(ctx context.Context, fromBlockInitial *big.
 				logs, err := api.client.FilterLogs(ctx, ethereum.FilterQuery{
 					Topics:    topics,
 					FromBlock: big.NewInt(0).SetUint64(fromBlock),
-					Addresses: []common.Address{
-						market.MarketAddr(),
-						market.BlacklistAddr(),
-						market.ProfileRegistryAddr(),
-					},
+					Addresses: api.addresses,
 				})
 
 				if err != nil {
@@ -870,6 +1039,15 @@ func (api *BasicEventsAPI) GetEvents(ctx context.Context, fromBlockInitial *big.
 	return out, nil
 }
 
+func (api *BasicEventsAPI) GetBlockHash(ctx context.Context, number *big.Int) (common.Hash, error) {
+	header, err := api.client.HeaderByNumber(ctx, number)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return header.Hash(), nil
+}
+
 func (api *BasicEventsAPI) processLog(log types.Log, eventTS uint64, out chan *Event) {
 	// This should never happen, but it's ethereum, and things might happen.
 	if len(log.Topics) < 1 {
@@ -881,11 +1059,13 @@ func (api *BasicEventsAPI) processLog(log types.Log, eventTS uint64, out chan *E
 	}
 
 	sendErr := func(out chan *Event, err error, topic common.Hash) {
-		out <- &Event{Data: &ErrorData{Err: err, Topic: topic.String()}, BlockNumber: log.BlockNumber, TS: eventTS}
+		out <- &Event{Data: &ErrorData{Err: err, Topic: topic.String()}, BlockNumber: log.BlockNumber,
+			BlockHash: log.BlockHash, LogIndex: log.Index, TS: eventTS}
 	}
 
 	sendData := func(data interface{}) {
-		out <- &Event{Data: data, BlockNumber: log.BlockNumber, TS: eventTS}
+		out <- &Event{Data: data, BlockNumber: log.BlockNumber, BlockHash: log.BlockHash, LogIndex: log.Index,
+			TS: eventTS}
 	}
 
 	var topic = log.Topics[0]
@@ -1031,10 +1211,10 @@ func (api *BasicEventsAPI) processLog(log types.Log, eventTS uint64, out chan *E
 type OracleUSDAPI struct {
 	client         EthereumClientBackend
 	oracleContract *marketAPI.OracleUSD
-	gasPrice       int64
+	txManager      *TxManager
 }
 
-func NewOracleUSDAPI(address common.Address, client EthereumClientBackend, gasPrice int64) (OracleAPI, error) {
+func NewOracleUSDAPI(address common.Address, client EthereumClientBackend, txManager *TxManager) (OracleAPI, error) {
 	oracleContract, err := marketAPI.NewOracleUSD(address, client)
 	if err != nil {
 		return nil, err
@@ -1043,16 +1223,131 @@ func NewOracleUSDAPI(address common.Address, client EthereumClientBackend, gasPr
 	return &OracleUSDAPI{
 		client:         client,
 		oracleContract: oracleContract,
-		gasPrice:       gasPrice,
+		txManager:      txManager,
 	}, nil
 
 }
 
 func (api *OracleUSDAPI) SetCurrentPrice(ctx context.Context, key *ecdsa.PrivateKey, price *big.Int) (*types.Transaction, error) {
-	opts := getTxOpts(ctx, key, defaultGasLimitForSidechain, api.gasPrice)
-	return api.oracleContract.SetCurrentPrice(opts, price)
+	return api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.oracleContract.SetCurrentPrice(opts, price)
+	})
 }
 
 func (api *OracleUSDAPI) GetCurrentPrice(ctx context.Context) (*big.Int, error) {
 	return api.oracleContract.GetCurrentPrice(getCallOptions(ctx))
 }
+
+type BasicGatekeeperAPI struct {
+	client             CustomEthereumClient
+	address            common.Address
+	gatekeeperContract *marketAPI.Gatekeeper
+	txManager          *TxManager
+	blockConfirmations int64
+}
+
+func NewGatekeeperAPI(client CustomEthereumClient, address common.Address, txManager *TxManager, blockConfirmations int64) (GatekeeperAPI, error) {
+	gatekeeperContract, err := marketAPI.NewGatekeeper(address, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BasicGatekeeperAPI{
+		client:             client,
+		address:            address,
+		gatekeeperContract: gatekeeperContract,
+		txManager:          txManager,
+		blockConfirmations: blockConfirmations,
+	}, nil
+}
+
+func (api *BasicGatekeeperAPI) Address() common.Address {
+	return api.address
+}
+
+func (api *BasicGatekeeperAPI) PayIn(ctx context.Context, key *ecdsa.PrivateKey, value *big.Int) (*big.Int, error) {
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.gatekeeperContract.PayIn(opts, value)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := api.txManager.WaitReceipt(ctx, tx, api.blockConfirmations)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := FindLogByTopic(receipt, market.PayInTopic)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractBig(logs.Topics, 2)
+}
+
+func (api *BasicGatekeeperAPI) Payout(ctx context.Context, key *ecdsa.PrivateKey, proof []byte, root common.Hash, from common.Address, txNumber, value *big.Int) error {
+	tx, err := api.txManager.Transact(ctx, key, defaultGasLimitForSidechain, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return api.gatekeeperContract.Payout(opts, proof, root, from, txNumber, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForTransactionResult(ctx, api.txManager, tx, market.PayoutTopic)
+	return err
+}
+
+func (api *BasicGatekeeperAPI) PayIns(ctx context.Context, from common.Address, fromBlock uint64) ([]*GatekeeperTransfer, uint64, error) {
+	return api.filterTransfers(ctx, market.PayInTopic, from, fromBlock)
+}
+
+func (api *BasicGatekeeperAPI) Payouts(ctx context.Context, from common.Address, fromBlock uint64) ([]*GatekeeperTransfer, uint64, error) {
+	return api.filterTransfers(ctx, market.PayoutTopic, from, fromBlock)
+}
+
+func (api *BasicGatekeeperAPI) filterTransfers(ctx context.Context, topic common.Hash, from common.Address, fromBlock uint64) ([]*GatekeeperTransfer, uint64, error) {
+	lastBlock, err := api.client.GetLastBlock(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if lastBlock.Uint64() < fromBlock {
+		return nil, lastBlock.Uint64(), nil
+	}
+
+	logs, err := api.client.FilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{api.address},
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   lastBlock,
+		Topics: [][]common.Hash{
+			{topic},
+			{common.BytesToHash(from.Bytes())},
+		},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	transfers := make([]*GatekeeperTransfer, 0, len(logs))
+	for _, l := range logs {
+		// Both the transaction number and the value are indexed.
+		if len(l.Topics) < 4 {
+			return nil, 0, errors.New("transfer topics are malformed")
+		}
+
+		header, err := api.client.HeaderByNumber(ctx, new(big.Int).SetUint64(l.BlockNumber))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		transfers = append(transfers, &GatekeeperTransfer{
+			From:        common.BytesToAddress(l.Topics[1].Bytes()),
+			TxNumber:    l.Topics[2].Big(),
+			Value:       l.Topics[3].Big(),
+			BlockNumber: l.BlockNumber,
+			TS:          header.Time.Uint64(),
+		})
+	}
+
+	return transfers, lastBlock.Uint64(), nil
+}
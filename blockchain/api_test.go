@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sonm-io/core/blockchain/market"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func changeRequestLog(topic common.Hash, id int64) *types.Log {
+	return &types.Log{Topics: []common.Hash{topic, common.BigToHash(big.NewInt(id))}}
+}
+
+func TestFindChangeRequestID(t *testing.T) {
+	requests := map[int64]*pb.DealChangeRequest{
+		1: {DealID: pb.NewBigIntFromInt(10), RequestType: pb.OrderType_BID},
+		2: {DealID: pb.NewBigIntFromInt(10), RequestType: pb.OrderType_ASK},
+		3: {DealID: pb.NewBigIntFromInt(20), RequestType: pb.OrderType_ASK},
+	}
+	getInfo := func(ctx context.Context, id *big.Int) (*pb.DealChangeRequest, error) {
+		request, ok := requests[id.Int64()]
+		if !ok {
+			return nil, fmt.Errorf("change request %s not found", id.String())
+		}
+		return request, nil
+	}
+
+	tests := []struct {
+		name        string
+		logs        []*types.Log
+		requestType pb.OrderType
+		id          int64
+	}{
+		{
+			name: "sent request",
+			logs: []*types.Log{
+				changeRequestLog(market.DealChangeRequestSentTopic, 2),
+			},
+			requestType: pb.OrderType_ASK,
+			id:          2,
+		},
+		{
+			name: "counterparty's request updated before ours is sent",
+			logs: []*types.Log{
+				changeRequestLog(market.DealChangeRequestUpdatedTopic, 1),
+				changeRequestLog(market.DealChangeRequestSentTopic, 2),
+				changeRequestLog(market.DealChangeRequestUpdatedTopic, 2),
+			},
+			requestType: pb.OrderType_ASK,
+			id:          2,
+		},
+		{
+			name: "request accepted immediately",
+			logs: []*types.Log{
+				{Topics: []common.Hash{market.DealChangeRequestUpdatedTopic}},
+				changeRequestLog(market.DealChangeRequestUpdatedTopic, 2),
+				changeRequestLog(market.DealChangeRequestUpdatedTopic, 1),
+			},
+			requestType: pb.OrderType_BID,
+			id:          1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id, err := findChangeRequestID(context.Background(), test.logs, big.NewInt(10), test.requestType, getInfo)
+			require.NoError(t, err)
+			assert.Equal(t, big.NewInt(test.id), id)
+		})
+	}
+}
+
+func TestFindChangeRequestIDOfAnotherDeal(t *testing.T) {
+	getInfo := func(ctx context.Context, id *big.Int) (*pb.DealChangeRequest, error) {
+		return &pb.DealChangeRequest{DealID: pb.NewBigIntFromInt(20), RequestType: pb.OrderType_ASK}, nil
+	}
+
+	logs := []*types.Log{changeRequestLog(market.DealChangeRequestSentTopic, 3)}
+	_, err := findChangeRequestID(context.Background(), logs, big.NewInt(10), pb.OrderType_ASK, getInfo)
+	assert.Error(t, err)
+}
@@ -31,6 +31,8 @@ type CustomEthereumClient interface {
 	GetLastBlock(ctx context.Context) (*big.Int, error)
 	// GetTransactionReceipt returns receipt of mined transaction or notFound if tx not mined
 	GetTransactionReceipt(ctx context.Context, txHash common.Hash) (*Receipt, error)
+	// NonceAt returns the nonce of the account at the given block, nil means the latest one
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
 }
 
 type CustomClient struct {
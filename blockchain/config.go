@@ -1,7 +1,11 @@
 package blockchain
 
 import (
+	"math/big"
 	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sonm-io/core/blockchain/market"
 )
 
 // Config represents SONM blockchain configuration structure that can act as a
@@ -9,14 +13,74 @@ import (
 type Config struct {
 	Endpoint          url.URL
 	SidechainEndpoint url.URL
+	// ChainID and SidechainID are used to sign transactions according to
+	// EIP-155. Nil values mean signing without replay protection.
+	ChainID     *big.Int
+	SidechainID *big.Int
+	// Contracts overrides addresses of SONM contracts. Zero addresses are
+	// resolved to the default ones.
+	Contracts ContractsConfig
 }
 
-func (m *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
-	var cfg struct {
-		Endpoint          string `yaml:"endpoint"`
-		SidechainEndpoint string `yaml:"sidechain_endpoint"`
+// ContractsConfig holds addresses of SONM contracts.
+type ContractsConfig struct {
+	SNM                 common.Address `yaml:"snm"`
+	SNMSidechain        common.Address `yaml:"snm_sidechain"`
+	Market              common.Address `yaml:"market"`
+	Blacklist           common.Address `yaml:"blacklist"`
+	ProfileRegistry     common.Address `yaml:"profile_registry"`
+	OracleUSD           common.Address `yaml:"oracle_usd"`
+	GatekeeperLive      common.Address `yaml:"gatekeeper_live"`
+	GatekeeperSidechain common.Address `yaml:"gatekeeper_sidechain"`
+}
+
+func defaultContracts() ContractsConfig {
+	return ContractsConfig{
+		SNM:                 market.SNMAddr(),
+		SNMSidechain:        market.SNMSidechainAddr(),
+		Market:              market.MarketAddr(),
+		Blacklist:           market.BlacklistAddr(),
+		ProfileRegistry:     market.ProfileRegistryAddr(),
+		OracleUSD:           market.OracleUsdAddr(),
+		GatekeeperLive:      market.GatekeeperLiveAddr(),
+		GatekeeperSidechain: market.GatekeeperSidechainAddr(),
+	}
+}
+
+// merge overrides addresses with non-zero addresses from the given config.
+func (m *ContractsConfig) merge(other ContractsConfig) {
+	overrides := []struct {
+		dst *common.Address
+		src common.Address
+	}{
+		{&m.SNM, other.SNM},
+		{&m.SNMSidechain, other.SNMSidechain},
+		{&m.Market, other.Market},
+		{&m.Blacklist, other.Blacklist},
+		{&m.ProfileRegistry, other.ProfileRegistry},
+		{&m.OracleUSD, other.OracleUSD},
+		{&m.GatekeeperLive, other.GatekeeperLive},
+		{&m.GatekeeperSidechain, other.GatekeeperSidechain},
 	}
 
+	for _, override := range overrides {
+		if override.src != (common.Address{}) {
+			*override.dst = override.src
+		}
+	}
+}
+
+type yamlConfig struct {
+	Endpoint          string          `yaml:"endpoint"`
+	SidechainEndpoint string          `yaml:"sidechain_endpoint"`
+	ChainID           uint64          `yaml:"chain_id,omitempty"`
+	SidechainID       uint64          `yaml:"sidechain_id,omitempty"`
+	Contracts         ContractsConfig `yaml:"contracts,omitempty"`
+}
+
+func (m *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var cfg yamlConfig
+
 	if err := unmarshal(&cfg); err != nil {
 		return err
 	}
@@ -41,6 +105,33 @@ func (m *Config) UnmarshalYAML(unmarshal func(interface{}) error) error {
 
 	m.Endpoint = *endpoint
 	m.SidechainEndpoint = *sidechainEndpoint
+	m.Contracts = cfg.Contracts
+
+	if cfg.ChainID != 0 {
+		m.ChainID = new(big.Int).SetUint64(cfg.ChainID)
+	}
+
+	if cfg.SidechainID != 0 {
+		m.SidechainID = new(big.Int).SetUint64(cfg.SidechainID)
+	}
 
 	return nil
 }
+
+func (m Config) MarshalYAML() (interface{}, error) {
+	cfg := yamlConfig{
+		Endpoint:          m.Endpoint.String(),
+		SidechainEndpoint: m.SidechainEndpoint.String(),
+		Contracts:         m.Contracts,
+	}
+
+	if m.ChainID != nil {
+		cfg.ChainID = m.ChainID.Uint64()
+	}
+
+	if m.SidechainID != nil {
+		cfg.SidechainID = m.SidechainID.Uint64()
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,63 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestConfigUnmarshalContracts(t *testing.T) {
+	input := `
+endpoint: "http://localhost:8545"
+sidechain_endpoint: "http://localhost:8546"
+chain_id: 1337
+contracts:
+  market: "0x8125721c2413d99a33e351e1f6bb4e56b6b633fd"
+`
+	cfg := &Config{}
+	require.NoError(t, yaml.Unmarshal([]byte(input), cfg))
+
+	assert.Equal(t, "http://localhost:8545", cfg.Endpoint.String())
+	assert.Equal(t, "http://localhost:8546", cfg.SidechainEndpoint.String())
+	assert.Equal(t, big.NewInt(1337), cfg.ChainID)
+	assert.Nil(t, cfg.SidechainID)
+	assert.Equal(t, common.HexToAddress("0x8125721c2413d99a33e351e1f6bb4e56b6b633fd"), cfg.Contracts.Market)
+	assert.Equal(t, common.Address{}, cfg.Contracts.Blacklist)
+}
+
+func TestConfigMarshalRoundTrip(t *testing.T) {
+	input := `
+endpoint: "http://localhost:8545"
+sidechain_endpoint: "http://localhost:8545"
+chain_id: 1337
+sidechain_id: 1337
+contracts:
+  snm: "0x0000000000000000000000000000000000000001"
+  gatekeeper_sidechain: "0x0000000000000000000000000000000000000002"
+`
+	cfg := &Config{}
+	require.NoError(t, yaml.Unmarshal([]byte(input), cfg))
+
+	data, err := yaml.Marshal(cfg)
+	require.NoError(t, err)
+
+	restored := &Config{}
+	require.NoError(t, yaml.Unmarshal(data, restored))
+	assert.Equal(t, cfg, restored)
+}
+
+func TestContractsConfigMerge(t *testing.T) {
+	contracts := ContractsConfig{
+		SNM:    common.HexToAddress("0x1"),
+		Market: common.HexToAddress("0x2"),
+	}
+
+	contracts.merge(ContractsConfig{Market: common.HexToAddress("0x3")})
+
+	assert.Equal(t, common.HexToAddress("0x1"), contracts.SNM)
+	assert.Equal(t, common.HexToAddress("0x3"), contracts.Market)
+}
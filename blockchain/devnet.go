@@ -0,0 +1,175 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	marketAPI "github.com/sonm-io/core/blockchain/market/api"
+	pb "github.com/sonm-io/core/proto"
+)
+
+const (
+	// Contract deployments do not fit into defaultGasLimitForSidechain, while
+	// dev chains usually have the block gas limit of about 6.7M.
+	devnetGasLimit = 6500000
+	// devnetFreezingTime is the time in seconds gatekeepers hold payouts for.
+	devnetFreezingTime = 60
+)
+
+var (
+	// devnetTokenPrice is the initial SNM price in USD (1 SNM = 1 USD) set
+	// into the oracle, otherwise deals can not be opened.
+	devnetTokenPrice = big.NewInt(1e18)
+)
+
+// DeployDevnet deploys the full set of SONM contracts to a development chain,
+// e.g. ganache or geth in "--dev" mode, and returns the config pointing to
+// them. The same chain acts both as the masterchain and the sidechain.
+//
+// The key's account must be funded, it becomes the owner of all contracts and
+// the holder of all tokens.
+func DeployDevnet(ctx context.Context, key *ecdsa.PrivateKey, endpoint string, chainID *big.Int, gasPrice int64) (*Config, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &devnetDeployer{
+		ctx:      ctx,
+		client:   client,
+		key:      key,
+		gasPrice: gasPrice,
+		chainID:  chainID,
+	}
+
+	contracts := ContractsConfig{}
+	if contracts.SNM, err = d.deploy("SNM", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := marketAPI.DeploySNM(opts, client)
+		return tx, err
+	}); err != nil {
+		return nil, err
+	}
+
+	if contracts.SNMSidechain, err = d.deploy("sidechain SNM", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := marketAPI.DeploySNM(opts, client)
+		return tx, err
+	}); err != nil {
+		return nil, err
+	}
+
+	var blacklist *marketAPI.Blacklist
+	if contracts.Blacklist, err = d.deploy("Blacklist", func(opts *bind.TransactOpts) (tx *types.Transaction, err error) {
+		_, tx, blacklist, err = marketAPI.DeployBlacklist(opts, client)
+		return tx, err
+	}); err != nil {
+		return nil, err
+	}
+
+	if contracts.ProfileRegistry, err = d.deploy("ProfileRegistry", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := marketAPI.DeployProfileRegistry(opts, client)
+		return tx, err
+	}); err != nil {
+		return nil, err
+	}
+
+	var oracle *marketAPI.OracleUSD
+	if contracts.OracleUSD, err = d.deploy("OracleUSD", func(opts *bind.TransactOpts) (tx *types.Transaction, err error) {
+		_, tx, oracle, err = marketAPI.DeployOracleUSD(opts, client)
+		return tx, err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := d.transact("set oracle price", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return oracle.SetCurrentPrice(opts, devnetTokenPrice)
+	}); err != nil {
+		return nil, err
+	}
+
+	if contracts.Market, err = d.deploy("Market", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := marketAPI.DeployMarket(opts, client, contracts.SNMSidechain, contracts.Blacklist,
+			contracts.OracleUSD, contracts.ProfileRegistry, big.NewInt(pb.MinNumBenchmarks), big.NewInt(pb.NumNetflags))
+		return tx, err
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := d.transact("set blacklist market address", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return blacklist.SetMarketAddress(opts, contracts.Market)
+	}); err != nil {
+		return nil, err
+	}
+
+	if contracts.GatekeeperLive, err = d.deploy("masterchain Gatekeeper", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := marketAPI.DeploySimpleGatekeeperWithLimit(opts, client, contracts.SNM, big.NewInt(devnetFreezingTime))
+		return tx, err
+	}); err != nil {
+		return nil, err
+	}
+
+	if contracts.GatekeeperSidechain, err = d.deploy("sidechain Gatekeeper", func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := marketAPI.DeploySimpleGatekeeperWithLimit(opts, client, contracts.SNMSidechain, big.NewInt(devnetFreezingTime))
+		return tx, err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		Endpoint:          *endpointURL,
+		SidechainEndpoint: *endpointURL,
+		ChainID:           chainID,
+		SidechainID:       chainID,
+		Contracts:         contracts,
+	}, nil
+}
+
+type devnetDeployer struct {
+	ctx      context.Context
+	client   CustomEthereumClient
+	key      *ecdsa.PrivateKey
+	gasPrice int64
+	chainID  *big.Int
+}
+
+func (m *devnetDeployer) deploy(name string, fn func(opts *bind.TransactOpts) (*types.Transaction, error)) (common.Address, error) {
+	tx, err := fn(getTxOpts(m.ctx, m.key, devnetGasLimit, m.gasPrice, m.chainID))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy %s: %v", name, err)
+	}
+
+	address, err := bind.WaitDeployed(m.ctx, m.client, tx)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to wait for %s deployment: %v", name, err)
+	}
+
+	return address, nil
+}
+
+func (m *devnetDeployer) transact(name string, fn func(opts *bind.TransactOpts) (*types.Transaction, error)) error {
+	tx, err := fn(getTxOpts(m.ctx, m.key, devnetGasLimit, m.gasPrice, m.chainID))
+	if err != nil {
+		return fmt.Errorf("failed to %s: %v", name, err)
+	}
+
+	receipt, err := bind.WaitMined(m.ctx, m.client, tx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for %s: %v", name, err)
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("failed to %s: transaction failed", name)
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package blockchain
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
 
 const (
 	defaultEthEndpoint        = "https://rinkeby.infura.io/00iTrs5PIy0uGODwcsrb"
@@ -15,6 +18,9 @@ type options struct {
 	gasPriceSidechain    int64
 	apiEndpoint          string
 	apiSidechainEndpoint string
+	chainID              *big.Int
+	sidechainID          *big.Int
+	contracts            ContractsConfig
 	logParsePeriod       time.Duration
 	blockConfirmations   int64
 }
@@ -25,6 +31,7 @@ func defaultOptions() *options {
 		gasPriceSidechain:    defaultGasPriceSidechain,
 		apiEndpoint:          defaultEthEndpoint,
 		apiSidechainEndpoint: defaultSidechainEndpoint,
+		contracts:            defaultContracts(),
 		logParsePeriod:       time.Second,
 		blockConfirmations:   defaultBlockConfirmations,
 	}
@@ -55,6 +62,9 @@ func WithConfig(cfg *Config) Option {
 		if cfg != nil {
 			o.apiEndpoint = cfg.Endpoint.String()
 			o.apiSidechainEndpoint = cfg.SidechainEndpoint.String()
+			o.chainID = cfg.ChainID
+			o.sidechainID = cfg.SidechainID
+			o.contracts.merge(cfg.Contracts)
 		}
 	}
 }
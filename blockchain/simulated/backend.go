@@ -0,0 +1,286 @@
+// Package simulated provides an in-memory implementation of blockchain.API
+// for tests.
+//
+// Contracts are modelled after their Solidity counterparts closely enough to
+// run scenarios like "order -> deal -> bill -> close" end-to-end, including
+// token transfers and emitted events, but without gas, signatures and
+// network. Each transaction is mined into its own block immediately, and the
+// chain clock only moves when told so, which allows to travel in time for
+// checking deal expiry and billing.
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/blockchain"
+)
+
+var (
+	// DefaultMarketAddress is the address the simulated market contract is
+	// deployed at. Consumers must approve it to spend their sidechain tokens
+	// before placing bids.
+	DefaultMarketAddress = common.HexToAddress("0x000000000000000000000000000000000000a001")
+	// DefaultTokenPrice is the initial USD/SNM rate of the oracle, 1 SNM = 1 USD.
+	DefaultTokenPrice = big.NewInt(1e18)
+)
+
+var _ blockchain.API = (*Backend)(nil)
+
+type options struct {
+	now           time.Time
+	marketAddress common.Address
+	tokenPrice    *big.Int
+}
+
+type Option func(*options)
+
+// WithTime sets the initial time of the chain.
+func WithTime(now time.Time) Option {
+	return func(o *options) {
+		o.now = now
+	}
+}
+
+func WithMarketAddress(address common.Address) Option {
+	return func(o *options) {
+		o.marketAddress = address
+	}
+}
+
+// WithTokenPrice sets the initial USD/SNM rate of the oracle.
+func WithTokenPrice(price *big.Int) Option {
+	return func(o *options) {
+		o.tokenPrice = price
+	}
+}
+
+// Backend is an in-memory blockchain implementing blockchain.API.
+//
+// All methods are safe for concurrent use.
+type Backend struct {
+	mu            sync.Mutex
+	now           time.Time
+	marketAddress common.Address
+
+	blockNumber uint64
+	events      []*blockchain.Event
+	// newBlock is closed and replaced each time a block is mined.
+	newBlock chan struct{}
+	nonces   map[common.Address]uint64
+
+	market          *market
+	liveToken       *token
+	sideToken       *token
+	blacklist       *blacklist
+	profileRegistry *profileRegistry
+	oracle          *oracle
+	liveGatekeeper  *gatekeeper
+	sideGatekeeper  *gatekeeper
+}
+
+func NewBackend(opts ...Option) *Backend {
+	o := &options{
+		now:           time.Now(),
+		marketAddress: DefaultMarketAddress,
+		tokenPrice:    DefaultTokenPrice,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	m := &Backend{
+		now:           o.now,
+		marketAddress: o.marketAddress,
+		newBlock:      make(chan struct{}),
+		nonces:        map[common.Address]uint64{},
+	}
+
+	m.market = newMarket()
+	m.liveToken = newToken()
+	m.sideToken = newToken()
+	m.blacklist = newBlacklist(o.marketAddress)
+	m.profileRegistry = newProfileRegistry()
+	m.oracle = newOracle(o.tokenPrice)
+	m.liveGatekeeper = newGatekeeper(DefaultLiveGatekeeperAddress, m.liveToken)
+	m.sideGatekeeper = newGatekeeper(DefaultSideGatekeeperAddress, m.sideToken)
+
+	return m
+}
+
+func (m *Backend) Market() blockchain.MarketAPI {
+	return &marketAPI{m}
+}
+
+func (m *Backend) LiveToken() blockchain.TokenAPI {
+	return &tokenAPI{m, m.liveToken}
+}
+
+func (m *Backend) SideToken() blockchain.TokenAPI {
+	return &tokenAPI{m, m.sideToken}
+}
+
+func (m *Backend) TestToken() blockchain.TestTokenAPI {
+	return &testTokenAPI{m}
+}
+
+func (m *Backend) Blacklist() blockchain.BlacklistAPI {
+	return &blacklistAPI{m}
+}
+
+func (m *Backend) ProfileRegistry() blockchain.ProfileRegistryAPI {
+	return &profileRegistryAPI{m}
+}
+
+func (m *Backend) Events() blockchain.EventsAPI {
+	return &eventsAPI{m}
+}
+
+func (m *Backend) OracleUSD() blockchain.OracleAPI {
+	return &oracleAPI{m}
+}
+
+func (m *Backend) LiveGatekeeper() blockchain.GatekeeperAPI {
+	return &gatekeeperAPI{m, m.liveGatekeeper, m.sideGatekeeper}
+}
+
+func (m *Backend) SideGatekeeper() blockchain.GatekeeperAPI {
+	return &gatekeeperAPI{m, m.sideGatekeeper, m.liveGatekeeper}
+}
+
+func (m *Backend) Transactions() blockchain.TransactionsAPI {
+	return &transactionsAPI{}
+}
+
+// MarketAddress returns the address of the market contract.
+func (m *Backend) MarketAddress() common.Address {
+	return m.marketAddress
+}
+
+// Now returns the current time of the chain, i.e. the timestamp the next
+// block is mined with.
+func (m *Backend) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.now
+}
+
+// AdvanceTime moves the chain clock forward.
+func (m *Backend) AdvanceTime(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.now = m.now.Add(d)
+}
+
+// BlockNumber returns the number of the latest mined block.
+func (m *Backend) BlockNumber() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.blockNumber
+}
+
+// MintLive credits masterchain tokens to the given address out of thin air.
+func (m *Backend) MintLive(to common.Address, amount *big.Int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.liveToken.mint(to, amount)
+}
+
+// MintSidechain credits sidechain tokens to the given address out of thin air.
+func (m *Backend) MintSidechain(to common.Address, amount *big.Int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sideToken.mint(to, amount)
+}
+
+// transact executes the function as a single transaction mined into a new
+// block. Events emitted by the function are published only if it succeeds.
+//
+// Unlike the EVM, state changes made by a failed function are not rolled
+// back, hence functions must check everything that can fail before making
+// any changes.
+func (m *Backend) transact(key *ecdsa.PrivateKey, fn func(tx *txContext) error) (*types.Transaction, error) {
+	return m.transactAs(crypto.PubkeyToAddress(key.PublicKey), fn)
+}
+
+func (m *Backend) transactAs(sender common.Address, fn func(tx *txContext) error) (*types.Transaction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := &txContext{
+		sender:      sender,
+		now:         m.now,
+		blockNumber: m.blockNumber + 1,
+	}
+
+	if err := fn(tx); err != nil {
+		return nil, err
+	}
+
+	nonce := m.nonces[sender]
+	m.nonces[sender]++
+
+	m.mine(tx.events)
+
+	return types.NewTransaction(nonce, m.marketAddress, big.NewInt(0), 0, big.NewInt(0), nil), nil
+}
+
+func (m *Backend) mine(data []interface{}) {
+	m.blockNumber++
+	hash := blockHash(m.blockNumber)
+	for idx, value := range data {
+		m.events = append(m.events, &blockchain.Event{
+			Data:        value,
+			BlockNumber: m.blockNumber,
+			BlockHash:   hash,
+			LogIndex:    uint(idx),
+			TS:          uint64(m.now.Unix()),
+		})
+	}
+
+	close(m.newBlock)
+	m.newBlock = make(chan struct{})
+}
+
+func blockHash(number uint64) common.Hash {
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, number)
+	return crypto.Keccak256Hash(data)
+}
+
+// transactionsAPI reports no pending transactions, because transactions are
+// mined as soon as they are sent.
+type transactionsAPI struct{}
+
+func (m *transactionsAPI) Pending() []*blockchain.PendingTx {
+	return nil
+}
+
+func (m *transactionsAPI) Wait(ctx context.Context, tx *types.Transaction) (*blockchain.Receipt, error) {
+	return &blockchain.Receipt{Receipt: &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful}}, nil
+}
+
+// txContext holds the sender and events of the transaction being executed.
+type txContext struct {
+	sender common.Address
+	now    time.Time
+	// blockNumber is the number of the block the transaction is mined into.
+	blockNumber uint64
+	events      []interface{}
+}
+
+func (m *txContext) emit(data interface{}) {
+	m.events = append(m.events, data)
+}
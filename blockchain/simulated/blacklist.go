@@ -0,0 +1,103 @@
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sonm-io/core/blockchain"
+)
+
+type blacklist struct {
+	marketAddress common.Address
+	// entries maps blacklist owners to blacklisted addresses.
+	entries map[common.Address]map[common.Address]bool
+	// masters are allowed to add entries on behalf of others.
+	masters map[common.Address]bool
+}
+
+func newBlacklist(marketAddress common.Address) *blacklist {
+	return &blacklist{
+		marketAddress: marketAddress,
+		entries:       map[common.Address]map[common.Address]bool{},
+		masters:       map[common.Address]bool{},
+	}
+}
+
+func (m *blacklist) check(who, whom common.Address) bool {
+	return m.entries[who][whom]
+}
+
+func (m *blacklist) add(tx *txContext, who, whom common.Address) {
+	if m.check(who, whom) {
+		return
+	}
+
+	if _, ok := m.entries[who]; !ok {
+		m.entries[who] = map[common.Address]bool{}
+	}
+	m.entries[who][whom] = true
+
+	tx.emit(&blockchain.AddedToBlacklistData{AdderID: who, AddeeID: whom})
+}
+
+type blacklistAPI struct {
+	backend *Backend
+}
+
+func (m *blacklistAPI) Check(ctx context.Context, who, whom common.Address) (bool, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return m.backend.blacklist.check(who, whom), nil
+}
+
+func (m *blacklistAPI) Add(ctx context.Context, key *ecdsa.PrivateKey, who, whom common.Address) (*types.Transaction, error) {
+	return m.backend.transact(key, func(tx *txContext) error {
+		blacklist := m.backend.blacklist
+		if tx.sender != who && tx.sender != blacklist.marketAddress && !blacklist.masters[tx.sender] {
+			return errors.New("sender is not allowed to modify the blacklist")
+		}
+
+		blacklist.add(tx, who, whom)
+		return nil
+	})
+}
+
+func (m *blacklistAPI) Remove(ctx context.Context, key *ecdsa.PrivateKey, whom common.Address) error {
+	_, err := m.backend.transact(key, func(tx *txContext) error {
+		blacklist := m.backend.blacklist
+		if !blacklist.check(tx.sender, whom) {
+			return errors.New("address is not blacklisted")
+		}
+
+		delete(blacklist.entries[tx.sender], whom)
+		tx.emit(&blockchain.RemovedFromBlacklistData{RemoverID: tx.sender, RemoveeID: whom})
+		return nil
+	})
+
+	return err
+}
+
+func (m *blacklistAPI) AddMaster(ctx context.Context, key *ecdsa.PrivateKey, root common.Address) (*types.Transaction, error) {
+	return m.backend.transact(key, func(tx *txContext) error {
+		m.backend.blacklist.masters[root] = true
+		return nil
+	})
+}
+
+func (m *blacklistAPI) RemoveMaster(ctx context.Context, key *ecdsa.PrivateKey, root common.Address) (*types.Transaction, error) {
+	return m.backend.transact(key, func(tx *txContext) error {
+		delete(m.backend.blacklist.masters, root)
+		return nil
+	})
+}
+
+func (m *blacklistAPI) SetMarketAddress(ctx context.Context, key *ecdsa.PrivateKey, market common.Address) (*types.Transaction, error) {
+	return m.backend.transact(key, func(tx *txContext) error {
+		m.backend.blacklist.marketAddress = market
+		return nil
+	})
+}
@@ -0,0 +1,63 @@
+package simulated
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sonm-io/core/blockchain"
+)
+
+type eventsAPI struct {
+	backend *Backend
+}
+
+// GetEvents streams events starting from the given block, following new
+// blocks until the context is cancelled.
+func (m *eventsAPI) GetEvents(ctx context.Context, fromBlockInitial *big.Int) (chan *blockchain.Event, error) {
+	out := make(chan *blockchain.Event, 128)
+
+	go func() {
+		fromBlock := fromBlockInitial.Uint64()
+		cursor := 0
+		for {
+			m.backend.mu.Lock()
+			events := m.backend.events[cursor:]
+			cursor = len(m.backend.events)
+			newBlock := m.backend.newBlock
+			m.backend.mu.Unlock()
+
+			for _, event := range events {
+				if event.BlockNumber < fromBlock {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case out <- event:
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-newBlock:
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (m *eventsAPI) GetBlockHash(ctx context.Context, number *big.Int) (common.Hash, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	if !number.IsUint64() || number.Uint64() > m.backend.blockNumber {
+		return common.Hash{}, fmt.Errorf("block %s is not mined yet", number.String())
+	}
+
+	return blockHash(number.Uint64()), nil
+}
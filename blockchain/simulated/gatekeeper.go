@@ -0,0 +1,183 @@
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sonm-io/core/blockchain"
+)
+
+var (
+	// DefaultLiveGatekeeperAddress is the address the masterchain gatekeeper
+	// is deployed at.
+	DefaultLiveGatekeeperAddress = common.HexToAddress("0x000000000000000000000000000000000000a002")
+	// DefaultSideGatekeeperAddress is the address the sidechain gatekeeper is
+	// deployed at.
+	DefaultSideGatekeeperAddress = common.HexToAddress("0x000000000000000000000000000000000000a003")
+)
+
+// gatekeeper locks tokens paid in on its chain and pays out tokens paid in
+// on the other chain.
+type gatekeeper struct {
+	address common.Address
+	token   *token
+	payIns  []*blockchain.GatekeeperTransfer
+	payouts []*blockchain.GatekeeperTransfer
+	paidOut map[common.Address]map[string]bool
+}
+
+func newGatekeeper(address common.Address, token *token) *gatekeeper {
+	return &gatekeeper{
+		address: address,
+		token:   token,
+		paidOut: map[common.Address]map[string]bool{},
+	}
+}
+
+func (m *gatekeeper) payIn(tx *txContext, value *big.Int) (*big.Int, error) {
+	if err := m.token.canTransferFrom(tx.sender, m.address, value); err != nil {
+		return nil, err
+	}
+
+	m.token.transferFrom(tx.sender, m.address, m.address, value)
+
+	txNumber := big.NewInt(int64(len(m.payIns) + 1))
+	m.payIns = append(m.payIns, &blockchain.GatekeeperTransfer{
+		From:        tx.sender,
+		TxNumber:    txNumber,
+		Value:       new(big.Int).Set(value),
+		BlockNumber: tx.blockNumber,
+		TS:          uint64(tx.now.Unix()),
+	})
+
+	return txNumber, nil
+}
+
+func (m *gatekeeper) isPaidOut(from common.Address, txNumber *big.Int) bool {
+	return m.paidOut[from][txNumber.String()]
+}
+
+// payout pays out the transfer made to the other gatekeeper in the given
+// block. Looking it up there stands in for the merkle proof verification.
+func (m *gatekeeper) payout(other *gatekeeper, from common.Address, txNumber, value *big.Int, blockNumber uint64, now time.Time) error {
+	if m.isPaidOut(from, txNumber) {
+		return errors.New("transfer is already paid out")
+	}
+
+	found := false
+	for _, payIn := range other.payIns {
+		if payIn.From == from && payIn.TxNumber.Cmp(txNumber) == 0 && payIn.Value.Cmp(value) == 0 {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.New("transfer is not paid in")
+	}
+	if err := m.token.canTransfer(m.address, value); err != nil {
+		return err
+	}
+
+	m.token.transfer(m.address, from, value)
+	if _, ok := m.paidOut[from]; !ok {
+		m.paidOut[from] = map[string]bool{}
+	}
+	m.paidOut[from][txNumber.String()] = true
+	m.payouts = append(m.payouts, &blockchain.GatekeeperTransfer{
+		From:        from,
+		TxNumber:    new(big.Int).Set(txNumber),
+		Value:       new(big.Int).Set(value),
+		BlockNumber: blockNumber,
+		TS:          uint64(now.Unix()),
+	})
+
+	return nil
+}
+
+func filterTransfers(transfers []*blockchain.GatekeeperTransfer, from common.Address, fromBlock uint64) []*blockchain.GatekeeperTransfer {
+	var result []*blockchain.GatekeeperTransfer
+	for _, transfer := range transfers {
+		if transfer.From == from && transfer.BlockNumber >= fromBlock {
+			clone := *transfer
+			result = append(result, &clone)
+		}
+	}
+
+	return result
+}
+
+// RelayTransfers pays out all transfers paid in to either gatekeeper, but
+// not paid out yet, acting as the gatekeeper service. Gatekeepers pay out of
+// their own balance, so they must be funded beforehand.
+func (m *Backend) RelayTransfers() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Payouts are mined into a single block.
+	blockNumber := m.blockNumber + 1
+	paid := false
+	for _, pair := range [][2]*gatekeeper{{m.liveGatekeeper, m.sideGatekeeper}, {m.sideGatekeeper, m.liveGatekeeper}} {
+		from, to := pair[0], pair[1]
+		for _, payIn := range from.payIns {
+			if to.isPaidOut(payIn.From, payIn.TxNumber) {
+				continue
+			}
+			if err := to.payout(from, payIn.From, payIn.TxNumber, payIn.Value, blockNumber, m.now); err != nil {
+				return err
+			}
+			paid = true
+		}
+	}
+
+	if paid {
+		m.mine(nil)
+	}
+
+	return nil
+}
+
+type gatekeeperAPI struct {
+	backend    *Backend
+	gatekeeper *gatekeeper
+	other      *gatekeeper
+}
+
+func (m *gatekeeperAPI) Address() common.Address {
+	return m.gatekeeper.address
+}
+
+func (m *gatekeeperAPI) PayIn(ctx context.Context, key *ecdsa.PrivateKey, value *big.Int) (*big.Int, error) {
+	var txNumber *big.Int
+	_, err := m.backend.transact(key, func(tx *txContext) (err error) {
+		txNumber, err = m.gatekeeper.payIn(tx, value)
+		return err
+	})
+
+	return txNumber, err
+}
+
+func (m *gatekeeperAPI) Payout(ctx context.Context, key *ecdsa.PrivateKey, proof []byte, root common.Hash, from common.Address, txNumber, value *big.Int) error {
+	_, err := m.backend.transact(key, func(tx *txContext) error {
+		return m.gatekeeper.payout(m.other, from, txNumber, value, tx.blockNumber, tx.now)
+	})
+
+	return err
+}
+
+func (m *gatekeeperAPI) PayIns(ctx context.Context, from common.Address, fromBlock uint64) ([]*blockchain.GatekeeperTransfer, uint64, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return filterTransfers(m.gatekeeper.payIns, from, fromBlock), m.backend.blockNumber, nil
+}
+
+func (m *gatekeeperAPI) Payouts(ctx context.Context, from common.Address, fromBlock uint64) ([]*blockchain.GatekeeperTransfer, uint64, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return filterTransfers(m.gatekeeper.payouts, from, fromBlock), m.backend.blockNumber, nil
+}
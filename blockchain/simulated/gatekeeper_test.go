@@ -0,0 +1,54 @@
+package simulated
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatekeeperDeposit(t *testing.T) {
+	ctx := context.Background()
+	backend := NewBackend()
+	user := newParty(t)
+
+	backend.MintLive(user.address, payment(1000))
+	backend.MintSidechain(DefaultSideGatekeeperAddress, payment(1000))
+
+	_, err := backend.LiveToken().Approve(ctx, user.key, backend.LiveGatekeeper().Address().Hex(), payment(400))
+	require.NoError(t, err)
+
+	txNumber, err := backend.LiveGatekeeper().PayIn(ctx, user.key, payment(400))
+	require.NoError(t, err)
+
+	payIns, lastBlock, err := backend.LiveGatekeeper().PayIns(ctx, user.address, 0)
+	require.NoError(t, err)
+	require.Len(t, payIns, 1)
+	assert.Equal(t, txNumber, payIns[0].TxNumber)
+	assert.Equal(t, payment(400), payIns[0].Value)
+
+	payouts, _, err := backend.SideGatekeeper().Payouts(ctx, user.address, 0)
+	require.NoError(t, err)
+	assert.Empty(t, payouts)
+
+	require.NoError(t, backend.RelayTransfers())
+
+	// Only blocks after the last one searched are searched again.
+	payouts, _, err = backend.SideGatekeeper().Payouts(ctx, user.address, lastBlock+1)
+	require.NoError(t, err)
+	require.Len(t, payouts, 1)
+	assert.Equal(t, txNumber, payouts[0].TxNumber)
+
+	live, err := backend.LiveToken().BalanceOf(ctx, user.address.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, payment(600), live)
+
+	side, err := backend.SideToken().BalanceOf(ctx, user.address.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, payment(400), side)
+
+	// The same transfer can not be paid out twice.
+	err = backend.SideGatekeeper().Payout(ctx, user.key, nil, [32]byte{}, user.address, txNumber, payment(400))
+	assert.Error(t, err)
+}
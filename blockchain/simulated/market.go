@@ -0,0 +1,760 @@
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/protobuf/proto"
+	"github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+)
+
+const (
+	// spotPeriod is the period funds are reserved for in spot deals.
+	spotPeriod = time.Hour
+	// forwardPeriod is the maximum period funds are reserved for in forward
+	// deals.
+	forwardPeriod = 24 * time.Hour
+)
+
+var (
+	errNotDealParty = errors.New("sender is not a party of the deal")
+	errDealClosed   = errors.New("deal is closed")
+)
+
+// market mirrors the Market contract. Orders, deals and change requests are
+// indexed by their ID minus one.
+type market struct {
+	orders         []*pb.Order
+	deals          []*pb.Deal
+	changeRequests []*pb.DealChangeRequest
+	// masters maps confirmed workers to their masters.
+	masters map[common.Address]common.Address
+	// workerRequests holds announced, but not yet confirmed workers of each
+	// master.
+	workerRequests map[common.Address]map[common.Address]bool
+}
+
+func newMarket() *market {
+	return &market{
+		masters:        map[common.Address]common.Address{},
+		workerRequests: map[common.Address]map[common.Address]bool{},
+	}
+}
+
+func (m *market) order(id *big.Int) (*pb.Order, error) {
+	if id == nil || id.Sign() <= 0 || id.Cmp(big.NewInt(int64(len(m.orders)))) > 0 {
+		return nil, fmt.Errorf("no order with id = %v", id)
+	}
+
+	return m.orders[id.Int64()-1], nil
+}
+
+func (m *market) deal(id *big.Int) (*pb.Deal, error) {
+	if id == nil || id.Sign() <= 0 || id.Cmp(big.NewInt(int64(len(m.deals)))) > 0 {
+		return nil, fmt.Errorf("no deal with id = %v", id)
+	}
+
+	return m.deals[id.Int64()-1], nil
+}
+
+func (m *market) changeRequest(id *big.Int) (*pb.DealChangeRequest, error) {
+	if id == nil || id.Sign() <= 0 || id.Cmp(big.NewInt(int64(len(m.changeRequests)))) > 0 {
+		return nil, fmt.Errorf("no change request with id = %v", id)
+	}
+
+	return m.changeRequests[id.Int64()-1], nil
+}
+
+// pendingChangeRequest returns the latest change request of the given side
+// of the deal, waiting for the counterparty's decision.
+func (m *market) pendingChangeRequest(dealID *big.Int, requestType pb.OrderType) *pb.DealChangeRequest {
+	for idx := len(m.changeRequests) - 1; idx >= 0; idx-- {
+		request := m.changeRequests[idx]
+		if request.GetDealID().Unwrap().Cmp(dealID) == 0 && request.GetRequestType() == requestType &&
+			request.GetStatus() == pb.ChangeRequestStatus_REQUEST_CREATED {
+			return request
+		}
+	}
+
+	return nil
+}
+
+func (m *market) master(worker common.Address) common.Address {
+	if master, ok := m.masters[worker]; ok {
+		return master
+	}
+
+	return worker
+}
+
+func isDealParty(deal *pb.Deal, address common.Address) bool {
+	return address == deal.GetSupplierID().Unwrap() || address == deal.GetConsumerID().Unwrap() ||
+		address == deal.GetMasterID().Unwrap()
+}
+
+// calculatePayment converts the price in USD per second for the given period
+// to SNM.
+func (m *Backend) calculatePayment(price *big.Int, period time.Duration) *big.Int {
+	amount := new(big.Int).Mul(m.oracle.price, price)
+	amount.Mul(amount, big.NewInt(int64(period/time.Second)))
+	return amount.Div(amount, big.NewInt(1e18))
+}
+
+func (m *Backend) placeOrder(tx *txContext, order *pb.Order) (*big.Int, error) {
+	if order.GetOrderType() != pb.OrderType_ASK && order.GetOrderType() != pb.OrderType_BID {
+		return nil, errors.New("unknown order type")
+	}
+	if len(order.GetBenchmarks().GetValues()) != pb.MinNumBenchmarks {
+		return nil, fmt.Errorf("expected %d benchmarks, got %d", pb.MinNumBenchmarks, len(order.GetBenchmarks().GetValues()))
+	}
+	if len(order.GetTag()) > 32 {
+		return nil, errors.New("tag value is too long")
+	}
+	if order.GetPrice() == nil {
+		return nil, errors.New("order price is required")
+	}
+
+	frozenSum := big.NewInt(0)
+	if order.GetOrderType() == pb.OrderType_BID {
+		period := time.Duration(order.GetDuration()) * time.Second
+		switch {
+		case order.GetDuration() == 0:
+			period = spotPeriod
+		case period > forwardPeriod:
+			period = forwardPeriod
+		}
+
+		frozenSum = m.calculatePayment(order.GetPrice().Unwrap(), period)
+		if err := m.sideToken.canTransferFrom(tx.sender, m.marketAddress, frozenSum); err != nil {
+			return nil, fmt.Errorf("failed to freeze bid funds: %v", err)
+		}
+		m.sideToken.transferFrom(tx.sender, m.marketAddress, m.marketAddress, frozenSum)
+	}
+
+	tag := make([]byte, 32)
+	copy(tag, order.GetTag())
+
+	id := big.NewInt(int64(len(m.market.orders) + 1))
+	m.market.orders = append(m.market.orders, &pb.Order{
+		Id:             pb.NewBigInt(id),
+		DealID:         pb.NewBigInt(big.NewInt(0)),
+		OrderType:      order.GetOrderType(),
+		OrderStatus:    pb.OrderStatus_ORDER_ACTIVE,
+		AuthorID:       pb.NewEthAddress(tx.sender),
+		CounterpartyID: pb.NewEthAddress(order.GetCounterpartyID().Unwrap()),
+		Duration:       order.GetDuration(),
+		Price:          pb.NewBigInt(new(big.Int).Set(order.GetPrice().Unwrap())),
+		Netflags:       order.GetNetflags(),
+		IdentityLevel:  order.GetIdentityLevel(),
+		Blacklist:      common.HexToAddress(order.GetBlacklist()).String(),
+		Tag:            tag,
+		Benchmarks:     &pb.Benchmarks{Values: append([]uint64{}, order.GetBenchmarks().GetValues()...)},
+		FrozenSum:      pb.NewBigInt(frozenSum),
+	})
+
+	tx.emit(&blockchain.OrderPlacedData{ID: id})
+	return id, nil
+}
+
+func (m *Backend) cancelOrder(tx *txContext, id *big.Int) error {
+	order, err := m.market.order(id)
+	if err != nil {
+		return err
+	}
+	if order.GetOrderStatus() != pb.OrderStatus_ORDER_ACTIVE {
+		return errors.New("order is not active")
+	}
+	if order.GetAuthorID().Unwrap() != tx.sender {
+		return errors.New("sender is not the author of the order")
+	}
+
+	if order.GetOrderType() == pb.OrderType_BID {
+		m.sideToken.transfer(m.marketAddress, tx.sender, order.GetFrozenSum().Unwrap())
+	}
+	order.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
+
+	tx.emit(&blockchain.OrderUpdatedData{ID: id})
+	return nil
+}
+
+func (m *Backend) checkMatch(ask, bid *pb.Order) error {
+	if ask.GetOrderStatus() != pb.OrderStatus_ORDER_ACTIVE || bid.GetOrderStatus() != pb.OrderStatus_ORDER_ACTIVE {
+		return errors.New("orders must be active")
+	}
+	if ask.GetOrderType() != pb.OrderType_ASK || bid.GetOrderType() != pb.OrderType_BID {
+		return errors.New("orders must be an ask and a bid")
+	}
+
+	supplier := ask.GetAuthorID().Unwrap()
+	consumer := bid.GetAuthorID().Unwrap()
+	master := m.market.master(supplier)
+
+	askCounterparty := ask.GetCounterpartyID().Unwrap()
+	bidCounterparty := bid.GetCounterpartyID().Unwrap()
+	if askCounterparty != (common.Address{}) && askCounterparty != m.market.master(consumer) {
+		return errors.New("bid author is not the ask counterparty")
+	}
+	if bidCounterparty != (common.Address{}) && bidCounterparty != master {
+		return errors.New("ask author is not the bid counterparty")
+	}
+
+	if m.blacklist.check(common.HexToAddress(bid.GetBlacklist()), master) ||
+		m.blacklist.check(consumer, master) ||
+		m.blacklist.check(supplier, consumer) ||
+		m.blacklist.check(common.HexToAddress(ask.GetBlacklist()), consumer) {
+		return errors.New("orders are blacklisted")
+	}
+
+	if ask.GetPrice().Unwrap().Cmp(bid.GetPrice().Unwrap()) > 0 {
+		return errors.New("ask price is higher than bid price")
+	}
+	if ask.GetDuration() < bid.GetDuration() {
+		return errors.New("ask duration is shorter than bid duration")
+	}
+
+	if m.profileRegistry.identityLevel(consumer) < ask.GetIdentityLevel() {
+		return errors.New("consumer identity level is too low")
+	}
+	if m.profileRegistry.identityLevel(supplier) < bid.GetIdentityLevel() {
+		return errors.New("supplier identity level is too low")
+	}
+
+	if ask.GetNetflags()&bid.GetNetflags() != bid.GetNetflags() {
+		return errors.New("ask netflags do not satisfy bid netflags")
+	}
+	if !ask.GetBenchmarks().Contains(bid.GetBenchmarks()) {
+		return errors.New("ask benchmarks do not satisfy bid benchmarks")
+	}
+
+	return nil
+}
+
+func (m *Backend) openDeal(tx *txContext, askID, bidID *big.Int) (*big.Int, error) {
+	ask, err := m.market.order(askID)
+	if err != nil {
+		return nil, err
+	}
+	bid, err := m.market.order(bidID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkMatch(ask, bid); err != nil {
+		return nil, err
+	}
+
+	id := big.NewInt(int64(len(m.market.deals) + 1))
+
+	ask.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
+	ask.DealID = pb.NewBigInt(id)
+	bid.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
+	bid.DealID = pb.NewBigInt(id)
+	tx.emit(&blockchain.OrderUpdatedData{ID: askID})
+	tx.emit(&blockchain.OrderUpdatedData{ID: bidID})
+
+	endTime := &pb.Timestamp{}
+	if bid.GetDuration() != 0 {
+		endTime.Seconds = tx.now.Add(time.Duration(bid.GetDuration()) * time.Second).Unix()
+	}
+
+	supplier := ask.GetAuthorID().Unwrap()
+	m.market.deals = append(m.market.deals, &pb.Deal{
+		Id:             pb.NewBigInt(id),
+		Benchmarks:     &pb.Benchmarks{Values: append([]uint64{}, ask.GetBenchmarks().GetValues()...)},
+		SupplierID:     pb.NewEthAddress(supplier),
+		ConsumerID:     pb.NewEthAddress(bid.GetAuthorID().Unwrap()),
+		MasterID:       pb.NewEthAddress(m.market.master(supplier)),
+		AskID:          pb.NewBigInt(askID),
+		BidID:          pb.NewBigInt(bidID),
+		Duration:       bid.GetDuration(),
+		Price:          pb.NewBigInt(new(big.Int).Set(ask.GetPrice().Unwrap())),
+		StartTime:      &pb.Timestamp{Seconds: tx.now.Unix()},
+		EndTime:        endTime,
+		Status:         pb.DealStatus_DEAL_ACCEPTED,
+		BlockedBalance: pb.NewBigInt(new(big.Int).Set(bid.GetFrozenSum().Unwrap())),
+		TotalPayout:    pb.NewBigInt(big.NewInt(0)),
+		LastBillTS:     &pb.Timestamp{Seconds: tx.now.Unix()},
+	})
+
+	tx.emit(&blockchain.DealOpenedData{ID: id})
+	return id, nil
+}
+
+// reserveFunds moves the consumer's tokens to the deal's blocked balance.
+func (m *Backend) reserveFunds(deal *pb.Deal, amount *big.Int) bool {
+	consumer := deal.GetConsumerID().Unwrap()
+	if m.sideToken.canTransferFrom(consumer, m.marketAddress, amount) != nil {
+		return false
+	}
+
+	m.sideToken.transferFrom(consumer, m.marketAddress, m.marketAddress, amount)
+	deal.BlockedBalance = pb.NewBigInt(new(big.Int).Add(deal.GetBlockedBalance().Unwrap(), amount))
+	return true
+}
+
+// payout pays the amount from the deal's blocked balance to the master.
+func (m *Backend) payout(tx *txContext, deal *pb.Deal, amount *big.Int) {
+	m.sideToken.transfer(m.marketAddress, deal.GetMasterID().Unwrap(), amount)
+	deal.BlockedBalance = pb.NewBigInt(new(big.Int).Sub(deal.GetBlockedBalance().Unwrap(), amount))
+	deal.TotalPayout = pb.NewBigInt(new(big.Int).Add(deal.GetTotalPayout().Unwrap(), amount))
+	deal.LastBillTS = &pb.Timestamp{Seconds: tx.now.Unix()}
+	tx.emit(&blockchain.BilledData{DealID: deal.GetId().Unwrap(), PaidAmount: amount})
+}
+
+// bill pays for the time passed since the last bill. If the consumer can not
+// afford it, the deal is closed.
+func (m *Backend) bill(tx *txContext, deal *pb.Deal) {
+	var period time.Duration
+	lastBillTS := deal.GetLastBillTS().Unix()
+	if deal.IsSpot() {
+		period = tx.now.Sub(lastBillTS)
+	} else {
+		endTime := deal.GetEndTime().Unix()
+		if !lastBillTS.Before(endTime) {
+			return
+		}
+		if tx.now.After(endTime) {
+			period = endTime.Sub(lastBillTS)
+		} else {
+			period = tx.now.Sub(lastBillTS)
+		}
+	}
+
+	amount := m.calculatePayment(deal.GetPrice().Unwrap(), period)
+	blockedBalance := deal.GetBlockedBalance().Unwrap()
+	if amount.Cmp(blockedBalance) > 0 && !m.reserveFunds(deal, new(big.Int).Sub(amount, blockedBalance)) {
+		m.payout(tx, deal, blockedBalance)
+		m.closeDeal(tx, deal)
+		return
+	}
+
+	m.payout(tx, deal, amount)
+}
+
+// reserveNextPeriod blocks the consumer's funds for the next billing period.
+// If the consumer can not afford it, the deal is closed.
+func (m *Backend) reserveNextPeriod(tx *txContext, deal *pb.Deal) {
+	if deal.GetStatus() == pb.DealStatus_DEAL_CLOSED {
+		return
+	}
+
+	period := spotPeriod
+	if !deal.IsSpot() {
+		endTime := deal.GetEndTime().Unix()
+		if tx.now.After(endTime) {
+			return
+		}
+
+		period = endTime.Sub(tx.now)
+		if period > forwardPeriod {
+			period = forwardPeriod
+		}
+	}
+
+	amount := m.calculatePayment(deal.GetPrice().Unwrap(), period)
+	blockedBalance := deal.GetBlockedBalance().Unwrap()
+	// The blocked balance is not earned yet, hence it is returned to the
+	// consumer instead of being paid out.
+	if amount.Cmp(blockedBalance) > 0 && !m.reserveFunds(deal, new(big.Int).Sub(amount, blockedBalance)) {
+		m.closeDeal(tx, deal)
+		m.refund(deal)
+	}
+}
+
+func (m *Backend) closeDeal(tx *txContext, deal *pb.Deal) {
+	if deal.GetStatus() == pb.DealStatus_DEAL_CLOSED {
+		return
+	}
+
+	deal.Status = pb.DealStatus_DEAL_CLOSED
+	deal.EndTime = &pb.Timestamp{Seconds: tx.now.Unix()}
+	tx.emit(&blockchain.DealUpdatedData{ID: deal.GetId().Unwrap()})
+}
+
+// refund returns the rest of the blocked balance to the consumer.
+func (m *Backend) refund(deal *pb.Deal) {
+	blockedBalance := deal.GetBlockedBalance().Unwrap()
+	if blockedBalance.Sign() == 0 {
+		return
+	}
+
+	m.sideToken.transfer(m.marketAddress, deal.GetConsumerID().Unwrap(), blockedBalance)
+	deal.BlockedBalance = pb.NewBigInt(big.NewInt(0))
+}
+
+func (m *Backend) acceptedDeal(tx *txContext, dealID *big.Int) (*pb.Deal, error) {
+	deal, err := m.market.deal(dealID)
+	if err != nil {
+		return nil, err
+	}
+	if deal.GetStatus() != pb.DealStatus_DEAL_ACCEPTED {
+		return nil, errDealClosed
+	}
+	if !isDealParty(deal, tx.sender) {
+		return nil, errNotDealParty
+	}
+
+	return deal, nil
+}
+
+func (m *Backend) createChangeRequest(tx *txContext, request *pb.DealChangeRequest) (*big.Int, error) {
+	deal, err := m.acceptedDeal(tx, request.GetDealID().Unwrap())
+	if err != nil {
+		return nil, err
+	}
+	if deal.IsSpot() && request.GetDuration() != 0 {
+		return nil, errors.New("duration of spot deal can not be changed")
+	}
+	if request.GetPrice() == nil {
+		return nil, errors.New("change request price is required")
+	}
+
+	requestType := pb.OrderType_ASK
+	counterpartyType := pb.OrderType_BID
+	if tx.sender == deal.GetConsumerID().Unwrap() {
+		requestType, counterpartyType = pb.OrderType_BID, pb.OrderType_ASK
+	}
+
+	id := big.NewInt(int64(len(m.market.changeRequests) + 1))
+	created := &pb.DealChangeRequest{
+		Id:          pb.NewBigInt(id),
+		DealID:      deal.GetId(),
+		RequestType: requestType,
+		Duration:    request.GetDuration(),
+		Price:       pb.NewBigInt(new(big.Int).Set(request.GetPrice().Unwrap())),
+		Status:      pb.ChangeRequestStatus_REQUEST_CREATED,
+		CreatedTS:   &pb.Timestamp{Seconds: tx.now.Unix()},
+	}
+
+	// A new request replaces the previous one of the same side.
+	if previous := m.market.pendingChangeRequest(deal.GetId().Unwrap(), requestType); previous != nil {
+		previous.Status = pb.ChangeRequestStatus_REQUEST_CANCELED
+		tx.emit(&blockchain.DealChangeRequestUpdatedData{ID: previous.GetId().Unwrap()})
+	}
+	m.market.changeRequests = append(m.market.changeRequests, created)
+	tx.emit(&blockchain.DealChangeRequestSentData{ID: id})
+
+	price := created.GetPrice().Unwrap()
+	dealPrice := deal.GetPrice().Unwrap()
+	newPrice, newDuration := price, created.GetDuration()
+	// A matching request of the counterparty accepts both.
+	matching := m.market.pendingChangeRequest(deal.GetId().Unwrap(), counterpartyType)
+	if matching != nil {
+		ask, bid := matching, created
+		if requestType == pb.OrderType_ASK {
+			ask, bid = created, matching
+		}
+
+		if ask.GetDuration() < bid.GetDuration() || ask.GetPrice().Unwrap().Cmp(bid.GetPrice().Unwrap()) > 0 {
+			matching = nil
+		} else {
+			newPrice, newDuration = ask.GetPrice().Unwrap(), bid.GetDuration()
+		}
+	}
+
+	// Otherwise changes in favor of the counterparty are accepted immediately.
+	favorable := created.GetDuration() == deal.GetDuration() &&
+		(requestType == pb.OrderType_BID && price.Cmp(dealPrice) > 0 || requestType == pb.OrderType_ASK && price.Cmp(dealPrice) < 0)
+
+	if matching == nil && !favorable {
+		return id, nil
+	}
+
+	created.Status = pb.ChangeRequestStatus_REQUEST_ACCEPTED
+	if matching != nil {
+		matching.Status = pb.ChangeRequestStatus_REQUEST_ACCEPTED
+		tx.emit(&blockchain.DealChangeRequestUpdatedData{ID: matching.GetId().Unwrap()})
+	}
+
+	// The time passed is paid by the old price.
+	m.bill(tx, deal)
+	m.reserveNextPeriod(tx, deal)
+	if deal.GetStatus() == pb.DealStatus_DEAL_ACCEPTED {
+		deal.Price = pb.NewBigInt(new(big.Int).Set(newPrice))
+		if !deal.IsSpot() {
+			deal.Duration = newDuration
+			deal.EndTime = &pb.Timestamp{Seconds: deal.GetStartTime().Unix().Add(time.Duration(newDuration) * time.Second).Unix()}
+		}
+		tx.emit(&blockchain.DealUpdatedData{ID: deal.GetId().Unwrap()})
+	}
+	tx.emit(&blockchain.DealChangeRequestUpdatedData{ID: id})
+
+	return id, nil
+}
+
+func (m *Backend) cancelChangeRequest(tx *txContext, id *big.Int) error {
+	request, err := m.market.changeRequest(id)
+	if err != nil {
+		return err
+	}
+	deal, err := m.market.deal(request.GetDealID().Unwrap())
+	if err != nil {
+		return err
+	}
+	if !isDealParty(deal, tx.sender) {
+		return errNotDealParty
+	}
+	if request.GetStatus() != pb.ChangeRequestStatus_REQUEST_CREATED {
+		return fmt.Errorf("change request is %s", request.GetStatus())
+	}
+
+	isConsumer := tx.sender == deal.GetConsumerID().Unwrap()
+	if isConsumer == (request.GetRequestType() == pb.OrderType_BID) {
+		request.Status = pb.ChangeRequestStatus_REQUEST_CANCELED
+	} else {
+		request.Status = pb.ChangeRequestStatus_REQUEST_REJECTED
+	}
+
+	tx.emit(&blockchain.DealChangeRequestUpdatedData{ID: id})
+	return nil
+}
+
+type marketAPI struct {
+	backend *Backend
+}
+
+func (m *marketAPI) OpenDeal(ctx context.Context, key *ecdsa.PrivateKey, askID, bidID *big.Int) <-chan blockchain.DealOrError {
+	ch := make(chan blockchain.DealOrError, 1)
+
+	var id *big.Int
+	_, err := m.backend.transact(key, func(tx *txContext) (err error) {
+		id, err = m.backend.openDeal(tx, askID, bidID)
+		return err
+	})
+	if err != nil {
+		ch <- blockchain.DealOrError{Err: err}
+		return ch
+	}
+
+	deal, err := m.GetDealInfo(ctx, id)
+	ch <- blockchain.DealOrError{Deal: deal, Err: err}
+	return ch
+}
+
+func (m *marketAPI) CloseDeal(ctx context.Context, key *ecdsa.PrivateKey, dealID *big.Int, blacklisted bool) <-chan error {
+	ch := make(chan error, 1)
+
+	_, err := m.backend.transact(key, func(tx *txContext) error {
+		deal, err := m.backend.acceptedDeal(tx, dealID)
+		if err != nil {
+			return err
+		}
+
+		consumer := deal.GetConsumerID().Unwrap()
+		if !deal.IsSpot() && !tx.now.After(deal.GetEndTime().Unix()) && tx.sender != consumer {
+			return errors.New("only consumer can close forward deal before its end")
+		}
+		if blacklisted && tx.sender != consumer {
+			return errors.New("only consumer can blacklist the counterparty")
+		}
+
+		m.backend.bill(tx, deal)
+		m.backend.closeDeal(tx, deal)
+		m.backend.refund(deal)
+		if blacklisted {
+			m.backend.blacklist.add(tx, consumer, deal.GetMasterID().Unwrap())
+		}
+		return nil
+	})
+
+	ch <- err
+	return ch
+}
+
+func (m *marketAPI) GetDealInfo(ctx context.Context, dealID *big.Int) (*pb.Deal, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	deal, err := m.backend.market.deal(dealID)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Clone(deal).(*pb.Deal), nil
+}
+
+func (m *marketAPI) GetDealsAmount(ctx context.Context) (*big.Int, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return big.NewInt(int64(len(m.backend.market.deals))), nil
+}
+
+func (m *marketAPI) PlaceOrder(ctx context.Context, key *ecdsa.PrivateKey, order *pb.Order) <-chan blockchain.OrderOrError {
+	ch := make(chan blockchain.OrderOrError, 1)
+
+	var id *big.Int
+	_, err := m.backend.transact(key, func(tx *txContext) (err error) {
+		id, err = m.backend.placeOrder(tx, order)
+		return err
+	})
+	if err != nil {
+		ch <- blockchain.OrderOrError{Err: err}
+		return ch
+	}
+
+	placed, err := m.GetOrderInfo(ctx, id)
+	ch <- blockchain.OrderOrError{Order: placed, Err: err}
+	return ch
+}
+
+func (m *marketAPI) CancelOrder(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) <-chan error {
+	ch := make(chan error, 1)
+
+	_, err := m.backend.transact(key, func(tx *txContext) error {
+		return m.backend.cancelOrder(tx, id)
+	})
+
+	ch <- err
+	return ch
+}
+
+func (m *marketAPI) GetOrderInfo(ctx context.Context, orderID *big.Int) (*pb.Order, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	order, err := m.backend.market.order(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Clone(order).(*pb.Order), nil
+}
+
+func (m *marketAPI) GetOrdersAmount(ctx context.Context) (*big.Int, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return big.NewInt(int64(len(m.backend.market.orders))), nil
+}
+
+func (m *marketAPI) Bill(ctx context.Context, key *ecdsa.PrivateKey, dealID *big.Int) <-chan error {
+	ch := make(chan error, 1)
+
+	_, err := m.backend.transact(key, func(tx *txContext) error {
+		deal, err := m.backend.acceptedDeal(tx, dealID)
+		if err != nil {
+			return err
+		}
+
+		m.backend.bill(tx, deal)
+		m.backend.reserveNextPeriod(tx, deal)
+		return nil
+	})
+
+	ch <- err
+	return ch
+}
+
+func (m *marketAPI) RegisterWorker(ctx context.Context, key *ecdsa.PrivateKey, master common.Address) <-chan error {
+	ch := make(chan error, 1)
+
+	_, err := m.backend.transact(key, func(tx *txContext) error {
+		market := m.backend.market
+		if market.master(tx.sender) != tx.sender {
+			return errors.New("worker already has a master")
+		}
+		if market.master(master) != master {
+			return errors.New("master is a worker itself")
+		}
+
+		if _, ok := market.workerRequests[master]; !ok {
+			market.workerRequests[master] = map[common.Address]bool{}
+		}
+		market.workerRequests[master][tx.sender] = true
+
+		tx.emit(&blockchain.WorkerAnnouncedData{SlaveID: tx.sender, MasterID: master})
+		return nil
+	})
+
+	ch <- err
+	return ch
+}
+
+func (m *marketAPI) ConfirmWorker(ctx context.Context, key *ecdsa.PrivateKey, slave common.Address) <-chan error {
+	ch := make(chan error, 1)
+
+	_, err := m.backend.transact(key, func(tx *txContext) error {
+		market := m.backend.market
+		if !market.workerRequests[tx.sender][slave] {
+			return errors.New("worker has not been announced")
+		}
+
+		delete(market.workerRequests[tx.sender], slave)
+		market.masters[slave] = tx.sender
+
+		tx.emit(&blockchain.WorkerConfirmedData{SlaveID: slave, MasterID: tx.sender})
+		return nil
+	})
+
+	ch <- err
+	return ch
+}
+
+func (m *marketAPI) RemoveWorker(ctx context.Context, key *ecdsa.PrivateKey, master, slave common.Address) <-chan error {
+	ch := make(chan error, 1)
+
+	_, err := m.backend.transact(key, func(tx *txContext) error {
+		market := m.backend.market
+		if actual, ok := market.masters[slave]; !ok || actual != master {
+			return errors.New("worker is not confirmed by the master")
+		}
+		if tx.sender != master && tx.sender != slave {
+			return errors.New("sender is neither the worker nor the master")
+		}
+
+		delete(market.masters, slave)
+
+		tx.emit(&blockchain.WorkerRemovedData{SlaveID: slave, MasterID: master})
+		return nil
+	})
+
+	ch <- err
+	return ch
+}
+
+func (m *marketAPI) GetMaster(ctx context.Context, slave common.Address) (common.Address, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return m.backend.market.master(slave), nil
+}
+
+func (m *marketAPI) GetDealChangeRequestInfo(ctx context.Context, id *big.Int) (*pb.DealChangeRequest, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	request, err := m.backend.market.changeRequest(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Clone(request).(*pb.DealChangeRequest), nil
+}
+
+func (m *marketAPI) CreateChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, changeRequest *pb.DealChangeRequest) (*big.Int, error) {
+	var id *big.Int
+	_, err := m.backend.transact(key, func(tx *txContext) (err error) {
+		id, err = m.backend.createChangeRequest(tx, changeRequest)
+		return err
+	})
+
+	return id, err
+}
+
+func (m *marketAPI) CancelChangeRequest(ctx context.Context, key *ecdsa.PrivateKey, id *big.Int) error {
+	_, err := m.backend.transact(key, func(tx *txContext) error {
+		return m.backend.cancelChangeRequest(tx, id)
+	})
+
+	return err
+}
+
+func (m *marketAPI) GetNumBenchmarks(ctx context.Context) (uint64, error) {
+	return pb.MinNumBenchmarks, nil
+}
@@ -0,0 +1,215 @@
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pricePerSecond is chosen so that, with the default oracle rate, payments
+// are equal to price multiplied by seconds.
+var pricePerSecond = big.NewInt(1e12)
+
+type party struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+func newParty(t *testing.T) party {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	return party{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func payment(seconds int64) *big.Int {
+	return new(big.Int).Mul(pricePerSecond, big.NewInt(seconds))
+}
+
+func newOrder(orderType pb.OrderType, duration time.Duration) *pb.Order {
+	return &pb.Order{
+		OrderType:  orderType,
+		Duration:   uint64(duration / time.Second),
+		Price:      pb.NewBigInt(pricePerSecond),
+		Benchmarks: &pb.Benchmarks{Values: make([]uint64, pb.MinNumBenchmarks)},
+	}
+}
+
+type dealFixture struct {
+	backend  *Backend
+	supplier party
+	consumer party
+	deal     *pb.Deal
+}
+
+// openDeal funds the consumer with the given amount and opens a deal of the
+// given duration between a fresh supplier and consumer.
+func openDeal(t *testing.T, duration time.Duration, funds *big.Int) *dealFixture {
+	ctx := context.Background()
+	backend := NewBackend(WithTime(time.Unix(1500000000, 0)))
+	supplier := newParty(t)
+	consumer := newParty(t)
+
+	backend.MintSidechain(consumer.address, funds)
+	_, err := backend.SideToken().Approve(ctx, consumer.key, backend.MarketAddress().Hex(), funds)
+	require.NoError(t, err)
+
+	ask := <-backend.Market().PlaceOrder(ctx, supplier.key, newOrder(pb.OrderType_ASK, duration))
+	require.NoError(t, ask.Err)
+	bid := <-backend.Market().PlaceOrder(ctx, consumer.key, newOrder(pb.OrderType_BID, duration))
+	require.NoError(t, bid.Err)
+
+	deal := <-backend.Market().OpenDeal(ctx, consumer.key, ask.Order.GetId().Unwrap(), bid.Order.GetId().Unwrap())
+	require.NoError(t, deal.Err)
+
+	return &dealFixture{
+		backend:  backend,
+		supplier: supplier,
+		consumer: consumer,
+		deal:     deal.Deal,
+	}
+}
+
+func (m *dealFixture) balance(t *testing.T, address common.Address) *big.Int {
+	balance, err := m.backend.SideToken().BalanceOf(context.Background(), address.Hex())
+	require.NoError(t, err)
+	return balance
+}
+
+func TestForwardDealLifecycle(t *testing.T) {
+	ctx := context.Background()
+	m := openDeal(t, 2*time.Hour, payment(10000))
+	dealID := m.deal.GetId().Unwrap()
+
+	assert.Equal(t, pb.DealStatus_DEAL_ACCEPTED, m.deal.GetStatus())
+	assert.Equal(t, m.supplier.address, m.deal.GetMasterID().Unwrap())
+	assert.Equal(t, payment(7200), m.deal.GetBlockedBalance().Unwrap())
+	assert.Equal(t, payment(2800), m.balance(t, m.consumer.address))
+
+	// The supplier can not close the forward deal before its end.
+	require.Error(t, <-m.backend.Market().CloseDeal(ctx, m.supplier.key, dealID, false))
+
+	m.backend.AdvanceTime(time.Hour)
+	require.NoError(t, <-m.backend.Market().Bill(ctx, m.supplier.key, dealID))
+	assert.Equal(t, payment(3600), m.balance(t, m.supplier.address))
+
+	// Billing after the deal end pays only for the time up to the end.
+	m.backend.AdvanceTime(2 * time.Hour)
+	require.NoError(t, <-m.backend.Market().CloseDeal(ctx, m.supplier.key, dealID, false))
+
+	deal, err := m.backend.Market().GetDealInfo(ctx, dealID)
+	require.NoError(t, err)
+	assert.Equal(t, pb.DealStatus_DEAL_CLOSED, deal.GetStatus())
+	assert.Equal(t, payment(7200), deal.GetTotalPayout().Unwrap())
+	assert.True(t, deal.GetBlockedBalance().IsZero())
+	assert.Equal(t, payment(7200), m.balance(t, m.supplier.address))
+	assert.Equal(t, payment(2800), m.balance(t, m.consumer.address))
+}
+
+func TestSpotDealClosesWhenConsumerRunsOutOfFunds(t *testing.T) {
+	ctx := context.Background()
+	m := openDeal(t, 0, payment(5400))
+	dealID := m.deal.GetId().Unwrap()
+
+	assert.Equal(t, payment(3600), m.deal.GetBlockedBalance().Unwrap())
+
+	m.backend.AdvanceTime(time.Hour)
+	require.NoError(t, <-m.backend.Market().Bill(ctx, m.supplier.key, dealID))
+
+	deal, err := m.backend.Market().GetDealInfo(ctx, dealID)
+	require.NoError(t, err)
+	assert.Equal(t, pb.DealStatus_DEAL_CLOSED, deal.GetStatus())
+	assert.Equal(t, m.backend.Now().Unix(), deal.GetEndTime().GetSeconds())
+	assert.Equal(t, payment(3600), m.balance(t, m.supplier.address))
+	assert.Equal(t, payment(1800), m.balance(t, m.consumer.address))
+}
+
+func TestChangeRequestApprovedByCounterparty(t *testing.T) {
+	ctx := context.Background()
+	m := openDeal(t, 2*time.Hour, payment(100000))
+	dealID := m.deal.GetId().Unwrap()
+	newPrice := new(big.Int).Mul(pricePerSecond, big.NewInt(2))
+
+	id, err := m.backend.Market().CreateChangeRequest(ctx, m.supplier.key, &pb.DealChangeRequest{
+		DealID:   pb.NewBigInt(dealID),
+		Duration: m.deal.GetDuration(),
+		Price:    pb.NewBigInt(newPrice),
+	})
+	require.NoError(t, err)
+
+	request, err := m.backend.Market().GetDealChangeRequestInfo(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, pb.OrderType_ASK, request.GetRequestType())
+	assert.Equal(t, pb.ChangeRequestStatus_REQUEST_CREATED, request.GetStatus())
+
+	_, err = m.backend.Market().CreateChangeRequest(ctx, m.consumer.key, &pb.DealChangeRequest{
+		DealID:   pb.NewBigInt(dealID),
+		Duration: m.deal.GetDuration(),
+		Price:    pb.NewBigInt(newPrice),
+	})
+	require.NoError(t, err)
+
+	request, err = m.backend.Market().GetDealChangeRequestInfo(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, pb.ChangeRequestStatus_REQUEST_ACCEPTED, request.GetStatus())
+
+	deal, err := m.backend.Market().GetDealInfo(ctx, dealID)
+	require.NoError(t, err)
+	assert.Equal(t, newPrice, deal.GetPrice().Unwrap())
+}
+
+func TestEventsFollowTransactions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := openDeal(t, time.Hour, payment(10000))
+
+	events, err := m.backend.Events().GetEvents(ctx, big.NewInt(0))
+	require.NoError(t, err)
+
+	expected := []interface{}{
+		&blockchain.OrderPlacedData{ID: big.NewInt(1)},
+		&blockchain.OrderPlacedData{ID: big.NewInt(2)},
+		&blockchain.OrderUpdatedData{ID: big.NewInt(1)},
+		&blockchain.OrderUpdatedData{ID: big.NewInt(2)},
+		&blockchain.DealOpenedData{ID: big.NewInt(1)},
+	}
+	for _, data := range expected {
+		select {
+		case event := <-events:
+			assert.Equal(t, data, event.Data)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+	}
+
+	hash, err := m.backend.Events().GetBlockHash(ctx, big.NewInt(int64(m.backend.BlockNumber())))
+	require.NoError(t, err)
+	assert.NotEqual(t, common.Hash{}, hash)
+}
+
+func TestCloseDealWithBlacklist(t *testing.T) {
+	ctx := context.Background()
+	m := openDeal(t, 0, payment(10000))
+	dealID := m.deal.GetId().Unwrap()
+
+	// Failed transactions must leave no traces.
+	require.Error(t, <-m.backend.Market().CloseDeal(ctx, m.supplier.key, dealID, true))
+	blacklisted, err := m.backend.Blacklist().Check(ctx, m.consumer.address, m.supplier.address)
+	require.NoError(t, err)
+	assert.False(t, blacklisted)
+
+	require.NoError(t, <-m.backend.Market().CloseDeal(ctx, m.consumer.key, dealID, true))
+	blacklisted, err = m.backend.Blacklist().Check(ctx, m.consumer.address, m.supplier.address)
+	require.NoError(t, err)
+	assert.True(t, blacklisted)
+}
@@ -0,0 +1,142 @@
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+)
+
+type profileRegistry struct {
+	validators     map[common.Address]int8
+	certificates   []*pb.Certificate
+	identityLevels map[common.Address]pb.IdentityLevel
+}
+
+func newProfileRegistry() *profileRegistry {
+	return &profileRegistry{
+		validators:     map[common.Address]int8{},
+		identityLevels: map[common.Address]pb.IdentityLevel{},
+	}
+}
+
+func (m *profileRegistry) identityLevel(owner common.Address) pb.IdentityLevel {
+	return m.identityLevels[owner]
+}
+
+// AddValidator registers a validator with the given level.
+func (m *Backend) AddValidator(id common.Address, level int8) {
+	m.transactAs(id, func(tx *txContext) error {
+		m.profileRegistry.validators[id] = level
+		tx.emit(&blockchain.ValidatorCreatedData{ID: id})
+		return nil
+	})
+}
+
+func (m *Backend) RemoveValidator(id common.Address) {
+	m.transactAs(id, func(tx *txContext) error {
+		delete(m.profileRegistry.validators, id)
+		tx.emit(&blockchain.ValidatorDeletedData{ID: id})
+		return nil
+	})
+}
+
+// CreateCertificate issues a certificate by the validator and returns its ID.
+func (m *Backend) CreateCertificate(validatorID, ownerID common.Address, attribute uint64, value []byte) (*big.Int, error) {
+	var id *big.Int
+	_, err := m.transactAs(validatorID, func(tx *txContext) error {
+		if _, ok := m.profileRegistry.validators[validatorID]; !ok {
+			return errors.New("sender is not a validator")
+		}
+
+		registry := m.profileRegistry
+		registry.certificates = append(registry.certificates, &pb.Certificate{
+			ValidatorID: pb.NewEthAddress(validatorID),
+			OwnerID:     pb.NewEthAddress(ownerID),
+			Attribute:   attribute,
+			Value:       value,
+		})
+		id = big.NewInt(int64(len(registry.certificates)))
+		tx.emit(&blockchain.CertificateCreatedData{ID: id})
+		return nil
+	})
+
+	return id, err
+}
+
+// SetIdentityLevel sets the identity level of the profile, which the real
+// registry derives from certificates.
+func (m *Backend) SetIdentityLevel(owner common.Address, level pb.IdentityLevel) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.profileRegistry.identityLevels[owner] = level
+}
+
+type profileRegistryAPI struct {
+	backend *Backend
+}
+
+func (m *profileRegistryAPI) GetValidator(ctx context.Context, validatorID common.Address) (*pb.Validator, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return &pb.Validator{
+		Id:    pb.NewEthAddress(validatorID),
+		Level: uint64(m.backend.profileRegistry.validators[validatorID]),
+	}, nil
+}
+
+func (m *profileRegistryAPI) GetCertificate(ctx context.Context, certificateID *big.Int) (*pb.Certificate, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	certificates := m.backend.profileRegistry.certificates
+	if certificateID.Sign() <= 0 || certificateID.Cmp(big.NewInt(int64(len(certificates)))) > 0 {
+		return nil, fmt.Errorf("no certificate with id = %s", certificateID.String())
+	}
+
+	certificate := certificates[certificateID.Int64()-1]
+	return &pb.Certificate{
+		ValidatorID: certificate.GetValidatorID(),
+		OwnerID:     certificate.GetOwnerID(),
+		Attribute:   certificate.GetAttribute(),
+		Value:       certificate.GetValue(),
+	}, nil
+}
+
+type oracle struct {
+	price *big.Int
+}
+
+func newOracle(price *big.Int) *oracle {
+	return &oracle{price: new(big.Int).Set(price)}
+}
+
+type oracleAPI struct {
+	backend *Backend
+}
+
+func (m *oracleAPI) SetCurrentPrice(ctx context.Context, key *ecdsa.PrivateKey, price *big.Int) (*types.Transaction, error) {
+	return m.backend.transact(key, func(tx *txContext) error {
+		if price.Sign() <= 0 {
+			return errors.New("price must be positive")
+		}
+
+		m.backend.oracle.price = new(big.Int).Set(price)
+		return nil
+	})
+}
+
+func (m *oracleAPI) GetCurrentPrice(ctx context.Context) (*big.Int, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return new(big.Int).Set(m.backend.oracle.price), nil
+}
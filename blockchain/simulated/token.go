@@ -0,0 +1,163 @@
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// testTokenAmount is the amount of tokens TestTokenAPI.GetTokens gives,
+	// 100 SNM.
+	testTokenAmount = new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))
+
+	errInsufficientBalance   = errors.New("insufficient balance")
+	errInsufficientAllowance = errors.New("insufficient allowance")
+)
+
+// token is an ERC20 token.
+type token struct {
+	totalSupply *big.Int
+	balances    map[common.Address]*big.Int
+	allowances  map[common.Address]map[common.Address]*big.Int
+}
+
+func newToken() *token {
+	return &token{
+		totalSupply: big.NewInt(0),
+		balances:    map[common.Address]*big.Int{},
+		allowances:  map[common.Address]map[common.Address]*big.Int{},
+	}
+}
+
+func (m *token) mint(to common.Address, amount *big.Int) {
+	m.totalSupply.Add(m.totalSupply, amount)
+	m.balances[to] = new(big.Int).Add(m.balanceOf(to), amount)
+}
+
+func (m *token) balanceOf(owner common.Address) *big.Int {
+	if balance, ok := m.balances[owner]; ok {
+		return new(big.Int).Set(balance)
+	}
+
+	return big.NewInt(0)
+}
+
+func (m *token) allowance(owner, spender common.Address) *big.Int {
+	if allowance, ok := m.allowances[owner][spender]; ok {
+		return new(big.Int).Set(allowance)
+	}
+
+	return big.NewInt(0)
+}
+
+func (m *token) approve(owner, spender common.Address, amount *big.Int) {
+	if _, ok := m.allowances[owner]; !ok {
+		m.allowances[owner] = map[common.Address]*big.Int{}
+	}
+	m.allowances[owner][spender] = new(big.Int).Set(amount)
+}
+
+func (m *token) canTransfer(from common.Address, amount *big.Int) error {
+	if amount.Sign() < 0 || m.balanceOf(from).Cmp(amount) < 0 {
+		return errInsufficientBalance
+	}
+
+	return nil
+}
+
+// canTransferFrom checks whether the spender can transfer tokens on behalf of
+// the owner.
+func (m *token) canTransferFrom(owner, spender common.Address, amount *big.Int) error {
+	if err := m.canTransfer(owner, amount); err != nil {
+		return err
+	}
+	if m.allowance(owner, spender).Cmp(amount) < 0 {
+		return errInsufficientAllowance
+	}
+
+	return nil
+}
+
+// transfer must be called after canTransfer check.
+func (m *token) transfer(from, to common.Address, amount *big.Int) {
+	m.balances[from] = new(big.Int).Sub(m.balanceOf(from), amount)
+	m.balances[to] = new(big.Int).Add(m.balanceOf(to), amount)
+}
+
+// transferFrom must be called after canTransferFrom check.
+func (m *token) transferFrom(owner, spender, to common.Address, amount *big.Int) {
+	m.approve(owner, spender, new(big.Int).Sub(m.allowance(owner, spender), amount))
+	m.transfer(owner, to, amount)
+}
+
+type tokenAPI struct {
+	backend *Backend
+	token   *token
+}
+
+func (m *tokenAPI) Approve(ctx context.Context, key *ecdsa.PrivateKey, to string, amount *big.Int) (*types.Transaction, error) {
+	return m.backend.transact(key, func(tx *txContext) error {
+		m.token.approve(tx.sender, common.HexToAddress(to), amount)
+		return nil
+	})
+}
+
+func (m *tokenAPI) Transfer(ctx context.Context, key *ecdsa.PrivateKey, to string, amount *big.Int) (*types.Transaction, error) {
+	return m.backend.transact(key, func(tx *txContext) error {
+		if err := m.token.canTransfer(tx.sender, amount); err != nil {
+			return err
+		}
+
+		m.token.transfer(tx.sender, common.HexToAddress(to), amount)
+		return nil
+	})
+}
+
+func (m *tokenAPI) TransferFrom(ctx context.Context, key *ecdsa.PrivateKey, from string, to string, amount *big.Int) (*types.Transaction, error) {
+	return m.backend.transact(key, func(tx *txContext) error {
+		owner := common.HexToAddress(from)
+		if err := m.token.canTransferFrom(owner, tx.sender, amount); err != nil {
+			return err
+		}
+
+		m.token.transferFrom(owner, tx.sender, common.HexToAddress(to), amount)
+		return nil
+	})
+}
+
+func (m *tokenAPI) BalanceOf(ctx context.Context, address string) (*big.Int, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return m.token.balanceOf(common.HexToAddress(address)), nil
+}
+
+func (m *tokenAPI) AllowanceOf(ctx context.Context, from string, to string) (*big.Int, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return m.token.allowance(common.HexToAddress(from), common.HexToAddress(to)), nil
+}
+
+func (m *tokenAPI) TotalSupply(ctx context.Context) (*big.Int, error) {
+	m.backend.mu.Lock()
+	defer m.backend.mu.Unlock()
+
+	return new(big.Int).Set(m.token.totalSupply), nil
+}
+
+type testTokenAPI struct {
+	backend *Backend
+}
+
+func (m *testTokenAPI) GetTokens(ctx context.Context, key *ecdsa.PrivateKey) (*types.Transaction, error) {
+	return m.backend.transact(key, func(tx *txContext) error {
+		m.backend.liveToken.mint(tx.sender, testTokenAmount)
+		return nil
+	})
+}
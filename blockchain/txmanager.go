@@ -0,0 +1,480 @@
+package blockchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/util"
+)
+
+const (
+	// defaultTxStuckTimeout is the time after which a transaction that is not
+	// mined is re-sent with a higher gas price.
+	defaultTxStuckTimeout = 90 * time.Second
+	// txRetention is the time results of finished transactions are kept for
+	// late WaitReceipt callers.
+	txRetention = 15 * time.Minute
+	// gasPriceBumpPercent is the gas price increase for replacing
+	// transactions. Nodes usually require at least 10% to accept a
+	// replacement.
+	gasPriceBumpPercent = 12
+	// maxGasPriceFactor limits how many times the gas price may be raised
+	// above the configured one.
+	maxGasPriceFactor = 5
+)
+
+// PendingTx describes a transaction sent by the TxManager, but not mined yet.
+type PendingTx struct {
+	// Chain is the name of the chain the transaction is sent to.
+	Chain string
+	// Hash is the hash of the latest sent version of the transaction.
+	Hash     common.Hash
+	From     common.Address
+	Nonce    uint64
+	GasPrice *big.Int
+	SentAt   time.Time
+	// Replacements is the number of times the transaction has been re-sent
+	// with a higher gas price.
+	Replacements int
+}
+
+// TransactionsAPI provides information about transactions in flight.
+type TransactionsAPI interface {
+	// Pending returns transactions sent, but not mined yet.
+	Pending() []*PendingTx
+	// Wait waits until the transaction is mined and confirmed, returning the
+	// receipt of whichever of its replacements is mined, or an error if it
+	// has failed.
+	Wait(ctx context.Context, tx *types.Transaction) (*Receipt, error)
+}
+
+type txAccount struct {
+	// mu serializes sending of the account's transactions, so that nonces
+	// are used in order.
+	mu     sync.Mutex
+	nonce  uint64
+	synced bool
+}
+
+type trackedTx struct {
+	PendingTx
+	key    *ecdsa.PrivateKey
+	tx     *types.Transaction
+	hashes []common.Hash
+
+	// done is closed when the transaction is mined or its nonce is used by
+	// another transaction.
+	done    chan struct{}
+	receipt *Receipt
+	err     error
+}
+
+// TxManager sends transactions to a single chain on behalf of any number of
+// keys.
+//
+// Nonces are allocated locally, so concurrent transactions from the same key
+// never collide. Transactions rejected as underpriced are re-priced before
+// sending, and transactions that are stuck in the pool are replaced with
+// higher priced ones, until the configured maximum is reached.
+type TxManager struct {
+	client         CustomEthereumClient
+	chain          string
+	chainID        *big.Int
+	gasPrice       *big.Int
+	maxGasPrice    *big.Int
+	logParsePeriod time.Duration
+	stuckTimeout   time.Duration
+
+	mu       sync.Mutex
+	accounts map[common.Address]*txAccount
+	txs      map[common.Hash]*trackedTx
+}
+
+// NewTxManager constructs a transaction manager for the chain the client is
+// connected to. A nil or zero chainID means transactions are signed without
+// replay protection.
+func NewTxManager(client CustomEthereumClient, chain string, gasPrice int64, chainID *big.Int, logParsePeriod time.Duration) *TxManager {
+	return &TxManager{
+		client:         client,
+		chain:          chain,
+		chainID:        chainID,
+		gasPrice:       big.NewInt(gasPrice),
+		maxGasPrice:    big.NewInt(gasPrice * maxGasPriceFactor),
+		logParsePeriod: logParsePeriod,
+		stuckTimeout:   defaultTxStuckTimeout,
+		accounts:       map[common.Address]*txAccount{},
+		txs:            map[common.Hash]*trackedTx{},
+	}
+}
+
+// Transact allocates the next nonce of the key and passes transaction
+// options with it to fn, which is expected to sign and send a transaction,
+// usually with a contract binding. The transaction is then monitored until
+// it is mined.
+func (m *TxManager) Transact(ctx context.Context, key *ecdsa.PrivateKey, gasLimit uint64, fn func(opts *bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	account := m.account(from)
+
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	if !account.synced {
+		nonce, err := m.client.PendingNonceAt(ctx, from)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get nonce for %s: %v", from.Hex(), err)
+		}
+
+		account.nonce = nonce
+		account.synced = true
+	}
+
+	opts := getTxOpts(ctx, key, gasLimit, m.gasPrice.Int64(), m.chainID)
+	opts.Nonce = new(big.Int).SetUint64(account.nonce)
+
+	for {
+		tx, err := fn(opts)
+		if err == nil {
+			account.nonce++
+			m.track(key, from, tx)
+			return tx, nil
+		}
+
+		if !isUnderpriced(err) || !m.canBump(opts.GasPrice) {
+			// The nonce may have been used outside of the manager, so it
+			// must be synchronized with the pool before the next send.
+			account.synced = false
+			return nil, err
+		}
+
+		opts.GasPrice = m.bump(opts.GasPrice)
+	}
+}
+
+// WaitReceipt waits until the transaction is mined and has the given number
+// of confirmations. For transactions sent via Transact the receipt of
+// whichever of its replacements gets mined is returned.
+//
+// There is no timeout other than the one of the context, because the
+// transaction may be mined at any moment until its nonce is used.
+func (m *TxManager) WaitReceipt(ctx context.Context, tx *types.Transaction, confirmations int64) (*Receipt, error) {
+	m.mu.Lock()
+	tracked, ok := m.txs[tx.Hash()]
+	m.mu.Unlock()
+
+	if !ok {
+		return WaitTransactionReceipt(ctx, m.client, confirmations, m.logParsePeriod, tx)
+	}
+
+	select {
+	case <-tracked.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if tracked.err != nil {
+		return nil, tracked.err
+	}
+
+	return m.waitConfirmations(ctx, tracked.receipt, confirmations)
+}
+
+func (m *TxManager) Pending() []*PendingTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var pending []*PendingTx
+	for hash, tracked := range m.txs {
+		// Replacements share the entry with the original transaction.
+		if hash != tracked.hashes[0] {
+			continue
+		}
+
+		select {
+		case <-tracked.done:
+			continue
+		default:
+		}
+
+		tx := tracked.PendingTx
+		tx.GasPrice = new(big.Int).Set(tracked.GasPrice)
+		pending = append(pending, &tx)
+	}
+
+	return pending
+}
+
+func (m *TxManager) isTracked(hash common.Hash) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.txs[hash]
+	return ok
+}
+
+func (m *TxManager) account(address common.Address) *txAccount {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	account, ok := m.accounts[address]
+	if !ok {
+		account = &txAccount{}
+		m.accounts[address] = account
+	}
+
+	return account
+}
+
+func (m *TxManager) track(key *ecdsa.PrivateKey, from common.Address, tx *types.Transaction) {
+	tracked := &trackedTx{
+		PendingTx: PendingTx{
+			Chain:    m.chain,
+			Hash:     tx.Hash(),
+			From:     from,
+			Nonce:    tx.Nonce(),
+			GasPrice: tx.GasPrice(),
+			SentAt:   time.Now(),
+		},
+		key:    key,
+		tx:     tx,
+		hashes: []common.Hash{tx.Hash()},
+		done:   make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	m.txs[tx.Hash()] = tracked
+	m.mu.Unlock()
+
+	go m.monitor(tracked)
+}
+
+// monitor polls receipts of all versions of the transaction and replaces it
+// when it gets stuck, until one of them is mined or the nonce is used by a
+// transaction sent outside of the manager.
+func (m *TxManager) monitor(tracked *trackedTx) {
+	ctx := context.Background()
+
+	tk := util.NewImmediateTicker(m.logParsePeriod)
+	defer tk.Stop()
+
+	for range tk.C {
+		// The nonce is checked first, so that a version mined in the
+		// meantime is found below.
+		nonce, err := m.client.NonceAt(ctx, tracked.From, nil)
+		if err != nil {
+			// Most likely a temporary network failure, retry on the next
+			// tick.
+			continue
+		}
+
+		receipt, err := m.findReceipt(ctx, tracked)
+		if err != nil {
+			continue
+		}
+		if receipt != nil {
+			m.finish(tracked, receipt, nil)
+			return
+		}
+		if nonce > tracked.Nonce {
+			m.finish(tracked, nil, fmt.Errorf("nonce %d of transaction %s is used by another transaction", tracked.Nonce, tracked.hashes[0].Hex()))
+			return
+		}
+
+		m.mu.Lock()
+		stuck := time.Since(tracked.SentAt) >= m.stuckTimeout
+		m.mu.Unlock()
+
+		if stuck {
+			m.replace(ctx, tracked)
+		}
+	}
+}
+
+func (m *TxManager) findReceipt(ctx context.Context, tracked *trackedTx) (*Receipt, error) {
+	m.mu.Lock()
+	hashes := append([]common.Hash{}, tracked.hashes...)
+	m.mu.Unlock()
+
+	for _, hash := range hashes {
+		receipt, err := m.client.GetTransactionReceipt(ctx, hash)
+		if err == ethereum.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return receipt, nil
+	}
+
+	return nil, nil
+}
+
+// replace re-sends the transaction with the same nonce and a higher gas
+// price.
+func (m *TxManager) replace(ctx context.Context, tracked *trackedTx) {
+	m.mu.Lock()
+	gasPrice := tracked.GasPrice
+	m.mu.Unlock()
+
+	for m.canBump(gasPrice) {
+		gasPrice = m.bump(gasPrice)
+
+		var tx *types.Transaction
+		if to := tracked.tx.To(); to != nil {
+			tx = types.NewTransaction(tracked.tx.Nonce(), *to, tracked.tx.Value(), tracked.tx.Gas(), gasPrice, tracked.tx.Data())
+		} else {
+			tx = types.NewContractCreation(tracked.tx.Nonce(), tracked.tx.Value(), tracked.tx.Gas(), gasPrice, tracked.tx.Data())
+		}
+		signed, err := types.SignTx(tx, m.signer(), tracked.key)
+		if err != nil {
+			return
+		}
+
+		err = m.client.SendTransaction(ctx, signed)
+		if isUnderpriced(err) {
+			continue
+		}
+		if err != nil {
+			// Most likely one of the previous versions has just been mined.
+			return
+		}
+
+		m.mu.Lock()
+		tracked.Hash = signed.Hash()
+		tracked.GasPrice = gasPrice
+		tracked.SentAt = time.Now()
+		tracked.Replacements++
+		tracked.hashes = append(tracked.hashes, signed.Hash())
+		m.txs[signed.Hash()] = tracked
+		m.mu.Unlock()
+		return
+	}
+}
+
+func (m *TxManager) finish(tracked *trackedTx, receipt *Receipt, err error) {
+	if err != nil {
+		// The nonce of a dropped transaction may be reused by the pool, so
+		// it must be synchronized before the next send.
+		account := m.account(tracked.From)
+		account.mu.Lock()
+		account.synced = false
+		account.mu.Unlock()
+	}
+
+	tracked.receipt = receipt
+	tracked.err = err
+	close(tracked.done)
+
+	// Keep the entry for a while, so that late WaitReceipt callers still
+	// find the right receipt.
+	time.AfterFunc(txRetention, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		for _, hash := range tracked.hashes {
+			delete(m.txs, hash)
+		}
+	})
+}
+
+func (m *TxManager) waitConfirmations(ctx context.Context, receipt *Receipt, confirmations int64) (*Receipt, error) {
+	if confirmations <= 0 {
+		return receipt, nil
+	}
+
+	tk := util.NewImmediateTicker(m.logParsePeriod)
+	defer tk.Stop()
+
+	confirmBlock := common.HexToHash(receipt.BlockNumber).Big()
+	confirmBlock.Add(confirmBlock, big.NewInt(confirmations))
+
+	for {
+		select {
+		case <-tk.C:
+			blockNumber, err := m.client.GetLastBlock(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if blockNumber.Cmp(confirmBlock) >= 0 {
+				return receipt, nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (m *TxManager) signer() types.Signer {
+	if m.chainID != nil && m.chainID.Sign() > 0 {
+		return types.NewEIP155Signer(m.chainID)
+	}
+
+	return types.HomesteadSigner{}
+}
+
+func (m *TxManager) canBump(gasPrice *big.Int) bool {
+	return gasPrice.Cmp(m.maxGasPrice) < 0
+}
+
+func (m *TxManager) bump(gasPrice *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(gasPrice, big.NewInt(100+gasPriceBumpPercent))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(gasPrice) <= 0 {
+		bumped.Add(gasPrice, big.NewInt(1))
+	}
+	if bumped.Cmp(m.maxGasPrice) > 0 {
+		bumped.Set(m.maxGasPrice)
+	}
+
+	return bumped
+}
+
+func isUnderpriced(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "underpriced")
+}
+
+// txManagers merges pending transactions of several chains.
+type txManagers struct {
+	managers      []*TxManager
+	confirmations int64
+}
+
+func (m *txManagers) Pending() []*PendingTx {
+	var pending []*PendingTx
+	for _, manager := range m.managers {
+		pending = append(pending, manager.Pending()...)
+	}
+
+	return pending
+}
+
+func (m *txManagers) Wait(ctx context.Context, tx *types.Transaction) (*Receipt, error) {
+	for _, manager := range m.managers {
+		if !manager.isTracked(tx.Hash()) {
+			continue
+		}
+
+		receipt, err := manager.WaitReceipt(ctx, tx, m.confirmations)
+		if err != nil {
+			return nil, err
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return nil, errors.New("transaction failed")
+		}
+
+		return receipt, nil
+	}
+
+	return nil, fmt.Errorf("transaction %s is not sent by the manager", tx.Hash().Hex())
+}
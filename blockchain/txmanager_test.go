@@ -0,0 +1,258 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTxClient accepts transactions priced at least minGasPrice and mines
+// those priced at least minedGasPrice. Nonces below usedNonce are considered
+// used by other transactions.
+type fakeTxClient struct {
+	CustomEthereumClient
+
+	mu            sync.Mutex
+	minGasPrice   *big.Int
+	minedGasPrice *big.Int
+	sent          []*types.Transaction
+	usedNonce     uint64
+	nonceRequests int
+}
+
+func (m *fakeTxClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nonceRequests++
+	return 0, nil
+}
+
+func (m *fakeTxClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.usedNonce, nil
+}
+
+func (m *fakeTxClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if tx.GasPrice().Cmp(m.minGasPrice) < 0 {
+		return errors.New("transaction underpriced")
+	}
+
+	m.sent = append(m.sent, tx)
+	return nil
+}
+
+func (m *fakeTxClient) GetTransactionReceipt(ctx context.Context, txHash common.Hash) (*Receipt, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, tx := range m.sent {
+		if tx.Hash() == txHash && tx.GasPrice().Cmp(m.minedGasPrice) >= 0 {
+			return &Receipt{Receipt: &types.Receipt{TxHash: txHash, Status: types.ReceiptStatusSuccessful}, BlockNumber: "0x1"}, nil
+		}
+	}
+
+	return nil, ethereum.NotFound
+}
+
+func (m *fakeTxClient) transactFn(ctx context.Context) func(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		tx := types.NewTransaction(opts.Nonce.Uint64(), common.Address{}, big.NewInt(0), opts.GasLimit, opts.GasPrice, nil)
+		signed, err := opts.Signer(types.HomesteadSigner{}, opts.From, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		return signed, m.SendTransaction(ctx, signed)
+	}
+}
+
+func (m *fakeTxClient) createFn(ctx context.Context) func(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		tx := types.NewContractCreation(opts.Nonce.Uint64(), big.NewInt(0), opts.GasLimit, opts.GasPrice, nil)
+		signed, err := opts.Signer(types.HomesteadSigner{}, opts.From, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		return signed, m.SendTransaction(ctx, signed)
+	}
+}
+
+func TestTxManagerAllocatesNonces(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	client := &fakeTxClient{minGasPrice: big.NewInt(0), minedGasPrice: big.NewInt(0)}
+	manager := NewTxManager(client, "test", 100, nil, time.Millisecond)
+
+	wg := sync.WaitGroup{}
+	for idx := 0; idx < 10; idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := manager.Transact(ctx, key, defaultGasLimit, client.transactFn(ctx))
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	nonces := map[uint64]bool{}
+	for _, tx := range client.sent {
+		nonces[tx.Nonce()] = true
+	}
+	assert.Len(t, nonces, 10)
+	for idx := uint64(0); idx < 10; idx++ {
+		assert.True(t, nonces[idx])
+	}
+}
+
+func TestTxManagerRepricesUnderpriced(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	client := &fakeTxClient{minGasPrice: big.NewInt(120), minedGasPrice: big.NewInt(0)}
+	manager := NewTxManager(client, "test", 100, nil, time.Millisecond)
+
+	tx, err := manager.Transact(ctx, key, defaultGasLimit, client.transactFn(ctx))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(125), tx.GasPrice())
+}
+
+func TestTxManagerReplacesStuck(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	client := &fakeTxClient{minGasPrice: big.NewInt(0), minedGasPrice: big.NewInt(110)}
+	manager := NewTxManager(client, "test", 100, nil, time.Millisecond)
+	manager.stuckTimeout = 10 * time.Millisecond
+
+	tx, err := manager.Transact(ctx, key, defaultGasLimit, client.transactFn(ctx))
+	require.NoError(t, err)
+
+	receipt, err := manager.WaitReceipt(ctx, tx, 0)
+	require.NoError(t, err)
+	assert.NotEqual(t, tx.Hash(), receipt.TxHash)
+	assert.Empty(t, manager.Pending())
+
+	transactions := &txManagers{managers: []*TxManager{manager}}
+	waited, err := transactions.Wait(ctx, tx)
+	require.NoError(t, err)
+	assert.Equal(t, receipt.TxHash, waited.TxHash)
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	require.Len(t, client.sent, 2)
+	assert.Equal(t, tx.Nonce(), client.sent[1].Nonce())
+	assert.Equal(t, big.NewInt(112), client.sent[1].GasPrice())
+}
+
+func TestTxManagerReplacesStuckContractCreation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	client := &fakeTxClient{minGasPrice: big.NewInt(0), minedGasPrice: big.NewInt(110)}
+	manager := NewTxManager(client, "test", 100, nil, time.Millisecond)
+	manager.stuckTimeout = 10 * time.Millisecond
+
+	tx, err := manager.Transact(ctx, key, defaultGasLimit, client.createFn(ctx))
+	require.NoError(t, err)
+
+	_, err = manager.WaitReceipt(ctx, tx, 0)
+	require.NoError(t, err)
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	require.Len(t, client.sent, 2)
+	assert.Nil(t, client.sent[1].To())
+}
+
+func TestTxManagerResyncsNonceAfterFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	client := &fakeTxClient{minGasPrice: big.NewInt(0), minedGasPrice: big.NewInt(1000000)}
+	manager := NewTxManager(client, "test", 100, nil, time.Millisecond)
+
+	tx, err := manager.Transact(ctx, key, defaultGasLimit, client.transactFn(ctx))
+	require.NoError(t, err)
+
+	// The nonce is used by a transaction sent outside of the manager.
+	client.mu.Lock()
+	client.usedNonce = 1
+	client.mu.Unlock()
+
+	_, err = manager.WaitReceipt(ctx, tx, 0)
+	require.Error(t, err)
+	require.NotEqual(t, ctx.Err(), err)
+
+	_, err = manager.Transact(ctx, key, defaultGasLimit, client.transactFn(ctx))
+	require.NoError(t, err)
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	assert.Equal(t, 2, client.nonceRequests)
+	assert.Equal(t, uint64(0), client.sent[len(client.sent)-1].Nonce())
+}
+
+func TestTxManagerWaitsUntilNonceIsUsed(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	client := &fakeTxClient{minGasPrice: big.NewInt(0), minedGasPrice: big.NewInt(1000000)}
+	manager := NewTxManager(client, "test", 100, nil, time.Millisecond)
+	manager.stuckTimeout = time.Hour
+
+	tx, err := manager.Transact(context.Background(), key, defaultGasLimit, client.transactFn(context.Background()))
+	require.NoError(t, err)
+
+	// Only the context limits waiting for a transaction that still may be
+	// mined.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = manager.WaitReceipt(ctx, tx, 0)
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.Len(t, manager.Pending(), 1)
+
+	// Then it is mined.
+	client.mu.Lock()
+	client.minedGasPrice = big.NewInt(0)
+	client.usedNonce = 1
+	client.mu.Unlock()
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	receipt, err := manager.WaitReceipt(ctx, tx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, tx.Hash(), receipt.TxHash)
+	assert.Empty(t, manager.Pending())
+}
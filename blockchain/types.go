@@ -21,7 +21,11 @@ type OrderOrError struct {
 type Event struct {
 	Data        interface{}
 	BlockNumber uint64
-	TS          uint64
+	BlockHash   common.Hash
+	// LogIndex is the index of the log the event was extracted from within
+	// its block.
+	LogIndex uint
+	TS       uint64
 }
 
 type DealOpenedData struct {
@@ -94,3 +98,13 @@ type ValidatorDeletedData struct {
 type CertificateCreatedData struct {
 	ID *big.Int
 }
+
+// GatekeeperTransfer is a transfer paid in to or paid out from a gatekeeper.
+type GatekeeperTransfer struct {
+	From     common.Address
+	TxNumber *big.Int
+	Value    *big.Int
+	// BlockNumber is the number of the block the transfer is logged in.
+	BlockNumber uint64
+	TS          uint64
+}
@@ -74,32 +74,13 @@ func FindLogByTopic(txReceipt *Receipt, topic common.Hash) (*types.Log, error) {
 	return nil, fmt.Errorf("cannot find topic \"%s\"in transaction", topic.Hex())
 }
 
-func waitForTransactionResult(ctx context.Context, client EthereumClientBackend, logParsePeriod time.Duration, tx *types.Transaction, topic common.Hash) (*types.Log, error) {
-	tk := util.NewImmediateTicker(logParsePeriod)
-	defer tk.Stop()
-
-	for {
-		select {
-		case <-tk.C:
-			var err error
-			tmpRec := &Receipt{}
-			tmpRec.Receipt, err = client.TransactionReceipt(ctx, tx.Hash())
-			if err != nil {
-				if err == ethereum.NotFound {
-					break
-				}
-				return nil, err
-			}
-			logs, err := FindLogByTopic(tmpRec, topic)
-			if err != nil {
-				return nil, err
-			}
-
-			return logs, err
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		}
+func waitForTransactionResult(ctx context.Context, txManager *TxManager, tx *types.Transaction, topic common.Hash) (*types.Log, error) {
+	receipt, err := txManager.WaitReceipt(ctx, tx, 0)
+	if err != nil {
+		return nil, err
 	}
+
+	return FindLogByTopic(receipt, topic)
 }
 
 func WaitTransactionReceipt(ctx context.Context, client CustomEthereumClient, confirmations int64, logParsePeriod time.Duration, tx *types.Transaction) (*Receipt, error) {
@@ -134,8 +115,18 @@ func WaitTransactionReceipt(ctx context.Context, client CustomEthereumClient, co
 	}
 }
 
-func getTxOpts(ctx context.Context, key *ecdsa.PrivateKey, gasLimit uint64, gasPrice int64) *bind.TransactOpts {
+// getTxOpts returns options for signing transactions with the given key. When
+// chainID is set, transactions are signed according to EIP-155, otherwise
+// they are signed without replay protection.
+func getTxOpts(ctx context.Context, key *ecdsa.PrivateKey, gasLimit uint64, gasPrice int64, chainID *big.Int) *bind.TransactOpts {
 	opts := bind.NewKeyedTransactor(key)
+	if chainID != nil && chainID.Sign() > 0 {
+		signFn := opts.Signer
+		signer := types.NewEIP155Signer(chainID)
+		opts.Signer = func(_ types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return signFn(signer, address, tx)
+		}
+	}
 	opts.Context = ctx
 	opts.GasLimit = gasLimit
 	opts.GasPrice = big.NewInt(gasPrice)
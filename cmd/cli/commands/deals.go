@@ -2,6 +2,8 @@ package commands
 
 import (
 	"os"
+	"strings"
+	"time"
 
 	pb "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
@@ -22,6 +24,15 @@ func init() {
 		dealStatusCmd,
 		dealOpenCmd,
 		dealCloseCmd,
+		dealSpendingCmd,
+		changeRequestsRoot,
+	)
+
+	changeRequestsRoot.AddCommand(
+		changeRequestCreateCmd,
+		changeRequestListCmd,
+		changeRequestApproveCmd,
+		changeRequestCancelCmd,
 	)
 }
 
@@ -55,6 +66,30 @@ var dealListCmd = &cobra.Command{
 	},
 }
 
+var dealSpendingCmd = &cobra.Command{
+	Use:    "spending",
+	Short:  "Show spendings of deals you consume",
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, _ []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		dealer, err := newDealsClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		spending, err := dealer.Spending(ctx, &pb.Empty{})
+		if err != nil {
+			showError(cmd, "Cannot get deal spendings", err)
+			os.Exit(1)
+		}
+
+		printDealSpending(cmd, spending)
+	},
+}
+
 var dealStatusCmd = &cobra.Command{
 	Use:    "status <deal_id>",
 	Short:  "Show deal status",
@@ -161,3 +196,148 @@ var dealCloseCmd = &cobra.Command{
 		showOk(cmd)
 	},
 }
+
+var changeRequestsRoot = &cobra.Command{
+	Use:   "change-request",
+	Short: "Request changes for deals",
+}
+
+var changeRequestCreateCmd = &cobra.Command{
+	Use:     "create <deal_id> <duration> <price>",
+	Short:   "Request to change deal's price and duration",
+	Example: "  sonmcli deal change-request create 42 10h 0.3 USD/h",
+	Args:    cobra.MinimumNArgs(3),
+	PreRun:  loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		dealID, err := util.ParseBigInt(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		duration, err := time.ParseDuration(args[1])
+		if err != nil {
+			showError(cmd, "Cannot parse duration", err)
+			os.Exit(1)
+		}
+
+		// Allow the price to be passed either quoted or as separate arguments.
+		price := &pb.Price{}
+		if err := price.LoadFromString(strings.Join(args[2:], " ")); err != nil {
+			showError(cmd, "Cannot parse price", err)
+			os.Exit(1)
+		}
+
+		dealer, err := newDealsClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		id, err := dealer.CreateChangeRequest(ctx, &pb.DealChangeRequest{
+			DealID:   pb.NewBigInt(dealID),
+			Duration: uint64(duration.Seconds()),
+			Price:    price.GetPerSecond(),
+		})
+		if err != nil {
+			showError(cmd, "Cannot create change request", err)
+			os.Exit(1)
+		}
+
+		printID(cmd, id.Unwrap().String())
+	},
+}
+
+var changeRequestListCmd = &cobra.Command{
+	Use:    "list <deal_id>",
+	Short:  "Show change requests for given deal",
+	Args:   cobra.MinimumNArgs(1),
+	PreRun: loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		dealID, err := util.ParseBigInt(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		dealer, err := newDealsClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		reply, err := dealer.ChangeRequestsList(ctx, pb.NewBigInt(dealID))
+		if err != nil {
+			showError(cmd, "Cannot get change requests for deal", err)
+			os.Exit(1)
+		}
+
+		printDealChangeRequests(cmd, reply.GetRequests())
+	},
+}
+
+var changeRequestApproveCmd = &cobra.Command{
+	Use:    "approve <req_id>",
+	Short:  "Agree to change deal conditions with given change request",
+	Args:   cobra.MinimumNArgs(1),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		id, err := util.ParseBigInt(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		dealer, err := newDealsClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		if _, err := dealer.ApproveChangeRequest(ctx, pb.NewBigInt(id)); err != nil {
+			showError(cmd, "Cannot approve change request", err)
+			os.Exit(1)
+		}
+
+		showOk(cmd)
+	},
+}
+
+var changeRequestCancelCmd = &cobra.Command{
+	Use:    "cancel <req_id>",
+	Short:  "Cancel own change request or decline the counterparty's one",
+	Args:   cobra.MinimumNArgs(1),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		id, err := util.ParseBigInt(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		dealer, err := newDealsClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		if _, err := dealer.CancelChangeRequest(ctx, pb.NewBigInt(id)); err != nil {
+			showError(cmd, "Cannot cancel change request", err)
+			os.Exit(1)
+		}
+
+		showOk(cmd)
+	},
+}
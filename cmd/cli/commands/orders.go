@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"context"
 	"os"
+	"time"
 
 	"github.com/sonm-io/core/cmd/cli/task_config"
 	pb "github.com/sonm-io/core/proto"
@@ -10,16 +12,19 @@ import (
 
 var (
 	ordersSearchLimit uint64 = 0
+	ordersCreateCount uint64
 )
 
 func init() {
 	orderListCmd.PersistentFlags().Uint64Var(&ordersSearchLimit, "limit", 10, "Orders count to show")
+	orderCreateCmd.PersistentFlags().Uint64Var(&ordersCreateCount, "count", 1, "Number of identical orders to place")
 
 	orderRootCmd.AddCommand(
 		orderListCmd,
 		orderStatusCmd,
 		orderCreateCmd,
 		orderCancelCmd,
+		orderPurgeCmd,
 	)
 }
 
@@ -102,6 +107,31 @@ var orderCreateCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		if ordersCreateCount > 1 {
+			req := &pb.CreateOrdersRequest{
+				Orders: []*pb.BidOrder{bid},
+				Count:  ordersCreateCount,
+			}
+			if err := req.Validate(); err != nil {
+				showError(cmd, "Invalid order count", err)
+				os.Exit(1)
+			}
+
+			// Each order is a separate transaction, so the timeout is
+			// given to every one of them.
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutFlag*time.Duration(ordersCreateCount))
+			defer cancel()
+
+			results, err := market.CreateOrders(ctx, req)
+			if err != nil {
+				showError(cmd, "Cannot create orders on marketplace", err)
+				os.Exit(1)
+			}
+
+			printOrderResults(cmd, results)
+			return
+		}
+
 		created, err := market.CreateOrder(ctx, bid)
 		if err != nil {
 			showError(cmd, "Cannot create order on marketplace", err)
@@ -137,3 +167,27 @@ var orderCancelCmd = &cobra.Command{
 		showOk(cmd)
 	},
 }
+
+var orderPurgeCmd = &cobra.Command{
+	Use:    "purge",
+	Short:  "Cancel all your active Bid orders on Marketplace",
+	PreRun: loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		market, err := newMarketClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		results, err := market.PurgeOrders(ctx, &pb.Empty{})
+		if err != nil {
+			showError(cmd, "Cannot purge orders on Marketplace", err)
+			os.Exit(1)
+		}
+
+		printOrderResults(cmd, results)
+	},
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,11 +19,20 @@ func printTaskStatus(cmd *cobra.Command, id string, taskStatus *pb.TaskStatusRep
 		cmd.Printf("  Image:  %s\r\n", taskStatus.GetImageName())
 		cmd.Printf("  Status: %s\r\n", taskStatus.GetStatus().String())
 		cmd.Printf("  Uptime: %s\r\n", time.Duration(taskStatus.GetUptime()).String())
+		if taskStatus.GetRestartCount() > 0 {
+			cmd.Printf("  Restarts: %d (last exit code: %d)\r\n", taskStatus.GetRestartCount(), taskStatus.GetExitCode())
+		}
 
 		if taskStatus.GetUsage() != nil {
 			cmd.Println("  Resources:")
 			cmd.Printf("    CPU: %d\r\n", taskStatus.Usage.GetCpu().GetTotal())
 			cmd.Printf("    MEM: %s\r\n", datasize.NewByteSize(taskStatus.Usage.GetMemory().GetMaxUsage()).HumanReadable())
+			if storage := taskStatus.GetUsage().GetStorage(); storage.GetQuota() > 0 {
+				cmd.Printf("    DISK: %s of %s\r\n", datasize.NewByteSize(storage.GetBytes()).HumanReadable(),
+					datasize.NewByteSize(storage.GetQuota()).HumanReadable())
+			} else {
+				cmd.Printf("    DISK: %s\r\n", datasize.NewByteSize(storage.GetBytes()).HumanReadable())
+			}
 			if taskStatus.GetUsage().GetNetwork() != nil {
 				cmd.Printf("    NET:\r\n")
 				for i, net := range taskStatus.GetUsage().GetNetwork() {
@@ -45,15 +55,18 @@ func printTaskStatus(cmd *cobra.Command, id string, taskStatus *pb.TaskStatusRep
 		}
 	} else {
 		v := map[string]interface{}{
-			"id":     id,
-			"status": taskStatus.Status.String(),
-			"image":  taskStatus.GetImageName(),
-			"ports":  taskStatus.GetPortMap(),
-			"uptime": fmt.Sprintf("%d", time.Duration(taskStatus.GetUptime())),
+			"id":           id,
+			"status":       taskStatus.Status.String(),
+			"image":        taskStatus.GetImageName(),
+			"ports":        taskStatus.GetPortMap(),
+			"uptime":       fmt.Sprintf("%d", time.Duration(taskStatus.GetUptime())),
+			"restartCount": taskStatus.GetRestartCount(),
+			"exitCode":     taskStatus.GetExitCode(),
 		}
 		if taskStatus.GetUsage() != nil {
 			v["cpu"] = fmt.Sprintf("%d", taskStatus.GetUsage().GetCpu().GetTotal())
 			v["mem"] = fmt.Sprintf("%d", taskStatus.GetUsage().GetMemory().GetMaxUsage())
+			v["disk"] = fmt.Sprintf("%d", taskStatus.GetUsage().GetStorage().GetBytes())
 			v["net"] = taskStatus.GetUsage().GetNetwork()
 		}
 
@@ -93,6 +106,54 @@ func printWorkerStatus(cmd *cobra.Command, stat *pb.StatusReply) {
 	}
 }
 
+func printWhitelist(cmd *cobra.Command, whitelist *pb.WhitelistReply) {
+	if isSimpleFormat() {
+		if !whitelist.GetEnabled() {
+			cmd.Println("Whitelist is disabled")
+			return
+		}
+
+		signer := whitelist.GetSigner()
+		if len(signer) == 0 {
+			signer = "none, unsigned whitelists are accepted"
+		}
+
+		updatedAt := "never"
+		if whitelist.GetUpdatedAt() != nil {
+			updatedAt = whitelist.GetUpdatedAt().Unix().Format(time.RFC3339)
+		}
+
+		cmd.Printf("URL:         %s\r\n", whitelist.GetUrl())
+		cmd.Printf("Signer:      %s\r\n", signer)
+		cmd.Printf("Serial:      %d\r\n", whitelist.GetSerial())
+		cmd.Printf("Updated at:  %s\r\n", updatedAt)
+		if len(whitelist.GetLastError()) != 0 {
+			cmd.Printf("Last error:  %s\r\n", whitelist.GetLastError())
+		}
+
+		cmd.Println("Privileged addresses:")
+		for _, addr := range whitelist.GetPrivilegedAddresses() {
+			cmd.Printf("  %s\r\n", addr)
+		}
+
+		names := make([]string, 0, len(whitelist.GetRecords()))
+		for name := range whitelist.GetRecords() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		cmd.Println("Allowed images:")
+		for _, name := range names {
+			cmd.Printf("  %s\r\n", name)
+			for _, digest := range whitelist.GetRecords()[name].GetAllowedHashes() {
+				cmd.Printf("    %s\r\n", digest)
+			}
+		}
+	} else {
+		showJSON(cmd, whitelist)
+	}
+}
+
 func printBenchmarkGroup(cmd *cobra.Command, benchmarks map[uint64]*pb.Benchmark) {
 	cmd.Println("  Benchmarks:")
 	for _, bn := range benchmarks {
@@ -164,6 +225,30 @@ func printOrdersList(cmd *cobra.Command, orders []*pb.Order) {
 	}
 }
 
+func printOrderResults(cmd *cobra.Command, reply *pb.OrderResultsReply) {
+	if isSimpleFormat() {
+		if len(reply.GetResults()) == 0 {
+			cmd.Println("No orders found")
+			return
+		}
+
+		for _, result := range reply.GetResults() {
+			id := "-"
+			if result.GetId() != nil {
+				id = result.GetId().Unwrap().String()
+			}
+
+			if len(result.GetError()) > 0 {
+				cmd.Printf("%10s | FAILED: %s\r\n", id, result.GetError())
+			} else {
+				cmd.Printf("%10s | OK\r\n", id)
+			}
+		}
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
 func printOrderDetails(cmd *cobra.Command, order *pb.Order) {
 	if isSimpleFormat() {
 		cmd.Printf("ID:              %s\r\n", order.Id)
@@ -248,6 +333,64 @@ func printDealsList(cmd *cobra.Command, deals []*pb.Deal) {
 
 }
 
+func printDealSpending(cmd *cobra.Command, reply *pb.SpendingReply) {
+	if !isSimpleFormat() {
+		showJSON(cmd, reply)
+		return
+	}
+
+	cmd.Printf("Balance:      %s SNM\r\n", reply.GetBalance().ToPriceString())
+	cmd.Printf("Spent:        %s SNM\r\n", reply.GetSpent().ToPriceString())
+	if !reply.GetBudget().IsZero() {
+		cmd.Printf("Budget:       %s SNM\r\n", reply.GetBudget().ToPriceString())
+	}
+	if reply.GetExhaustion() != nil {
+		cmd.Printf("Runs out at:  %s\r\n", reply.GetExhaustion().Unix().Format(time.RFC3339))
+	}
+
+	if len(reply.GetDeals()) == 0 {
+		cmd.Println("No deals found")
+		return
+	}
+
+	for _, deal := range reply.GetDeals() {
+		cmd.Println()
+		cmd.Printf("ID:           %s\r\n", deal.GetDealID().Unwrap().String())
+		cmd.Printf("Price:        %s USD/sec\r\n", deal.GetPrice().ToPriceString())
+		cmd.Printf("Blocked:      %s SNM\r\n", deal.GetBlockedBalance().ToPriceString())
+		cmd.Printf("Total payout: %s SNM\r\n", deal.GetTotalPayout().ToPriceString())
+		cmd.Printf("Accrued:      %s SNM\r\n", deal.GetAccrued().ToPriceString())
+		cmd.Printf("Forecast:     %s SNM\r\n", deal.GetForecast().ToPriceString())
+		cmd.Printf("Last bill:    %s\r\n", deal.GetLastBillTS().Unix().Format(time.RFC3339))
+		cmd.Printf("Next bill:    %s\r\n", deal.GetNextBillTS().Unix().Format(time.RFC3339))
+		cmd.Printf("Paid until:   %s\r\n", deal.GetPaidUntil().Unix().Format(time.RFC3339))
+		if deal.GetOverBudget() {
+			cmd.Println("Over budget:  yes")
+		}
+	}
+}
+
+func printDealChangeRequests(cmd *cobra.Command, requests []*pb.DealChangeRequest) {
+	if isSimpleFormat() {
+		if len(requests) == 0 {
+			cmd.Println("No change requests found")
+			return
+		}
+
+		cmd.Println("        ID | type |       status     |      price (USD/sec)     |   duration ")
+		for _, req := range requests {
+			cmd.Printf("%10s | %4s | %16s | %24s | %10s\r\n",
+				req.GetId().Unwrap().String(),
+				req.GetRequestType().String(),
+				req.GetStatus().String(),
+				req.GetPrice().ToPriceString(),
+				(time.Second * time.Duration(req.GetDuration())).String())
+		}
+	} else {
+		showJSON(cmd, map[string]interface{}{"requests": requests})
+	}
+}
+
 func printDealInfo(cmd *cobra.Command, info *pb.DealInfoReply) {
 	if isSimpleFormat() {
 		deal := info.GetDeal()
@@ -351,6 +494,49 @@ func printTaskStart(cmd *cobra.Command, start *pb.StartTaskReply) {
 	}
 }
 
+func printTaskEvent(cmd *cobra.Command, event *pb.TaskEvent) {
+	if isSimpleFormat() {
+		ts := event.GetTimestamp().Unix().Format(time.RFC3339)
+		cmd.Printf("%s %s %s", ts, event.GetId(), event.GetType().String())
+
+		switch event.GetType() {
+		case pb.TaskEvent_FINISHED, pb.TaskEvent_BROKEN, pb.TaskEvent_OOM_KILLED:
+			cmd.Printf(" (exit code: %d)", event.GetExitCode())
+		case pb.TaskEvent_RESTARTED:
+			cmd.Printf(" (restarts: %d, last exit code: %d)", event.GetRestartCount(), event.GetExitCode())
+		}
+
+		cmd.Println()
+	} else {
+		showJSON(cmd, event)
+	}
+}
+
+func printTaskSnapshot(cmd *cobra.Command, snapshot *pb.TaskSnapshot) {
+	if isSimpleFormat() {
+		ts := snapshot.GetCreatedAt().Unix().Format(time.RFC3339)
+		size := datasize.NewByteSize(snapshot.GetSize())
+		cmd.Printf("%s #%d %s %s (%s)\r\n", snapshot.GetTaskID(), snapshot.GetSerial(), ts, snapshot.GetName(), size.HumanReadable())
+	} else {
+		showJSON(cmd, snapshot)
+	}
+}
+
+func printTaskSnapshots(cmd *cobra.Command, reply *pb.TaskSnapshotsReply) {
+	if isSimpleFormat() {
+		if len(reply.GetSnapshots()) == 0 {
+			cmd.Println("No snapshots")
+			return
+		}
+
+		for _, snapshot := range reply.GetSnapshots() {
+			printTaskSnapshot(cmd, snapshot)
+		}
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
 func printBalanceInfo(cmd *cobra.Command, reply *pb.BalanceReply) {
 	side := reply.GetSideBalance().ToPriceString()
 	live := reply.GetLiveBalance().ToPriceString()
@@ -381,3 +567,63 @@ func printBlacklist(cmd *cobra.Command, list *pb.BlacklistReply) {
 		showJSON(cmd, list)
 	}
 }
+
+func printPendingTransactions(cmd *cobra.Command, reply *pb.PendingTransactionsReply) {
+	if isSimpleFormat() {
+		if len(reply.GetTransactions()) == 0 {
+			cmd.Println("No pending transactions")
+			return
+		}
+
+		for _, tx := range reply.GetTransactions() {
+			cmd.Printf("%s (%s)\n", tx.GetHash(), tx.GetChain())
+			cmd.Printf("  From:         %s\n", tx.GetFrom().Unwrap().Hex())
+			cmd.Printf("  Nonce:        %d\n", tx.GetNonce())
+			cmd.Printf("  Gas price:    %s\n", tx.GetGasPrice().Unwrap().String())
+			cmd.Printf("  Sent at:      %s\n", tx.GetSentAt().Unix().Format(time.RFC3339))
+			cmd.Printf("  Replacements: %d\n", tx.GetReplacements())
+		}
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
+func printTokenTransfer(cmd *cobra.Command, transfer *pb.TokenTransfer) {
+	if isSimpleFormat() {
+		cmd.Printf("%s #%s: %s SNM, %s\n", transfer.GetDirection(), transfer.GetTxNumber().Unwrap().String(),
+			transfer.GetAmount().ToPriceString(), transfer.GetStatus())
+	} else {
+		showJSON(cmd, transfer)
+	}
+}
+
+func printTokenTransfers(cmd *cobra.Command, reply *pb.TokenTransfersReply) {
+	if isSimpleFormat() {
+		if len(reply.GetTransfers()) == 0 {
+			cmd.Println("No transfers")
+			return
+		}
+
+		for _, transfer := range reply.GetTransfers() {
+			printTokenTransfer(cmd, transfer)
+		}
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
+func printTokenTransaction(cmd *cobra.Command, reply *pb.TokenTransactionReply) {
+	if isSimpleFormat() {
+		cmd.Printf("Transaction %s confirmed\n", reply.GetHash())
+	} else {
+		showJSON(cmd, reply)
+	}
+}
+
+func printTokenAllowance(cmd *cobra.Command, reply *pb.TokenAllowanceReply) {
+	if isSimpleFormat() {
+		cmd.Printf("Allowance: %s SNM\n", reply.GetAllowance().ToPriceString())
+	} else {
+		showJSON(cmd, map[string]string{"allowance": reply.GetAllowance().ToPriceString()})
+	}
+}
@@ -0,0 +1,388 @@
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/sonm-io/core/insonmnia/structs"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/xdocker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	transferChunkSize  = 1 * 1024 * 1024
+	transferAttempts   = 10
+	transferRetryDelay = 3 * time.Second
+)
+
+// isTransferRetryable returns true if the image transfer failed with the
+// given error can be resumed.
+func isTransferRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.Internal, codes.DataLoss:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryTransfer calls the given transfer attempt function until it either
+// succeeds, fails with an error that is not retryable or the attempts are
+// exhausted.
+func retryTransfer(ctx context.Context, attempt func() error) error {
+	for idx := 1; ; idx++ {
+		err := attempt()
+		if err == nil || idx >= transferAttempts || !isTransferRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(transferRetryDelay):
+		}
+	}
+}
+
+// openImagePush opens the image archive to be pushed.
+//
+// When the archive is produced by "docker save", layers the Worker already
+// has are stripped from it into a temporary file. The returned function
+// closes the archive and removes the temporary file, if any.
+func openImagePush(ctx context.Context, node pb.TaskManagementClient, dealID, path string) (*os.File, func(), error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closeFile := func() { file.Close() }
+
+	layers, err := xdocker.ReadLayers(file)
+	switch err {
+	case nil:
+	case xdocker.ErrNotImageArchive:
+		return rewind(file, closeFile)
+	default:
+		file.Close()
+		return nil, nil, fmt.Errorf("malformed image archive: %v", err)
+	}
+
+	chainIDs := make([]string, 0, len(layers))
+	for _, layer := range layers {
+		chainIDs = append(chainIDs, layer.ChainID)
+	}
+
+	reply, err := node.ImageLayers(ctx, &pb.ImageLayersRequest{DealId: dealID, ChainIDs: chainIDs})
+	if err != nil {
+		// Older Workers know nothing about layers, so the archive is pushed
+		// entirely.
+		if status.Code(err) == codes.Unimplemented {
+			return rewind(file, closeFile)
+		}
+
+		file.Close()
+		return nil, nil, err
+	}
+
+	known := map[string]bool{}
+	for _, chainID := range reply.GetChainIDs() {
+		known[chainID] = true
+	}
+
+	// The same layer tarball may be shared by several images, so it can be
+	// omitted only if all of them have it.
+	required := map[string]bool{}
+	for _, layer := range layers {
+		if !known[layer.ChainID] {
+			required[layer.Path] = true
+		}
+	}
+
+	var skip []xdocker.Layer
+	for _, layer := range layers {
+		if !required[layer.Path] {
+			skip = append(skip, layer)
+		}
+	}
+
+	if len(skip) == 0 {
+		return rewind(file, closeFile)
+	}
+
+	defer file.Close()
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, err
+	}
+
+	tmp, err := ioutil.TempFile("", "sonm-push-")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closeTmp := func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}
+
+	if err := xdocker.StripLayers(file, tmp, skip); err != nil {
+		closeTmp()
+		return nil, nil, err
+	}
+
+	return rewind(tmp, closeTmp)
+}
+
+func rewind(file *os.File, closeFile func()) (*os.File, func(), error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		closeFile()
+		return nil, nil, err
+	}
+
+	return file, closeFile, nil
+}
+
+// imagePush uploads an image archive, resuming from the offset the Worker
+// has committed when the upload is interrupted.
+type imagePush struct {
+	node     pb.TaskManagementClient
+	dealID   string
+	file     *os.File
+	size     int64
+	digest   string
+	progress func(committed int64)
+}
+
+func newImagePush(node pb.TaskManagementClient, dealID string, file *os.File, progress func(int64)) (*imagePush, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := xdocker.Digest(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return &imagePush{
+		node:     node,
+		dealID:   dealID,
+		file:     file,
+		size:     info.Size(),
+		digest:   digest,
+		progress: progress,
+	}, nil
+}
+
+// Run pushes the archive and returns the trailer of the successful attempt.
+func (m *imagePush) Run(ctx context.Context) (metadata.MD, error) {
+	var trailer metadata.MD
+	err := retryTransfer(ctx, func() error {
+		var err error
+		trailer, err = m.attempt(ctx)
+		return err
+	})
+
+	return trailer, err
+}
+
+func (m *imagePush) attempt(ctx context.Context) (metadata.MD, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
+		"deal":   m.dealID,
+		"size":   strconv.FormatInt(m.size, 10),
+		"digest": m.digest,
+	}))
+
+	client, err := m.node.PushTask(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := client.Header()
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := structs.RequireHeaderInt64(header, "offset")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := m.file.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	committed := offset
+	m.progress(committed)
+
+	buf := make([]byte, transferChunkSize)
+	for {
+		n, readErr := m.file.Read(buf)
+		if n > 0 {
+			if err := client.Send(&pb.Chunk{Chunk: buf[:n]}); err != nil {
+				if err == io.EOF {
+					// The real error is delivered with the status.
+					_, err = client.Recv()
+				}
+				return nil, err
+			}
+
+			for remaining := int64(n); remaining > 0; {
+				progress, err := client.Recv()
+				if err != nil {
+					return nil, err
+				}
+
+				remaining -= progress.Size
+				committed += progress.Size
+				m.progress(committed)
+			}
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+
+	if err := client.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	for {
+		if _, err := client.Recv(); err != nil {
+			if err == io.EOF {
+				return client.Trailer(), nil
+			}
+
+			return nil, err
+		}
+	}
+}
+
+// imagePull downloads an image archive, resuming from the number of bytes
+// received when the download is interrupted, and verifies its digest.
+type imagePull struct {
+	node     pb.TaskManagementClient
+	dealID   string
+	taskID   string
+	wr       io.Writer
+	hash     hash.Hash
+	snapshot uint64
+	received int64
+	size     int64
+	digest   string
+	// started is called once the archive size is known.
+	started  func(size int64)
+	progress func(received int64)
+}
+
+func newImagePull(node pb.TaskManagementClient, dealID, taskID string, wr io.Writer) *imagePull {
+	return &imagePull{
+		node:     node,
+		dealID:   dealID,
+		taskID:   taskID,
+		wr:       wr,
+		hash:     sha256.New(),
+		started:  func(int64) {},
+		progress: func(int64) {},
+	}
+}
+
+func (m *imagePull) Run(ctx context.Context) error {
+	err := retryTransfer(ctx, func() error {
+		err := m.attempt(ctx)
+		// Workers that do not report the digest are unable to resume pulls,
+		// so only the ones that have not received anything can be retried.
+		if err != nil && len(m.digest) == 0 && m.received > 0 {
+			return fmt.Errorf("cannot resume image pull: %v", err)
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(m.digest) == 0 {
+		return nil
+	}
+
+	if digest := "sha256:" + hex.EncodeToString(m.hash.Sum(nil)); digest != m.digest {
+		return fmt.Errorf("image archive digest mismatch: expected %s, got %s", m.digest, digest)
+	}
+
+	return nil
+}
+
+func (m *imagePull) attempt(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client, err := m.node.PullTask(ctx, &pb.PullTaskRequest{
+		DealId:   m.dealID,
+		TaskId:   m.taskID,
+		Offset:   m.received,
+		Digest:   m.digest,
+		Snapshot: m.snapshot,
+	})
+	if err != nil {
+		return err
+	}
+
+	header, err := client.Header()
+	if err != nil {
+		return err
+	}
+
+	size, err := structs.RequireHeaderInt64(header, "size")
+	if err != nil {
+		return err
+	}
+
+	if m.received == 0 {
+		m.size = size
+		if digest, ok := header["digest"]; ok && len(digest) > 0 {
+			m.digest = digest[0]
+		}
+		m.started(size)
+	}
+
+	for {
+		chunk, err := client.Recv()
+		if err == io.EOF {
+			if m.received < m.size {
+				return status.Errorf(codes.Aborted, "stream closed after %d of %d bytes", m.received, m.size)
+			}
+
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := m.wr.Write(chunk.Chunk); err != nil {
+			return err
+		}
+
+		m.hash.Write(chunk.Chunk)
+		m.received += int64(len(chunk.Chunk))
+		m.progress(m.received)
+	}
+}
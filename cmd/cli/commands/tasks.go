@@ -2,22 +2,21 @@ package commands
 
 import (
 	"bufio"
-	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
 	"os"
-	"strconv"
 	"time"
 
+	"github.com/docker/docker/api/types"
 	"github.com/gosuri/uiprogress"
 	"github.com/sonm-io/core/cmd/cli/task_config"
-	"github.com/sonm-io/core/insonmnia/structs"
 	pb "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc/metadata"
 )
 
 func init() {
@@ -29,6 +28,12 @@ func init() {
 	taskLogsCmd.Flags().BoolVar(&details, detailsFlag, false, "Show extra details provided to logs")
 
 	taskPullCmd.Flags().StringVar(&taskPullOutput, "output", "", "file to output")
+	taskPullCmd.Flags().Uint64Var(&taskPullSnapshot, "snapshot", 0, "serial number of the task's snapshot to pull instead of the image committed on stop")
+
+	taskCommitCmd.Flags().StringVar(&taskCommitRegistry, "registry", "", "registry to push the snapshot to, e.g. \"registry.example.com/user\"")
+	taskCommitCmd.Flags().StringVar(&taskCommitUser, "user", "", "registry user name")
+	taskCommitCmd.Flags().StringVar(&taskCommitPassword, "password", "", "registry password")
+	taskCommitCmd.Flags().DurationVar(&taskCommitEvery, "every", 0, "keep committing the task periodically with the given interval")
 
 	taskRootCmd.AddCommand(
 		taskListCmd,
@@ -39,10 +44,20 @@ func init() {
 		taskPullCmd,
 		taskPushCmd,
 		taskJoinNetworkCmd,
+		taskWatchCmd,
+		taskCommitCmd,
+		taskSnapshotsCmd,
 	)
 }
 
-var taskPullOutput string
+var (
+	taskPullOutput     string
+	taskPullSnapshot   uint64
+	taskCommitRegistry string
+	taskCommitUser     string
+	taskCommitPassword string
+	taskCommitEvery    time.Duration
+)
 
 var taskRootCmd = &cobra.Command{
 	Use:   "task",
@@ -181,6 +196,14 @@ var taskStartCmd = &cobra.Command{
 				Volumes:       volumes,
 				Mounts:        taskDef.Mounts(),
 				Networks:      networks,
+				Entrypoint:    taskDef.GetEntrypoint(),
+				Args:          taskDef.GetCommand(),
+				WorkDir:       taskDef.GetWorkDir(),
+				User:          taskDef.GetUser(),
+				StopSignal:    taskDef.GetStopSignal(),
+				StopTimeout:   taskDef.GetStopTimeout(),
+				RestartPolicy: taskDef.GetRestartPolicy(),
+				HealthCheck:   taskDef.GetHealthCheck(),
 			},
 			Resources: taskDef.GetResources(),
 		}
@@ -326,6 +349,54 @@ var taskLogsCmd = &cobra.Command{
 	},
 }
 
+var taskWatchCmd = &cobra.Command{
+	Use:    "watch <deal_id> [task_id]",
+	Short:  "Watch task lifecycle events",
+	Args:   cobra.RangeArgs(1, 2),
+	PreRun: loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot connect to Node", err)
+			os.Exit(1)
+		}
+
+		dealID, err := util.ParseBigInt(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		req := &pb.WatchTasksRequest{DealID: pb.NewBigInt(dealID)}
+		if len(args) > 1 {
+			req.Id = args[1]
+		}
+
+		watchClient, err := node.Watch(ctx, req)
+		if err != nil {
+			showError(cmd, "Cannot watch tasks", err)
+			os.Exit(1)
+		}
+
+		for {
+			event, err := watchClient.Recv()
+			if err == io.EOF {
+				return
+			}
+
+			if err != nil {
+				showError(cmd, "Cannot receive task event", err)
+				os.Exit(1)
+			}
+
+			printTaskEvent(cmd, event)
+		}
+	},
+}
+
 var taskStopCmd = &cobra.Command{
 	Use:    "stop <deal_id> <task_id>",
 	Short:  "Stop task",
@@ -371,7 +442,6 @@ var taskPullCmd = &cobra.Command{
 		taskID := args[1]
 
 		var wr io.Writer
-		var err error
 		if taskPullOutput == "" {
 			wr = os.Stdout
 		} else {
@@ -396,72 +466,28 @@ var taskPullCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		req := &pb.PullTaskRequest{
-			DealId: dealID,
-			TaskId: taskID,
-		}
-
-		client, err := node.PullTask(ctx, req)
-		if err != nil {
-			showError(cmd, "Cannot create image pull client", err)
-			os.Exit(1)
-		}
-
 		var bar *uiprogress.Bar
-		var bytesRecv int64
-
-		receivedSize := false
-		streaming := true
-		for streaming {
-			chunk, err := client.Recv()
-			if chunk != nil {
-				if !receivedSize {
-
-					header, err := client.Header()
-					if err != nil {
-						showError(cmd, "Cannot get client header", err)
-						os.Exit(1)
-					}
-
-					size, err := structs.RequireHeaderInt64(header, "size")
-					if err != nil {
-						showError(cmd, "Cannot convert header value to int64", err)
-						os.Exit(1)
-					}
-
-					if taskPullOutput != "" {
-						uiprogress.Start()
-						bar = uiprogress.AddBar(int(size))
-						bar.PrependFunc(func(b *uiprogress.Bar) string {
-							return fmt.Sprintf("Pushing %d/%d B)", bytesRecv, size)
-						})
-						bar.AppendCompleted()
-					}
-					receivedSize = true
-				}
-
-				n, err := io.Copy(wr, bytes.NewReader(chunk.Chunk))
-				if err != nil {
-					showError(cmd, "Cannot write to file", err)
-					os.Exit(1)
-				}
-
-				bytesRecv += n
-				if bar != nil {
-					bar.Set(int(bytesRecv))
-				}
+		pull := newImagePull(node, dealID, taskID, w)
+		pull.snapshot = taskPullSnapshot
+		if taskPullOutput != "" {
+			pull.started = func(size int64) {
+				uiprogress.Start()
+				bar = uiprogress.AddBar(int(size))
+				bar.PrependFunc(func(b *uiprogress.Bar) string {
+					return fmt.Sprintf("Pulling %d/%d B)", pull.received, size)
+				})
+				bar.AppendCompleted()
 			}
-
-			if err != nil {
-				if err == io.EOF {
-					streaming = false
-				} else {
-					showError(cmd, "Streaming error", err)
-					os.Exit(1)
-				}
+			pull.progress = func(received int64) {
+				bar.Set(int(received))
 			}
 		}
 
+		if err := pull.Run(ctx); err != nil {
+			showError(cmd, "Cannot pull image", err)
+			os.Exit(1)
+		}
+
 		if err := w.Flush(); err != nil {
 			showError(cmd, "Cannot flush writer", err)
 			os.Exit(1)
@@ -478,103 +504,149 @@ var taskPushCmd = &cobra.Command{
 		dealID := args[0]
 		path := args[1]
 
-		file, err := os.Open(path)
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot connect to Node", err)
+			os.Exit(1)
+		}
+
+		file, closeFile, err := openImagePush(ctx, node, dealID, path)
 		if err != nil {
 			showError(cmd, "Cannot open archive path", err)
 			os.Exit(1)
 		}
 
-		defer file.Close()
+		defer closeFile()
 
-		fileInfo, err := file.Stat()
+		var bar *uiprogress.Bar
+		var bytesCommitted int64
+		push, err := newImagePush(node, dealID, file, func(committed int64) {
+			bytesCommitted = committed
+			bar.Set(int(committed))
+		})
 		if err != nil {
-			showError(cmd, "Cannot stat file", err)
+			showError(cmd, "Cannot prepare archive", err)
 			os.Exit(1)
 		}
 
-		ctx, cancel := newTimeoutContext()
-		defer cancel()
+		uiprogress.Start()
+		bar = uiprogress.AddBar(int(push.size))
+		bar.PrependFunc(func(b *uiprogress.Bar) string {
+			return fmt.Sprintf("Pushing %d/%d B)", bytesCommitted, push.size)
+		})
+		bar.AppendCompleted()
 
-		node, err := newTaskClient(ctx)
+		trailer, err := push.Run(ctx)
 		if err != nil {
-			showError(cmd, "Cannot connect to Node", err)
+			showError(cmd, "Cannot push image", err)
+			os.Exit(1)
+		}
+
+		status, ok := trailer["status"]
+		if !ok {
+			showError(cmd, "No status returned", nil)
 			os.Exit(1)
 		}
 
-		ctx = metadata.NewOutgoingContext(ctx, metadata.New(map[string]string{
-			"deal": dealID,
-			"size": strconv.FormatInt(fileInfo.Size(), 10),
-		}))
+		showJSON(cmd, map[string]interface{}{"status": status})
+	},
+}
 
-		client, err := node.PushTask(ctx)
+var taskCommitCmd = &cobra.Command{
+	Use:    "commit <deal_id> <task_id>",
+	Short:  "Snapshot the task, optionally pushing the snapshot to a registry",
+	Args:   cobra.MinimumNArgs(2),
+	PreRun: loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		dealID, err := util.ParseBigInt(args[0])
 		if err != nil {
-			showError(cmd, "Cannot create push task client", err)
+			showError(cmd, err.Error(), nil)
 			os.Exit(1)
 		}
 
-		readCompleted := false
-		bytesRemaining := int64(0)
-		bytesCommitted := int64(0)
+		req := &pb.CommitTaskRequest{
+			Id:       args[1],
+			DealID:   pb.NewBigInt(dealID),
+			Registry: taskCommitRegistry,
+		}
 
-		uiprogress.Start()
-		bar := uiprogress.AddBar(int(fileInfo.Size()))
-		bar.PrependFunc(func(b *uiprogress.Bar) string {
-			return fmt.Sprintf("Pushing %d/%d B)", bytesCommitted, fileInfo.Size())
-		})
-		bar.AppendCompleted()
+		if len(taskCommitRegistry) != 0 && len(taskCommitUser) != 0 {
+			auth, err := json.Marshal(types.AuthConfig{
+				Username:      taskCommitUser,
+				Password:      taskCommitPassword,
+				ServerAddress: taskCommitRegistry,
+			})
+			if err != nil {
+				showError(cmd, "Cannot encode registry credentials", err)
+				os.Exit(1)
+			}
+
+			req.Auth = base64.StdEncoding.EncodeToString(auth)
+		}
 
-		buf := make([]byte, 1*1024*1024)
 		for {
-			if !readCompleted {
-				n, err := file.Read(buf)
-				if err != nil {
-					if err == io.EOF {
-						readCompleted = true
-
-						if err := client.CloseSend(); err != nil {
-							showError(cmd, "Cannot close client stream", err)
-							os.Exit(1)
-						}
-					} else {
-						showError(cmd, "Cannot read file", err)
-						os.Exit(1)
-					}
-				}
+			if err := commitTask(cmd, req); err != nil {
+				showError(cmd, "Cannot commit task", err)
+				os.Exit(1)
+			}
 
-				if n > 0 {
-					bytesRemaining = int64(n)
-					client.Send(&pb.Chunk{Chunk: buf[:n]})
-				}
+			if taskCommitEvery == 0 {
+				return
 			}
 
-			for {
-				progress, err := client.Recv()
-				if err == io.EOF {
-					if bytesCommitted == fileInfo.Size() {
-						status, ok := client.Trailer()["status"]
-						if !ok {
-							showError(cmd, "No status returned", nil)
-							os.Exit(1)
-						}
-
-						showJSON(cmd, map[string]interface{}{"status": status})
-						return
-					}
-				}
+			time.Sleep(taskCommitEvery)
+		}
+	},
+}
 
-				if err != nil {
-					showError(cmd, "Cannot read from stream", err)
-					os.Exit(1)
-				}
+func commitTask(cmd *cobra.Command, req *pb.CommitTaskRequest) error {
+	ctx, cancel := newTimeoutContext()
+	defer cancel()
 
-				bytesCommitted += progress.Size
-				bytesRemaining -= progress.Size
-				bar.Set(int(bytesCommitted))
+	node, err := newTaskClient(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot connect to Node: %v", err)
+	}
 
-				if bytesRemaining == 0 {
-					break
-				}
-			}
+	snapshot, err := node.Commit(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	printTaskSnapshot(cmd, snapshot)
+	return nil
+}
+
+var taskSnapshotsCmd = &cobra.Command{
+	Use:    "snapshots <deal_id>",
+	Short:  "Show snapshots of the deal's tasks",
+	Args:   cobra.MinimumNArgs(1),
+	PreRun: loadKeyStoreIfRequired,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		node, err := newTaskClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot connect to Node", err)
+			os.Exit(1)
 		}
+
+		dealID, err := util.ParseBigInt(args[0])
+		if err != nil {
+			showError(cmd, err.Error(), nil)
+			os.Exit(1)
+		}
+
+		reply, err := node.Snapshots(ctx, &pb.TaskSnapshotsRequest{DealID: pb.NewBigInt(dealID)})
+		if err != nil {
+			showError(cmd, "Cannot list snapshots", err)
+			os.Exit(1)
+		}
+
+		printTaskSnapshots(cmd, reply)
 	},
 }
@@ -1,16 +1,34 @@
 package commands
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
 	"github.com/spf13/cobra"
 )
 
+var (
+	tokenChainFlag string
+	tokenOwnerFlag string
+)
+
 func init() {
+	for _, cmd := range []*cobra.Command{tokenSendCmd, tokenApproveCmd, tokenAllowanceCmd} {
+		cmd.PersistentFlags().StringVar(&tokenChainFlag, "chain", "side", "Chain to use: \"side\" (SONM) or \"live\" (Ethereum)")
+	}
+	tokenAllowanceCmd.PersistentFlags().StringVar(&tokenOwnerFlag, "owner", "", "Owner of the tokens, defaults to the current account")
+
 	tokenRootCmd.AddCommand(
 		tokenGetCmd,
 		tokenBalanceCmd,
+		tokenDepositCmd,
+		tokenWithdrawCmd,
+		tokenTransfersCmd,
+		tokenSendCmd,
+		tokenApproveCmd,
+		tokenAllowanceCmd,
 	)
 }
 
@@ -65,3 +83,233 @@ var tokenBalanceCmd = &cobra.Command{
 		printBalanceInfo(cmd, balance)
 	},
 }
+
+var tokenDepositCmd = &cobra.Command{
+	Use:    "deposit <amount>",
+	Short:  "Transfer SNM from Ethereum to SONM blockchain",
+	Args:   cobra.ExactArgs(1),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		tokenTransfer(cmd, args[0], sonm.TokenTransferDirection_DEPOSIT)
+	},
+}
+
+var tokenWithdrawCmd = &cobra.Command{
+	Use:    "withdraw <amount>",
+	Short:  "Transfer SNM from SONM blockchain to Ethereum",
+	Args:   cobra.ExactArgs(1),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		tokenTransfer(cmd, args[0], sonm.TokenTransferDirection_WITHDRAWAL)
+	},
+}
+
+var tokenTransfersCmd = &cobra.Command{
+	Use:    "transfers",
+	Short:  "Show deposits and withdrawals of the Node's account",
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		token, err := newTokenManagementClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		transfers, err := token.Transfers(ctx, &sonm.Empty{})
+		if err != nil {
+			showError(cmd, "Cannot load transfers", err)
+			os.Exit(1)
+		}
+
+		printTokenTransfers(cmd, transfers)
+	},
+}
+
+var tokenSendCmd = &cobra.Command{
+	Use:    "transfer <to> <amount>",
+	Short:  "Send SNM to the given address",
+	Args:   cobra.ExactArgs(2),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		chain, err := parseTokenChain(tokenChainFlag)
+		if err != nil {
+			showError(cmd, "Cannot parse chain", err)
+			os.Exit(1)
+		}
+
+		to, err := util.HexToAddress(args[0])
+		if err != nil {
+			showError(cmd, "Cannot parse recipient address", err)
+			os.Exit(1)
+		}
+
+		amount, err := util.StringToEtherPrice(args[1])
+		if err != nil {
+			showError(cmd, "Cannot parse amount", err)
+			os.Exit(1)
+		}
+
+		token, err := newTokenManagementClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		reply, err := token.Transfer(ctx, &sonm.TokenTransferRequest{
+			Chain:  chain,
+			To:     sonm.NewEthAddress(to),
+			Amount: sonm.NewBigInt(amount),
+		})
+		if err != nil {
+			showError(cmd, "Cannot transfer tokens", err)
+			os.Exit(1)
+		}
+
+		printTokenTransaction(cmd, reply)
+	},
+}
+
+var tokenApproveCmd = &cobra.Command{
+	Use:    "approve <spender> <amount>",
+	Short:  "Allow the spender to withdraw SNM from the current account",
+	Args:   cobra.ExactArgs(2),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		chain, err := parseTokenChain(tokenChainFlag)
+		if err != nil {
+			showError(cmd, "Cannot parse chain", err)
+			os.Exit(1)
+		}
+
+		spender, err := util.HexToAddress(args[0])
+		if err != nil {
+			showError(cmd, "Cannot parse spender address", err)
+			os.Exit(1)
+		}
+
+		amount, err := util.StringToEtherPrice(args[1])
+		if err != nil {
+			showError(cmd, "Cannot parse amount", err)
+			os.Exit(1)
+		}
+
+		token, err := newTokenManagementClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		reply, err := token.Approve(ctx, &sonm.TokenApproveRequest{
+			Chain:   chain,
+			Spender: sonm.NewEthAddress(spender),
+			Amount:  sonm.NewBigInt(amount),
+		})
+		if err != nil {
+			showError(cmd, "Cannot approve tokens", err)
+			os.Exit(1)
+		}
+
+		printTokenTransaction(cmd, reply)
+	},
+}
+
+var tokenAllowanceCmd = &cobra.Command{
+	Use:    "allowance <spender>",
+	Short:  "Show the amount of SNM the spender is allowed to withdraw",
+	Args:   cobra.ExactArgs(1),
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		chain, err := parseTokenChain(tokenChainFlag)
+		if err != nil {
+			showError(cmd, "Cannot parse chain", err)
+			os.Exit(1)
+		}
+
+		spender, err := util.HexToAddress(args[0])
+		if err != nil {
+			showError(cmd, "Cannot parse spender address", err)
+			os.Exit(1)
+		}
+
+		request := &sonm.TokenAllowanceRequest{
+			Chain:   chain,
+			Spender: sonm.NewEthAddress(spender),
+		}
+		if len(tokenOwnerFlag) > 0 {
+			owner, err := util.HexToAddress(tokenOwnerFlag)
+			if err != nil {
+				showError(cmd, "Cannot parse owner address", err)
+				os.Exit(1)
+			}
+
+			request.Owner = sonm.NewEthAddress(owner)
+		}
+
+		token, err := newTokenManagementClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		allowance, err := token.Allowance(ctx, request)
+		if err != nil {
+			showError(cmd, "Cannot load allowance", err)
+			os.Exit(1)
+		}
+
+		printTokenAllowance(cmd, allowance)
+	},
+}
+
+func parseTokenChain(chain string) (sonm.TokenChain, error) {
+	switch chain {
+	case "side":
+		return sonm.TokenChain_SIDE_CHAIN, nil
+	case "live":
+		return sonm.TokenChain_LIVE_CHAIN, nil
+	default:
+		return 0, fmt.Errorf("unknown chain \"%s\", expected \"side\" or \"live\"", chain)
+	}
+}
+
+func tokenTransfer(cmd *cobra.Command, amountArg string, direction sonm.TokenTransferDirection) {
+	ctx, cancel := newTimeoutContext()
+	defer cancel()
+
+	amount, err := util.StringToEtherPrice(amountArg)
+	if err != nil {
+		showError(cmd, "Cannot parse amount", err)
+		os.Exit(1)
+	}
+
+	token, err := newTokenManagementClient(ctx)
+	if err != nil {
+		showError(cmd, "Cannot create client connection", err)
+		os.Exit(1)
+	}
+
+	var transfer *sonm.TokenTransfer
+	if direction == sonm.TokenTransferDirection_DEPOSIT {
+		transfer, err = token.Deposit(ctx, sonm.NewBigInt(amount))
+	} else {
+		transfer, err = token.Withdraw(ctx, sonm.NewBigInt(amount))
+	}
+	if err != nil {
+		showError(cmd, "Cannot transfer tokens", err)
+		os.Exit(1)
+	}
+
+	printTokenTransfer(cmd, transfer)
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/sonm-io/core/proto"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	txRootCmd.AddCommand(
+		txPendingCmd,
+	)
+}
+
+var txRootCmd = &cobra.Command{
+	Use:   "tx",
+	Short: "Inspect transactions sent by the Node",
+}
+
+var txPendingCmd = &cobra.Command{
+	Use:    "pending",
+	Short:  "Show transactions that are not mined yet",
+	PreRun: loadKeyStoreWrapper,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := newTimeoutContext()
+		defer cancel()
+
+		transactions, err := newTransactionsClient(ctx)
+		if err != nil {
+			showError(cmd, "Cannot create client connection", err)
+			os.Exit(1)
+		}
+
+		reply, err := transactions.Pending(ctx, &sonm.Empty{})
+		if err != nil {
+			showError(cmd, "Cannot get pending transactions", err)
+			os.Exit(1)
+		}
+
+		printPendingTransactions(cmd, reply)
+	},
+}
@@ -43,6 +43,7 @@ func init() {
 		askPlansRootCmd,
 		workerTasksCmd,
 		workerDevicesCmd,
+		workerWhitelistCmd,
 	)
 }
 
@@ -66,3 +67,17 @@ var workerStatusCmd = &cobra.Command{
 		printWorkerStatus(cmd, status)
 	},
 }
+
+var workerWhitelistCmd = &cobra.Command{
+	Use:   "whitelist",
+	Short: "Show the effective image whitelist policy",
+	Run: func(cmd *cobra.Command, _ []string) {
+		whitelist, err := worker.Whitelist(workerCtx, &pb.Empty{})
+		if err != nil {
+			showError(cmd, "Cannot get worker whitelist", err)
+			os.Exit(1)
+		}
+
+		printWhitelist(cmd, whitelist)
+	},
+}
@@ -3,8 +3,10 @@ package task_config
 import (
 	b64 "encoding/base64"
 	"encoding/json"
+	"fmt"
 	"os"
 
+	"github.com/anmitsu/go-shlex"
 	"github.com/docker/docker/api/types"
 	"github.com/jinzhu/configor"
 	sonm "github.com/sonm-io/core/proto"
@@ -16,6 +18,14 @@ type TaskConfig interface {
 	GetSSHKey() string
 	GetEnvVars() map[string]string
 	GetCommitOnStop() bool
+	GetEntrypoint() []string
+	GetCommand() []string
+	GetWorkDir() string
+	GetUser() string
+	GetStopSignal() string
+	GetStopTimeout() *sonm.Duration
+	GetRestartPolicy() *sonm.ContainerRestartPolicy
+	GetHealthCheck() *sonm.HealthCheck
 
 	GetRegistryName() string
 	GetRegistryAuth() string
@@ -27,14 +37,60 @@ type TaskConfig interface {
 }
 
 type container struct {
-	Name         string            `yaml:"name" required:"true"`
-	Entrypoint   string            `yaml:"command" required:"false"`
-	SSHKey       string            `yaml:"ssh_key" required:"false"`
-	Env          map[string]string `yaml:"env" required:"false"`
-	CommitOnStop bool              `yaml:"commit_on_stop" required:"false"`
-	Volumes      map[string]volume
-	Mounts       []string
-	Networks     []network
+	Name          string            `yaml:"name" required:"true"`
+	Entrypoint    commandLine       `yaml:"entrypoint" required:"false"`
+	Command       commandLine       `yaml:"command" required:"false"`
+	WorkDir       string            `yaml:"work_dir" required:"false"`
+	User          string            `yaml:"user" required:"false"`
+	StopSignal    string            `yaml:"stop_signal" required:"false"`
+	StopTimeout   *sonm.Duration    `yaml:"stop_timeout" required:"false"`
+	RestartPolicy *restartPolicy    `yaml:"restart_policy" required:"false"`
+	HealthCheck   *healthCheck      `yaml:"health_check" required:"false"`
+	SSHKey        string            `yaml:"ssh_key" required:"false"`
+	Env           map[string]string `yaml:"env" required:"false"`
+	CommitOnStop  bool              `yaml:"commit_on_stop" required:"false"`
+	Volumes       map[string]volume
+	Mounts        []string
+	Networks      []network
+}
+
+// commandLine describes a command with its arguments. It can be specified
+// either as a list or as a single string, which is split into arguments
+// using shell-like rules.
+type commandLine []string
+
+func (m *commandLine) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var args []string
+	if err := unmarshal(&args); err == nil {
+		*m = args
+		return nil
+	}
+
+	var line string
+	if err := unmarshal(&line); err != nil {
+		return err
+	}
+
+	args, err := shlex.Split(line, true)
+	if err != nil {
+		return fmt.Errorf("failed to parse command line `%s`: %v", line, err)
+	}
+
+	*m = args
+	return nil
+}
+
+type restartPolicy struct {
+	Name       string `yaml:"name"`
+	MaxRetries uint32 `yaml:"max_retries"`
+}
+
+type healthCheck struct {
+	Command     commandLine    `yaml:"command"`
+	Interval    *sonm.Duration `yaml:"interval"`
+	Timeout     *sonm.Duration `yaml:"timeout"`
+	Retries     uint32         `yaml:"retries"`
+	StartPeriod *sonm.Duration `yaml:"start_period"`
 }
 
 type volume struct {
@@ -81,6 +137,57 @@ func (yc *YamlConfig) GetCommitOnStop() bool {
 	return yc.Task.Container.CommitOnStop
 }
 
+func (yc *YamlConfig) GetEntrypoint() []string {
+	return yc.Task.Container.Entrypoint
+}
+
+func (yc *YamlConfig) GetCommand() []string {
+	return yc.Task.Container.Command
+}
+
+func (yc *YamlConfig) GetWorkDir() string {
+	return yc.Task.Container.WorkDir
+}
+
+func (yc *YamlConfig) GetUser() string {
+	return yc.Task.Container.User
+}
+
+func (yc *YamlConfig) GetStopSignal() string {
+	return yc.Task.Container.StopSignal
+}
+
+func (yc *YamlConfig) GetStopTimeout() *sonm.Duration {
+	return yc.Task.Container.StopTimeout
+}
+
+func (yc *YamlConfig) GetRestartPolicy() *sonm.ContainerRestartPolicy {
+	policy := yc.Task.Container.RestartPolicy
+	if policy == nil {
+		return nil
+	}
+
+	return &sonm.ContainerRestartPolicy{
+		Name:              policy.Name,
+		MaximumRetryCount: policy.MaxRetries,
+	}
+}
+
+func (yc *YamlConfig) GetHealthCheck() *sonm.HealthCheck {
+	check := yc.Task.Container.HealthCheck
+	if check == nil {
+		return nil
+	}
+
+	return &sonm.HealthCheck{
+		Command:     check.Command,
+		Interval:    check.Interval,
+		Timeout:     check.Timeout,
+		Retries:     check.Retries,
+		StartPeriod: check.StartPeriod,
+	}
+}
+
 func (yc *YamlConfig) GetRegistryName() string {
 	if yc.Task.Registry != nil {
 		return yc.Task.Registry.Name
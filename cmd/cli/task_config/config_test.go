@@ -4,12 +4,14 @@ import (
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"encoding/base64"
 	"encoding/json"
 
 	"github.com/docker/docker/api/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -107,6 +109,60 @@ func TestTaskNoRegistry(t *testing.T) {
 	assert.Equal(t, "", cfg.GetRegistryAuth())
 }
 
+func TestTaskCommand(t *testing.T) {
+	createTestConfigFile(`task:
+  container:
+    name: user/image:v1
+    entrypoint: ["/bin/sh", "-c"]
+    command: /myapp -param=1 --name "my app"
+    work_dir: /opt/app
+    user: nobody
+    stop_signal: SIGINT
+    stop_timeout: 30s
+`)
+	defer deleteTestConfigFile()
+
+	cfg, err := LoadConfig(testCfgPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/bin/sh", "-c"}, cfg.GetEntrypoint())
+	assert.Equal(t, []string{"/myapp", "-param=1", "--name", "my app"}, cfg.GetCommand())
+	assert.Equal(t, "/opt/app", cfg.GetWorkDir())
+	assert.Equal(t, "nobody", cfg.GetUser())
+	assert.Equal(t, "SIGINT", cfg.GetStopSignal())
+	assert.Equal(t, 30*time.Second, cfg.GetStopTimeout().Unwrap())
+}
+
+func TestTaskRestartPolicyAndHealthCheck(t *testing.T) {
+	createTestConfigFile(`task:
+  container:
+    name: user/image:v1
+    restart_policy:
+      name: on-failure
+      max_retries: 5
+    health_check:
+      command: curl -f http://localhost/
+      interval: 30s
+      retries: 3
+      start_period: 1m
+`)
+	defer deleteTestConfigFile()
+
+	cfg, err := LoadConfig(testCfgPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, "on-failure", cfg.GetRestartPolicy().GetName())
+	assert.Equal(t, uint32(5), cfg.GetRestartPolicy().GetMaximumRetryCount())
+
+	healthCheck := cfg.GetHealthCheck()
+	require.NotNil(t, healthCheck)
+	assert.Equal(t, []string{"curl", "-f", "http://localhost/"}, healthCheck.GetCommand())
+	assert.Equal(t, 30*time.Second, healthCheck.GetInterval().Unwrap())
+	assert.Nil(t, healthCheck.GetTimeout())
+	assert.Equal(t, uint32(3), healthCheck.GetRetries())
+	assert.Equal(t, time.Minute, healthCheck.GetStartPeriod().Unwrap())
+}
+
 func TestTaskMinimal(t *testing.T) {
 	createTestConfigFile(`task:
   container:
@@ -123,6 +179,11 @@ func TestTaskMinimal(t *testing.T) {
 	assert.Equal(t, "", cfg.GetSSHKey())
 	assert.Equal(t, "", cfg.GetRegistryName())
 	assert.Equal(t, "", cfg.GetRegistryAuth())
+	assert.Empty(t, cfg.GetEntrypoint())
+	assert.Empty(t, cfg.GetCommand())
+	assert.Nil(t, cfg.GetStopTimeout())
+	assert.Nil(t, cfg.GetRestartPolicy())
+	assert.Nil(t, cfg.GetHealthCheck())
 }
 
 func TestTaskNameRequired(t *testing.T) {
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/accounts"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	endpointFlag   string
+	keyFlag        string
+	keystoreFlag   string
+	passphraseFlag string
+	chainIDFlag    uint64
+	gasPriceFlag   int64
+	outputFlag     string
+)
+
+func main() {
+	c := &cobra.Command{
+		Use:   "sonmdevnet",
+		Short: "Deploy SONM contracts to a local development chain",
+		Long: "Deploys the full set of SONM contracts to a local geth or ganache chain and writes out\n" +
+			"the \"blockchain\" config section, which can be pasted into worker, node and DWH configs.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := run(); err != nil {
+				fmt.Printf("Failed to deploy contracts: %s\r\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	c.Flags().StringVar(&endpointFlag, "endpoint", "http://localhost:8545", "Development chain endpoint")
+	c.Flags().StringVar(&keyFlag, "key", "", "Hex-encoded private key of a funded account")
+	c.Flags().StringVar(&keystoreFlag, "keystore", "", "Keystore to load the key from, if --key is not set")
+	c.Flags().StringVar(&passphraseFlag, "passphrase", "", "Keystore passphrase")
+	c.Flags().Uint64Var(&chainIDFlag, "chain-id", 0, "Chain ID for EIP-155 transaction signing, 0 disables it")
+	c.Flags().Int64Var(&gasPriceFlag, "gas-price", 0, "Gas price in Wei")
+	c.Flags().StringVar(&outputFlag, "output", "", "Path to write the config to, defaults to stdout")
+
+	c.Execute()
+}
+
+func run() error {
+	key, err := loadKey()
+	if err != nil {
+		return fmt.Errorf("failed to load key: %s", err)
+	}
+
+	var chainID *big.Int
+	if chainIDFlag != 0 {
+		chainID = new(big.Int).SetUint64(chainIDFlag)
+	}
+
+	cfg, err := blockchain.DeployDevnet(context.Background(), key, endpointFlag, chainID, gasPriceFlag)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(map[string]interface{}{"blockchain": cfg})
+	if err != nil {
+		return err
+	}
+
+	if len(outputFlag) == 0 {
+		fmt.Print(string(data))
+		return nil
+	}
+
+	return ioutil.WriteFile(outputFlag, data, 0644)
+}
+
+func loadKey() (*ecdsa.PrivateKey, error) {
+	if len(keyFlag) != 0 {
+		return crypto.HexToECDSA(keyFlag)
+	}
+
+	return accounts.LoadKeys(keystoreFlag, passphraseFlag, accounts.Silent())
+}
@@ -1,6 +1,7 @@
 package dwh
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/jinzhu/configor"
 	"github.com/pkg/errors"
 	"github.com/sonm-io/core/accounts"
@@ -18,6 +19,9 @@ type Config struct {
 	MetricsListenAddr string             `yaml:"metrics_listen_addr" default:"127.0.0.1:14004"`
 	ColdStart         *ColdStartConfig   `yaml:"cold_start"`
 	NumWorkers        int                `yaml:"num_workers" default:"16"`
+	// Admin is allowed to manage failed events. Defaults to the DWH's own
+	// address.
+	Admin *common.Address `yaml:"admin"`
 }
 
 type storageConfig struct {
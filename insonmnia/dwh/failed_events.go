@@ -0,0 +1,336 @@
+package dwh
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxEventRetryTime = time.Hour
+)
+
+var (
+	errFailedEventsPending = errors.New("queued behind failed events of the same entity")
+
+	// eventDataTypes maps names of event payload types to the types
+	// themselves, which is required to decode failed events.
+	eventDataTypes = map[string]reflect.Type{}
+)
+
+func init() {
+	for _, data := range []interface{}{
+		&blockchain.DealOpenedData{},
+		&blockchain.DealUpdatedData{},
+		&blockchain.DealChangeRequestSentData{},
+		&blockchain.DealChangeRequestUpdatedData{},
+		&blockchain.OrderPlacedData{},
+		&blockchain.OrderUpdatedData{},
+		&blockchain.BilledData{},
+		&blockchain.WorkerAnnouncedData{},
+		&blockchain.WorkerConfirmedData{},
+		&blockchain.WorkerRemovedData{},
+		&blockchain.AddedToBlacklistData{},
+		&blockchain.RemovedFromBlacklistData{},
+		&blockchain.ValidatorCreatedData{},
+		&blockchain.ValidatorDeletedData{},
+		&blockchain.CertificateCreatedData{},
+	} {
+		dataType := reflect.TypeOf(data).Elem()
+		eventDataTypes[dataType.Name()] = dataType
+	}
+}
+
+// failedEvent is a blockchain event that has failed to be processed and is
+// waiting for retry.
+type failedEvent struct {
+	ID          uint64
+	EntityKey   string
+	BlockNumber uint64
+	BlockHash   string
+	LogIndex    uint64
+	TS          uint64
+	Type        string
+	Data        string
+	Attempts    uint64
+	LastError   string
+	NextRetryTS int64
+}
+
+func newFailedEvent(event *blockchain.Event, entityKey string) (*failedEvent, error) {
+	dataType := reflect.TypeOf(event.Data)
+	if dataType.Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("unexpected event data type: %s", dataType.String())
+	}
+	if _, ok := eventDataTypes[dataType.Elem().Name()]; !ok {
+		return nil, fmt.Errorf("unsupported event data type: %s", dataType.String())
+	}
+
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal event data")
+	}
+
+	return &failedEvent{
+		EntityKey:   entityKey,
+		BlockNumber: event.BlockNumber,
+		BlockHash:   event.BlockHash.Hex(),
+		LogIndex:    uint64(event.LogIndex),
+		TS:          event.TS,
+		Type:        dataType.Elem().Name(),
+		Data:        string(data),
+	}, nil
+}
+
+func (m *failedEvent) Event() (*blockchain.Event, error) {
+	dataType, ok := eventDataTypes[m.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported event data type: %s", m.Type)
+	}
+
+	data := reflect.New(dataType).Interface()
+	if err := json.Unmarshal([]byte(m.Data), data); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal event data")
+	}
+
+	return &blockchain.Event{
+		Data:        data,
+		BlockNumber: m.BlockNumber,
+		BlockHash:   common.HexToHash(m.BlockHash),
+		LogIndex:    uint(m.LogIndex),
+		TS:          m.TS,
+	}, nil
+}
+
+func (m *failedEvent) Unwrap() *pb.FailedEvent {
+	return &pb.FailedEvent{
+		Id:          m.ID,
+		BlockNumber: m.BlockNumber,
+		Type:        m.Type,
+		Data:        m.Data,
+		Attempts:    m.Attempts,
+		LastError:   m.LastError,
+		NextRetryTS: &pb.Timestamp{Seconds: m.NextRetryTS},
+	}
+}
+
+// eventEntityKey returns the key of the entity the event belongs to. Events of
+// the same entity are processed in order of their arrival.
+func eventEntityKey(event *blockchain.Event) string {
+	switch value := event.Data.(type) {
+	case *blockchain.DealOpenedData:
+		return fmt.Sprintf("Deal_%s", value.ID.String())
+	case *blockchain.DealUpdatedData:
+		return fmt.Sprintf("Deal_%s", value.ID.String())
+	case *blockchain.BilledData:
+		return fmt.Sprintf("Deal_%s", value.DealID.String())
+	case *blockchain.OrderPlacedData:
+		return fmt.Sprintf("Order_%s", value.ID.String())
+	case *blockchain.OrderUpdatedData:
+		return fmt.Sprintf("Order_%s", value.ID.String())
+	case *blockchain.DealChangeRequestSentData:
+		return fmt.Sprintf("DealChangeRequest_%s", value.ID.String())
+	case *blockchain.DealChangeRequestUpdatedData:
+		return fmt.Sprintf("DealChangeRequest_%s", value.ID.String())
+	case *blockchain.WorkerAnnouncedData:
+		return fmt.Sprintf("Worker_%s_%s", value.MasterID.Hex(), value.SlaveID.Hex())
+	case *blockchain.WorkerConfirmedData:
+		return fmt.Sprintf("Worker_%s_%s", value.MasterID.Hex(), value.SlaveID.Hex())
+	case *blockchain.WorkerRemovedData:
+		return fmt.Sprintf("Worker_%s_%s", value.MasterID.Hex(), value.SlaveID.Hex())
+	case *blockchain.AddedToBlacklistData:
+		return fmt.Sprintf("Blacklist_%s_%s", value.AdderID.Hex(), value.AddeeID.Hex())
+	case *blockchain.RemovedFromBlacklistData:
+		return fmt.Sprintf("Blacklist_%s_%s", value.RemoverID.Hex(), value.RemoveeID.Hex())
+	case *blockchain.ValidatorCreatedData:
+		return fmt.Sprintf("Validator_%s", value.ID.Hex())
+	case *blockchain.ValidatorDeletedData:
+		return fmt.Sprintf("Validator_%s", value.ID.Hex())
+	case *blockchain.CertificateCreatedData:
+		return fmt.Sprintf("Certificate_%s", value.ID.String())
+	default:
+		return ""
+	}
+}
+
+// eventShard returns the index of the worker the event must be processed by,
+// so that events of the same entity are never processed concurrently.
+func eventShard(entityKey string, numWorkers int) int {
+	hash := fnv.New32a()
+	hash.Write([]byte(entityKey))
+	return int(hash.Sum32() % uint32(numWorkers))
+}
+
+// eventRetryDelay returns the exponential backoff delay before the next
+// attempt to process a failed event.
+func eventRetryDelay(attempts uint64) time.Duration {
+	delay := eventRetryTime
+	for idx := uint64(1); idx < attempts && delay < maxEventRetryTime; idx++ {
+		delay *= 2
+	}
+	if delay > maxEventRetryTime {
+		delay = maxEventRetryTime
+	}
+
+	return delay
+}
+
+// handleEvent processes the event or, if it fails, persists it for retry.
+func (w *DWH) handleEvent(event *blockchain.Event) error {
+	entityKey := eventEntityKey(event)
+
+	// Events of an entity that already has failed events are queued behind
+	// them, otherwise e.g. OrderUpdated could be applied before OrderPlaced.
+	pending, err := w.hasFailedEvents(entityKey)
+	if err == nil {
+		if !pending {
+			if err = w.processEvent(event); err == nil {
+				return nil
+			}
+		} else {
+			err = errFailedEventsPending
+		}
+	}
+
+	failed, encodeErr := newFailedEvent(event, entityKey)
+	if encodeErr != nil {
+		return errors.Wrapf(encodeErr, "failed to persist failed event (%v)", err)
+	}
+	failed.LastError = err.Error()
+	if err == errFailedEventsPending {
+		failed.NextRetryTS = time.Now().Unix()
+	} else {
+		failed.Attempts = 1
+		failed.NextRetryTS = time.Now().Add(eventRetryDelay(failed.Attempts)).Unix()
+	}
+
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	if err := w.storage.InsertFailedEvent(conn, failed); err != nil {
+		return errors.Wrap(err, "failed to InsertFailedEvent")
+	}
+
+	return err
+}
+
+func (w *DWH) hasFailedEvents(entityKey string) (bool, error) {
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	return w.storage.CheckFailedEvents(conn, entityKey)
+}
+
+func (w *DWH) retryFailedEvents() {
+	ticker := time.NewTicker(eventRetryTime)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			w.logger.Info("context cancelled (retryFailedEvents)")
+			return
+		case <-ticker.C:
+		case <-w.retryWakeup:
+		}
+
+		if err := w.processFailedEvents(); err != nil {
+			w.logger.Warn("failed to process failed events", util.LaconicError(err))
+		}
+	}
+}
+
+// processFailedEvents retries failed events that are due. Once an event of an
+// entity fails again, the rest of the entity's events are left for the next
+// round.
+func (w *DWH) processFailedEvents() error {
+	w.failedEventsMu.Lock()
+	defer w.failedEventsMu.Unlock()
+
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	now := time.Now()
+	events, err := w.storage.GetDueFailedEvents(conn, now.Unix())
+	if err != nil {
+		return errors.Wrap(err, "failed to GetDueFailedEvents")
+	}
+
+	blocked := map[string]bool{}
+	for _, failed := range events {
+		if blocked[failed.EntityKey] {
+			continue
+		}
+
+		event, err := failed.Event()
+		if err == nil {
+			err = w.processEvent(event)
+		}
+		if err != nil {
+			blocked[failed.EntityKey] = true
+			failed.Attempts++
+			failed.LastError = err.Error()
+			failed.NextRetryTS = now.Add(eventRetryDelay(failed.Attempts)).Unix()
+			w.logger.Warn("failed to retry event", util.LaconicError(err), zap.Uint64("id", failed.ID),
+				zap.String("type", failed.Type), zap.String("data", failed.Data),
+				zap.Uint64("attempts", failed.Attempts))
+			if err := w.storage.UpdateFailedEvent(conn, failed); err != nil {
+				return errors.Wrap(err, "failed to UpdateFailedEvent")
+			}
+			continue
+		}
+
+		if err := w.storage.DeleteFailedEvent(conn, failed.ID); err != nil {
+			return errors.Wrap(err, "failed to DeleteFailedEvent")
+		}
+	}
+
+	return nil
+}
+
+func (w *DWH) GetFailedEvents(ctx context.Context, request *pb.FailedEventsRequest) (*pb.FailedEventsReply, error) {
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	events, count, err := w.storage.GetFailedEvents(conn, request)
+	if err != nil {
+		w.logger.Error("failed to GetFailedEvents", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.NotFound, "failed to GetFailedEvents")
+	}
+
+	reply := &pb.FailedEventsReply{Count: count}
+	for _, event := range events {
+		reply.Events = append(reply.Events, event.Unwrap())
+	}
+
+	return reply, nil
+}
+
+func (w *DWH) ReplayFailedEvents(ctx context.Context, request *pb.ReplayFailedEventsRequest) (*pb.Empty, error) {
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	if err := w.storage.ScheduleFailedEvents(conn, request.Ids, time.Now().Unix()); err != nil {
+		w.logger.Error("failed to ScheduleFailedEvents", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.Internal, "failed to ReplayFailedEvents")
+	}
+
+	select {
+	case w.retryWakeup <- struct{}{}:
+	default:
+	}
+
+	return &pb.Empty{}, nil
+}
@@ -75,6 +75,34 @@ func newPostgresStorage(tInfo *tablesInfo, numBenchmarks uint64) *sqlStorage {
 			storeStaleID:                 `INSERT INTO StaleIDs VALUES ($1)`,
 			removeStaleID:                `DELETE FROM StaleIDs WHERE Id = $1`,
 			checkStaleID:                 `SELECT * FROM StaleIDs WHERE Id = $1`,
+			insertOrderHistory:           makeInsertHistoryQuery(`INSERT INTO OrdersHistory(%s) VALUES (%s) ON CONFLICT (Id) DO NOTHING`, formatCb, tInfo.OrdersHistoryColumns),
+			insertDealHistory:            makeInsertHistoryQuery(`INSERT INTO DealsHistory(%s) VALUES (%s) ON CONFLICT (Id) DO NOTHING`, formatCb, tInfo.DealsHistoryColumns),
+			updateDealHistory:            `UPDATE DealsHistory SET EndTime = $1, Duration = $2, Status = $3 WHERE Id = $4`,
+			insertPaymentHistory:         `INSERT INTO PaymentsHistory VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`,
+			deleteOrdersHistory:          `DELETE FROM OrdersHistory WHERE BlockNumber > $1`,
+			deleteDealsHistory:           `DELETE FROM DealsHistory WHERE BlockNumber > $1`,
+			deletePaymentsHistory:        `DELETE FROM PaymentsHistory WHERE BlockNumber > $1`,
+			selectDealPayments:           `SELECT BillTS, PaidAmount, DealID FROM DealPayments WHERE DealID = $1`,
+			insertBlockHash:              `INSERT INTO Blocks VALUES ($1, $2) ON CONFLICT (Number) DO UPDATE SET Hash = $2`,
+			selectBlockHashes:            `SELECT Number, Hash FROM Blocks ORDER BY Number DESC`,
+			deleteBlockHashes:            `DELETE FROM Blocks WHERE Number > $1`,
+			pruneBlockHashes:             `DELETE FROM Blocks WHERE Number < $1`,
+			insertEntitySnapshot:         `INSERT INTO EntitySnapshots VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`,
+			selectEntitySnapshots:        `SELECT BlockNumber, Entity, Id, Snapshot FROM EntitySnapshots WHERE BlockNumber > $1 ORDER BY BlockNumber ASC`,
+			deleteEntitySnapshots:        `DELETE FROM EntitySnapshots WHERE BlockNumber > $1`,
+			pruneEntitySnapshots:         `DELETE FROM EntitySnapshots WHERE BlockNumber < $1`,
+			insertProcessedEvent:         `INSERT INTO ProcessedEvents VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+			checkProcessedEvent:          `SELECT BlockNumber FROM ProcessedEvents WHERE BlockNumber = $1 AND LogIndex = $2`,
+			deleteProcessedEvents:        `DELETE FROM ProcessedEvents WHERE BlockNumber > $1`,
+			pruneProcessedEvents:         `DELETE FROM ProcessedEvents WHERE BlockNumber < $1`,
+			insertFailedEvent:            `INSERT INTO FailedEvents(EntityKey, BlockNumber, BlockHash, LogIndex, TS, Type, Data, Attempts, LastError, NextRetryTS) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			checkFailedEvents:            `SELECT Id FROM FailedEvents WHERE EntityKey = $1 LIMIT 1`,
+			selectDueFailedEvents:        `SELECT * FROM FailedEvents AS f WHERE NextRetryTS <= $1 AND NOT EXISTS (SELECT 1 FROM FailedEvents AS p WHERE p.EntityKey = f.EntityKey AND p.Id < f.Id AND p.NextRetryTS > $2) ORDER BY Id ASC`,
+			updateFailedEvent:            `UPDATE FailedEvents SET Attempts = $1, LastError = $2, NextRetryTS = $3 WHERE Id = $4`,
+			scheduleFailedEvent:          `UPDATE FailedEvents SET NextRetryTS = $1 WHERE Id = $2`,
+			scheduleAllFailedEvents:      `UPDATE FailedEvents SET NextRetryTS = $1`,
+			deleteFailedEvent:            `DELETE FROM FailedEvents WHERE Id = $1`,
+			deleteFailedEvents:           `DELETE FROM FailedEvents WHERE BlockNumber > $1`,
 		},
 		setupCommands: &sqlSetupCommands{
 			createTableDeals: makeTableWithBenchmarks(`
@@ -196,6 +224,67 @@ func newPostgresStorage(tInfo *tablesInfo, numBenchmarks uint64) *sqlStorage {
 			createTableStaleIDs: `
 	CREATE TABLE IF NOT EXISTS StaleIDs (
 		Id 							TEXT NOT NULL
+	)`,
+			createTableOrdersHistory: makeTableWithBenchmarks(`
+	CREATE TABLE IF NOT EXISTS OrdersHistory (
+		Id						TEXT UNIQUE NOT NULL,
+		CreatedTS				INTEGER NOT NULL,
+		Type					INTEGER NOT NULL,
+		AuthorID				TEXT NOT NULL,
+		Price					TEXT NOT NULL,
+		Netflags				INTEGER NOT NULL,
+		BlockNumber				INTEGER NOT NULL`, `BIGINT DEFAULT 0`),
+			createTableDealsHistory: makeTableWithBenchmarks(`
+	CREATE TABLE IF NOT EXISTS DealsHistory (
+		Id						TEXT UNIQUE NOT NULL,
+		SupplierID				TEXT NOT NULL,
+		ConsumerID				TEXT NOT NULL,
+		StartTime				INTEGER NOT NULL,
+		EndTime					INTEGER NOT NULL,
+		Duration				INTEGER NOT NULL,
+		Status					INTEGER NOT NULL,
+		Netflags				INTEGER NOT NULL,
+		BlockNumber				INTEGER NOT NULL`, `BIGINT DEFAULT 0`),
+			createTablePaymentsHistory: `
+	CREATE TABLE IF NOT EXISTS PaymentsHistory (
+		BillTS						INTEGER NOT NULL,
+		PaidAmount					TEXT NOT NULL,
+		DealID						TEXT NOT NULL,
+		BlockNumber					INTEGER NOT NULL,
+		UNIQUE						(BillTS, PaidAmount, DealID)
+	)`,
+			createTableBlocks: `
+	CREATE TABLE IF NOT EXISTS Blocks (
+		Number						INTEGER UNIQUE NOT NULL,
+		Hash						TEXT NOT NULL
+	)`,
+			createTableEntitySnapshots: `
+	CREATE TABLE IF NOT EXISTS EntitySnapshots (
+		BlockNumber					INTEGER NOT NULL,
+		Entity						TEXT NOT NULL,
+		Id							TEXT NOT NULL,
+		Snapshot					BYTEA NOT NULL,
+		UNIQUE						(BlockNumber, Entity, Id)
+	)`,
+			createTableProcessedEvents: `
+	CREATE TABLE IF NOT EXISTS ProcessedEvents (
+		BlockNumber					INTEGER NOT NULL,
+		LogIndex					INTEGER NOT NULL,
+		UNIQUE						(BlockNumber, LogIndex)
+	)`,
+			createTableFailedEvents: `
+	CREATE TABLE IF NOT EXISTS FailedEvents (
+		Id							BIGSERIAL PRIMARY KEY,
+		EntityKey					TEXT NOT NULL,
+		BlockNumber					INTEGER NOT NULL,
+		BlockHash					TEXT NOT NULL,
+		LogIndex					INTEGER NOT NULL,
+		TS							BIGINT NOT NULL,
+		Type						TEXT NOT NULL,
+		Data						TEXT NOT NULL,
+		Attempts					INTEGER NOT NULL,
+		LastError					TEXT NOT NULL,
+		NextRetryTS					BIGINT NOT NULL
 	)`,
 			createIndexCmd: `CREATE INDEX IF NOT EXISTS %s_%s ON %s (%s)`,
 			tablesInfo:     tInfo,
@@ -224,6 +313,8 @@ func (r *postgresQueryRunner) Run(conn queryConn, opts *queryOpts) (*sql.Rows, u
 		columns = strings.Join(r.tablesInfo.DealColumns, ", ")
 	case "Orders":
 		columns = strings.Join(r.tablesInfo.OrderColumns, ", ")
+	case "DealsHistory":
+		columns = strings.Join(r.tablesInfo.DealsHistoryColumns, ", ")
 	default:
 		columns = "*"
 	}
@@ -0,0 +1,355 @@
+package dwh
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxReorgDepth is the number of recent blocks for which block hashes and
+	// entity snapshots are kept, i.e. the deepest chain reorganisation that
+	// can be rolled back.
+	maxReorgDepth = 1024
+
+	snapshotEntityOrder = "Order"
+	snapshotEntityDeal  = "Deal"
+)
+
+type blockHash struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// entitySnapshot is a serialized state of an order or a deal before it has
+// been changed by events from the given block.
+type entitySnapshot struct {
+	BlockNumber uint64
+	Entity      string
+	ID          *big.Int
+	Data        []byte
+}
+
+// orderSnapshot holds nil Order if the order has not been stored.
+type orderSnapshot struct {
+	Order *pb.DWHOrder
+}
+
+// dealSnapshot holds nil Deal if the deal has not been stored.
+type dealSnapshot struct {
+	Deal           *pb.DWHDeal
+	Conditions     []*pb.DealCondition
+	Payments       []*pb.DealPayment
+	ChangeRequests []*pb.DealChangeRequest
+}
+
+// findForkPoint compares hashes of processed blocks with the canonical chain,
+// starting from the latest one, and returns the number of the latest block
+// that is still canonical.
+//
+// Hashes are stored only for blocks containing market events, so instead of
+// checking parent hashes of consecutive blocks it is checked whether the last
+// processed block is still the ancestor of the new one.
+func (w *DWH) findForkPoint() (uint64, bool, error) {
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	hashes, err := w.storage.GetBlockHashes(conn)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to GetBlockHashes")
+	}
+
+	for idx, block := range hashes {
+		canonical, err := w.blockchain.Events().GetBlockHash(w.ctx, new(big.Int).SetUint64(block.Number))
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "failed to get hash of block %d", block.Number)
+		}
+
+		if canonical == block.Hash {
+			return block.Number, idx != 0, nil
+		}
+	}
+
+	if len(hashes) == 0 {
+		return 0, false, nil
+	}
+
+	// None of the retained blocks is canonical, roll back as far as possible.
+	return hashes[len(hashes)-1].Number - 1, true, nil
+}
+
+func (w *DWH) storeBlockHash(blockNumber uint64, hash common.Hash) error {
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	if err := w.storage.InsertBlockHash(conn, blockNumber, hash); err != nil {
+		return errors.Wrap(err, "failed to InsertBlockHash")
+	}
+
+	if blockNumber > maxReorgDepth {
+		if err := w.storage.PruneReorgData(conn, blockNumber-maxReorgDepth); err != nil {
+			return errors.Wrap(err, "failed to PruneReorgData")
+		}
+	}
+
+	return nil
+}
+
+// snapshotEntities stores the state of orders and deals that are going to be
+// changed by the event, so that they can be restored if the event's block
+// is reorganised out of the chain.
+//
+// Only the first snapshot of an entity within a block is kept.
+func (w *DWH) snapshotEntities(conn queryConn, event *blockchain.Event) error {
+	var orderIDs, dealIDs []*big.Int
+	switch value := event.Data.(type) {
+	case *blockchain.OrderPlacedData:
+		orderIDs = append(orderIDs, value.ID)
+	case *blockchain.OrderUpdatedData:
+		orderIDs = append(orderIDs, value.ID)
+	case *blockchain.DealOpenedData:
+		dealIDs = append(dealIDs, value.ID)
+	case *blockchain.DealUpdatedData:
+		dealIDs = append(dealIDs, value.ID)
+	case *blockchain.BilledData:
+		dealIDs = append(dealIDs, value.DealID)
+	case *blockchain.DealChangeRequestSentData:
+		changeRequest, err := w.blockchain.Market().GetDealChangeRequestInfo(w.ctx, value.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to GetDealChangeRequestInfo")
+		}
+		dealIDs = append(dealIDs, changeRequest.DealID.Unwrap())
+	case *blockchain.DealChangeRequestUpdatedData:
+		changeRequest, err := w.blockchain.Market().GetDealChangeRequestInfo(w.ctx, value.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to GetDealChangeRequestInfo")
+		}
+		dealIDs = append(dealIDs, changeRequest.DealID.Unwrap())
+	default:
+		return nil
+	}
+
+	for _, dealID := range dealIDs {
+		snapshot, err := w.snapshotDeal(conn, dealID)
+		if err != nil {
+			return err
+		}
+
+		// Ask and bid orders are removed when deals are closed.
+		if snapshot.Deal != nil {
+			orderIDs = append(orderIDs, snapshot.Deal.GetDeal().GetAskID().Unwrap(),
+				snapshot.Deal.GetDeal().GetBidID().Unwrap())
+		}
+
+		if err := w.storeSnapshot(conn, event.BlockNumber, snapshotEntityDeal, dealID, snapshot); err != nil {
+			return err
+		}
+	}
+
+	for _, orderID := range orderIDs {
+		snapshot := &orderSnapshot{}
+		if order, err := w.storage.GetOrderByID(conn, orderID); err == nil {
+			snapshot.Order = order
+		}
+
+		if err := w.storeSnapshot(conn, event.BlockNumber, snapshotEntityOrder, orderID, snapshot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *DWH) snapshotDeal(conn queryConn, dealID *big.Int) (*dealSnapshot, error) {
+	snapshot := &dealSnapshot{}
+
+	deal, err := w.storage.GetDealByID(conn, dealID)
+	if err != nil {
+		return snapshot, nil
+	}
+	snapshot.Deal = deal
+
+	snapshot.Conditions, _, err = w.storage.GetDealConditions(conn, &pb.DealConditionsRequest{DealID: pb.NewBigInt(dealID)})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to GetDealConditions")
+	}
+
+	snapshot.Payments, err = w.storage.GetDealPayments(conn, dealID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to GetDealPayments")
+	}
+
+	snapshot.ChangeRequests, err = w.storage.GetDealChangeRequestsByID(conn, dealID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to GetDealChangeRequestsByID")
+	}
+
+	return snapshot, nil
+}
+
+func (w *DWH) storeSnapshot(conn queryConn, blockNumber uint64, entity string, id *big.Int, snapshot interface{}) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s snapshot", entity)
+	}
+
+	if err := w.storage.InsertEntitySnapshot(conn, blockNumber, entity, id, data); err != nil {
+		return errors.Wrapf(err, "failed to InsertEntitySnapshot (%s %s)", entity, id.String())
+	}
+
+	return nil
+}
+
+// rollback restores orders and deals changed after the given block to their
+// state at that block, so that events from the new canonical chain can be
+// replayed on top of it. History entries appended after the block are
+// removed, and deals closed after it are reopened in the history.
+//
+// Profiles, workers, blacklists, validators and certificates are not rolled
+// back.
+func (w *DWH) rollback(forkBlock uint64) error {
+	w.logger.Warn("rolling back chain reorganisation", zap.Uint64("fork_block", forkBlock))
+
+	w.failedEventsMu.Lock()
+	defer w.failedEventsMu.Unlock()
+
+	conn, err := newTxConn(w.db, w.logger)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer conn.Finish()
+
+	snapshots, err := w.storage.GetEntitySnapshots(conn, forkBlock)
+	if err != nil {
+		return errors.Wrap(err, "failed to GetEntitySnapshots")
+	}
+
+	// Snapshots are sorted by block number, so the first snapshot of each
+	// entity holds its state at the fork point.
+	var (
+		seen   = map[string]bool{}
+		orders []*entitySnapshot
+		deals  []*entitySnapshot
+	)
+	for _, snapshot := range snapshots {
+		key := fmt.Sprintf("%s_%s", snapshot.Entity, snapshot.ID.String())
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		switch snapshot.Entity {
+		case snapshotEntityOrder:
+			orders = append(orders, snapshot)
+		case snapshotEntityDeal:
+			deals = append(deals, snapshot)
+		}
+	}
+
+	// Deals must be restored after their ask and bid orders.
+	for _, snapshot := range deals {
+		if err := w.storage.DeleteDeal(conn, snapshot.ID); err != nil {
+			return errors.Wrap(err, "failed to DeleteDeal")
+		}
+	}
+	for _, snapshot := range orders {
+		if err := w.restoreOrder(conn, snapshot); err != nil {
+			return errors.Wrapf(err, "failed to restore order %s", snapshot.ID.String())
+		}
+	}
+	for _, snapshot := range deals {
+		if err := w.restoreDeal(conn, snapshot); err != nil {
+			return errors.Wrapf(err, "failed to restore deal %s", snapshot.ID.String())
+		}
+	}
+
+	if err := w.storage.DeleteHistory(conn, forkBlock); err != nil {
+		return errors.Wrap(err, "failed to DeleteHistory")
+	}
+
+	if err := w.storage.DeleteEntitySnapshots(conn, forkBlock); err != nil {
+		return errors.Wrap(err, "failed to DeleteEntitySnapshots")
+	}
+	if err := w.storage.DeleteBlockHashes(conn, forkBlock); err != nil {
+		return errors.Wrap(err, "failed to DeleteBlockHashes")
+	}
+	// Events from orphaned blocks must not be retried, and events from the
+	// new canonical chain must not be skipped as already processed.
+	if err := w.storage.DeleteFailedEvents(conn, forkBlock); err != nil {
+		return errors.Wrap(err, "failed to DeleteFailedEvents")
+	}
+	if err := w.storage.DeleteProcessedEvents(conn, forkBlock); err != nil {
+		return errors.Wrap(err, "failed to DeleteProcessedEvents")
+	}
+	if err := w.storage.UpdateLastKnownBlock(conn, int64(forkBlock)); err != nil {
+		return errors.Wrap(err, "failed to UpdateLastKnownBlock")
+	}
+
+	w.mu.Lock()
+	w.lastKnownBlock = forkBlock
+	w.blockEndCallbacks = nil
+	w.mu.Unlock()
+
+	return nil
+}
+
+func (w *DWH) restoreOrder(conn queryConn, snapshot *entitySnapshot) error {
+	var order orderSnapshot
+	if err := json.Unmarshal(snapshot.Data, &order); err != nil {
+		return errors.Wrap(err, "failed to unmarshal order snapshot")
+	}
+
+	if err := w.storage.DeleteOrder(conn, snapshot.ID); err != nil {
+		return errors.Wrap(err, "failed to DeleteOrder")
+	}
+
+	if order.Order == nil {
+		return nil
+	}
+
+	return w.storage.InsertOrder(conn, order.Order)
+}
+
+func (w *DWH) restoreDeal(conn queryConn, snapshot *entitySnapshot) error {
+	var deal dealSnapshot
+	if err := json.Unmarshal(snapshot.Data, &deal); err != nil {
+		return errors.Wrap(err, "failed to unmarshal deal snapshot")
+	}
+
+	if deal.Deal == nil {
+		return nil
+	}
+
+	if err := w.storage.InsertDeal(conn, deal.Deal.GetDeal()); err != nil {
+		return errors.Wrap(err, "failed to InsertDeal")
+	}
+	if err := w.storage.RevertDealHistory(conn, deal.Deal.GetDeal()); err != nil {
+		return errors.Wrap(err, "failed to RevertDealHistory")
+	}
+
+	// Conditions are sorted by ID in descending order, the latest condition
+	// must be inserted last.
+	for idx := len(deal.Conditions) - 1; idx >= 0; idx-- {
+		if err := w.storage.InsertDealCondition(conn, deal.Conditions[idx]); err != nil {
+			return errors.Wrap(err, "failed to InsertDealCondition")
+		}
+	}
+	for _, payment := range deal.Payments {
+		if err := w.storage.InsertDealPayment(conn, payment); err != nil {
+			return errors.Wrap(err, "failed to InsertDealPayment")
+		}
+	}
+	for _, changeRequest := range deal.ChangeRequests {
+		if err := w.storage.InsertDealChangeRequest(conn, changeRequest); err != nil {
+			return errors.Wrap(err, "failed to InsertDealChangeRequest")
+		}
+	}
+
+	return nil
+}
@@ -11,11 +11,13 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/grpc-ecosystem/go-grpc-prometheus"
 	_ "github.com/mattn/go-sqlite3"
 	log "github.com/noxiouz/zapctx/ctxlog"
 	"github.com/pkg/errors"
 	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/insonmnia/auth"
 	pb "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
 	"github.com/sonm-io/core/util/rest"
@@ -30,6 +32,7 @@ import (
 )
 
 const (
+	dwhAPIPrefix   = "/sonm.DWH/"
 	eventRetryTime = time.Second * 3
 )
 
@@ -40,6 +43,7 @@ type DWH struct {
 	cancel            context.CancelFunc
 	grpc              *grpc.Server
 	http              *rest.Server
+	authorization     *auth.AuthRouter
 	logger            *zap.Logger
 	db                *sql.DB
 	creds             credentials.TransportCredentials
@@ -49,15 +53,20 @@ type DWH struct {
 	numBenchmarks     uint64
 	blockEndCallbacks []func() error
 	lastKnownBlock    uint64
+	subscriptions     *subscriptions
+	failedEventsMu    sync.Mutex
+	retryWakeup       chan struct{}
 }
 
 func NewDWH(ctx context.Context, cfg *Config, key *ecdsa.PrivateKey) (*DWH, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	w := &DWH{
-		ctx:    ctx,
-		cancel: cancel,
-		cfg:    cfg,
-		logger: log.GetLogger(ctx),
+		ctx:           ctx,
+		cancel:        cancel,
+		cfg:           cfg,
+		logger:        log.GetLogger(ctx),
+		subscriptions: newSubscriptions(),
+		retryWakeup:   make(chan struct{}, 1),
 	}
 
 	bch, err := blockchain.NewAPI(blockchain.WithConfig(w.cfg.Blockchain))
@@ -106,19 +115,41 @@ func NewDWH(ctx context.Context, cfg *Config, key *ecdsa.PrivateKey) (*DWH, erro
 
 	w.certRotator = certRotator
 	w.creds = util.NewTLS(TLSConfig)
-	w.grpc = xgrpc.NewServer(w.logger, xgrpc.Credentials(w.creds), xgrpc.DefaultTraceInterceptor())
+	w.authorization = w.newAuthorization(crypto.PubkeyToAddress(key.PublicKey))
+	w.grpc = xgrpc.NewServer(w.logger,
+		xgrpc.Credentials(w.creds),
+		xgrpc.DefaultTraceInterceptor(),
+		xgrpc.AuthorizationInterceptor(w.authorization),
+	)
 	pb.RegisterDWHServer(w.grpc, w)
 	grpc_prometheus.Register(w.grpc)
 
 	return w, nil
 }
 
+// newAuthorization restricts admin methods to the configured admin, or to the
+// DWH's own key if none is configured. Other methods are public.
+func (w *DWH) newAuthorization(ethAddr common.Address) *auth.AuthRouter {
+	admin := ethAddr
+	if w.cfg.Admin != nil {
+		admin = *w.cfg.Admin
+	}
+
+	return auth.NewEventAuthorization(w.ctx,
+		auth.WithLog(w.logger),
+		auth.WithEventPrefix(dwhAPIPrefix),
+		auth.Allow("GetFailedEvents", "ReplayFailedEvents").With(auth.NewTransportAuthorization(admin)),
+		auth.WithFallback(auth.NewNilAuthorization()),
+	)
+}
+
 func (w *DWH) Serve() error {
 	w.logger.Info("starting with backend", zap.String("backend", w.cfg.Storage.Backend),
 		zap.String("endpoint", w.cfg.Storage.Endpoint))
 
 	if w.cfg.Blockchain != nil {
 		go w.monitorBlockchain()
+		go w.retryFailedEvents()
 	} else {
 		w.logger.Info("monitoring disabled")
 	}
@@ -157,7 +188,9 @@ func (w *DWH) serveGRPC() error {
 }
 
 func (w *DWH) serveHTTP() error {
-	options := []rest.Option{rest.WithContext(w.ctx)}
+	// Admin methods are authorized by the peer's certificate, which is never
+	// present over HTTP, hence they are effectively available via gRPC only.
+	options := []rest.Option{rest.WithContext(w.ctx), rest.WithInterceptor(xgrpc.AuthUnaryInterceptor(w.authorization))}
 	lis, err := net.Listen("tcp", w.cfg.HTTPListenAddr)
 	if err != nil {
 		log.S(w.ctx).Info("failed to create http listener")
@@ -340,6 +373,50 @@ func (w *DWH) GetWorkers(ctx context.Context, request *pb.WorkersRequest) (*pb.W
 	return &pb.WorkersReply{Workers: workers, Count: count}, nil
 }
 
+func (w *DWH) GetMarketStats(ctx context.Context, request *pb.MarketStatsRequest) (*pb.MarketStatsReply, error) {
+	if request.GetTo().GetSeconds() == 0 {
+		request.To = &pb.Timestamp{Seconds: time.Now().Unix()}
+	}
+	if request.GetTo().GetSeconds() <= request.GetFrom().GetSeconds() {
+		return nil, status.Error(codes.InvalidArgument, "`to` must be greater than `from`")
+	}
+	for _, percentile := range request.Percentiles {
+		if percentile < 1 || percentile > 99 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid percentile %d, must be in [1, 99]", percentile)
+		}
+	}
+	if request.PricePerBenchmark && request.PriceBenchmarkID >= w.numBenchmarks {
+		return nil, status.Errorf(codes.InvalidArgument, "benchmark %d is not supported", request.PriceBenchmarkID)
+	}
+	if _, _, numBuckets := marketStatsRange(request); numBuckets > maxMarketStatsBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "too many buckets requested: %d, max %d", numBuckets, maxMarketStatsBuckets)
+	}
+
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	buckets, err := w.storage.GetMarketStats(conn, request)
+	if err != nil {
+		w.logger.Error("failed to GetMarketStats", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.Internal, "failed to GetMarketStats")
+	}
+
+	return &pb.MarketStatsReply{Buckets: buckets}, nil
+}
+
+func (w *DWH) GetDealsHistory(ctx context.Context, request *pb.DealsHistoryRequest) (*pb.DealsHistoryReply, error) {
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	deals, err := w.storage.GetDealsHistory(conn, request)
+	if err != nil {
+		w.logger.Warn("failed to GetDealsHistory", util.LaconicError(err), zap.Any("request", *request))
+		return nil, status.Error(codes.NotFound, "failed to GetDealsHistory")
+	}
+
+	return &pb.DealsHistoryReply{Deals: deals}, nil
+}
+
 func (w *DWH) monitorBlockchain() error {
 	w.logger.Info("starting monitoring")
 
@@ -366,15 +443,31 @@ func (w *DWH) watchMarketEvents() error {
 		w.lastKnownBlock = 0
 	}
 
+	ctx, cancel := context.WithCancel(w.ctx)
+	defer cancel()
+
 	w.logger.Info("starting from block", zap.Uint64("block_number", w.lastKnownBlock))
-	events, err := w.blockchain.Events().GetEvents(w.ctx, big.NewInt(0).SetUint64(w.lastKnownBlock))
+	events, err := w.blockchain.Events().GetEvents(ctx, big.NewInt(0).SetUint64(w.lastKnownBlock))
 	if err != nil {
 		return err
 	}
 
-	jobs := make(chan *blockchain.Event)
-	for workerID := 0; workerID < w.cfg.NumWorkers; workerID++ {
-		go w.runEventWorker(workerID, jobs)
+	// Events are sharded between workers by entity, so that events of the
+	// same entity are processed in order.
+	wg := sync.WaitGroup{}
+	jobs := make([]chan *blockchain.Event, w.cfg.NumWorkers)
+	for workerID := range jobs {
+		jobs[workerID] = make(chan *blockchain.Event)
+		wg.Add(1)
+		go func(workerID int) {
+			defer wg.Done()
+			w.runEventWorker(workerID, jobs[workerID])
+		}(workerID)
+	}
+	closeJobs := func() {
+		for _, workerJobs := range jobs {
+			close(workerJobs)
+		}
 	}
 
 	for {
@@ -384,12 +477,30 @@ func (w *DWH) watchMarketEvents() error {
 			return nil
 		case event, ok := <-events:
 			if !ok {
-				close(jobs)
+				closeJobs()
 				return errors.New("events channel closed")
 			}
 
+			if w.isNewBlock(event) {
+				forkBlock, reorg, err := w.findForkPoint()
+				if err != nil {
+					w.logger.Warn("failed to check for chain reorganisation", util.LaconicError(err),
+						zap.Uint64("block_number", event.BlockNumber))
+				}
+				if reorg {
+					// Events from the orphaned blocks must be applied before
+					// rolling them back.
+					closeJobs()
+					wg.Wait()
+					if err := w.rollback(forkBlock); err != nil {
+						return errors.Wrap(err, "failed to roll back chain reorganisation")
+					}
+					return errors.Errorf("chain reorganisation detected, restarting from block %d", forkBlock)
+				}
+			}
+
 			w.processBlockBoundary(event)
-			jobs <- event
+			jobs[eventShard(eventEntityKey(event), len(jobs))] <- event
 		}
 	}
 }
@@ -405,12 +516,12 @@ func (w *DWH) runEventWorker(workerID int, events chan *blockchain.Event) {
 				w.logger.Info("events channel closed", zap.Int("worker_id", workerID))
 				return
 			}
-			if err := w.processEvent(event); err != nil {
-				w.logger.Warn("failed to processEvent, retrying", util.LaconicError(err),
+			if err := w.handleEvent(event); err != nil {
+				w.logger.Warn("failed to processEvent, queued for retry", util.LaconicError(err),
 					zap.Uint64("block_number", event.BlockNumber),
 					zap.String("event_type", reflect.TypeOf(event.Data).String()),
 					zap.Any("event_data", event.Data), zap.Int("worker_id", workerID))
-				w.retryEvent(event)
+				continue
 			}
 			w.logger.Debug("processed event", zap.Uint64("block_number", event.BlockNumber),
 				zap.String("event_type", reflect.TypeOf(event.Data).String()),
@@ -419,38 +530,85 @@ func (w *DWH) runEventWorker(workerID int, events chan *blockchain.Event) {
 	}
 }
 
+// processEvent applies the event to the storage and notifies subscribers
+// once the changes are committed.
+//
+// Snapshots of the affected entities, the changes themselves and the mark of
+// the event as processed are committed in a single transaction. Events that
+// have already been processed, e.g. when restarting from the last known block
+// or retrying, are skipped.
 func (w *DWH) processEvent(event *blockchain.Event) error {
+	processed := false
+	err := inTransaction(w.db, w.logger, func(conn queryConn) error {
+		var err error
+		processed, err = w.isEventProcessed(conn, event)
+		if err != nil {
+			return errors.Wrap(err, "failed to check whether event is processed")
+		}
+		if processed {
+			return nil
+		}
+
+		if err := w.snapshotEntities(conn, event); err != nil {
+			return errors.Wrap(err, "failed to snapshot entities")
+		}
+
+		if err := w.applyEvent(conn, event); err != nil {
+			return err
+		}
+
+		if err := w.markEventProcessed(conn, event); err != nil {
+			return errors.Wrap(err, "failed to mark event as processed")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if processed {
+		w.logger.Debug("skipping already processed event", zap.Uint64("block_number", event.BlockNumber),
+			zap.Uint("log_index", event.LogIndex))
+		return nil
+	}
+
+	w.notifySubscribers(event)
+	return nil
+}
+
+func (w *DWH) applyEvent(conn queryConn, event *blockchain.Event) error {
 	switch value := event.Data.(type) {
 	case *blockchain.DealOpenedData:
-		return w.onDealOpened(value.ID)
+		return w.onDealOpened(conn, event.BlockNumber, value.ID)
 	case *blockchain.DealUpdatedData:
-		return w.onDealUpdated(value.ID)
+		return w.onDealUpdated(conn, value.ID)
 	case *blockchain.OrderPlacedData:
-		return w.onOrderPlaced(event.TS, value.ID)
+		return w.onOrderPlaced(conn, event.TS, event.BlockNumber, value.ID)
 	case *blockchain.OrderUpdatedData:
-		return w.onOrderUpdated(value.ID)
+		return w.onOrderUpdated(conn, value.ID)
 	case *blockchain.DealChangeRequestSentData:
-		return w.onDealChangeRequestSent(event.TS, value.ID)
+		return w.onDealChangeRequestSent(conn, event.TS, value.ID)
 	case *blockchain.DealChangeRequestUpdatedData:
-		return w.onDealChangeRequestUpdated(event.TS, value.ID)
+		return w.onDealChangeRequestUpdated(conn, event.TS, value.ID)
 	case *blockchain.BilledData:
-		return w.onBilled(event.TS, value.DealID, value.PaidAmount)
+		return w.onBilled(conn, event.TS, event.BlockNumber, value.DealID, value.PaidAmount)
 	case *blockchain.WorkerAnnouncedData:
-		return w.onWorkerAnnounced(value.MasterID.Hex(), value.SlaveID.Hex())
+		return w.onWorkerAnnounced(conn, value.MasterID.Hex(), value.SlaveID.Hex())
 	case *blockchain.WorkerConfirmedData:
-		return w.onWorkerConfirmed(value.MasterID.Hex(), value.SlaveID.Hex())
+		return w.onWorkerConfirmed(conn, value.MasterID.Hex(), value.SlaveID.Hex())
 	case *blockchain.WorkerRemovedData:
-		return w.onWorkerRemoved(value.MasterID.Hex(), value.SlaveID.Hex())
+		return w.onWorkerRemoved(conn, value.MasterID.Hex(), value.SlaveID.Hex())
 	case *blockchain.AddedToBlacklistData:
-		return w.onAddedToBlacklist(value.AdderID.Hex(), value.AddeeID.Hex())
+		return w.onAddedToBlacklist(conn, value.AdderID.Hex(), value.AddeeID.Hex())
 	case *blockchain.RemovedFromBlacklistData:
-		w.onRemovedFromBlacklist(value.RemoverID.Hex(), value.RemoveeID.Hex())
+		w.onRemovedFromBlacklist(conn, value.RemoverID.Hex(), value.RemoveeID.Hex())
 	case *blockchain.ValidatorCreatedData:
-		return w.onValidatorCreated(value.ID)
+		return w.onValidatorCreated(conn, value.ID)
 	case *blockchain.ValidatorDeletedData:
-		return w.onValidatorDeleted(value.ID)
+		return w.onValidatorDeleted(conn, value.ID)
 	case *blockchain.CertificateCreatedData:
-		return w.onCertificateCreated(value.ID)
+		return w.onCertificateCreated(conn, value.ID)
 	case *blockchain.ErrorData:
 		w.logger.Warn("received error from events channel", zap.Error(value.Err), zap.String("topic", value.Topic))
 	}
@@ -458,44 +616,43 @@ func (w *DWH) processEvent(event *blockchain.Event) error {
 	return nil
 }
 
-func (w *DWH) retryEvent(event *blockchain.Event) {
-	timer := time.NewTimer(eventRetryTime)
-	select {
-	case <-w.ctx.Done():
-		w.logger.Info("context cancelled while retrying event",
-			zap.Uint64("block_number", event.BlockNumber),
-			zap.String("event_type", reflect.TypeOf(event.Data).String()))
-		return
-	case <-timer.C:
-		if err := w.processEvent(event); err != nil {
-			w.logger.Warn("failed to retry processEvent", util.LaconicError(err),
-				zap.Uint64("block_number", event.BlockNumber),
-				zap.String("event_type", reflect.TypeOf(event.Data).String()),
-				zap.Any("event_data", event.Data))
-		}
+// isEventProcessed reports whether the event has been processed. Events
+// without a block hash are not tracked.
+func (w *DWH) isEventProcessed(conn queryConn, event *blockchain.Event) (bool, error) {
+	if event.BlockHash == (common.Hash{}) {
+		return false, nil
 	}
+
+	return w.storage.CheckProcessedEvent(conn, event.BlockNumber, event.LogIndex)
 }
 
-func (w *DWH) onDealOpened(dealID *big.Int) error {
-	deal, err := w.blockchain.Market().GetDealInfo(w.ctx, dealID)
-	if err != nil {
-		return errors.Wrapf(err, "failed to GetDealInfo")
+func (w *DWH) markEventProcessed(conn queryConn, event *blockchain.Event) error {
+	if event.BlockHash == (common.Hash{}) {
+		return nil
 	}
 
-	conn, err := newTxConn(w.db, w.logger)
+	return w.storage.InsertProcessedEvent(conn, event.BlockNumber, event.LogIndex)
+}
+
+func (w *DWH) onDealOpened(conn queryConn, blockNumber uint64, dealID *big.Int) error {
+	deal, err := w.blockchain.Market().GetDealInfo(w.ctx, dealID)
 	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
+		return errors.Wrapf(err, "failed to GetDealInfo")
 	}
-	defer conn.Finish()
 
 	if deal.Status == pb.DealStatus_DEAL_CLOSED {
-		if err := w.storage.StoreStaleID(newSimpleConn(w.db), dealID, "Deal"); err != nil {
+		if err := w.storage.StoreStaleID(conn, dealID, "Deal"); err != nil {
 			return errors.Wrap(err, "failed to StoreStaleID")
 		}
 		w.logger.Debug("skipping inactive deal", zap.String("deal_id", dealID.String()))
 		return nil
 	}
 
+	if _, err := w.storage.GetDealByID(conn, dealID); err == nil {
+		w.logger.Debug("skipping already stored deal", zap.String("deal_id", dealID.String()))
+		return nil
+	}
+
 	if err := w.checkBenchmarks(deal.Benchmarks); err != nil {
 		return err
 	}
@@ -505,6 +662,10 @@ func (w *DWH) onDealOpened(dealID *big.Int) error {
 		return errors.Wrapf(err, "failed to insertDeal")
 	}
 
+	if err := w.storage.InsertDealHistory(conn, deal, blockNumber); err != nil {
+		return errors.Wrap(err, "failed to InsertDealHistory")
+	}
+
 	err = w.storage.InsertDealCondition(conn,
 		&pb.DealCondition{
 			SupplierID:  deal.SupplierID,
@@ -525,18 +686,12 @@ func (w *DWH) onDealOpened(dealID *big.Int) error {
 	return nil
 }
 
-func (w *DWH) onDealUpdated(dealID *big.Int) error {
+func (w *DWH) onDealUpdated(conn queryConn, dealID *big.Int) error {
 	deal, err := w.blockchain.Market().GetDealInfo(w.ctx, dealID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to GetDealInfo")
 	}
 
-	conn, err := newTxConn(w.db, w.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	// If deal is known to be stale:
 	if ok, err := w.storage.CheckStaleID(conn, dealID, "Deal"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
@@ -548,6 +703,9 @@ func (w *DWH) onDealUpdated(dealID *big.Int) error {
 	}
 
 	if deal.Status == pb.DealStatus_DEAL_CLOSED {
+		if err := w.storage.CloseDealHistory(conn, deal.Id.Unwrap(), uint64(deal.EndTime.Seconds), deal.Duration); err != nil {
+			return errors.Wrap(err, "failed to CloseDealHistory")
+		}
 		err = w.storage.DeleteDeal(conn, deal.Id.Unwrap())
 		if err != nil {
 			return errors.Wrap(err, "failed to delete deal (possibly old log entry)")
@@ -569,18 +727,12 @@ func (w *DWH) onDealUpdated(dealID *big.Int) error {
 	return nil
 }
 
-func (w *DWH) onDealChangeRequestSent(eventTS uint64, changeRequestID *big.Int) error {
+func (w *DWH) onDealChangeRequestSent(conn queryConn, eventTS uint64, changeRequestID *big.Int) error {
 	changeRequest, err := w.blockchain.Market().GetDealChangeRequestInfo(w.ctx, changeRequestID)
 	if err != nil {
 		return err
 	}
 
-	conn, err := newTxConn(w.db, w.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	// If deal is known to be stale, skip.
 	if ok, err := w.storage.CheckStaleID(conn, changeRequest.DealID.Unwrap(), "Deal"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
@@ -621,18 +773,12 @@ func (w *DWH) onDealChangeRequestSent(eventTS uint64, changeRequestID *big.Int)
 	return err
 }
 
-func (w *DWH) onDealChangeRequestUpdated(eventTS uint64, changeRequestID *big.Int) error {
+func (w *DWH) onDealChangeRequestUpdated(conn queryConn, eventTS uint64, changeRequestID *big.Int) error {
 	changeRequest, err := w.blockchain.Market().GetDealChangeRequestInfo(w.ctx, changeRequestID)
 	if err != nil {
 		return err
 	}
 
-	conn, err := newTxConn(w.db, w.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	// If deal is known to be stale, skip.
 	if ok, err := w.storage.CheckStaleID(conn, changeRequest.DealID.Unwrap(), "Deal"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
@@ -689,13 +835,7 @@ func (w *DWH) onDealChangeRequestUpdated(eventTS uint64, changeRequestID *big.In
 	return nil
 }
 
-func (w *DWH) onBilled(eventTS uint64, dealID, payedAmount *big.Int) error {
-	conn, err := newTxConn(w.db, w.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
+func (w *DWH) onBilled(conn queryConn, eventTS, blockNumber uint64, dealID, payedAmount *big.Int) error {
 	// If deal is known to be stale, skip.
 	if ok, err := w.storage.CheckStaleID(conn, dealID, "Deal"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
@@ -725,14 +865,17 @@ func (w *DWH) onBilled(eventTS uint64, dealID, payedAmount *big.Int) error {
 		return errors.Wrap(err, "failed to UpdateDealConditionPayout")
 	}
 
-	err = w.storage.InsertDealPayment(conn, &pb.DealPayment{
+	payment := &pb.DealPayment{
 		DealID:      pb.NewBigInt(dealID),
 		PayedAmount: pb.NewBigInt(payedAmount),
 		PaymentTS:   &pb.Timestamp{Seconds: int64(eventTS)},
-	})
-	if err != nil {
+	}
+	if err := w.storage.InsertDealPayment(conn, payment); err != nil {
 		return errors.Wrap(err, "insertDealPayment failed")
 	}
+	if err := w.storage.InsertPaymentHistory(conn, payment, blockNumber); err != nil {
+		return errors.Wrap(err, "failed to InsertPaymentHistory")
+	}
 
 	return nil
 }
@@ -752,18 +895,12 @@ func (w *DWH) updateDealPayout(conn queryConn, dealID, payedAmount *big.Int, bil
 	return nil
 }
 
-func (w *DWH) onOrderPlaced(eventTS uint64, orderID *big.Int) error {
+func (w *DWH) onOrderPlaced(conn queryConn, eventTS, blockNumber uint64, orderID *big.Int) error {
 	order, err := w.blockchain.Market().GetOrderInfo(w.ctx, orderID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to GetOrderInfo")
 	}
 
-	conn, err := newTxConn(w.db, w.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	if order.OrderStatus == pb.OrderStatus_ORDER_INACTIVE && order.DealID.IsZero() {
 		if err := w.storage.StoreStaleID(conn, orderID, "Order"); err != nil {
 			return errors.Wrap(err, "failed to StoreStaleID")
@@ -772,6 +909,11 @@ func (w *DWH) onOrderPlaced(eventTS uint64, orderID *big.Int) error {
 		return nil
 	}
 
+	if _, err := w.storage.GetOrderByID(conn, orderID); err == nil {
+		w.logger.Debug("skipping already stored order", zap.String("order_id", orderID.String()))
+		return nil
+	}
+
 	profile, err := w.storage.GetProfileByID(conn, order.AuthorID.Unwrap())
 	if err != nil {
 		certificates, _ := json.Marshal([]*pb.Certificate{})
@@ -790,7 +932,7 @@ func (w *DWH) onOrderPlaced(eventTS uint64, orderID *big.Int) error {
 		return err
 	}
 
-	err = w.storage.InsertOrder(conn, &pb.DWHOrder{
+	dwhOrder := &pb.DWHOrder{
 		CreatedTS:            &pb.Timestamp{Seconds: int64(eventTS)},
 		CreatorIdentityLevel: profile.IdentityLevel,
 		CreatorName:          profile.Name,
@@ -812,33 +954,33 @@ func (w *DWH) onOrderPlaced(eventTS uint64, orderID *big.Int) error {
 			FrozenSum:      order.FrozenSum,
 			Benchmarks:     order.Benchmarks,
 		},
-	})
-	if err != nil {
+	}
+	if err := w.storage.InsertOrder(conn, dwhOrder); err != nil {
 		return errors.Wrapf(err, "failed to insertOrder")
 	}
+	if err := w.storage.InsertOrderHistory(conn, dwhOrder, blockNumber); err != nil {
+		return errors.Wrap(err, "failed to InsertOrderHistory")
+	}
 
 	return nil
 }
 
-func (w *DWH) onOrderUpdated(orderID *big.Int) error {
+func (w *DWH) onOrderUpdated(conn queryConn, orderID *big.Int) error {
 	order, err := w.blockchain.Market().GetOrderInfo(w.ctx, orderID)
 	if err != nil {
 		return errors.Wrap(err, "failed to GetOrderInfo")
 	}
 
-	conn, err := newTxConn(w.db, w.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	// If the order was known to be inactive, delete it from the list of inactive entities
 	// and skip.
 	if ok, err := w.storage.CheckStaleID(conn, orderID, "Order"); err != nil {
 		return errors.Wrap(err, "failed to CheckStaleID")
 	} else {
 		if ok {
-			w.removeStaleEntityID(orderID, "Order")
+			w.logger.Debug("removing stale entity from cache", zap.String("entity", "Order"), zap.String("id", orderID.String()))
+			if err := w.storage.RemoveStaleID(conn, orderID, "Order"); err != nil {
+				return errors.Wrap(err, "failed to RemoveStaleID")
+			}
 			return nil
 		}
 	}
@@ -864,10 +1006,7 @@ func (w *DWH) onOrderUpdated(orderID *big.Int) error {
 	return nil
 }
 
-func (w *DWH) onWorkerAnnounced(masterID, slaveID string) error {
-	conn := newSimpleConn(w.db)
-	defer conn.Finish()
-
+func (w *DWH) onWorkerAnnounced(conn queryConn, masterID, slaveID string) error {
 	if err := w.storage.InsertWorker(conn, masterID, slaveID); err != nil {
 		return errors.Wrap(err, "onWorkerAnnounced failed")
 	}
@@ -875,10 +1014,7 @@ func (w *DWH) onWorkerAnnounced(masterID, slaveID string) error {
 	return nil
 }
 
-func (w *DWH) onWorkerConfirmed(masterID, slaveID string) error {
-	conn := newSimpleConn(w.db)
-	defer conn.Finish()
-
+func (w *DWH) onWorkerConfirmed(conn queryConn, masterID, slaveID string) error {
 	if err := w.storage.UpdateWorker(conn, masterID, slaveID); err != nil {
 		return errors.Wrap(err, "onWorkerConfirmed failed")
 	}
@@ -886,10 +1022,7 @@ func (w *DWH) onWorkerConfirmed(masterID, slaveID string) error {
 	return nil
 }
 
-func (w *DWH) onWorkerRemoved(masterID, slaveID string) error {
-	conn := newSimpleConn(w.db)
-	defer conn.Finish()
-
+func (w *DWH) onWorkerRemoved(conn queryConn, masterID, slaveID string) error {
 	if err := w.storage.DeleteWorker(conn, masterID, slaveID); err != nil {
 		return errors.Wrap(err, "onWorkerRemoved failed")
 	}
@@ -897,10 +1030,7 @@ func (w *DWH) onWorkerRemoved(masterID, slaveID string) error {
 	return nil
 }
 
-func (w *DWH) onAddedToBlacklist(adderID, addeeID string) error {
-	conn := newSimpleConn(w.db)
-	defer conn.Finish()
-
+func (w *DWH) onAddedToBlacklist(conn queryConn, adderID, addeeID string) error {
 	if err := w.storage.InsertBlacklistEntry(conn, adderID, addeeID); err != nil {
 		return errors.Wrap(err, "onAddedToBlacklist failed")
 	}
@@ -908,10 +1038,7 @@ func (w *DWH) onAddedToBlacklist(adderID, addeeID string) error {
 	return nil
 }
 
-func (w *DWH) onRemovedFromBlacklist(removerID, removeeID string) error {
-	conn := newSimpleConn(w.db)
-	defer conn.Finish()
-
+func (w *DWH) onRemovedFromBlacklist(conn queryConn, removerID, removeeID string) error {
 	if err := w.storage.DeleteBlacklistEntry(conn, removerID, removeeID); err != nil {
 		return errors.Wrap(err, "onRemovedFromBlacklist failed")
 	}
@@ -919,15 +1046,12 @@ func (w *DWH) onRemovedFromBlacklist(removerID, removeeID string) error {
 	return nil
 }
 
-func (w *DWH) onValidatorCreated(validatorID common.Address) error {
+func (w *DWH) onValidatorCreated(conn queryConn, validatorID common.Address) error {
 	validator, err := w.blockchain.ProfileRegistry().GetValidator(w.ctx, validatorID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get validator `%s`", validatorID.String())
 	}
 
-	conn := newSimpleConn(w.db)
-	defer conn.Finish()
-
 	if err := w.storage.InsertValidator(conn, validator); err != nil {
 		return errors.Wrap(err, "failed to insertValidator")
 	}
@@ -935,15 +1059,12 @@ func (w *DWH) onValidatorCreated(validatorID common.Address) error {
 	return nil
 }
 
-func (w *DWH) onValidatorDeleted(validatorID common.Address) error {
+func (w *DWH) onValidatorDeleted(conn queryConn, validatorID common.Address) error {
 	validator, err := w.blockchain.ProfileRegistry().GetValidator(w.ctx, validatorID)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get validator `%s`", validatorID.String())
 	}
 
-	conn := newSimpleConn(w.db)
-	defer conn.Finish()
-
 	if err := w.storage.UpdateValidator(conn, validator); err != nil {
 		return errors.Wrap(err, "failed to updateValidator")
 	}
@@ -951,18 +1072,12 @@ func (w *DWH) onValidatorDeleted(validatorID common.Address) error {
 	return nil
 }
 
-func (w *DWH) onCertificateCreated(certificateID *big.Int) error {
+func (w *DWH) onCertificateCreated(conn queryConn, certificateID *big.Int) error {
 	certificate, err := w.blockchain.ProfileRegistry().GetCertificate(w.ctx, certificateID)
 	if err != nil {
 		return errors.Wrap(err, "failed to GetCertificate")
 	}
 
-	conn, err := newTxConn(w.db, w.logger)
-	if err != nil {
-		return errors.Wrap(err, "failed to begin transaction")
-	}
-	defer conn.Finish()
-
 	if err = w.storage.InsertCertificate(conn, certificate); err != nil {
 		return errors.Wrap(err, "failed to insertCertificate")
 	}
@@ -1216,5 +1331,20 @@ func (w *DWH) processBlockBoundary(event *blockchain.Event) {
 			w.logger.Warn("failed to updateLastKnownBlock", util.LaconicError(err),
 				zap.Uint64("block_number", event.BlockNumber))
 		}
+		if event.BlockHash != (common.Hash{}) {
+			if err := w.storeBlockHash(event.BlockNumber, event.BlockHash); err != nil {
+				w.logger.Warn("failed to storeBlockHash", util.LaconicError(err),
+					zap.Uint64("block_number", event.BlockNumber))
+			}
+		}
 	}
 }
+
+// isNewBlock reports whether the event is the first one from a block with a
+// known hash.
+func (w *DWH) isNewBlock(event *blockchain.Event) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.lastKnownBlock != event.BlockNumber && event.BlockHash != (common.Hash{})
+}
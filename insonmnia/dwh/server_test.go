@@ -16,7 +16,10 @@ import (
 	"github.com/pkg/errors"
 	bch "github.com/sonm-io/core/blockchain"
 	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -75,6 +78,7 @@ func getTestDWH(dbPath string) (*DWH, error) {
 		db:            db,
 		logger:        log.GetLogger(ctx),
 		numBenchmarks: 12,
+		subscriptions: newSubscriptions(),
 	}
 
 	return w, setupTestDB(w)
@@ -265,6 +269,32 @@ func TestDWH_GetOrders(t *testing.T) {
 			return
 		}
 	}
+	// Test benchmarks bounded from below only.
+	{
+		request := &pb.OrdersRequest{
+			Type:       pb.OrderType_ASK,
+			Benchmarks: map[uint64]*pb.MaxMinUint64{0: {Min: 15}},
+		}
+		orders, _, err := globalDWH.storage.GetOrders(newSimpleConn(globalDWH.db), request)
+
+		if err != nil {
+			t.Errorf("Request `%+v` failed: %s", request, err)
+			return
+		}
+
+		if len(orders) != 5 {
+			t.Errorf("Expected 5 orders in reply, got %d", len(orders))
+			return
+		}
+
+		for _, order := range orders {
+			if order.GetOrder().GetBenchmarks().Get(0) < 15 {
+				t.Errorf("Request `%+v` failed, expected benchmark 0 of at least %d, got %d",
+					request, 15, order.GetOrder().GetBenchmarks().Get(0))
+				return
+			}
+		}
+	}
 	// Test TEXT columns which should be treated as INTEGERS.
 	{
 		request := &pb.OrdersRequest{
@@ -515,6 +545,437 @@ func TestDWH_GetProfiles(t *testing.T) {
 	}
 }
 
+func TestDWH_GetMarketStats(t *testing.T) {
+	globalDWH.mu.Lock()
+	defer globalDWH.mu.Unlock()
+
+	var (
+		commands = globalDWH.storage.(*sqlStorage).commands
+		from     = int64(1530000000)
+	)
+
+	insertOrder := func(id string, createdTS int64, orderType pb.OrderType, price int64) {
+		values := []interface{}{id, createdTS, uint64(orderType), common.HexToAddress("0xA").Hex(),
+			pb.NewBigIntFromInt(price).PaddedString(), 0}
+		for benchID := 0; benchID < 12; benchID++ {
+			values = append(values, 10)
+		}
+		values = append(values, 0) // BlockNumber
+		_, err := globalDWH.db.Exec(commands.insertOrderHistory, values...)
+		require.NoError(t, err)
+	}
+	insertOrder("9090001", from+1, pb.OrderType_ASK, 40)
+	insertOrder("9090002", from+2, pb.OrderType_ASK, 10)
+	insertOrder("9090003", from+3, pb.OrderType_ASK, 30)
+	insertOrder("9090004", from+4, pb.OrderType_ASK, 20)
+	insertOrder("9090005", from+5, pb.OrderType_BID, 1000)
+	insertOrder("9090006", from+3600, pb.OrderType_ASK, 50)
+
+	insertDeal := func(id string, supplierID string, startTime int64) {
+		values := []interface{}{id, common.HexToAddress(supplierID).Hex(), common.HexToAddress("0xB").Hex(), startTime, 0, 0,
+			uint64(pb.DealStatus_DEAL_ACCEPTED), 0}
+		for benchID := 0; benchID < 12; benchID++ {
+			values = append(values, 10)
+		}
+		values = append(values, 0) // BlockNumber
+		_, err := globalDWH.db.Exec(commands.insertDealHistory, values...)
+		require.NoError(t, err)
+	}
+	insertDeal("9090101", "0x1", from+10)
+	insertDeal("9090102", "0x2", from+20)
+
+	conn := newSimpleConn(globalDWH.db)
+	require.NoError(t, globalDWH.storage.CloseDealHistory(conn, big.NewInt(9090102), uint64(from+3605), 0))
+
+	_, err := globalDWH.db.Exec(commands.insertPaymentHistory, from+100, pb.NewBigIntFromInt(7).PaddedString(), "9090102", 0)
+	require.NoError(t, err)
+	_, err = globalDWH.db.Exec(commands.insertPaymentHistory, from+3700, pb.NewBigIntFromInt(5).PaddedString(), "9090102", 0)
+	require.NoError(t, err)
+
+	reply, err := globalDWH.GetMarketStats(globalDWH.ctx, &pb.MarketStatsRequest{
+		From:        &pb.Timestamp{Seconds: from},
+		To:          &pb.Timestamp{Seconds: from + 7200},
+		Granularity: pb.MarketStatsRequest_HOUR,
+		OrderType:   pb.OrderType_ASK,
+		Percentiles: []uint32{25, 90},
+	})
+	require.NoError(t, err)
+	require.Len(t, reply.Buckets, 2)
+
+	bucket := reply.Buckets[0]
+	assert.Equal(t, from, bucket.StartTime.Seconds)
+	assert.Equal(t, uint64(4), bucket.Orders)
+	assert.Equal(t, "20", bucket.MedianPrice.Unwrap().String())
+	require.Len(t, bucket.PercentilePrices, 2)
+	assert.Equal(t, "10", bucket.PercentilePrices[0].Unwrap().String())
+	assert.Equal(t, "40", bucket.PercentilePrices[1].Unwrap().String())
+	assert.Equal(t, uint64(2), bucket.OpenedDeals)
+	assert.Equal(t, uint64(0), bucket.ClosedDeals)
+	assert.Equal(t, "7", bucket.TotalPayout.Unwrap().String())
+	assert.Equal(t, uint64(2), bucket.ActiveSuppliers)
+
+	bucket = reply.Buckets[1]
+	assert.Equal(t, from+3600, bucket.StartTime.Seconds)
+	assert.Equal(t, uint64(1), bucket.Orders)
+	assert.Equal(t, "50", bucket.MedianPrice.Unwrap().String())
+	assert.Equal(t, uint64(0), bucket.OpenedDeals)
+	assert.Equal(t, uint64(1), bucket.ClosedDeals)
+	assert.Equal(t, "5", bucket.TotalPayout.Unwrap().String())
+	assert.Equal(t, uint64(2), bucket.ActiveSuppliers)
+
+	reply, err = globalDWH.GetMarketStats(globalDWH.ctx, &pb.MarketStatsRequest{
+		From:              &pb.Timestamp{Seconds: from},
+		To:                &pb.Timestamp{Seconds: from + 3600},
+		OrderType:         pb.OrderType_ASK,
+		PricePerBenchmark: true,
+		PriceBenchmarkID:  0,
+	})
+	require.NoError(t, err)
+	require.Len(t, reply.Buckets, 1)
+	assert.Equal(t, "2", reply.Buckets[0].MedianPrice.Unwrap().String())
+
+	_, err = globalDWH.GetMarketStats(globalDWH.ctx, &pb.MarketStatsRequest{
+		From:        &pb.Timestamp{Seconds: from},
+		To:          &pb.Timestamp{Seconds: from + 3600},
+		Percentiles: []uint32{100},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = globalDWH.GetMarketStats(globalDWH.ctx, &pb.MarketStatsRequest{
+		From:              &pb.Timestamp{Seconds: from},
+		To:                &pb.Timestamp{Seconds: from + 3600},
+		PricePerBenchmark: true,
+		PriceBenchmarkID:  globalDWH.numBenchmarks,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDWH_GetDealsHistory(t *testing.T) {
+	globalDWH.mu.Lock()
+	defer globalDWH.mu.Unlock()
+
+	var (
+		commands = globalDWH.storage.(*sqlStorage).commands
+		supplier = common.HexToAddress("0xC1")
+		consumer = common.HexToAddress("0xC2")
+		other    = common.HexToAddress("0xC3")
+	)
+
+	insertDeal := func(id string, supplierID, consumerID common.Address, startTime, duration int64) {
+		values := []interface{}{id, supplierID.Hex(), consumerID.Hex(), startTime, 0, duration,
+			uint64(pb.DealStatus_DEAL_ACCEPTED), 0}
+		for benchID := 0; benchID < 12; benchID++ {
+			values = append(values, 10)
+		}
+		values = append(values, 0) // BlockNumber
+		_, err := globalDWH.db.Exec(commands.insertDealHistory, values...)
+		require.NoError(t, err)
+	}
+	insertDeal("9191001", supplier, consumer, 1600000001, 3600)
+	insertDeal("9191002", supplier, consumer, 1600000002, 3600)
+	insertDeal("9191003", supplier, other, 1600000003, 0)
+	insertDeal("9191004", other, consumer, 1600000004, 3600)
+	defer globalDWH.db.Exec("DELETE FROM DealsHistory WHERE Id IN ('9191001', '9191002', '9191003', '9191004')")
+
+	conn := newSimpleConn(globalDWH.db)
+	defer conn.Finish()
+	// Closed deals are kept in the history with their final duration.
+	require.NoError(t, globalDWH.storage.CloseDealHistory(conn, big.NewInt(9191001), 1600003601, 3600))
+	require.NoError(t, globalDWH.storage.CloseDealHistory(conn, big.NewInt(9191002), 1600000602, 7200))
+	require.NoError(t, globalDWH.storage.CloseDealHistory(conn, big.NewInt(9191003), 1600000603, 0))
+
+	reply, err := globalDWH.GetDealsHistory(globalDWH.ctx, &pb.DealsHistoryRequest{
+		Status:     pb.DealStatus_DEAL_CLOSED,
+		SupplierID: pb.NewEthAddress(supplier),
+	})
+	require.NoError(t, err)
+	require.Len(t, reply.Deals, 3)
+
+	// The most recent deals go first.
+	deal := reply.Deals[1]
+	assert.Equal(t, "9191002", deal.GetId().Unwrap().String())
+	assert.Equal(t, supplier, deal.GetSupplierID().Unwrap())
+	assert.Equal(t, consumer, deal.GetConsumerID().Unwrap())
+	assert.Equal(t, int64(1600000002), deal.GetStartTime().GetSeconds())
+	assert.Equal(t, int64(1600000602), deal.GetEndTime().GetSeconds())
+	assert.Equal(t, uint64(7200), deal.GetDuration())
+	assert.Equal(t, pb.DealStatus_DEAL_CLOSED, deal.GetStatus())
+	assert.Equal(t, uint64(10), deal.GetBenchmarks().Get(0))
+	assert.Equal(t, "9191003", reply.Deals[0].GetId().Unwrap().String())
+	assert.Equal(t, "9191001", reply.Deals[2].GetId().Unwrap().String())
+
+	reply, err = globalDWH.GetDealsHistory(globalDWH.ctx, &pb.DealsHistoryRequest{
+		ConsumerID: pb.NewEthAddress(consumer),
+		Limit:      2,
+	})
+	require.NoError(t, err)
+	require.Len(t, reply.Deals, 2)
+	assert.Equal(t, "9191004", reply.Deals[0].GetId().Unwrap().String())
+	assert.Equal(t, pb.DealStatus_DEAL_ACCEPTED, reply.Deals[0].GetStatus())
+	assert.Equal(t, "9191002", reply.Deals[1].GetId().Unwrap().String())
+}
+
+func TestDWH_SubscribeOrders(t *testing.T) {
+	globalDWH.mu.Lock()
+	defer globalDWH.mu.Unlock()
+
+	asks, unsubscribeAsks := globalDWH.subscriptions.SubscribeOrders(&pb.OrdersRequest{
+		Type:     pb.OrderType_ASK,
+		Netflags: &pb.CmpUint64{Operator: pb.CmpOp_GTE, Value: 3},
+	})
+	defer unsubscribeAsks()
+	bids, unsubscribeBids := globalDWH.subscriptions.SubscribeOrders(&pb.OrdersRequest{Type: pb.OrderType_BID})
+	defer unsubscribeBids()
+
+	globalDWH.notifyOrderSubscribers(big.NewInt(20200), pb.DWHEventType_CREATED)
+	require.Len(t, asks, 1)
+	require.Len(t, bids, 0)
+
+	event := <-asks
+	assert.Equal(t, pb.DWHEventType_CREATED, event.Type)
+	assert.Equal(t, "20200", event.Order.Order.Id.Unwrap().String())
+
+	globalDWH.notifyOrderSubscribers(big.NewInt(20209000), pb.DWHEventType_UPDATED)
+	require.Len(t, asks, 1)
+	require.Len(t, bids, 1)
+
+	event = <-bids
+	assert.Equal(t, pb.DWHEventType_DELETED, event.Type)
+	assert.Equal(t, "20209000", event.Order.Order.Id.Unwrap().String())
+}
+
+func TestDWH_SubscribeDeals(t *testing.T) {
+	globalDWH.mu.Lock()
+	defer globalDWH.mu.Unlock()
+
+	deals, unsubscribe := globalDWH.subscriptions.SubscribeDeals(&pb.DealsRequest{
+		SupplierID: pb.NewEthAddress(common.HexToAddress("0x11")),
+		Benchmarks: map[uint64]*pb.MaxMinUint64{0: {Min: 10, Max: 10}},
+	})
+	defer unsubscribe()
+
+	globalDWH.notifyDealSubscribers(big.NewInt(40400), pb.DWHEventType_UPDATED)
+	require.Len(t, deals, 0)
+	globalDWH.notifyDealSubscribers(big.NewInt(40401), pb.DWHEventType_UPDATED)
+	require.Len(t, deals, 1)
+
+	event := <-deals
+	assert.Equal(t, pb.DWHEventType_UPDATED, event.Type)
+	assert.Equal(t, "40401", event.Deal.Deal.Id.Unwrap().String())
+}
+
+func TestDWH_rollback(t *testing.T) {
+	var (
+		orderID = big.NewInt(20200)
+		dealID  = big.NewInt(40401)
+	)
+
+	conn := newSimpleConn(globalDWH.db)
+	defer conn.Finish()
+
+	require.NoError(t, globalDWH.storage.InsertBlockHash(conn, 100, common.HexToHash("0x100")))
+	require.NoError(t, globalDWH.snapshotEntities(conn, &bch.Event{
+		BlockNumber: 100,
+		Data:        &bch.OrderUpdatedData{ID: orderID},
+	}))
+	require.NoError(t, globalDWH.snapshotEntities(conn, &bch.Event{
+		BlockNumber: 100,
+		Data:        &bch.DealUpdatedData{ID: dealID},
+	}))
+	// Only the earliest snapshot must be restored.
+	require.NoError(t, globalDWH.storage.DeleteOrder(conn, orderID))
+	require.NoError(t, globalDWH.snapshotEntities(conn, &bch.Event{
+		BlockNumber: 101,
+		Data:        &bch.OrderUpdatedData{ID: orderID},
+	}))
+	require.NoError(t, globalDWH.storage.DeleteDeal(conn, dealID))
+
+	commands := globalDWH.storage.(*sqlStorage).commands
+	insertHistory := func(cmd string, blockNumber uint64, values ...interface{}) {
+		for benchID := 0; benchID < 12; benchID++ {
+			values = append(values, 10)
+		}
+		_, err := globalDWH.db.Exec(cmd, append(values, blockNumber)...)
+		require.NoError(t, err)
+	}
+	owner := common.HexToAddress("0xA").Hex()
+	price := pb.NewBigIntFromInt(1).PaddedString()
+	insertHistory(commands.insertOrderHistory, 50, "7070001", 1, uint64(pb.OrderType_ASK), owner, price, 0)
+	insertHistory(commands.insertOrderHistory, 100, "7070002", 1, uint64(pb.OrderType_ASK), owner, price, 0)
+	insertHistory(commands.insertDealHistory, 50, dealID.String(), owner, owner, 1, 0, 0, uint64(pb.DealStatus_DEAL_ACCEPTED), 0)
+	insertHistory(commands.insertDealHistory, 100, "7070003", owner, owner, 1, 0, 0, uint64(pb.DealStatus_DEAL_ACCEPTED), 0)
+	require.NoError(t, globalDWH.storage.CloseDealHistory(conn, dealID, 200, 0))
+	_, err := globalDWH.db.Exec(commands.insertPaymentHistory, 1, price, "7070003", 50)
+	require.NoError(t, err)
+	_, err = globalDWH.db.Exec(commands.insertPaymentHistory, 2, price, "7070003", 100)
+	require.NoError(t, err)
+
+	require.NoError(t, globalDWH.rollback(99))
+
+	countRows := func(query string, args ...interface{}) int {
+		var count int
+		require.NoError(t, globalDWH.db.QueryRow(query, args...).Scan(&count))
+		return count
+	}
+	assert.Equal(t, 1, countRows("SELECT COUNT(*) FROM OrdersHistory WHERE Id='7070001'"))
+	assert.Equal(t, 0, countRows("SELECT COUNT(*) FROM OrdersHistory WHERE Id='7070002'"))
+	assert.Equal(t, 0, countRows("SELECT COUNT(*) FROM DealsHistory WHERE Id='7070003'"))
+	assert.Equal(t, 1, countRows("SELECT COUNT(*) FROM PaymentsHistory WHERE DealID='7070003'"))
+	assert.Equal(t, 0, countRows("SELECT COUNT(*) FROM DealsHistory WHERE Id=? AND Status=?",
+		dealID.String(), uint64(pb.DealStatus_DEAL_CLOSED)))
+
+	order, err := globalDWH.storage.GetOrderByID(conn, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, orderID.String(), order.GetOrder().GetId().Unwrap().String())
+
+	deal, err := globalDWH.storage.GetDealByID(conn, dealID)
+	require.NoError(t, err)
+	assert.Equal(t, dealID.String(), deal.GetDeal().GetId().Unwrap().String())
+
+	snapshots, err := globalDWH.storage.GetEntitySnapshots(conn, 99)
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 0)
+
+	hashes, err := globalDWH.storage.GetBlockHashes(conn)
+	require.NoError(t, err)
+	assert.Len(t, hashes, 0)
+	assert.Equal(t, uint64(99), globalDWH.lastKnownBlock)
+}
+
+func TestDWH_failedEvents(t *testing.T) {
+	var (
+		controller   = gomock.NewController(t)
+		mockBlock    = bch.NewMockAPI(controller)
+		mockProfiles = bch.NewMockProfileRegistryAPI(controller)
+		validatorID  = common.HexToAddress("0xF00D")
+		validator    = &pb.Validator{Id: pb.NewEthAddress(validatorID), Level: 2}
+	)
+	defer controller.Finish()
+
+	gomock.InOrder(
+		mockProfiles.EXPECT().GetValidator(gomock.Any(), validatorID).Return(nil, errors.New("unavailable")),
+		mockProfiles.EXPECT().GetValidator(gomock.Any(), validatorID).Times(2).Return(validator, nil),
+	)
+	mockBlock.EXPECT().ProfileRegistry().AnyTimes().Return(mockProfiles)
+
+	globalDWH.blockchain = mockBlock
+	defer func() { globalDWH.blockchain = nil }()
+
+	created := &bch.Event{
+		Data:        &bch.ValidatorCreatedData{ID: validatorID},
+		BlockNumber: 200,
+		BlockHash:   common.HexToHash("0x200"),
+		LogIndex:    1,
+	}
+	deleted := &bch.Event{
+		Data:        &bch.ValidatorDeletedData{ID: validatorID},
+		BlockNumber: 200,
+		BlockHash:   common.HexToHash("0x200"),
+		LogIndex:    2,
+	}
+
+	assert.Error(t, globalDWH.handleEvent(created))
+	// Must be queued behind the failed event without being processed.
+	assert.Equal(t, errFailedEventsPending, globalDWH.handleEvent(deleted))
+
+	reply, err := globalDWH.GetFailedEvents(globalDWH.ctx, &pb.FailedEventsRequest{WithCount: true})
+	require.NoError(t, err)
+	require.Len(t, reply.Events, 2)
+	assert.Equal(t, uint64(2), reply.Count)
+	assert.Equal(t, "ValidatorCreatedData", reply.Events[0].Type)
+	assert.Equal(t, uint64(1), reply.Events[0].Attempts)
+	assert.Equal(t, "ValidatorDeletedData", reply.Events[1].Type)
+	assert.Equal(t, uint64(0), reply.Events[1].Attempts)
+
+	_, err = globalDWH.ReplayFailedEvents(globalDWH.ctx, &pb.ReplayFailedEventsRequest{})
+	require.NoError(t, err)
+	require.NoError(t, globalDWH.processFailedEvents())
+
+	reply, err = globalDWH.GetFailedEvents(globalDWH.ctx, &pb.FailedEventsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, reply.Events, 0)
+
+	// Already processed events must be skipped.
+	assert.NoError(t, globalDWH.handleEvent(created))
+}
+
+func TestEventRetryDelay(t *testing.T) {
+	assert.Equal(t, eventRetryTime, eventRetryDelay(1))
+	assert.Equal(t, 4*eventRetryTime, eventRetryDelay(3))
+	assert.Equal(t, maxEventRetryTime, eventRetryDelay(100))
+}
+
+func TestDWH_processEventRollback(t *testing.T) {
+	var (
+		controller = gomock.NewController(t)
+		mockBlock  = bch.NewMockAPI(controller)
+		mockMarket = bch.NewMockMarketAPI(controller)
+	)
+	defer controller.Finish()
+
+	mockMarket.EXPECT().GetDealInfo(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	mockBlock.EXPECT().Market().AnyTimes().Return(mockMarket)
+
+	globalDWH.blockchain = mockBlock
+	defer func() { globalDWH.blockchain = nil }()
+
+	event := &bch.Event{
+		Data:        &bch.DealUpdatedData{ID: big.NewInt(50500)},
+		BlockNumber: 300,
+		BlockHash:   common.HexToHash("0x300"),
+	}
+	require.Error(t, globalDWH.processEvent(event))
+
+	// Neither the snapshot nor the processed mark must outlive the failure.
+	conn := newSimpleConn(globalDWH.db)
+	defer conn.Finish()
+
+	snapshots, err := globalDWH.storage.GetEntitySnapshots(conn, 299)
+	require.NoError(t, err)
+	assert.Len(t, snapshots, 0)
+
+	processed, err := globalDWH.storage.CheckProcessedEvent(conn, event.BlockNumber, event.LogIndex)
+	require.NoError(t, err)
+	assert.False(t, processed)
+}
+
+func TestDWH_GetDueFailedEvents(t *testing.T) {
+	conn := newSimpleConn(globalDWH.db)
+	defer conn.Finish()
+
+	insert := func(entityKey string, nextRetryTS int64) {
+		require.NoError(t, globalDWH.storage.InsertFailedEvent(conn, &failedEvent{
+			EntityKey:   entityKey,
+			Type:        "ValidatorCreatedData",
+			NextRetryTS: nextRetryTS,
+		}))
+	}
+
+	// More events than a single page of a regular query holds.
+	for idx := 0; idx < MaxLimit+10; idx++ {
+		insert(fmt.Sprintf("Validator_%d", idx), 100)
+	}
+	insert("Deal_1", 200)
+	// Queued behind the event of the same deal, which is not due yet.
+	insert("Deal_1", 100)
+	insert("Deal_2", 200)
+
+	events, err := globalDWH.storage.GetDueFailedEvents(conn, 150)
+	require.NoError(t, err)
+	require.Len(t, events, MaxLimit+10)
+	for idx, event := range events {
+		assert.Equal(t, fmt.Sprintf("Validator_%d", idx), event.EntityKey)
+	}
+
+	events, err = globalDWH.storage.GetDueFailedEvents(conn, 200)
+	require.NoError(t, err)
+	assert.Len(t, events, MaxLimit+13)
+
+	for _, event := range events {
+		require.NoError(t, globalDWH.storage.DeleteFailedEvent(conn, event.ID))
+	}
+}
+
 func TestDWH_monitor(t *testing.T) {
 	var (
 		controller           = gomock.NewController(t)
@@ -637,7 +1098,7 @@ func TestDWH_monitor(t *testing.T) {
 }
 
 func testOrderPlaced(commonEventTS uint64, commonID *big.Int) error {
-	if err := monitorDWH.onOrderPlaced(commonEventTS, commonID); err != nil {
+	if err := monitorDWH.onOrderPlaced(newSimpleConn(monitorDWH.db), commonEventTS, 0, commonID); err != nil {
 		return errors.Wrap(err, "onOrderPlaced failed")
 	}
 	if order, err := monitorDWH.storage.GetOrderByID(newSimpleConn(monitorDWH.db), commonID); err != nil {
@@ -651,7 +1112,7 @@ func testOrderPlaced(commonEventTS uint64, commonID *big.Int) error {
 }
 
 func testDealOpened(deal *pb.Deal, commonID *big.Int) error {
-	if err := monitorDWH.onDealOpened(commonID); err != nil {
+	if err := monitorDWH.onDealOpened(newSimpleConn(monitorDWH.db), 0, commonID); err != nil {
 		return errors.Wrap(err, "onDealOpened failed")
 	}
 	// Firstly, check that a deal was created.
@@ -676,7 +1137,7 @@ func testDealOpened(deal *pb.Deal, commonID *big.Int) error {
 
 func testValidatorCreatedUpdated(validator *pb.Validator) error {
 	// Check that a Validator entry is added after ValidatorCreated event.
-	if err := monitorDWH.onValidatorCreated(common.HexToAddress(common.HexToAddress("0xC").Hex())); err != nil {
+	if err := monitorDWH.onValidatorCreated(newSimpleConn(monitorDWH.db), common.HexToAddress(common.HexToAddress("0xC").Hex())); err != nil {
 		return errors.Wrap(err, "onValidatorCreated failed")
 	}
 	if validators, _, err := monitorDWH.storage.GetValidators(newSimpleConn(monitorDWH.db), &pb.ValidatorsRequest{}); err != nil {
@@ -692,7 +1153,7 @@ func testValidatorCreatedUpdated(validator *pb.Validator) error {
 	}
 	validator.Level = 0
 	// Check that a Validator entry is updated after ValidatorDeleted event.
-	if err := monitorDWH.onValidatorDeleted(common.HexToAddress(common.HexToAddress("0xC").Hex())); err != nil {
+	if err := monitorDWH.onValidatorDeleted(newSimpleConn(monitorDWH.db), common.HexToAddress(common.HexToAddress("0xC").Hex())); err != nil {
 		return errors.Wrap(err, "onValidatorDeleted failed")
 	}
 	if validators, _, err := monitorDWH.storage.GetValidators(newSimpleConn(monitorDWH.db), &pb.ValidatorsRequest{}); err != nil {
@@ -712,7 +1173,7 @@ func testValidatorCreatedUpdated(validator *pb.Validator) error {
 func testCertificateUpdated(certificate *pb.Certificate, commonID *big.Int) error {
 	// Check that a Certificate entry is created after CertificateCreated event. We create a special certificate,
 	// `Name`, that will be recorded directly into profile. There's two such certificate types: `Name` and `Country`.
-	if err := monitorDWH.onCertificateCreated(commonID); err != nil {
+	if err := monitorDWH.onCertificateCreated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onCertificateCreated failed")
 	}
 	if certificateAttrs, err := getCertificates(monitorDWH); err != nil {
@@ -748,7 +1209,7 @@ func testCertificateUpdated(certificate *pb.Certificate, commonID *big.Int) erro
 	certificate.Attribute = CertificateCountry
 	certificate.Value = []byte("Country")
 	// Check that a  Profile entry is updated after CertificateCreated event.
-	if err := monitorDWH.onCertificateCreated(commonID); err != nil {
+	if err := monitorDWH.onCertificateCreated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onCertificateCreated failed")
 	}
 	if profiles, _, err := monitorDWH.storage.GetProfiles(newSimpleConn(monitorDWH.db), &pb.ProfilesRequest{}); err != nil {
@@ -802,7 +1263,7 @@ func testOrderUpdated(order *pb.Order, commonID *big.Int) error {
 	// Check that if order is updated, it is deleted. Order should be deleted because its DealID is not set
 	// (this means that is has become inactive due to a cancellation and not a match).
 	order.OrderStatus = pb.OrderStatus_ORDER_INACTIVE
-	if err := monitorDWH.onOrderUpdated(commonID); err != nil {
+	if err := monitorDWH.onOrderUpdated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onOrderUpdated failed")
 	}
 	if _, err := monitorDWH.storage.GetOrderByID(newSimpleConn(monitorDWH.db), commonID); err == nil {
@@ -814,7 +1275,7 @@ func testOrderUpdated(order *pb.Order, commonID *big.Int) error {
 func testDealUpdated(deal *pb.Deal, commonID *big.Int) error {
 	deal.Duration += 1
 	// Test onDealUpdated event handling.
-	if err := monitorDWH.onDealUpdated(commonID); err != nil {
+	if err := monitorDWH.onDealUpdated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onDealUpdated failed")
 	}
 	if deal, err := monitorDWH.storage.GetDealByID(newSimpleConn(monitorDWH.db), commonID); err != nil {
@@ -829,7 +1290,7 @@ func testDealUpdated(deal *pb.Deal, commonID *big.Int) error {
 
 func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, commonEventTS uint64, commonID *big.Int) error {
 	// Test creating an ASK DealChangeRequest.
-	if err := monitorDWH.onDealChangeRequestSent(commonEventTS, big.NewInt(0)); err != nil {
+	if err := monitorDWH.onDealChangeRequestSent(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(0)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestSent failed")
 	}
 	if changeRequest, err := getDealChangeRequest(monitorDWH, changeRequest.Id); err != nil {
@@ -842,7 +1303,7 @@ func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, comm
 	// Check that after a second ASK DealChangeRequest was created, the new one was kept and the old one was deleted.
 	changeRequest.Id = pb.NewBigIntFromInt(1)
 	changeRequest.Duration = 10021
-	if err := monitorDWH.onDealChangeRequestSent(commonEventTS, big.NewInt(1)); err != nil {
+	if err := monitorDWH.onDealChangeRequestSent(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(1)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestSent (2) failed")
 	}
 	if changeRequest, err := getDealChangeRequest(monitorDWH, changeRequest.Id); err != nil {
@@ -859,7 +1320,7 @@ func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, comm
 	changeRequest.Id = pb.NewBigIntFromInt(2)
 	changeRequest.Duration = 10022
 	changeRequest.RequestType = pb.OrderType_BID
-	if err := monitorDWH.onDealChangeRequestSent(commonEventTS, big.NewInt(2)); err != nil {
+	if err := monitorDWH.onDealChangeRequestSent(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(2)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestSent (3) failed")
 	}
 	if changeRequest, err := getDealChangeRequest(monitorDWH, changeRequest.Id); err != nil {
@@ -875,7 +1336,7 @@ func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, comm
 	// Check that when a DealChangeRequest is updated to any status but REJECTED, it is deleted.
 	changeRequest.Id = pb.NewBigIntFromInt(1)
 	changeRequest.Status = pb.ChangeRequestStatus_REQUEST_ACCEPTED
-	if err := monitorDWH.onDealChangeRequestUpdated(commonEventTS, big.NewInt(1)); err != nil {
+	if err := monitorDWH.onDealChangeRequestUpdated(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(1)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestUpdated failed")
 	}
 	if _, err := getDealChangeRequest(monitorDWH, pb.NewBigIntFromInt(1)); err == nil {
@@ -884,7 +1345,7 @@ func testDealChangeRequestSentAccepted(changeRequest *pb.DealChangeRequest, comm
 	// Check that when a DealChangeRequest is updated to REJECTED, it is kept.
 	changeRequest.Id = pb.NewBigIntFromInt(2)
 	changeRequest.Status = pb.ChangeRequestStatus_REQUEST_REJECTED
-	if err := monitorDWH.onDealChangeRequestUpdated(commonEventTS, big.NewInt(2)); err != nil {
+	if err := monitorDWH.onDealChangeRequestUpdated(newSimpleConn(monitorDWH.db), commonEventTS, big.NewInt(2)); err != nil {
 		return errors.Wrap(err, "onDealChangeRequestUpdated (4) failed")
 	}
 	if _, err := getDealChangeRequest(monitorDWH, pb.NewBigIntFromInt(2)); err != nil {
@@ -921,7 +1382,7 @@ func testBilled(commonEventTS uint64, commonID *big.Int) error {
 
 	// Check that after a Billed event last DealCondition.Payout is updated.
 	newBillTS := commonEventTS + 1
-	if err := monitorDWH.onBilled(newBillTS, commonID, big.NewInt(10)); err != nil {
+	if err := monitorDWH.onBilled(newSimpleConn(monitorDWH.db), newBillTS, 0, commonID, big.NewInt(10)); err != nil {
 		return errors.Wrap(err, "onBilled failed")
 	}
 	if dealConditions, _, err := monitorDWH.storage.GetDealConditions(
@@ -966,7 +1427,7 @@ func testDealClosed(deal *pb.Deal, commonID *big.Int) error {
 	// Check that when a Deal's status is updated to CLOSED, Deal and its DealConditions are deleted.
 	deal.Status = pb.DealStatus_DEAL_CLOSED
 	// Test onDealUpdated event handling.
-	if err := monitorDWH.onDealUpdated(commonID); err != nil {
+	if err := monitorDWH.onDealUpdated(newSimpleConn(monitorDWH.db), commonID); err != nil {
 		return errors.Wrap(err, "onDealUpdated")
 	}
 	if _, err := monitorDWH.storage.GetDealByID(newSimpleConn(monitorDWH.db), commonID); err == nil {
@@ -985,7 +1446,7 @@ func testDealClosed(deal *pb.Deal, commonID *big.Int) error {
 
 func testWorkerAnnouncedConfirmedRemoved() error {
 	// Check that a worker is added after a WorkerAnnounced event.
-	if err := monitorDWH.onWorkerAnnounced(common.HexToAddress("0xC").Hex(),
+	if err := monitorDWH.onWorkerAnnounced(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC").Hex(),
 		common.HexToAddress("0xD").Hex()); err != nil {
 		return errors.Wrap(err, "onWorkerAnnounced failed")
 	}
@@ -1001,7 +1462,7 @@ func testWorkerAnnouncedConfirmedRemoved() error {
 		}
 	}
 	// Check that a worker is confirmed after a WorkerConfirmed event.
-	if err := monitorDWH.onWorkerConfirmed(common.HexToAddress("0xC").Hex(),
+	if err := monitorDWH.onWorkerConfirmed(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC").Hex(),
 		common.HexToAddress("0xD").Hex()); err != nil {
 		return errors.Wrap(err, "onWorkerConfirmed failed")
 	}
@@ -1017,7 +1478,7 @@ func testWorkerAnnouncedConfirmedRemoved() error {
 		}
 	}
 	// Check that a worker is deleted after a WorkerRemoved event.
-	if err := monitorDWH.onWorkerRemoved(common.HexToAddress("0xC").Hex(),
+	if err := monitorDWH.onWorkerRemoved(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC").Hex(),
 		common.HexToAddress("0xD").Hex()); err != nil {
 		return errors.Wrap(err, "onWorkerRemoved failed")
 	}
@@ -1033,7 +1494,7 @@ func testWorkerAnnouncedConfirmedRemoved() error {
 
 func testBlacklistAddedRemoved() error {
 	// Check that a Blacklist entry is added after AddedToBlacklist event.
-	if err := monitorDWH.onAddedToBlacklist(common.HexToAddress("0xC").Hex(),
+	if err := monitorDWH.onAddedToBlacklist(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC").Hex(),
 		common.HexToAddress("0xD").Hex()); err != nil {
 		return errors.Wrap(err, "onAddedToBlacklist failed")
 	}
@@ -1047,7 +1508,7 @@ func testBlacklistAddedRemoved() error {
 		}
 	}
 	// Check that a Blacklist entry is deleted after RemovedFromBlacklist event.
-	if err := monitorDWH.onRemovedFromBlacklist(common.HexToAddress("0xC").Hex(),
+	if err := monitorDWH.onRemovedFromBlacklist(newSimpleConn(monitorDWH.db), common.HexToAddress("0xC").Hex(),
 		common.HexToAddress("0xD").Hex()); err != nil {
 		return errors.Wrap(err, "onRemovedFromBlacklist failed")
 	}
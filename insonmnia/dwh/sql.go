@@ -94,6 +94,167 @@ func (c *sqlStorage) InsertDeal(conn queryConn, deal *pb.Deal) error {
 	return err
 }
 
+// InsertDealHistory appends the deal opened in the given block to the
+// history. Must be called after the deal's ask order is stored.
+func (c *sqlStorage) InsertDealHistory(conn queryConn, deal *pb.Deal, blockNumber uint64) error {
+	ask, err := c.GetOrderByID(conn, deal.AskID.Unwrap())
+	if err != nil {
+		return errors.Wrapf(err, "failed to getOrderDetails (Ask)")
+	}
+
+	historyColumns := []interface{}{
+		deal.Id.Unwrap().String(),
+		deal.SupplierID.Unwrap().Hex(),
+		deal.ConsumerID.Unwrap().Hex(),
+		deal.StartTime.Seconds,
+		deal.EndTime.Seconds,
+		deal.Duration,
+		uint64(deal.Status),
+		ask.GetOrder().Netflags,
+	}
+	for benchID := uint64(0); benchID < c.numBenchmarks; benchID++ {
+		historyColumns = append(historyColumns, deal.Benchmarks.Values[benchID])
+	}
+	historyColumns = append(historyColumns, blockNumber)
+
+	_, err = conn.Exec(c.commands.insertDealHistory, historyColumns...)
+	return err
+}
+
+// GetDealsHistory returns deals from the history, most recent first.
+func (c *sqlStorage) GetDealsHistory(conn queryConn, request *pb.DealsHistoryRequest) ([]*pb.Deal, error) {
+	var filters []*filter
+	if request.Status > 0 {
+		filters = append(filters, newFilter("Status", eq, request.Status, "AND"))
+	}
+	if !request.SupplierID.IsZero() {
+		filters = append(filters, newFilter("SupplierID", eq, request.SupplierID.Unwrap().Hex(), "AND"))
+	}
+	if !request.ConsumerID.IsZero() {
+		filters = append(filters, newFilter("ConsumerID", eq, request.ConsumerID.Unwrap().Hex(), "AND"))
+	}
+	rows, _, err := c.queryRunner.Run(conn, &queryOpts{
+		table:    "DealsHistory",
+		filters:  filters,
+		sortings: []*pb.SortingOption{{Field: "StartTime", Order: pb.SortingOrder_Desc}},
+		offset:   request.Offset,
+		limit:    request.Limit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to run query")
+	}
+	defer rows.Close()
+
+	var deals []*pb.Deal
+	for rows.Next() {
+		deal, err := c.decodeDealHistory(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decodeDealHistory")
+		}
+
+		deals = append(deals, deal)
+	}
+
+	return deals, rows.Err()
+}
+
+func (c *sqlStorage) decodeDealHistory(rows *sql.Rows) (*pb.Deal, error) {
+	var (
+		id                 string
+		supplierID         string
+		consumerID         string
+		startTime, endTime int64
+		duration           uint64
+		status             uint64
+		netflags           uint64
+		blockNumber        uint64
+		benchmarks         = make([]uint64, c.numBenchmarks)
+	)
+	allFields := []interface{}{&id, &supplierID, &consumerID, &startTime, &endTime, &duration, &status, &netflags}
+	for benchID := range benchmarks {
+		allFields = append(allFields, &benchmarks[benchID])
+	}
+	allFields = append(allFields, &blockNumber)
+	if err := rows.Scan(allFields...); err != nil {
+		return nil, errors.Wrap(err, "failed to scan DealsHistory row")
+	}
+
+	bigID, err := pb.NewBigIntFromString(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse Id")
+	}
+
+	return &pb.Deal{
+		Id:         bigID,
+		SupplierID: pb.NewEthAddress(common.HexToAddress(supplierID)),
+		ConsumerID: pb.NewEthAddress(common.HexToAddress(consumerID)),
+		StartTime:  &pb.Timestamp{Seconds: startTime},
+		EndTime:    &pb.Timestamp{Seconds: endTime},
+		Duration:   duration,
+		Status:     pb.DealStatus(status),
+		Benchmarks: &pb.Benchmarks{Values: benchmarks},
+	}, nil
+}
+
+func (c *sqlStorage) GetDealPayments(conn queryConn, dealID *big.Int) ([]*pb.DealPayment, error) {
+	rows, err := conn.Query(c.commands.selectDealPayments, dealID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectDealPayments")
+	}
+	defer rows.Close()
+
+	var out []*pb.DealPayment
+	for rows.Next() {
+		var (
+			billTS     int64
+			paidAmount string
+			id         string
+		)
+		if err := rows.Scan(&billTS, &paidAmount, &id); err != nil {
+			return nil, errors.Wrap(err, "failed to scan DealPayment row")
+		}
+
+		bigPaidAmount, err := util.ParseBigInt(paidAmount)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse PaidAmount")
+		}
+
+		out = append(out, &pb.DealPayment{
+			DealID:      pb.NewBigInt(dealID),
+			PayedAmount: pb.NewBigInt(bigPaidAmount),
+			PaymentTS:   &pb.Timestamp{Seconds: billTS},
+		})
+	}
+
+	return out, rows.Err()
+}
+
+// CloseDealHistory marks the deal closed in the history with its final end
+// time and duration, which may have been changed while the deal was active.
+func (c *sqlStorage) CloseDealHistory(conn queryConn, dealID *big.Int, endTime, duration uint64) error {
+	_, err := conn.Exec(c.commands.updateDealHistory, endTime, duration, uint64(pb.DealStatus_DEAL_CLOSED), dealID.String())
+	return err
+}
+
+// RevertDealHistory restores the end time, the duration and the status of
+// the deal in the history to the given ones.
+func (c *sqlStorage) RevertDealHistory(conn queryConn, deal *pb.Deal) error {
+	_, err := conn.Exec(c.commands.updateDealHistory, deal.EndTime.Seconds, deal.Duration, uint64(deal.Status), deal.Id.Unwrap().String())
+	return err
+}
+
+// DeleteHistory removes orders, deals and payments appended to the history
+// after the given block.
+func (c *sqlStorage) DeleteHistory(conn queryConn, afterBlock uint64) error {
+	for _, cmd := range []string{c.commands.deleteOrdersHistory, c.commands.deleteDealsHistory, c.commands.deletePaymentsHistory} {
+		if _, err := conn.Exec(cmd, afterBlock); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *sqlStorage) UpdateDeal(conn queryConn, deal *pb.Deal) error {
 	_, err := conn.Exec(c.commands.updateDeal,
 		deal.Duration,
@@ -279,6 +440,26 @@ func (c *sqlStorage) InsertOrder(conn queryConn, order *pb.DWHOrder) error {
 	return err
 }
 
+// InsertOrderHistory appends the order placed in the given block to the
+// history.
+func (c *sqlStorage) InsertOrderHistory(conn queryConn, order *pb.DWHOrder, blockNumber uint64) error {
+	historyColumns := []interface{}{
+		order.GetOrder().Id.Unwrap().String(),
+		order.CreatedTS.Seconds,
+		uint64(order.GetOrder().OrderType),
+		order.GetOrder().AuthorID.Unwrap().Hex(),
+		order.GetOrder().Price.PaddedString(),
+		order.GetOrder().Netflags,
+	}
+	for benchID := uint64(0); benchID < c.numBenchmarks; benchID++ {
+		historyColumns = append(historyColumns, order.GetOrder().Benchmarks.Values[benchID])
+	}
+	historyColumns = append(historyColumns, blockNumber)
+
+	_, err := conn.Exec(c.commands.insertOrderHistory, historyColumns...)
+	return err
+}
+
 func (c *sqlStorage) UpdateOrderStatus(conn queryConn, orderID *big.Int, status pb.OrderStatus) error {
 	_, err := conn.Exec(c.commands.updateOrderStatus, status, orderID.String())
 	return err
@@ -652,6 +833,14 @@ func (c *sqlStorage) InsertDealPayment(conn queryConn, payment *pb.DealPayment)
 	return err
 }
 
+// InsertPaymentHistory appends the payment made in the given block to the
+// history.
+func (c *sqlStorage) InsertPaymentHistory(conn queryConn, payment *pb.DealPayment, blockNumber uint64) error {
+	_, err := conn.Exec(c.commands.insertPaymentHistory, payment.PaymentTS.Seconds, payment.PayedAmount.PaddedString(),
+		payment.DealID.Unwrap().String(), blockNumber)
+	return err
+}
+
 func (c *sqlStorage) InsertWorker(conn queryConn, masterID, slaveID string) error {
 	_, err := conn.Exec(c.commands.insertWorker, masterID, slaveID, false)
 	return err
@@ -892,6 +1081,207 @@ func (c *sqlStorage) UpdateLastKnownBlock(conn queryConn, blockNumber int64) err
 	return err
 }
 
+func (c *sqlStorage) InsertBlockHash(conn queryConn, blockNumber uint64, hash common.Hash) error {
+	_, err := conn.Exec(c.commands.insertBlockHash, blockNumber, hash.Hex())
+	return err
+}
+
+func (c *sqlStorage) GetBlockHashes(conn queryConn) ([]*blockHash, error) {
+	rows, err := conn.Query(c.commands.selectBlockHashes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectBlockHashes")
+	}
+	defer rows.Close()
+
+	var out []*blockHash
+	for rows.Next() {
+		var (
+			number uint64
+			hash   string
+		)
+		if err := rows.Scan(&number, &hash); err != nil {
+			return nil, errors.Wrap(err, "failed to scan block hash")
+		}
+		out = append(out, &blockHash{Number: number, Hash: common.HexToHash(hash)})
+	}
+
+	return out, rows.Err()
+}
+
+func (c *sqlStorage) DeleteBlockHashes(conn queryConn, afterBlock uint64) error {
+	_, err := conn.Exec(c.commands.deleteBlockHashes, afterBlock)
+	return err
+}
+
+func (c *sqlStorage) InsertEntitySnapshot(conn queryConn, blockNumber uint64, entity string, id *big.Int, snapshot []byte) error {
+	_, err := conn.Exec(c.commands.insertEntitySnapshot, blockNumber, entity, id.String(), snapshot)
+	return err
+}
+
+func (c *sqlStorage) GetEntitySnapshots(conn queryConn, afterBlock uint64) ([]*entitySnapshot, error) {
+	rows, err := conn.Query(c.commands.selectEntitySnapshots, afterBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectEntitySnapshots")
+	}
+	defer rows.Close()
+
+	var out []*entitySnapshot
+	for rows.Next() {
+		var (
+			snapshot = &entitySnapshot{}
+			id       string
+		)
+		if err := rows.Scan(&snapshot.BlockNumber, &snapshot.Entity, &id, &snapshot.Data); err != nil {
+			return nil, errors.Wrap(err, "failed to scan entity snapshot")
+		}
+		if snapshot.ID, err = util.ParseBigInt(id); err != nil {
+			return nil, errors.Wrap(err, "failed to parse entity ID")
+		}
+		out = append(out, snapshot)
+	}
+
+	return out, rows.Err()
+}
+
+func (c *sqlStorage) DeleteEntitySnapshots(conn queryConn, afterBlock uint64) error {
+	_, err := conn.Exec(c.commands.deleteEntitySnapshots, afterBlock)
+	return err
+}
+
+func (c *sqlStorage) PruneReorgData(conn queryConn, beforeBlock uint64) error {
+	if _, err := conn.Exec(c.commands.pruneBlockHashes, beforeBlock); err != nil {
+		return err
+	}
+
+	if _, err := conn.Exec(c.commands.pruneEntitySnapshots, beforeBlock); err != nil {
+		return err
+	}
+
+	_, err := conn.Exec(c.commands.pruneProcessedEvents, beforeBlock)
+	return err
+}
+
+func (c *sqlStorage) InsertProcessedEvent(conn queryConn, blockNumber uint64, logIndex uint) error {
+	_, err := conn.Exec(c.commands.insertProcessedEvent, blockNumber, logIndex)
+	return err
+}
+
+func (c *sqlStorage) CheckProcessedEvent(conn queryConn, blockNumber uint64, logIndex uint) (bool, error) {
+	rows, err := conn.Query(c.commands.checkProcessedEvent, blockNumber, logIndex)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to checkProcessedEvent")
+	}
+	defer rows.Close()
+
+	return rows.Next(), rows.Err()
+}
+
+func (c *sqlStorage) DeleteProcessedEvents(conn queryConn, afterBlock uint64) error {
+	_, err := conn.Exec(c.commands.deleteProcessedEvents, afterBlock)
+	return err
+}
+
+func (c *sqlStorage) InsertFailedEvent(conn queryConn, event *failedEvent) error {
+	_, err := conn.Exec(c.commands.insertFailedEvent, event.EntityKey, event.BlockNumber, event.BlockHash,
+		event.LogIndex, event.TS, event.Type, event.Data, event.Attempts, event.LastError, event.NextRetryTS)
+	return err
+}
+
+// GetFailedEvents returns failed events in order of their arrival.
+func (c *sqlStorage) GetFailedEvents(conn queryConn, request *pb.FailedEventsRequest) ([]*failedEvent, uint64, error) {
+	rows, count, err := c.queryRunner.Run(conn, &queryOpts{
+		table:     "FailedEvents",
+		sortings:  []*pb.SortingOption{{Field: "Id", Order: pb.SortingOrder_Asc}},
+		offset:    request.Offset,
+		limit:     request.Limit,
+		withCount: request.WithCount,
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to run query")
+	}
+	defer rows.Close()
+
+	out, err := scanFailedEvents(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return out, count, nil
+}
+
+// GetDueFailedEvents returns failed events that are due for retry at the
+// given time in order of their arrival. Events queued behind ones of the same
+// entity that are not due yet are not returned.
+func (c *sqlStorage) GetDueFailedEvents(conn queryConn, now int64) ([]*failedEvent, error) {
+	rows, err := conn.Query(c.commands.selectDueFailedEvents, now, now)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to selectDueFailedEvents")
+	}
+	defer rows.Close()
+
+	return scanFailedEvents(rows)
+}
+
+func scanFailedEvents(rows *sql.Rows) ([]*failedEvent, error) {
+	var out []*failedEvent
+	for rows.Next() {
+		event := &failedEvent{}
+		if err := rows.Scan(&event.ID, &event.EntityKey, &event.BlockNumber, &event.BlockHash, &event.LogIndex,
+			&event.TS, &event.Type, &event.Data, &event.Attempts, &event.LastError, &event.NextRetryTS); err != nil {
+			return nil, errors.Wrap(err, "failed to scan failed event")
+		}
+		out = append(out, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "rows error")
+	}
+
+	return out, nil
+}
+
+func (c *sqlStorage) CheckFailedEvents(conn queryConn, entityKey string) (bool, error) {
+	rows, err := conn.Query(c.commands.checkFailedEvents, entityKey)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to checkFailedEvents")
+	}
+	defer rows.Close()
+
+	return rows.Next(), rows.Err()
+}
+
+func (c *sqlStorage) UpdateFailedEvent(conn queryConn, event *failedEvent) error {
+	_, err := conn.Exec(c.commands.updateFailedEvent, event.Attempts, event.LastError, event.NextRetryTS, event.ID)
+	return err
+}
+
+// ScheduleFailedEvents sets the next retry time of the given failed events, or
+// all of them if no IDs are specified.
+func (c *sqlStorage) ScheduleFailedEvents(conn queryConn, ids []uint64, nextRetryTS int64) error {
+	if len(ids) == 0 {
+		_, err := conn.Exec(c.commands.scheduleAllFailedEvents, nextRetryTS)
+		return err
+	}
+
+	for _, id := range ids {
+		if _, err := conn.Exec(c.commands.scheduleFailedEvent, nextRetryTS, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *sqlStorage) DeleteFailedEvent(conn queryConn, id uint64) error {
+	_, err := conn.Exec(c.commands.deleteFailedEvent, id)
+	return err
+}
+
+func (c *sqlStorage) DeleteFailedEvents(conn queryConn, afterBlock uint64) error {
+	_, err := conn.Exec(c.commands.deleteFailedEvents, afterBlock)
+	return err
+}
+
 func (c *sqlStorage) StoreStaleID(conn queryConn, id *big.Int, entity string) error {
 	_, err := conn.Exec(c.commands.storeStaleID, fmt.Sprintf("%s_%s", entity, id.String()))
 	return err
@@ -922,7 +1312,7 @@ func (c *sqlStorage) addBenchmarksConditions(benches map[uint64]*pb.MaxMinUint64
 			*filters = append(*filters, newFilter(getBenchmarkColumn(benchID), lte, condition.Max, "AND"))
 		}
 		if condition.Min > 0 {
-			*filters = append(*filters, newFilter(getBenchmarkColumn(benchID), gte, condition.Max, "AND"))
+			*filters = append(*filters, newFilter(getBenchmarkColumn(benchID), gte, condition.Min, "AND"))
 		}
 	}
 }
@@ -1373,6 +1763,34 @@ type sqlCommands struct {
 	storeStaleID                 string
 	removeStaleID                string
 	checkStaleID                 string
+	insertOrderHistory           string
+	insertDealHistory            string
+	updateDealHistory            string
+	insertPaymentHistory         string
+	deleteOrdersHistory          string
+	deleteDealsHistory           string
+	deletePaymentsHistory        string
+	selectDealPayments           string
+	insertBlockHash              string
+	selectBlockHashes            string
+	deleteBlockHashes            string
+	pruneBlockHashes             string
+	insertEntitySnapshot         string
+	selectEntitySnapshots        string
+	deleteEntitySnapshots        string
+	pruneEntitySnapshots         string
+	insertProcessedEvent         string
+	checkProcessedEvent          string
+	deleteProcessedEvents        string
+	pruneProcessedEvents         string
+	insertFailedEvent            string
+	checkFailedEvents            string
+	selectDueFailedEvents        string
+	updateFailedEvent            string
+	scheduleFailedEvent          string
+	scheduleAllFailedEvents      string
+	deleteFailedEvent            string
+	deleteFailedEvents           string
 }
 
 type sqlSetupCommands struct {
@@ -1388,8 +1806,21 @@ type sqlSetupCommands struct {
 	createTableProfiles       string
 	createTableMisc           string
 	createTableStaleIDs       string
-	createIndexCmd            string
-	tablesInfo                *tablesInfo
+	// History tables are append-only, i.e. they keep orders, deals and
+	// payments after they are removed from the main tables, which allows to
+	// collect market statistics.
+	createTableOrdersHistory   string
+	createTableDealsHistory    string
+	createTablePaymentsHistory string
+	// Blocks and snapshots tables are used to roll back chain reorganisations.
+	createTableBlocks          string
+	createTableEntitySnapshots string
+	// ProcessedEvents table makes event processing idempotent, FailedEvents
+	// table keeps events that are waiting for retry.
+	createTableProcessedEvents string
+	createTableFailedEvents    string
+	createIndexCmd             string
+	tablesInfo                 *tablesInfo
 }
 
 func (c *sqlSetupCommands) setupTables(db *sql.DB) error {
@@ -1453,6 +1884,41 @@ func (c *sqlSetupCommands) setupTables(db *sql.DB) error {
 		return errors.Wrapf(err, "failed to %s", c.createTableMisc)
 	}
 
+	_, err = db.Exec(c.createTableOrdersHistory)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableOrdersHistory)
+	}
+
+	_, err = db.Exec(c.createTableDealsHistory)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableDealsHistory)
+	}
+
+	_, err = db.Exec(c.createTablePaymentsHistory)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTablePaymentsHistory)
+	}
+
+	_, err = db.Exec(c.createTableBlocks)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableBlocks)
+	}
+
+	_, err = db.Exec(c.createTableEntitySnapshots)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableEntitySnapshots)
+	}
+
+	_, err = db.Exec(c.createTableProcessedEvents)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableProcessedEvents)
+	}
+
+	_, err = db.Exec(c.createTableFailedEvents)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s", c.createTableFailedEvents)
+	}
+
 	return nil
 }
 
@@ -1502,6 +1968,29 @@ func (c *sqlSetupCommands) createIndices(db *sql.DB) error {
 	if err = c.createIndex(db, c.createIndexCmd, "StaleIDs", "Id"); err != nil {
 		return err
 	}
+	for _, column := range []string{"CreatedTS", "Type"} {
+		if err = c.createIndex(db, c.createIndexCmd, "OrdersHistory", column); err != nil {
+			return err
+		}
+	}
+	for _, column := range []string{"SupplierID", "ConsumerID", "StartTime", "EndTime"} {
+		if err = c.createIndex(db, c.createIndexCmd, "DealsHistory", column); err != nil {
+			return err
+		}
+	}
+	for _, column := range []string{"BillTS", "DealID"} {
+		if err = c.createIndex(db, c.createIndexCmd, "PaymentsHistory", column); err != nil {
+			return err
+		}
+	}
+	if err = c.createIndex(db, c.createIndexCmd, "EntitySnapshots", "BlockNumber"); err != nil {
+		return err
+	}
+	for _, column := range []string{"EntityKey", "BlockNumber"} {
+		if err = c.createIndex(db, c.createIndexCmd, "FailedEvents", column); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -1582,6 +2071,8 @@ type tablesInfo struct {
 	NumOrderColumns         uint64
 	ProfileColumnsSet       map[string]bool
 	DealConditionColumnsSet map[string]bool
+	OrdersHistoryColumns    []string
+	DealsHistoryColumns     []string
 }
 
 func newTablesInfo(numBenchmarks uint64) *tablesInfo {
@@ -1658,13 +2149,19 @@ func newTablesInfo(numBenchmarks uint64) *tablesInfo {
 		NumOrderColumns:         uint64(len(orderColumns)),
 		DealConditionColumnsSet: stringSliceToSet(dealConditionColumns),
 		ProfileColumnsSet:       stringSliceToSet(profileColumns),
+		OrdersHistoryColumns:    []string{"Id", "CreatedTS", "Type", "AuthorID", "Price", "Netflags"},
+		DealsHistoryColumns:     []string{"Id", "SupplierID", "ConsumerID", "StartTime", "EndTime", "Duration", "Status", "Netflags"},
 	}
 	for benchmarkID := uint64(0); benchmarkID < numBenchmarks; benchmarkID++ {
 		out.DealColumns = append(out.DealColumns, getBenchmarkColumn(uint64(benchmarkID)))
 		out.DealColumnsSet[getBenchmarkColumn(uint64(benchmarkID))] = true
 		out.OrderColumns = append(out.OrderColumns, getBenchmarkColumn(uint64(benchmarkID)))
 		out.OrderColumnsSet[getBenchmarkColumn(uint64(benchmarkID))] = true
+		out.OrdersHistoryColumns = append(out.OrdersHistoryColumns, getBenchmarkColumn(uint64(benchmarkID)))
+		out.DealsHistoryColumns = append(out.DealsHistoryColumns, getBenchmarkColumn(uint64(benchmarkID)))
 	}
+	out.OrdersHistoryColumns = append(out.OrdersHistoryColumns, "BlockNumber")
+	out.DealsHistoryColumns = append(out.DealsHistoryColumns, "BlockNumber")
 
 	return out
 }
@@ -1707,6 +2204,14 @@ func makeSelectOrderByIDQuery(format string, tInfo *tablesInfo) string {
 	return fmt.Sprintf(format, strings.Join(tInfo.OrderColumns, ", "))
 }
 
+func makeInsertHistoryQuery(format string, formatCb formatArg, columns []string) string {
+	placeholders := ""
+	for i := range columns {
+		placeholders += formatCb(uint64(i), i == len(columns)-1)
+	}
+	return fmt.Sprintf(format, strings.Join(columns, ", "), placeholders)
+}
+
 func makeTableWithBenchmarks(format, benchmarkType string) string {
 	benchmarkColumns := make([]string, NumMaxBenchmarks)
 	for benchmarkID := uint64(0); benchmarkID < NumMaxBenchmarks; benchmarkID++ {
@@ -82,6 +82,34 @@ func newSQLiteStorage(tInfo *tablesInfo, numBenchmarks uint64) *sqlStorage {
 			storeStaleID:                 `INSERT INTO StaleIDs VALUES (?)`,
 			removeStaleID:                `DELETE FROM StaleIDs WHERE Id = ?`,
 			checkStaleID:                 `SELECT * FROM StaleIDs WHERE Id = ?`,
+			insertOrderHistory:           makeInsertHistoryQuery(`INSERT OR IGNORE INTO OrdersHistory(%s) VALUES (%s)`, formatCb, tInfo.OrdersHistoryColumns),
+			insertDealHistory:            makeInsertHistoryQuery(`INSERT OR IGNORE INTO DealsHistory(%s) VALUES (%s)`, formatCb, tInfo.DealsHistoryColumns),
+			updateDealHistory:            `UPDATE DealsHistory SET EndTime=?, Duration=?, Status=? WHERE Id=?`,
+			insertPaymentHistory:         `INSERT OR IGNORE INTO PaymentsHistory VALUES (?, ?, ?, ?)`,
+			deleteOrdersHistory:          `DELETE FROM OrdersHistory WHERE BlockNumber>?`,
+			deleteDealsHistory:           `DELETE FROM DealsHistory WHERE BlockNumber>?`,
+			deletePaymentsHistory:        `DELETE FROM PaymentsHistory WHERE BlockNumber>?`,
+			selectDealPayments:           `SELECT BillTS, PaidAmount, DealID FROM DealPayments WHERE DealID=?`,
+			insertBlockHash:              `INSERT OR REPLACE INTO Blocks VALUES (?, ?)`,
+			selectBlockHashes:            `SELECT Number, Hash FROM Blocks ORDER BY Number DESC`,
+			deleteBlockHashes:            `DELETE FROM Blocks WHERE Number>?`,
+			pruneBlockHashes:             `DELETE FROM Blocks WHERE Number<?`,
+			insertEntitySnapshot:         `INSERT OR IGNORE INTO EntitySnapshots VALUES (?, ?, ?, ?)`,
+			selectEntitySnapshots:        `SELECT BlockNumber, Entity, Id, Snapshot FROM EntitySnapshots WHERE BlockNumber>? ORDER BY BlockNumber ASC`,
+			deleteEntitySnapshots:        `DELETE FROM EntitySnapshots WHERE BlockNumber>?`,
+			pruneEntitySnapshots:         `DELETE FROM EntitySnapshots WHERE BlockNumber<?`,
+			insertProcessedEvent:         `INSERT OR IGNORE INTO ProcessedEvents VALUES (?, ?)`,
+			checkProcessedEvent:          `SELECT BlockNumber FROM ProcessedEvents WHERE BlockNumber=? AND LogIndex=?`,
+			deleteProcessedEvents:        `DELETE FROM ProcessedEvents WHERE BlockNumber>?`,
+			pruneProcessedEvents:         `DELETE FROM ProcessedEvents WHERE BlockNumber<?`,
+			insertFailedEvent:            `INSERT INTO FailedEvents VALUES (NULL, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			checkFailedEvents:            `SELECT Id FROM FailedEvents WHERE EntityKey=? LIMIT 1`,
+			selectDueFailedEvents:        `SELECT * FROM FailedEvents AS f WHERE NextRetryTS<=? AND NOT EXISTS (SELECT 1 FROM FailedEvents AS p WHERE p.EntityKey=f.EntityKey AND p.Id<f.Id AND p.NextRetryTS>?) ORDER BY Id ASC`,
+			updateFailedEvent:            `UPDATE FailedEvents SET Attempts=?, LastError=?, NextRetryTS=? WHERE Id=?`,
+			scheduleFailedEvent:          `UPDATE FailedEvents SET NextRetryTS=? WHERE Id=?`,
+			scheduleAllFailedEvents:      `UPDATE FailedEvents SET NextRetryTS=?`,
+			deleteFailedEvent:            `DELETE FROM FailedEvents WHERE Id=?`,
+			deleteFailedEvents:           `DELETE FROM FailedEvents WHERE BlockNumber>?`,
 		},
 		setupCommands: &sqlSetupCommands{
 			// Incomplete, modified during setup.
@@ -209,6 +237,67 @@ func newSQLiteStorage(tInfo *tablesInfo, numBenchmarks uint64) *sqlStorage {
 	CREATE TABLE IF NOT EXISTS Misc (
 		Id							INTEGER PRIMARY KEY AUTOINCREMENT,
 		LastKnownBlock				INTEGER NOT NULL
+	)`,
+			createTableOrdersHistory: makeTableWithBenchmarks(`
+	CREATE TABLE IF NOT EXISTS OrdersHistory (
+		Id						TEXT UNIQUE NOT NULL,
+		CreatedTS				INTEGER NOT NULL,
+		Type					INTEGER NOT NULL,
+		AuthorID				TEXT NOT NULL,
+		Price					TEXT NOT NULL,
+		Netflags				INTEGER NOT NULL,
+		BlockNumber				INTEGER NOT NULL`, `INTEGER DEFAULT 0`),
+			createTableDealsHistory: makeTableWithBenchmarks(`
+	CREATE TABLE IF NOT EXISTS DealsHistory (
+		Id						TEXT UNIQUE NOT NULL,
+		SupplierID				TEXT NOT NULL,
+		ConsumerID				TEXT NOT NULL,
+		StartTime				INTEGER NOT NULL,
+		EndTime					INTEGER NOT NULL,
+		Duration				INTEGER NOT NULL,
+		Status					INTEGER NOT NULL,
+		Netflags				INTEGER NOT NULL,
+		BlockNumber				INTEGER NOT NULL`, `INTEGER DEFAULT 0`),
+			createTablePaymentsHistory: `
+	CREATE TABLE IF NOT EXISTS PaymentsHistory (
+		BillTS						INTEGER NOT NULL,
+		PaidAmount					TEXT NOT NULL,
+		DealID						TEXT NOT NULL,
+		BlockNumber					INTEGER NOT NULL,
+		UNIQUE						(BillTS, PaidAmount, DealID)
+	)`,
+			createTableBlocks: `
+	CREATE TABLE IF NOT EXISTS Blocks (
+		Number						INTEGER UNIQUE NOT NULL,
+		Hash						TEXT NOT NULL
+	)`,
+			createTableEntitySnapshots: `
+	CREATE TABLE IF NOT EXISTS EntitySnapshots (
+		BlockNumber					INTEGER NOT NULL,
+		Entity						TEXT NOT NULL,
+		Id							TEXT NOT NULL,
+		Snapshot					BLOB NOT NULL,
+		UNIQUE						(BlockNumber, Entity, Id)
+	)`,
+			createTableProcessedEvents: `
+	CREATE TABLE IF NOT EXISTS ProcessedEvents (
+		BlockNumber					INTEGER NOT NULL,
+		LogIndex					INTEGER NOT NULL,
+		UNIQUE						(BlockNumber, LogIndex)
+	)`,
+			createTableFailedEvents: `
+	CREATE TABLE IF NOT EXISTS FailedEvents (
+		Id							INTEGER PRIMARY KEY AUTOINCREMENT,
+		EntityKey					TEXT NOT NULL,
+		BlockNumber					INTEGER NOT NULL,
+		BlockHash					TEXT NOT NULL,
+		LogIndex					INTEGER NOT NULL,
+		TS							INTEGER NOT NULL,
+		Type						TEXT NOT NULL,
+		Data						TEXT NOT NULL,
+		Attempts					INTEGER NOT NULL,
+		LastError					TEXT NOT NULL,
+		NextRetryTS					INTEGER NOT NULL
 	)`,
 			createIndexCmd: `CREATE INDEX IF NOT EXISTS %s_%s ON %s (%s)`,
 			tablesInfo:     tInfo,
@@ -237,6 +326,8 @@ func (r *sqliteQueryRunner) Run(conn queryConn, opts *queryOpts) (*sql.Rows, uin
 		columns = strings.Join(r.tablesInfo.DealColumns, ", ")
 	case "Orders":
 		columns = strings.Join(r.tablesInfo.OrderColumns, ", ")
+	case "DealsHistory":
+		columns = strings.Join(r.tablesInfo.DealsHistoryColumns, ", ")
 	default:
 		columns = "*"
 	}
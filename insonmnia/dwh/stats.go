@@ -0,0 +1,295 @@
+package dwh
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	pb "github.com/sonm-io/core/proto"
+)
+
+const (
+	// maxMarketStatsBuckets limits the number of buckets that can be requested at once.
+	maxMarketStatsBuckets = 10000
+	secondsInHour         = 3600
+	secondsInDay          = 86400
+)
+
+// statsQuery renders WHERE clauses for market statistics queries, which unlike other queries
+// must not be paginated.
+type statsQuery struct {
+	formatCb   formatArg
+	conditions []string
+	values     []interface{}
+}
+
+func (q *statsQuery) add(field, cmpOperator string, value interface{}) {
+	q.conditions = append(q.conditions, fmt.Sprintf("%s %s %s", field, cmpOperator, q.placeholder()))
+	q.values = append(q.values, value)
+}
+
+func (q *statsQuery) addFilters(filters []*filter) {
+	for _, filter := range filters {
+		q.add(filter.Field, filter.CmpOperator, filter.Value)
+	}
+}
+
+func (q *statsQuery) placeholder() string {
+	return q.formatCb(uint64(len(q.values)), true)
+}
+
+func (q *statsQuery) where() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(q.conditions, " AND ")
+}
+
+type marketStatsBucket struct {
+	prices    []*big.Int
+	orders    uint64
+	opened    uint64
+	closed    uint64
+	payout    *big.Int
+	suppliers map[string]struct{}
+}
+
+// marketStatsRange returns the start of the first bucket aligned to the
+// requested granularity, the bucket width in seconds and the number of
+// buckets required to cover the requested period.
+func marketStatsRange(request *pb.MarketStatsRequest) (from, step, numBuckets int64) {
+	step = secondsInHour
+	if request.Granularity == pb.MarketStatsRequest_DAY {
+		step = secondsInDay
+	}
+
+	from = request.From.GetSeconds()
+	from -= from % step
+	numBuckets = (request.To.GetSeconds() - from + step - 1) / step
+
+	return from, step, numBuckets
+}
+
+// GetMarketStats aggregates history tables into time buckets of the requested granularity.
+//
+// Percentiles are calculated using the nearest-rank method.
+func (c *sqlStorage) GetMarketStats(conn queryConn, request *pb.MarketStatsRequest) ([]*pb.MarketStatsBucket, error) {
+	if request.PricePerBenchmark && request.PriceBenchmarkID >= c.numBenchmarks {
+		return nil, fmt.Errorf("benchmark %d is not supported", request.PriceBenchmarkID)
+	}
+
+	from, step, numBuckets := marketStatsRange(request)
+	to := request.To.GetSeconds()
+	if numBuckets > maxMarketStatsBuckets {
+		return nil, fmt.Errorf("too many buckets requested: %d, max %d", numBuckets, maxMarketStatsBuckets)
+	}
+
+	buckets := make([]*marketStatsBucket, numBuckets)
+	for idx := range buckets {
+		buckets[idx] = &marketStatsBucket{payout: big.NewInt(0), suppliers: map[string]struct{}{}}
+	}
+	bucketIdx := func(ts int64) int64 {
+		return (ts - from) / step
+	}
+
+	var filters []*filter
+	if request.Netflags != nil && request.Netflags.Value > 0 {
+		filters = append(filters, newNetflagsFilter(request.Netflags.Operator, request.Netflags.Value))
+	}
+	c.addBenchmarksConditions(request.Benchmarks, &filters)
+
+	if err := c.collectOrdersStats(conn, request, filters, from, to, buckets, bucketIdx); err != nil {
+		return nil, errors.Wrap(err, "failed to collect orders stats")
+	}
+	if err := c.collectDealsStats(conn, filters, from, to, buckets, bucketIdx); err != nil {
+		return nil, errors.Wrap(err, "failed to collect deals stats")
+	}
+	if err := c.collectPaymentsStats(conn, filters, from, to, buckets, bucketIdx); err != nil {
+		return nil, errors.Wrap(err, "failed to collect payments stats")
+	}
+
+	var out []*pb.MarketStatsBucket
+	for idx, bucket := range buckets {
+		sort.Slice(bucket.prices, func(i, j int) bool {
+			return bucket.prices[i].Cmp(bucket.prices[j]) < 0
+		})
+
+		var percentilePrices []*pb.BigInt
+		for _, percentile := range request.Percentiles {
+			percentilePrices = append(percentilePrices, pb.NewBigInt(nearestRank(bucket.prices, percentile)))
+		}
+
+		out = append(out, &pb.MarketStatsBucket{
+			StartTime:        &pb.Timestamp{Seconds: from + int64(idx)*step},
+			Orders:           bucket.orders,
+			MedianPrice:      pb.NewBigInt(nearestRank(bucket.prices, 50)),
+			PercentilePrices: percentilePrices,
+			OpenedDeals:      bucket.opened,
+			ClosedDeals:      bucket.closed,
+			TotalPayout:      pb.NewBigInt(bucket.payout),
+			ActiveSuppliers:  uint64(len(bucket.suppliers)),
+		})
+	}
+
+	return out, nil
+}
+
+func (c *sqlStorage) collectOrdersStats(conn queryConn, request *pb.MarketStatsRequest, filters []*filter,
+	from, to int64, buckets []*marketStatsBucket, bucketIdx func(int64) int64) error {
+	query := &statsQuery{formatCb: c.formatCb}
+	query.add("CreatedTS", gte, from)
+	query.add("CreatedTS", "<", to)
+	if request.OrderType != pb.OrderType_ANY {
+		query.add("Type", eq, uint64(request.OrderType))
+	}
+	query.addFilters(filters)
+
+	columns := "CreatedTS, Price"
+	if request.PricePerBenchmark {
+		columns += ", " + getBenchmarkColumn(request.PriceBenchmarkID)
+	}
+
+	rows, err := conn.Query(fmt.Sprintf("SELECT %s FROM OrdersHistory%s", columns, query.where()), query.values...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			createdTS int64
+			rawPrice  string
+			benchmark uint64
+		)
+		dest := []interface{}{&createdTS, &rawPrice}
+		if request.PricePerBenchmark {
+			dest = append(dest, &benchmark)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return errors.Wrap(err, "failed to scan order")
+		}
+
+		price, ok := new(big.Int).SetString(rawPrice, 10)
+		if !ok {
+			return fmt.Errorf("failed to parse order price: %s", rawPrice)
+		}
+
+		bucket := buckets[bucketIdx(createdTS)]
+		bucket.orders++
+		if request.PricePerBenchmark {
+			if benchmark == 0 {
+				continue
+			}
+			price.Div(price, new(big.Int).SetUint64(benchmark))
+		}
+		bucket.prices = append(bucket.prices, price)
+	}
+
+	return rows.Err()
+}
+
+func (c *sqlStorage) collectDealsStats(conn queryConn, filters []*filter, from, to int64,
+	buckets []*marketStatsBucket, bucketIdx func(int64) int64) error {
+	query := &statsQuery{formatCb: c.formatCb}
+	query.add("StartTime", "<", to)
+	query.addFilters(filters)
+	query.conditions = append(query.conditions, fmt.Sprintf("(Status <> %s OR EndTime >= %s)",
+		query.formatCb(uint64(len(query.values)), true), query.formatCb(uint64(len(query.values)+1), true)))
+	query.values = append(query.values, uint64(pb.DealStatus_DEAL_CLOSED), from)
+
+	rows, err := conn.Query(fmt.Sprintf("SELECT SupplierID, StartTime, EndTime, Status FROM DealsHistory%s",
+		query.where()), query.values...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			supplierID         string
+			startTime, endTime int64
+			status             uint64
+		)
+		if err := rows.Scan(&supplierID, &startTime, &endTime, &status); err != nil {
+			return errors.Wrap(err, "failed to scan deal")
+		}
+
+		closed := status == uint64(pb.DealStatus_DEAL_CLOSED)
+		if startTime >= from {
+			buckets[bucketIdx(startTime)].opened++
+		}
+		if closed && endTime < to {
+			buckets[bucketIdx(endTime)].closed++
+		}
+
+		first, last := int64(0), int64(len(buckets)-1)
+		if startTime >= from {
+			first = bucketIdx(startTime)
+		}
+		if closed && endTime < to {
+			last = bucketIdx(endTime)
+		}
+		for idx := first; idx <= last; idx++ {
+			buckets[idx].suppliers[supplierID] = struct{}{}
+		}
+	}
+
+	return rows.Err()
+}
+
+func (c *sqlStorage) collectPaymentsStats(conn queryConn, filters []*filter, from, to int64,
+	buckets []*marketStatsBucket, bucketIdx func(int64) int64) error {
+	query := &statsQuery{formatCb: c.formatCb}
+	query.add("BillTS", gte, from)
+	query.add("BillTS", "<", to)
+	if len(filters) > 0 {
+		dealsQuery := &statsQuery{formatCb: c.formatCb, values: query.values}
+		dealsQuery.addFilters(filters)
+		query.conditions = append(query.conditions,
+			fmt.Sprintf("DealID IN (SELECT Id FROM DealsHistory%s)", dealsQuery.where()))
+		query.values = dealsQuery.values
+	}
+
+	rows, err := conn.Query(fmt.Sprintf("SELECT BillTS, PaidAmount FROM PaymentsHistory%s", query.where()),
+		query.values...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			billTS    int64
+			rawAmount string
+		)
+		if err := rows.Scan(&billTS, &rawAmount); err != nil {
+			return errors.Wrap(err, "failed to scan payment")
+		}
+
+		amount, ok := new(big.Int).SetString(rawAmount, 10)
+		if !ok {
+			return fmt.Errorf("failed to parse payment amount: %s", rawAmount)
+		}
+
+		bucket := buckets[bucketIdx(billTS)]
+		bucket.payout.Add(bucket.payout, amount)
+	}
+
+	return rows.Err()
+}
+
+// nearestRank returns the given percentile of sorted values, or zero if there are no values.
+func nearestRank(sorted []*big.Int, percentile uint32) *big.Int {
+	if len(sorted) == 0 {
+		return big.NewInt(0)
+	}
+
+	rank := (int(percentile)*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
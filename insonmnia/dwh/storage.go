@@ -60,6 +60,33 @@ type storage interface {
 	StoreStaleID(conn queryConn, id *big.Int, entity string) error
 	RemoveStaleID(conn queryConn, id *big.Int, entity string) error
 	CheckStaleID(conn queryConn, id *big.Int, entity string) (bool, error)
+	InsertOrderHistory(conn queryConn, order *pb.DWHOrder, blockNumber uint64) error
+	InsertDealHistory(conn queryConn, deal *pb.Deal, blockNumber uint64) error
+	InsertPaymentHistory(conn queryConn, payment *pb.DealPayment, blockNumber uint64) error
+	CloseDealHistory(conn queryConn, dealID *big.Int, endTime, duration uint64) error
+	RevertDealHistory(conn queryConn, deal *pb.Deal) error
+	DeleteHistory(conn queryConn, afterBlock uint64) error
+	GetMarketStats(conn queryConn, request *pb.MarketStatsRequest) ([]*pb.MarketStatsBucket, error)
+	GetDealsHistory(conn queryConn, request *pb.DealsHistoryRequest) ([]*pb.Deal, error)
+	GetDealPayments(conn queryConn, dealID *big.Int) ([]*pb.DealPayment, error)
+	InsertBlockHash(conn queryConn, blockNumber uint64, hash common.Hash) error
+	GetBlockHashes(conn queryConn) ([]*blockHash, error)
+	DeleteBlockHashes(conn queryConn, afterBlock uint64) error
+	InsertEntitySnapshot(conn queryConn, blockNumber uint64, entity string, id *big.Int, snapshot []byte) error
+	GetEntitySnapshots(conn queryConn, afterBlock uint64) ([]*entitySnapshot, error)
+	DeleteEntitySnapshots(conn queryConn, afterBlock uint64) error
+	PruneReorgData(conn queryConn, beforeBlock uint64) error
+	InsertProcessedEvent(conn queryConn, blockNumber uint64, logIndex uint) error
+	CheckProcessedEvent(conn queryConn, blockNumber uint64, logIndex uint) (bool, error)
+	DeleteProcessedEvents(conn queryConn, afterBlock uint64) error
+	InsertFailedEvent(conn queryConn, event *failedEvent) error
+	GetFailedEvents(conn queryConn, request *pb.FailedEventsRequest) ([]*failedEvent, uint64, error)
+	GetDueFailedEvents(conn queryConn, now int64) ([]*failedEvent, error)
+	CheckFailedEvents(conn queryConn, entityKey string) (bool, error)
+	UpdateFailedEvent(conn queryConn, event *failedEvent) error
+	ScheduleFailedEvents(conn queryConn, ids []uint64, nextRetryTS int64) error
+	DeleteFailedEvent(conn queryConn, id uint64) error
+	DeleteFailedEvents(conn queryConn, afterBlock uint64) error
 }
 
 type queryConn interface {
@@ -105,6 +132,24 @@ func newTxConn(db *sql.DB, logger *zap.Logger) (queryConn, error) {
 	return &txConn{tx: tx, logger: logger}, nil
 }
 
+// inTransaction runs the given function within a transaction, which is
+// committed only if the function succeeds.
+func inTransaction(db *sql.DB, logger *zap.Logger, fn func(conn queryConn) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	conn := &txConn{tx: tx, logger: logger}
+	if err := fn(conn); err != nil {
+		conn.hasErrors = true
+		conn.Finish()
+		return err
+	}
+
+	return conn.Finish()
+}
+
 func (t *txConn) Exec(query string, args ...interface{}) (sql.Result, error) {
 	result, err := t.tx.Exec(query, args...)
 	if err != nil {
@@ -0,0 +1,393 @@
+package dwh
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
+	pb "github.com/sonm-io/core/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const subscriptionBufferSize = 128
+
+type orderSubscriber struct {
+	filter *pb.OrdersRequest
+	ch     chan *pb.DWHOrderEvent
+}
+
+type dealSubscriber struct {
+	filter *pb.DealsRequest
+	ch     chan *pb.DWHDealEvent
+}
+
+// subscriptions fans committed order and deal changes out to subscribers.
+//
+// Subscribers that are unable to keep up with events are dropped by closing
+// their channels.
+type subscriptions struct {
+	mu     sync.Mutex
+	orders map[*orderSubscriber]struct{}
+	deals  map[*dealSubscriber]struct{}
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{
+		orders: map[*orderSubscriber]struct{}{},
+		deals:  map[*dealSubscriber]struct{}{},
+	}
+}
+
+// SubscribeOrders returns a channel of order events matching the given filter
+// and a function that must be called to unsubscribe.
+func (s *subscriptions) SubscribeOrders(filter *pb.OrdersRequest) (<-chan *pb.DWHOrderEvent, func()) {
+	subscriber := &orderSubscriber{
+		filter: filter,
+		ch:     make(chan *pb.DWHOrderEvent, subscriptionBufferSize),
+	}
+
+	s.mu.Lock()
+	s.orders[subscriber] = struct{}{}
+	s.mu.Unlock()
+
+	return subscriber.ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.orders[subscriber]; ok {
+			delete(s.orders, subscriber)
+			close(subscriber.ch)
+		}
+	}
+}
+
+// SubscribeDeals returns a channel of deal events matching the given filter
+// and a function that must be called to unsubscribe.
+func (s *subscriptions) SubscribeDeals(filter *pb.DealsRequest) (<-chan *pb.DWHDealEvent, func()) {
+	subscriber := &dealSubscriber{
+		filter: filter,
+		ch:     make(chan *pb.DWHDealEvent, subscriptionBufferSize),
+	}
+
+	s.mu.Lock()
+	s.deals[subscriber] = struct{}{}
+	s.mu.Unlock()
+
+	return subscriber.ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.deals[subscriber]; ok {
+			delete(s.deals, subscriber)
+			close(subscriber.ch)
+		}
+	}
+}
+
+func (s *subscriptions) hasOrderSubscribers() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.orders) > 0
+}
+
+func (s *subscriptions) hasDealSubscribers() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.deals) > 0
+}
+
+func (s *subscriptions) PublishOrder(event *pb.DWHOrderEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for subscriber := range s.orders {
+		if event.Type != pb.DWHEventType_DELETED && !orderMatches(subscriber.filter, event.Order) {
+			continue
+		}
+
+		select {
+		case subscriber.ch <- event:
+		default:
+			delete(s.orders, subscriber)
+			close(subscriber.ch)
+		}
+	}
+}
+
+func (s *subscriptions) PublishDeal(event *pb.DWHDealEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for subscriber := range s.deals {
+		if event.Type != pb.DWHEventType_DELETED && !dealMatches(subscriber.filter, event.Deal) {
+			continue
+		}
+
+		select {
+		case subscriber.ch <- event:
+		default:
+			delete(s.deals, subscriber)
+			close(subscriber.ch)
+		}
+	}
+}
+
+// orderMatches mirrors filters applied by GetOrders, except that order status
+// is matched only if it is specified, so that subscribers are notified when
+// orders become inactive.
+func orderMatches(request *pb.OrdersRequest, dwhOrder *pb.DWHOrder) bool {
+	order := dwhOrder.GetOrder()
+
+	if request.Status > 0 && request.Status != order.GetOrderStatus() {
+		return false
+	}
+	if !request.DealID.IsZero() && request.DealID.Cmp(order.GetDealID()) != 0 {
+		return false
+	}
+	if request.Type > 0 && request.Type != order.GetOrderType() {
+		return false
+	}
+	if !request.AuthorID.IsZero() && request.AuthorID.Unwrap() != order.GetAuthorID().Unwrap() {
+		return false
+	}
+	if !request.CounterpartyID.IsZero() && request.CounterpartyID.Unwrap() != order.GetCounterpartyID().Unwrap() {
+		return false
+	}
+	if !durationMatches(request.Duration, order.GetDuration()) {
+		return false
+	}
+	if !priceMatches(request.Price, order.GetPrice()) {
+		return false
+	}
+	if !netflagsMatch(request.Netflags, order.GetNetflags()) {
+		return false
+	}
+	if request.CreatorIdentityLevel > 0 && uint64(request.CreatorIdentityLevel) > dwhOrder.GetCreatorIdentityLevel() {
+		return false
+	}
+	if createdTS := request.CreatedTS; createdTS != nil {
+		seconds := dwhOrder.GetCreatedTS().GetSeconds()
+		if createdTS.Max != nil && createdTS.Max.Seconds > 0 && seconds > createdTS.Max.Seconds {
+			return false
+		}
+		if createdTS.Min != nil && createdTS.Min.Seconds > 0 && seconds < createdTS.Min.Seconds {
+			return false
+		}
+	}
+
+	return benchmarksMatch(request.Benchmarks, order.GetBenchmarks())
+}
+
+// dealMatches mirrors filters applied by GetDeals.
+func dealMatches(request *pb.DealsRequest, dwhDeal *pb.DWHDeal) bool {
+	deal := dwhDeal.GetDeal()
+
+	if request.Status > 0 && request.Status != deal.GetStatus() {
+		return false
+	}
+	if !request.SupplierID.IsZero() && request.SupplierID.Unwrap() != deal.GetSupplierID().Unwrap() {
+		return false
+	}
+	if !request.ConsumerID.IsZero() && request.ConsumerID.Unwrap() != deal.GetConsumerID().Unwrap() {
+		return false
+	}
+	if !request.MasterID.IsZero() && request.MasterID.Unwrap() != deal.GetMasterID().Unwrap() {
+		return false
+	}
+	if !request.AskID.IsZero() && request.AskID.Cmp(deal.GetAskID()) != 0 {
+		return false
+	}
+	if !request.BidID.IsZero() && request.BidID.Cmp(deal.GetBidID()) != 0 {
+		return false
+	}
+	if !durationMatches(request.Duration, deal.GetDuration()) {
+		return false
+	}
+	if !priceMatches(request.Price, deal.GetPrice()) {
+		return false
+	}
+	if !netflagsMatch(request.Netflags, dwhDeal.GetNetflags()) {
+		return false
+	}
+	if request.AskIdentityLevel > 0 && uint64(request.AskIdentityLevel) > dwhDeal.GetAskIdentityLevel() {
+		return false
+	}
+	if request.BidIdentityLevel > 0 && uint64(request.BidIdentityLevel) > dwhDeal.GetBidIdentityLevel() {
+		return false
+	}
+
+	return benchmarksMatch(request.Benchmarks, deal.GetBenchmarks())
+}
+
+func durationMatches(condition *pb.MaxMinUint64, value uint64) bool {
+	if condition == nil {
+		return true
+	}
+
+	return (condition.Max == 0 || value <= condition.Max) && value >= condition.Min
+}
+
+func priceMatches(condition *pb.MaxMinBig, value *pb.BigInt) bool {
+	if condition == nil {
+		return true
+	}
+	if condition.Max != nil && value.Cmp(condition.Max) > 0 {
+		return false
+	}
+	if condition.Min != nil && value.Cmp(condition.Min) < 0 {
+		return false
+	}
+
+	return true
+}
+
+// netflagsMatch mirrors newNetflagsFilter.
+func netflagsMatch(condition *pb.CmpUint64, value uint64) bool {
+	if condition == nil || condition.Value == 0 {
+		return true
+	}
+
+	switch condition.Operator {
+	case pb.CmpOp_GTE:
+		return value&condition.Value == condition.Value
+	case pb.CmpOp_LTE:
+		return value&^condition.Value == 0
+	default:
+		return value == condition.Value
+	}
+}
+
+// benchmarksMatch mirrors addBenchmarksConditions.
+func benchmarksMatch(conditions map[uint64]*pb.MaxMinUint64, benchmarks *pb.Benchmarks) bool {
+	values := benchmarks.GetValues()
+	for benchID, condition := range conditions {
+		var value uint64
+		if benchID < uint64(len(values)) {
+			value = values[benchID]
+		}
+
+		if condition.Max > 0 && value > condition.Max {
+			return false
+		}
+		if condition.Min > 0 && value < condition.Min {
+			return false
+		}
+	}
+
+	return true
+}
+
+// notifySubscribers publishes the committed state of entities affected by the
+// event.
+func (w *DWH) notifySubscribers(event *blockchain.Event) {
+	switch value := event.Data.(type) {
+	case *blockchain.OrderPlacedData:
+		w.notifyOrderSubscribers(value.ID, pb.DWHEventType_CREATED)
+	case *blockchain.OrderUpdatedData:
+		w.notifyOrderSubscribers(value.ID, pb.DWHEventType_UPDATED)
+	case *blockchain.DealOpenedData:
+		w.notifyDealSubscribers(value.ID, pb.DWHEventType_CREATED)
+	case *blockchain.DealUpdatedData:
+		w.notifyDealSubscribers(value.ID, pb.DWHEventType_UPDATED)
+	case *blockchain.BilledData:
+		w.notifyDealSubscribers(value.DealID, pb.DWHEventType_UPDATED)
+	case *blockchain.DealChangeRequestUpdatedData:
+		changeRequest, err := w.blockchain.Market().GetDealChangeRequestInfo(w.ctx, value.ID)
+		if err != nil {
+			w.logger.Warn("failed to get deal change request to notify subscribers", zap.Error(err))
+			return
+		}
+		w.notifyDealSubscribers(changeRequest.DealID.Unwrap(), pb.DWHEventType_UPDATED)
+	}
+}
+
+func (w *DWH) notifyOrderSubscribers(orderID *big.Int, eventType pb.DWHEventType) {
+	if !w.subscriptions.hasOrderSubscribers() {
+		return
+	}
+
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	order, err := w.storage.GetOrderByID(conn, orderID)
+	if err != nil {
+		// Orders without deals are removed once they are updated.
+		if eventType == pb.DWHEventType_CREATED {
+			return
+		}
+		order = &pb.DWHOrder{Order: &pb.Order{Id: pb.NewBigInt(orderID)}}
+		eventType = pb.DWHEventType_DELETED
+	}
+
+	w.subscriptions.PublishOrder(&pb.DWHOrderEvent{Type: eventType, Order: order})
+}
+
+func (w *DWH) notifyDealSubscribers(dealID *big.Int, eventType pb.DWHEventType) {
+	if !w.subscriptions.hasDealSubscribers() {
+		return
+	}
+
+	conn := newSimpleConn(w.db)
+	defer conn.Finish()
+
+	deal, err := w.storage.GetDealByID(conn, dealID)
+	if err != nil {
+		// Closed deals are removed.
+		if eventType == pb.DWHEventType_CREATED {
+			return
+		}
+		deal = &pb.DWHDeal{Deal: &pb.Deal{Id: pb.NewBigInt(dealID)}}
+		eventType = pb.DWHEventType_DELETED
+	}
+
+	w.subscriptions.PublishDeal(&pb.DWHDealEvent{Type: eventType, Deal: deal})
+}
+
+func (w *DWH) SubscribeOrders(request *pb.OrdersRequest, stream pb.DWH_SubscribeOrdersServer) error {
+	events, unsubscribe := w.subscriptions.SubscribeOrders(request)
+	defer unsubscribe()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "order events are consumed too slowly")
+			}
+
+			if err := stream.Send(event); err != nil {
+				return errors.Wrap(err, "failed to send order event")
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-w.ctx.Done():
+			return status.Error(codes.Unavailable, "DWH is shutting down")
+		}
+	}
+}
+
+func (w *DWH) SubscribeDeals(request *pb.DealsRequest, stream pb.DWH_SubscribeDealsServer) error {
+	events, unsubscribe := w.subscriptions.SubscribeDeals(request)
+	defer unsubscribe()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "deal events are consumed too slowly")
+			}
+
+			if err := stream.Send(event); err != nil {
+				return errors.Wrap(err, "failed to send deal event")
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-w.ctx.Done():
+			return status.Error(codes.Unavailable, "DWH is shutting down")
+		}
+	}
+}
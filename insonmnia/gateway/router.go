@@ -2,6 +2,10 @@
 
 package gateway
 
+import (
+	"context"
+)
+
 type Route struct {
 	ID          string
 	Protocol    string
@@ -36,6 +40,15 @@ type Router interface {
 	Close() error
 }
 
+// NewRouter constructs a new router that forwards traffic through IPVS
+// virtual services, which ports are allocated from the given pool.
+//
+// On platforms without IPVS support the returned router routes traffic
+// directly to the real services.
+func NewRouter(ctx context.Context, gate *Gateway, pool *PortPool) Router {
+	return newIPVSRouter(ctx, gate, pool)
+}
+
 type directRouter struct {
 }
 
@@ -35,10 +35,12 @@ func (r *ipvsRouter) Register(ID string, protocol string) (VirtualService, error
 
 	serviceOptions, err := NewServiceOptions(host.String(), port, protocol)
 	if err != nil {
+		r.pool.Retain(ID)
 		return nil, err
 	}
 
 	if err := r.gateway.CreateService(ID, serviceOptions); err != nil {
+		r.pool.Retain(ID)
 		return nil, err
 	}
 
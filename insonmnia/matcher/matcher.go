@@ -11,7 +11,6 @@ import (
 	"github.com/sonm-io/core/blockchain"
 	"github.com/sonm-io/core/insonmnia/dwh"
 	"github.com/sonm-io/core/proto"
-	pb "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
 	"github.com/sonm-io/core/util/multierror"
 	"go.uber.org/zap"
@@ -24,8 +23,9 @@ type Matcher interface {
 // YAMLConfig is embeddable config that can be integrated with
 // another component's config.
 type YAMLConfig struct {
-	PollDelay  time.Duration `yaml:"poll_delay" default:"30s"`
-	QueryLimit uint64        `yaml:"query_limit" default:"50"`
+	PollDelay  time.Duration  `yaml:"poll_delay" default:"30s"`
+	QueryLimit uint64         `yaml:"query_limit" default:"50"`
+	Strategy   StrategyConfig `yaml:"strategy"`
 }
 
 type Config struct {
@@ -34,6 +34,9 @@ type Config struct {
 	DWH        sonm.DWHClient
 	Eth        blockchain.API
 	QueryLimit uint64
+	// Strategy decides which of the matching orders to try first. Orders
+	// are tried in DWH order if it is not set.
+	Strategy MatchingStrategy
 }
 
 func (c *Config) validate() error {
@@ -42,6 +45,9 @@ func (c *Config) validate() error {
 	if c.QueryLimit == 0 {
 		c.QueryLimit = dwh.MaxLimit
 	}
+	if c.Strategy == nil {
+		c.Strategy = &dwhStrategy{}
+	}
 
 	if c.Key == nil {
 		err = multierror.Append(err, errors.New("private key is required"))
@@ -75,55 +81,143 @@ func (m *matcher) CreateDealByOrder(ctx context.Context, order *sonm.Order) (*so
 	id := order.GetId().Unwrap()
 	ctxlog.G(ctx).Debug("starting matcher", zap.String("orderID", id.String()))
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	tk := util.NewImmediateTicker(m.cfg.PollDelay)
 	defer tk.Stop()
 
+	placedOrders := m.watchPlacedOrders(ctx, order)
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		case <-tk.C:
-			if err := m.checkIfOrderExists(ctx, id); err != nil {
+		case <-placedOrders:
+		}
+
+		if err := m.checkIfOrderExists(ctx, id); err != nil {
+			return nil, err
+		}
+
+		matchingOrders, err := m.getMatchingOrders(ctx, order)
+		if err != nil {
+			// dwh failure is not critical, we must survive it
+			ctxlog.S(ctx).Debugf("failed to get matching orders from DWH: %s", err)
+			continue
+
+		}
+
+		if len(matchingOrders) == 0 {
+			continue
+		}
+
+		// 3. iterate over sorted orders
+		for _, dealWithMe := range matchingOrders {
+			bid, ask, err := m.reorderOrders(order, dealWithMe)
+			if err != nil {
 				return nil, err
 			}
 
-			matchingOrders, err := m.getMatchingOrders(ctx, id)
-			if err != nil {
-				// dwh failure is not critical, we must survive it
-				ctxlog.S(ctx).Debugf("failed to get matching orders from DWH: %s", err)
-				continue
+			// 4. try to open deal
+			deal, err := m.openDeal(ctx, bid, ask)
+			if err == nil {
+				ctxlog.G(ctx).Debug("deal is opened",
+					zap.String("bid", bid.GetId().Unwrap().String()),
+					zap.String("ask", ask.GetId().Unwrap().String()),
+					zap.String("deal", deal.GetId().Unwrap().String()))
+				return deal, nil
+			}
 
+			// 5. if deal is not created - wait for timeout and goto 1
+			ctxlog.G(ctx).Warn("cannot open deal",
+				zap.Error(err),
+				zap.String("bid", bid.GetId().Unwrap().String()),
+				zap.String("ask", ask.GetId().Unwrap().String()))
+		}
+	}
+}
+
+// watchPlacedOrders subscribes to orders that may match the given one,
+// returning a channel that fires when some of them are placed, so that
+// matching can be retried without waiting for the next poll.
+//
+// Matching falls back to polling only if DWH subscriptions are unavailable.
+func (m *matcher) watchPlacedOrders(ctx context.Context, order *sonm.Order) <-chan struct{} {
+	placedOrders := make(chan struct{}, 1)
+
+	stream, err := m.cfg.DWH.SubscribeOrders(ctx, placedOrdersRequest(order))
+	if err != nil {
+		ctxlog.S(ctx).Debugf("failed to subscribe to DWH orders, falling back to polling: %s", err)
+		return placedOrders
+	}
+
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				ctxlog.S(ctx).Debugf("DWH orders subscription terminated, falling back to polling: %s", err)
+				return
 			}
 
-			if len(matchingOrders) == 0 {
+			if event.GetType() != sonm.DWHEventType_CREATED {
 				continue
 			}
 
-			// 3. iterate over sorted orders
-			for _, dealWithMe := range matchingOrders {
-				bid, ask, err := m.reorderOrders(order, dealWithMe)
-				if err != nil {
-					return nil, err
-				}
-
-				// 4. try to open deal
-				deal, err := m.openDeal(ctx, bid, ask)
-				if err == nil {
-					ctxlog.G(ctx).Debug("deal is opened",
-						zap.String("bid", bid.GetId().Unwrap().String()),
-						zap.String("ask", ask.GetId().Unwrap().String()),
-						zap.String("deal", deal.GetId().Unwrap().String()))
-					return deal, nil
-				}
-
-				// 5. if deal is not created - wait for timeout and goto 1
-				ctxlog.G(ctx).Warn("cannot open deal",
-					zap.Error(err),
-					zap.String("bid", bid.GetId().Unwrap().String()),
-					zap.String("ask", ask.GetId().Unwrap().String()))
+			select {
+			case placedOrders <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	return placedOrders
+}
+
+// placedOrdersRequest returns the subscription request for active orders of
+// the opposite type, which price, duration, netflags and benchmarks allow
+// them to match the given order. The DWH makes the final decision anyway,
+// the request just filters out orders that can never match.
+func placedOrdersRequest(order *sonm.Order) *sonm.OrdersRequest {
+	request := &sonm.OrdersRequest{
+		Status:     sonm.OrderStatus_ORDER_ACTIVE,
+		Benchmarks: map[uint64]*sonm.MaxMinUint64{},
+	}
+
+	if !order.GetCounterpartyID().IsZero() {
+		request.AuthorID = order.GetCounterpartyID()
+	}
+
+	if order.GetOrderType() == sonm.OrderType_BID {
+		request.Type = sonm.OrderType_ASK
+		request.Price = &sonm.MaxMinBig{Max: order.GetPrice()}
+		request.Netflags = &sonm.CmpUint64{Operator: sonm.CmpOp_GTE, Value: order.GetNetflags()}
+		if order.GetDuration() > 0 {
+			request.Duration = &sonm.MaxMinUint64{Min: order.GetDuration()}
+		}
+		for benchID, value := range order.GetBenchmarks().GetValues() {
+			if value > 0 {
+				request.Benchmarks[uint64(benchID)] = &sonm.MaxMinUint64{Min: value}
+			}
+		}
+	} else {
+		request.Type = sonm.OrderType_BID
+		request.Price = &sonm.MaxMinBig{Min: order.GetPrice()}
+		request.Netflags = &sonm.CmpUint64{Operator: sonm.CmpOp_LTE, Value: order.GetNetflags()}
+		if order.GetDuration() > 0 {
+			request.Duration = &sonm.MaxMinUint64{Max: order.GetDuration()}
+		}
+		// Zero values can't be expressed as an upper bound, so such
+		// benchmarks are left for the DWH to check.
+		for benchID, value := range order.GetBenchmarks().GetValues() {
+			if value > 0 {
+				request.Benchmarks[uint64(benchID)] = &sonm.MaxMinUint64{Max: value}
 			}
 		}
 	}
+
+	return request
 }
 
 func (m *matcher) checkIfOrderExists(ctx context.Context, id *big.Int) error {
@@ -139,9 +233,9 @@ func (m *matcher) checkIfOrderExists(ctx context.Context, id *big.Int) error {
 	return nil
 }
 
-func (m *matcher) getMatchingOrders(ctx context.Context, id *big.Int) ([]*sonm.Order, error) {
+func (m *matcher) getMatchingOrders(ctx context.Context, order *sonm.Order) ([]*sonm.Order, error) {
 	dwhReply, err := m.cfg.DWH.GetMatchingOrders(ctx, &sonm.MatchingOrdersRequest{
-		Id:    pb.NewBigInt(id),
+		Id:    order.GetId(),
 		Limit: m.cfg.QueryLimit,
 	})
 
@@ -149,8 +243,15 @@ func (m *matcher) getMatchingOrders(ctx context.Context, id *big.Int) ([]*sonm.O
 		return nil, err
 	}
 
-	orders := make([]*sonm.Order, len(dwhReply.GetOrders()))
-	for idx, ord := range dwhReply.GetOrders() {
+	candidates, err := m.cfg.Strategy.Sort(ctx, order, dwhReply.GetOrders())
+	if err != nil {
+		// an arbitrary matching order is still better than none
+		ctxlog.S(ctx).Debugf("failed to sort matching orders, keeping DWH order: %s", err)
+		candidates = dwhReply.GetOrders()
+	}
+
+	orders := make([]*sonm.Order, len(candidates))
+	for idx, ord := range candidates {
 		orders[idx] = ord.GetOrder()
 	}
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/mock/gomock"
 	"github.com/sonm-io/core/blockchain"
@@ -25,6 +26,8 @@ func mockDWH(ctrl *gomock.Controller, t sonm.OrderType) sonm.DWHClient {
 	dwh := sonm.NewMockDWHClient(ctrl)
 	dwh.EXPECT().GetMatchingOrders(gomock.Any(), gomock.Any()).AnyTimes().
 		Return(&sonm.DWHOrdersReply{Orders: orders}, nil)
+	dwh.EXPECT().SubscribeOrders(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(nil, fmt.Errorf("subscriptions are not supported"))
 	return dwh
 }
 
@@ -114,6 +117,100 @@ func TestMatcherFailedByTimeout(t *testing.T) {
 	assert.EqualError(t, err, "context deadline exceeded")
 }
 
+func TestMatcherWakesUpOnPlacedOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key, _ := crypto.GenerateKey()
+	eth, dealChan := mockEth(ctrl)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	target := &sonm.Order{
+		Id:        pb.NewBigIntFromInt(1),
+		OrderType: sonm.OrderType_BID,
+		Price:     sonm.NewBigIntFromInt(100),
+	}
+
+	events := make(chan *sonm.DWHOrderEvent)
+	stream := sonm.NewMockDWH_SubscribeOrdersClient(ctrl)
+	stream.EXPECT().Recv().AnyTimes().DoAndReturn(func() (*sonm.DWHOrderEvent, error) {
+		select {
+		case event := <-events:
+			return event, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	})
+
+	dwh := sonm.NewMockDWHClient(ctrl)
+	dwh.EXPECT().SubscribeOrders(gomock.Any(), placedOrdersRequest(target)).Return(stream, nil)
+	first := dwh.EXPECT().GetMatchingOrders(gomock.Any(), gomock.Any()).
+		Return(&sonm.DWHOrdersReply{}, nil)
+	dwh.EXPECT().GetMatchingOrders(gomock.Any(), gomock.Any()).After(first).
+		Return(&sonm.DWHOrdersReply{Orders: []*sonm.DWHOrder{
+			{Order: &sonm.Order{OrderType: sonm.OrderType_ASK, Id: pb.NewBigIntFromInt(111)}},
+		}}, nil)
+
+	m, err := NewMatcher(&Config{
+		Key:        key,
+		PollDelay:  time.Minute,
+		QueryLimit: 10,
+		DWH:        dwh,
+		Eth:        eth,
+	})
+	require.NoError(t, err)
+
+	go func() {
+		events <- &sonm.DWHOrderEvent{Type: sonm.DWHEventType_CREATED}
+		dealChan <- blockchain.DealOrError{Deal: &sonm.Deal{Id: pb.NewBigIntFromInt(123)}, Err: nil}
+	}()
+
+	deal, err := m.CreateDealByOrder(ctx, target)
+	require.NoError(t, err)
+	assert.Equal(t, "123", deal.GetId().Unwrap().String())
+}
+
+func TestPlacedOrdersRequestBid(t *testing.T) {
+	bid := &sonm.Order{
+		OrderType:      sonm.OrderType_BID,
+		CounterpartyID: sonm.NewEthAddress(common.HexToAddress("0x1")),
+		Price:          sonm.NewBigIntFromInt(100),
+		Duration:       3600,
+		Netflags:       3,
+		Benchmarks:     &sonm.Benchmarks{Values: []uint64{0, 20}},
+	}
+
+	request := placedOrdersRequest(bid)
+	assert.Equal(t, sonm.OrderType_ASK, request.Type)
+	assert.Equal(t, sonm.OrderStatus_ORDER_ACTIVE, request.Status)
+	assert.Equal(t, common.HexToAddress("0x1"), request.AuthorID.Unwrap())
+	assert.Equal(t, "100", request.Price.Max.Unwrap().String())
+	assert.Nil(t, request.Price.Min)
+	assert.Equal(t, &sonm.MaxMinUint64{Min: 3600}, request.Duration)
+	assert.Equal(t, &sonm.CmpUint64{Operator: sonm.CmpOp_GTE, Value: 3}, request.Netflags)
+	assert.Equal(t, map[uint64]*sonm.MaxMinUint64{1: {Min: 20}}, request.Benchmarks)
+}
+
+func TestPlacedOrdersRequestAsk(t *testing.T) {
+	ask := &sonm.Order{
+		OrderType:  sonm.OrderType_ASK,
+		Price:      sonm.NewBigIntFromInt(100),
+		Netflags:   1,
+		Benchmarks: &sonm.Benchmarks{Values: []uint64{10, 0}},
+	}
+
+	request := placedOrdersRequest(ask)
+	assert.Equal(t, sonm.OrderType_BID, request.Type)
+	assert.Nil(t, request.AuthorID)
+	assert.Equal(t, "100", request.Price.Min.Unwrap().String())
+	assert.Nil(t, request.Price.Max)
+	assert.Nil(t, request.Duration)
+	assert.Equal(t, &sonm.CmpUint64{Operator: sonm.CmpOp_LTE, Value: 1}, request.Netflags)
+	assert.Equal(t, map[uint64]*sonm.MaxMinUint64{0: {Max: 10}}, request.Benchmarks)
+}
+
 func TestMatcherConfigValidate(t *testing.T) {
 	_, err := NewMatcher(&Config{
 		PollDelay: 0,
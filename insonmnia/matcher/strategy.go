@@ -0,0 +1,278 @@
+package matcher
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sonm-io/core/proto"
+)
+
+const (
+	StrategyDWH         = "dwh"
+	StrategyCheapest    = "cheapest"
+	StrategyIdentity    = "identity"
+	StrategyPreferred   = "preferred"
+	StrategyReliability = "reliability"
+)
+
+// MatchingStrategy decides which of the matching orders the matcher tries
+// to open a deal with first.
+type MatchingStrategy interface {
+	// Sort orders candidates matching the given order from the most
+	// preferable to the least one.
+	Sort(ctx context.Context, order *sonm.Order, candidates []*sonm.DWHOrder) ([]*sonm.DWHOrder, error)
+}
+
+// StrategyConfig is embeddable config for selecting a matching strategy.
+type StrategyConfig struct {
+	// Type is one of "dwh", "cheapest", "identity", "preferred" and
+	// "reliability".
+	Type string `yaml:"type" default:"dwh"`
+	// PreferredCounterparties are tried first, in the given order, by the
+	// "preferred" strategy.
+	PreferredCounterparties []common.Address `yaml:"preferred_counterparties"`
+	// HistoryLimit is the number of past deals of each counterparty the
+	// "reliability" strategy examines.
+	HistoryLimit uint64 `yaml:"history_limit" default:"100"`
+	// HistoryCacheTTL is the time the "reliability" strategy keeps failure
+	// rates of counterparties for.
+	HistoryCacheTTL time.Duration `yaml:"history_cache_ttl" default:"10m"`
+}
+
+// NewStrategy constructs the matching strategy described by the config.
+func NewStrategy(cfg StrategyConfig, dwh sonm.DWHClient) (MatchingStrategy, error) {
+	switch cfg.Type {
+	case "", StrategyDWH:
+		return &dwhStrategy{}, nil
+	case StrategyCheapest:
+		return &cheapestStrategy{}, nil
+	case StrategyIdentity:
+		return &identityStrategy{}, nil
+	case StrategyPreferred:
+		if len(cfg.PreferredCounterparties) == 0 {
+			return nil, fmt.Errorf("preferred counterparties are required for \"%s\" strategy", StrategyPreferred)
+		}
+		return newPreferredStrategy(cfg.PreferredCounterparties), nil
+	case StrategyReliability:
+		if dwh == nil {
+			return nil, fmt.Errorf("DWH client is required for \"%s\" strategy", StrategyReliability)
+		}
+		return newReliabilityStrategy(dwh, cfg.HistoryLimit, cfg.HistoryCacheTTL), nil
+	default:
+		return nil, fmt.Errorf("unknown matching strategy \"%s\"", cfg.Type)
+	}
+}
+
+// dwhStrategy keeps candidates in the order DWH returns them.
+type dwhStrategy struct{}
+
+func (dwhStrategy) Sort(ctx context.Context, order *sonm.Order, candidates []*sonm.DWHOrder) ([]*sonm.DWHOrder, error) {
+	return candidates, nil
+}
+
+// cheapestStrategy prefers candidates with the lowest price per benchmark
+// unit, where a unit is the amount of each benchmark the order requires.
+//
+// Candidates of an order without benchmark requirements are compared by
+// price only.
+type cheapestStrategy struct{}
+
+func (cheapestStrategy) Sort(ctx context.Context, order *sonm.Order, candidates []*sonm.DWHOrder) ([]*sonm.DWHOrder, error) {
+	prices := make(map[*sonm.DWHOrder]*big.Float, len(candidates))
+	for _, candidate := range candidates {
+		prices[candidate] = pricePerUnit(order, candidate.GetOrder())
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return prices[candidates[i]].Cmp(prices[candidates[j]]) < 0
+	})
+
+	return candidates, nil
+}
+
+func pricePerUnit(order, candidate *sonm.Order) *big.Float {
+	price := new(big.Float).SetInt(candidate.GetPrice().Unwrap())
+
+	required := order.GetBenchmarks().GetValues()
+	provided := candidate.GetBenchmarks().GetValues()
+
+	units := new(big.Float)
+	for idx, value := range required {
+		if value == 0 || idx >= len(provided) {
+			continue
+		}
+
+		units.Add(units, new(big.Float).Quo(new(big.Float).SetUint64(provided[idx]), new(big.Float).SetUint64(value)))
+	}
+
+	if units.Sign() == 0 {
+		return price
+	}
+
+	return price.Quo(price, units)
+}
+
+// identityStrategy prefers candidates created by counterparties with the
+// highest identity level.
+type identityStrategy struct{}
+
+func (identityStrategy) Sort(ctx context.Context, order *sonm.Order, candidates []*sonm.DWHOrder) ([]*sonm.DWHOrder, error) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].GetCreatorIdentityLevel() > candidates[j].GetCreatorIdentityLevel()
+	})
+
+	return candidates, nil
+}
+
+// preferredStrategy tries candidates of the preferred counterparties first,
+// in the configured order, followed by the rest.
+type preferredStrategy struct {
+	ranks map[common.Address]int
+}
+
+func newPreferredStrategy(counterparties []common.Address) *preferredStrategy {
+	ranks := map[common.Address]int{}
+	for idx, addr := range counterparties {
+		if _, ok := ranks[addr]; !ok {
+			ranks[addr] = idx
+		}
+	}
+
+	return &preferredStrategy{ranks: ranks}
+}
+
+func (m *preferredStrategy) Sort(ctx context.Context, order *sonm.Order, candidates []*sonm.DWHOrder) ([]*sonm.DWHOrder, error) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return m.rank(candidates[i]) < m.rank(candidates[j])
+	})
+
+	return candidates, nil
+}
+
+func (m *preferredStrategy) rank(candidate *sonm.DWHOrder) int {
+	if rank, ok := m.ranks[candidate.GetOrder().GetAuthorID().Unwrap()]; ok {
+		return rank
+	}
+
+	return len(m.ranks)
+}
+
+// reliabilityStrategy prefers candidates created by counterparties with the
+// lowest rate of failed deals in DWH history.
+//
+// A closed forward deal is considered failed if it was closed before its
+// duration ended. Spot deals have no scheduled end, so they are not taken
+// into account, and counterparties without history have zero failure rate.
+//
+// Rates are cached, because the matcher sorts candidates on every poll.
+type reliabilityStrategy struct {
+	dwh          sonm.DWHClient
+	historyLimit uint64
+	cacheTTL     time.Duration
+
+	mu    sync.Mutex
+	rates map[reliabilityKey]*failureRate
+}
+
+// reliabilityKey distinguishes supplier and consumer histories of the same
+// counterparty.
+type reliabilityKey struct {
+	author    common.Address
+	orderType sonm.OrderType
+}
+
+type failureRate struct {
+	rate      float64
+	updatedAt time.Time
+}
+
+func newReliabilityStrategy(dwh sonm.DWHClient, historyLimit uint64, cacheTTL time.Duration) *reliabilityStrategy {
+	return &reliabilityStrategy{
+		dwh:          dwh,
+		historyLimit: historyLimit,
+		cacheTTL:     cacheTTL,
+		rates:        map[reliabilityKey]*failureRate{},
+	}
+}
+
+func (m *reliabilityStrategy) Sort(ctx context.Context, order *sonm.Order, candidates []*sonm.DWHOrder) ([]*sonm.DWHOrder, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for key, rate := range m.rates {
+		if now.Sub(rate.updatedAt) >= m.cacheTTL {
+			delete(m.rates, key)
+		}
+	}
+
+	rates := map[common.Address]float64{}
+	for _, candidate := range candidates {
+		author := candidate.GetOrder().GetAuthorID().Unwrap()
+		if _, ok := rates[author]; ok {
+			continue
+		}
+
+		key := reliabilityKey{author: author, orderType: candidate.GetOrder().GetOrderType()}
+		cached, ok := m.rates[key]
+		if !ok {
+			rate, err := m.failureRate(ctx, author, key.orderType)
+			if err != nil {
+				return nil, err
+			}
+
+			cached = &failureRate{rate: rate, updatedAt: now}
+			m.rates[key] = cached
+		}
+
+		rates[author] = cached.rate
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return rates[candidates[i].GetOrder().GetAuthorID().Unwrap()] < rates[candidates[j].GetOrder().GetAuthorID().Unwrap()]
+	})
+
+	return candidates, nil
+}
+
+// failureRate examines the history, because DWH removes closed deals from
+// the list of current ones.
+func (m *reliabilityStrategy) failureRate(ctx context.Context, author common.Address, orderType sonm.OrderType) (float64, error) {
+	request := &sonm.DealsHistoryRequest{
+		Status: sonm.DealStatus_DEAL_CLOSED,
+		Limit:  m.historyLimit,
+	}
+	if orderType == sonm.OrderType_ASK {
+		request.SupplierID = sonm.NewEthAddress(author)
+	} else {
+		request.ConsumerID = sonm.NewEthAddress(author)
+	}
+
+	reply, err := m.dwh.GetDealsHistory(ctx, request)
+	if err != nil {
+		return 0, fmt.Errorf("could not get deals history of %s from DWH: %s", author.Hex(), err)
+	}
+
+	total, failed := 0, 0
+	for _, deal := range reply.GetDeals() {
+		if deal.IsSpot() {
+			continue
+		}
+
+		total++
+		if deal.GetEndTime().GetSeconds()-deal.GetStartTime().GetSeconds() < int64(deal.GetDuration()) {
+			failed++
+		}
+	}
+
+	if total == 0 {
+		return 0, nil
+	}
+
+	return float64(failed) / float64(total), nil
+}
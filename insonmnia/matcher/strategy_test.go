@@ -0,0 +1,137 @@
+package matcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func candidate(id int64, author common.Address, price int64, benchmarks ...uint64) *sonm.DWHOrder {
+	return &sonm.DWHOrder{Order: &sonm.Order{
+		Id:         sonm.NewBigIntFromInt(id),
+		OrderType:  sonm.OrderType_ASK,
+		AuthorID:   sonm.NewEthAddress(author),
+		Price:      sonm.NewBigIntFromInt(price),
+		Benchmarks: &sonm.Benchmarks{Values: benchmarks},
+	}}
+}
+
+func sortedIDs(t *testing.T, strategy MatchingStrategy, order *sonm.Order, candidates ...*sonm.DWHOrder) []string {
+	sorted, err := strategy.Sort(context.Background(), order, candidates)
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(sorted))
+	for _, candidate := range sorted {
+		ids = append(ids, candidate.GetOrder().GetId().Unwrap().String())
+	}
+
+	return ids
+}
+
+func TestCheapestStrategy(t *testing.T) {
+	strategy, err := NewStrategy(StrategyConfig{Type: StrategyCheapest}, nil)
+	require.NoError(t, err)
+
+	bid := &sonm.Order{OrderType: sonm.OrderType_BID, Benchmarks: &sonm.Benchmarks{Values: []uint64{10, 0, 2}}}
+
+	// The second ask costs more, but provides twice as many benchmark units.
+	ids := sortedIDs(t, strategy, bid,
+		candidate(1, common.Address{}, 100, 10, 5, 2),
+		candidate(2, common.Address{}, 150, 20, 5, 4),
+		candidate(3, common.Address{}, 90, 10, 5, 2),
+	)
+	assert.Equal(t, []string{"2", "3", "1"}, ids)
+}
+
+func TestIdentityStrategy(t *testing.T) {
+	strategy, err := NewStrategy(StrategyConfig{Type: StrategyIdentity}, nil)
+	require.NoError(t, err)
+
+	first, second, third := candidate(1, common.Address{}, 1), candidate(2, common.Address{}, 1), candidate(3, common.Address{}, 1)
+	first.CreatorIdentityLevel = uint64(sonm.IdentityLevel_ANONYMOUS)
+	second.CreatorIdentityLevel = uint64(sonm.IdentityLevel_IDENTIFIED)
+	third.CreatorIdentityLevel = uint64(sonm.IdentityLevel_IDENTIFIED)
+
+	assert.Equal(t, []string{"2", "3", "1"}, sortedIDs(t, strategy, &sonm.Order{}, first, second, third))
+}
+
+func TestPreferredStrategy(t *testing.T) {
+	preferred := common.HexToAddress("0x1")
+	morePreferred := common.HexToAddress("0x2")
+
+	_, err := NewStrategy(StrategyConfig{Type: StrategyPreferred}, nil)
+	require.Error(t, err)
+
+	strategy, err := NewStrategy(StrategyConfig{
+		Type:                    StrategyPreferred,
+		PreferredCounterparties: []common.Address{morePreferred, preferred},
+	}, nil)
+	require.NoError(t, err)
+
+	ids := sortedIDs(t, strategy, &sonm.Order{},
+		candidate(1, common.HexToAddress("0x3"), 1),
+		candidate(2, preferred, 1),
+		candidate(3, morePreferred, 1),
+	)
+	assert.Equal(t, []string{"3", "2", "1"}, ids)
+}
+
+func TestReliabilityStrategy(t *testing.T) {
+	reliable := common.HexToAddress("0x1")
+	unreliable := common.HexToAddress("0x2")
+	unknown := common.HexToAddress("0x3")
+
+	// Forward deals of an hour, the unreliable supplier has closed one of
+	// two of them early. Spot deals are not taken into account.
+	dealsOf := map[common.Address][]*sonm.Deal{
+		reliable: {
+			{Duration: 3600, StartTime: &sonm.Timestamp{Seconds: 0}, EndTime: &sonm.Timestamp{Seconds: 3600}},
+			{StartTime: &sonm.Timestamp{Seconds: 0}, EndTime: &sonm.Timestamp{Seconds: 60}},
+		},
+		unreliable: {
+			{Duration: 3600, StartTime: &sonm.Timestamp{Seconds: 0}, EndTime: &sonm.Timestamp{Seconds: 3600}},
+			{Duration: 3600, StartTime: &sonm.Timestamp{Seconds: 0}, EndTime: &sonm.Timestamp{Seconds: 60}},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Each supplier's history is requested once, the second sort uses the
+	// cached rates.
+	requested := map[common.Address]int{}
+	dwh := sonm.NewMockDWHClient(ctrl)
+	dwh.EXPECT().GetDealsHistory(gomock.Any(), gomock.Any()).Times(3).
+		DoAndReturn(func(ctx context.Context, in *sonm.DealsHistoryRequest, opts ...grpc.CallOption) (*sonm.DealsHistoryReply, error) {
+			assert.Equal(t, sonm.DealStatus_DEAL_CLOSED, in.GetStatus())
+			assert.Equal(t, uint64(10), in.GetLimit())
+			assert.Nil(t, in.GetConsumerID())
+			requested[in.GetSupplierID().Unwrap()]++
+			return &sonm.DealsHistoryReply{Deals: dealsOf[in.GetSupplierID().Unwrap()]}, nil
+		})
+
+	strategy, err := NewStrategy(StrategyConfig{Type: StrategyReliability, HistoryLimit: 10, HistoryCacheTTL: time.Hour}, dwh)
+	require.NoError(t, err)
+
+	for idx := 0; idx < 2; idx++ {
+		ids := sortedIDs(t, strategy, &sonm.Order{},
+			candidate(1, unreliable, 1),
+			candidate(2, reliable, 1),
+			candidate(3, unknown, 1),
+		)
+		assert.Equal(t, []string{"2", "3", "1"}, ids)
+	}
+	assert.Equal(t, map[common.Address]int{reliable: 1, unreliable: 1, unknown: 1}, requested)
+}
+
+func TestUnknownStrategy(t *testing.T) {
+	_, err := NewStrategy(StrategyConfig{Type: "random"}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,275 @@
+package node
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/insonmnia/dwh"
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// accountant tracks spendings of deals where the node's account is the
+// consumer, predicting bills and exhaustion of the balance, and closes or
+// reports deals that would overdraw the configured budget.
+type accountant struct {
+	cfg     accountantConfig
+	remotes *remoteOptions
+}
+
+func newAccountant(cfg accountantConfig, remotes *remoteOptions) *accountant {
+	return &accountant{
+		cfg:     cfg,
+		remotes: remotes,
+	}
+}
+
+// Run periodically checks consumer deals until the context is canceled.
+func (m *accountant) Run(ctx context.Context) error {
+	tk := util.NewImmediateTicker(m.cfg.PollDelay)
+	defer tk.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tk.C:
+			if err := m.check(ctx); err != nil {
+				log.S(ctx).Warnf("failed to check deal spendings: %s", err)
+			}
+		}
+	}
+}
+
+func (m *accountant) check(ctx context.Context) error {
+	spending, err := m.Spending(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, deal := range spending.GetDeals() {
+		if !deal.GetOverBudget() {
+			continue
+		}
+
+		id := deal.GetDealID().Unwrap()
+		if !m.cfg.AutoClose {
+			log.G(ctx).Warn("deal would overdraw the budget",
+				zap.String("deal", id.String()), zap.String("forecast", deal.GetForecast().ToPriceString()))
+			continue
+		}
+
+		if err := <-m.remotes.eth.Market().CloseDeal(ctx, m.remotes.key, id, false); err != nil {
+			log.G(ctx).Warn("failed to close deal that would overdraw the budget", zap.String("deal", id.String()), zap.Error(err))
+			continue
+		}
+
+		log.G(ctx).Info("closed deal that would overdraw the budget", zap.String("deal", id.String()))
+	}
+
+	if spending.GetExhaustion() != nil && spending.GetExhaustion().Unix().Before(time.Now().Add(m.cfg.Horizon)) {
+		log.G(ctx).Warn("balance is running out", zap.Time("exhaustion", spending.GetExhaustion().Unix()))
+	}
+
+	return nil
+}
+
+// Spending collects actual state of the consumer deals and accounts them.
+func (m *accountant) Spending(ctx context.Context) (*sonm.SpendingReply, error) {
+	addr := crypto.PubkeyToAddress(m.remotes.key.PublicKey)
+
+	dwhDeals, err := getAllDeals(ctx, m.remotes.dwh, &sonm.DealsRequest{
+		Status:     sonm.DealStatus_DEAL_ACCEPTED,
+		ConsumerID: sonm.NewEthAddress(addr),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get deals from DWH: %s", err)
+	}
+
+	// DWH may lag behind, so the billing state is taken from the blockchain.
+	deals := make([]*sonm.Deal, 0, len(dwhDeals))
+	for _, dwhDeal := range dwhDeals {
+		deal, err := m.remotes.eth.Market().GetDealInfo(ctx, dwhDeal.GetDeal().GetId().Unwrap())
+		if err != nil {
+			return nil, fmt.Errorf("could not get deal info from blockchain: %s", err)
+		}
+
+		if deal.GetStatus() == sonm.DealStatus_DEAL_ACCEPTED {
+			deals = append(deals, deal)
+		}
+	}
+
+	balance, err := m.remotes.eth.SideToken().BalanceOf(ctx, addr.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("could not get side token balance: %s", err)
+	}
+
+	rate, err := m.remotes.eth.OracleUSD().GetCurrentPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get USD/SNM rate: %s", err)
+	}
+
+	return m.account(time.Now(), rate, balance, deals), nil
+}
+
+// account calculates spendings of the given deals at the given time, with
+// deal prices converted to SNM at the given USD/SNM rate.
+//
+// Deals are charged against the budget in the order they were opened, so
+// the newest ones are the first to overdraw it.
+func (m *accountant) account(now time.Time, rate, balance *big.Int, deals []*sonm.Deal) *sonm.SpendingReply {
+	sort.Slice(deals, func(i, j int) bool {
+		return deals[i].GetStartTime().GetSeconds() < deals[j].GetStartTime().GetSeconds()
+	})
+
+	budget := m.cfg.Budget.Unwrap()
+	spent := big.NewInt(0)
+	forecast := big.NewInt(0)
+	// Spot deals reserve the next period from the balance on each bill,
+	// while forward deals are paid in advance.
+	available := new(big.Int).Set(balance)
+	spotPrice := big.NewInt(0)
+
+	reply := &sonm.SpendingReply{
+		Deals:   []*sonm.DealSpending{},
+		Balance: sonm.NewBigInt(balance),
+		Budget:  sonm.NewBigInt(budget),
+	}
+
+	for _, deal := range deals {
+		spending := m.accountDeal(now, rate, deal)
+
+		spent.Add(spent, spending.GetTotalPayout().Unwrap())
+		spent.Add(spent, spending.GetAccrued().Unwrap())
+		forecast.Add(forecast, spending.GetForecast().Unwrap())
+		spending.OverBudget = budget.Sign() > 0 && forecast.Cmp(budget) > 0
+
+		if deal.IsSpot() {
+			available.Add(available, spending.GetBlockedBalance().Unwrap())
+			available.Sub(available, spending.GetAccrued().Unwrap())
+			spotPrice.Add(spotPrice, spending.GetPrice().Unwrap())
+		}
+
+		reply.Deals = append(reply.Deals, spending)
+	}
+
+	reply.Spent = sonm.NewBigInt(spent)
+	if spotPrice.Sign() > 0 && rate.Sign() > 0 {
+		exhaustion := now
+		if available.Sign() > 0 {
+			exhaustion = now.Add(durationOf(available, spotPrice, rate))
+		}
+		reply.Exhaustion = &sonm.Timestamp{Seconds: exhaustion.Unix()}
+	}
+
+	return reply
+}
+
+func (m *accountant) accountDeal(now time.Time, rate *big.Int, deal *sonm.Deal) *sonm.DealSpending {
+	price := deal.GetPrice().Unwrap()
+	blocked := deal.GetBlockedBalance().Unwrap()
+	totalPayout := deal.GetTotalPayout().Unwrap()
+
+	lastBill := deal.GetStartTime().Unix()
+	if deal.GetLastBillTS().GetSeconds() > 0 {
+		lastBill = deal.GetLastBillTS().Unix()
+	}
+
+	billPeriod := m.cfg.RegularBillPeriod
+	if deal.IsSpot() {
+		billPeriod = m.cfg.SpotBillPeriod
+	}
+
+	accrueUntil := now
+	horizon := now.Add(m.cfg.Horizon)
+	nextBill := lastBill.Add(billPeriod)
+	paidUntil := lastBill
+	if price.Sign() > 0 && rate.Sign() > 0 {
+		paidUntil = lastBill.Add(durationOf(blocked, price, rate))
+	}
+
+	if !deal.IsSpot() {
+		endTime := deal.GetStartTime().Unix().Add(time.Duration(deal.GetDuration()) * time.Second)
+		accrueUntil = earliest(accrueUntil, endTime)
+		horizon = earliest(horizon, endTime)
+		nextBill = earliest(nextBill, endTime)
+		paidUntil = earliest(paidUntil, endTime)
+	}
+
+	forecast := new(big.Int).Add(totalPayout, costOf(price, rate, horizon.Sub(lastBill)))
+
+	return &sonm.DealSpending{
+		DealID:         deal.GetId(),
+		Price:          sonm.NewBigInt(price),
+		BlockedBalance: sonm.NewBigInt(blocked),
+		TotalPayout:    sonm.NewBigInt(totalPayout),
+		LastBillTS:     &sonm.Timestamp{Seconds: lastBill.Unix()},
+		Accrued:        sonm.NewBigInt(costOf(price, rate, accrueUntil.Sub(lastBill))),
+		NextBillTS:     &sonm.Timestamp{Seconds: nextBill.Unix()},
+		PaidUntil:      &sonm.Timestamp{Seconds: paidUntil.Unix()},
+		Forecast:       sonm.NewBigInt(forecast),
+	}
+}
+
+// costOf returns the cost in SNM of the period at the given price in USD per
+// second, the same way the market contract does.
+func costOf(price, rate *big.Int, period time.Duration) *big.Int {
+	if period <= 0 {
+		return big.NewInt(0)
+	}
+
+	cost := big.NewInt(int64(period / time.Second))
+	cost.Mul(cost, price)
+	cost.Mul(cost, rate)
+	return cost.Quo(cost, big.NewInt(params.Ether))
+}
+
+// durationOf returns the period the amount of SNM lasts for at the given
+// price in USD per second.
+func durationOf(amount, price, rate *big.Int) time.Duration {
+	seconds := new(big.Int).Mul(amount, big.NewInt(params.Ether))
+	seconds.Quo(seconds, new(big.Int).Mul(price, rate))
+	if !seconds.IsInt64() || seconds.Int64() > int64(math.MaxInt64/time.Second) {
+		return math.MaxInt64
+	}
+
+	return time.Duration(seconds.Int64()) * time.Second
+}
+
+// getAllDeals returns all deals matching the request, fetching them from DWH
+// page by page, because DWH limits the number of deals returned at once.
+func getAllDeals(ctx context.Context, client sonm.DWHClient, request *sonm.DealsRequest) ([]*sonm.DWHDeal, error) {
+	page := *request
+	page.Limit = dwh.MaxLimit
+
+	var deals []*sonm.DWHDeal
+	for {
+		reply, err := client.GetDeals(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		deals = append(deals, reply.GetDeals()...)
+		if uint64(len(reply.GetDeals())) < page.Limit {
+			return deals, nil
+		}
+
+		page.Offset += page.Limit
+	}
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
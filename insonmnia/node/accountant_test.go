@@ -0,0 +1,243 @@
+package node
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/insonmnia/dwh"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRate is the USD/SNM rate the costs are equal to prices multiplied by
+// seconds at.
+var testRate = big.NewInt(params.Ether)
+
+func newTestAccountant(budget int64) *accountant {
+	return newAccountant(accountantConfig{
+		Horizon:           time.Hour,
+		Budget:            tokenAmount{value: big.NewInt(budget)},
+		RegularBillPeriod: 24 * time.Hour,
+		SpotBillPeriod:    time.Hour,
+	}, nil)
+}
+
+type testDeal struct {
+	id       int64
+	price    int64
+	blocked  int64
+	payout   int64
+	start    time.Time
+	lastBill time.Time
+	duration time.Duration
+}
+
+func (m testDeal) Deal() *sonm.Deal {
+	deal := &sonm.Deal{
+		Id:             sonm.NewBigIntFromInt(m.id),
+		Price:          sonm.NewBigIntFromInt(m.price),
+		BlockedBalance: sonm.NewBigIntFromInt(m.blocked),
+		TotalPayout:    sonm.NewBigIntFromInt(m.payout),
+		StartTime:      &sonm.Timestamp{Seconds: m.start.Unix()},
+		LastBillTS:     &sonm.Timestamp{},
+		Duration:       uint64(m.duration / time.Second),
+	}
+	if !m.lastBill.IsZero() {
+		deal.LastBillTS.Seconds = m.lastBill.Unix()
+	}
+
+	return deal
+}
+
+func TestAccountDeal(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+
+	tests := []struct {
+		name      string
+		deal      testDeal
+		accrued   int64
+		forecast  int64
+		lastBill  time.Time
+		nextBill  time.Time
+		paidUntil time.Time
+	}{
+		{
+			name:      "spot deal before the first bill",
+			deal:      testDeal{price: 1, blocked: 3600, start: now.Add(-30 * time.Minute)},
+			accrued:   1800,
+			forecast:  5400,
+			lastBill:  now.Add(-30 * time.Minute),
+			nextBill:  now.Add(30 * time.Minute),
+			paidUntil: now.Add(30 * time.Minute),
+		},
+		{
+			name: "billed spot deal",
+			deal: testDeal{
+				price:    1,
+				blocked:  3600,
+				payout:   6600,
+				start:    now.Add(-2 * time.Hour),
+				lastBill: now.Add(-10 * time.Minute),
+			},
+			accrued:   600,
+			forecast:  10800,
+			lastBill:  now.Add(-10 * time.Minute),
+			nextBill:  now.Add(50 * time.Minute),
+			paidUntil: now.Add(50 * time.Minute),
+		},
+		{
+			name:      "spot deal with blocked balance running out",
+			deal:      testDeal{price: 2, blocked: 1200, start: now.Add(-20 * time.Minute)},
+			accrued:   2400,
+			forecast:  9600,
+			lastBill:  now.Add(-20 * time.Minute),
+			nextBill:  now.Add(40 * time.Minute),
+			paidUntil: now.Add(-10 * time.Minute),
+		},
+		{
+			name:      "forward deal ending within the horizon",
+			deal:      testDeal{price: 2, blocked: 14400, start: now.Add(-90 * time.Minute), duration: 2 * time.Hour},
+			accrued:   10800,
+			forecast:  14400,
+			lastBill:  now.Add(-90 * time.Minute),
+			nextBill:  now.Add(30 * time.Minute),
+			paidUntil: now.Add(30 * time.Minute),
+		},
+		{
+			name:      "expired forward deal",
+			deal:      testDeal{price: 1, blocked: 3600, start: now.Add(-3 * time.Hour), duration: time.Hour},
+			accrued:   3600,
+			forecast:  3600,
+			lastBill:  now.Add(-3 * time.Hour),
+			nextBill:  now.Add(-2 * time.Hour),
+			paidUntil: now.Add(-2 * time.Hour),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spending := newTestAccountant(0).accountDeal(now, testRate, test.deal.Deal())
+
+			assert.Equal(t, big.NewInt(test.accrued), spending.GetAccrued().Unwrap())
+			assert.Equal(t, big.NewInt(test.forecast), spending.GetForecast().Unwrap())
+			assert.Equal(t, test.lastBill.Unix(), spending.GetLastBillTS().GetSeconds())
+			assert.Equal(t, test.nextBill.Unix(), spending.GetNextBillTS().GetSeconds())
+			assert.Equal(t, test.paidUntil.Unix(), spending.GetPaidUntil().GetSeconds())
+		})
+	}
+}
+
+func TestAccount(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+
+	older := testDeal{id: 1, price: 1, blocked: 3600, start: now.Add(-30 * time.Minute)}
+	newer := testDeal{id: 2, price: 1, blocked: 3600, start: now.Add(-10 * time.Minute)}
+	forward := testDeal{id: 3, price: 1, blocked: 7200, start: now.Add(-time.Hour), duration: 2 * time.Hour}
+
+	tests := []struct {
+		name       string
+		budget     int64
+		balance    int64
+		deals      []testDeal
+		overBudget []bool
+		spent      int64
+		exhaustion *time.Time
+	}{
+		{
+			name:       "newest deal overdraws the budget",
+			budget:     6000,
+			balance:    3600,
+			deals:      []testDeal{newer, older},
+			overBudget: []bool{false, true},
+			spent:      2400,
+			exhaustion: timeOf(now.Add(4200 * time.Second)),
+		},
+		{
+			name:       "no budget",
+			balance:    3600,
+			deals:      []testDeal{older, newer},
+			overBudget: []bool{false, false},
+			spent:      2400,
+			exhaustion: timeOf(now.Add(4200 * time.Second)),
+		},
+		{
+			name:       "forward deals do not exhaust the balance",
+			budget:     7200,
+			deals:      []testDeal{forward},
+			overBudget: []bool{false},
+			spent:      3600,
+		},
+		{
+			name:       "exhausted balance",
+			deals:      []testDeal{{id: 4, price: 1, start: now.Add(-time.Minute)}},
+			overBudget: []bool{false},
+			spent:      60,
+			exhaustion: timeOf(now),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var deals []*sonm.Deal
+			for _, deal := range test.deals {
+				deals = append(deals, deal.Deal())
+			}
+
+			reply := newTestAccountant(test.budget).account(now, testRate, big.NewInt(test.balance), deals)
+
+			require.Len(t, reply.GetDeals(), len(test.overBudget))
+			for idx, deal := range reply.GetDeals() {
+				if idx > 0 {
+					prev := reply.GetDeals()[idx-1].GetLastBillTS().GetSeconds()
+					assert.True(t, prev <= deal.GetLastBillTS().GetSeconds(), "deals must be ordered by start time")
+				}
+				assert.Equal(t, test.overBudget[idx], deal.GetOverBudget(), "deal %s", deal.GetDealID().Unwrap())
+			}
+
+			assert.Equal(t, big.NewInt(test.spent), reply.GetSpent().Unwrap())
+			if test.exhaustion == nil {
+				assert.Nil(t, reply.GetExhaustion())
+			} else {
+				require.NotNil(t, reply.GetExhaustion())
+				assert.Equal(t, test.exhaustion.Unix(), reply.GetExhaustion().GetSeconds())
+			}
+		})
+	}
+}
+
+func TestGetAllDealsPaginates(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	page := func(offset, count uint64) *sonm.DWHDealsReply {
+		reply := &sonm.DWHDealsReply{}
+		for idx := uint64(0); idx < count; idx++ {
+			reply.Deals = append(reply.Deals, &sonm.DWHDeal{Deal: &sonm.Deal{Id: sonm.NewBigIntFromInt(int64(offset + idx))}})
+		}
+		return reply
+	}
+
+	client := sonm.NewMockDWHClient(controller)
+	gomock.InOrder(
+		client.EXPECT().GetDeals(gomock.Any(), &sonm.DealsRequest{Status: sonm.DealStatus_DEAL_ACCEPTED, Limit: dwh.MaxLimit}).
+			Return(page(0, dwh.MaxLimit), nil),
+		client.EXPECT().GetDeals(gomock.Any(), &sonm.DealsRequest{Status: sonm.DealStatus_DEAL_ACCEPTED, Limit: dwh.MaxLimit, Offset: dwh.MaxLimit}).
+			Return(page(dwh.MaxLimit, 1), nil),
+	)
+
+	request := &sonm.DealsRequest{Status: sonm.DealStatus_DEAL_ACCEPTED}
+	deals, err := getAllDeals(context.Background(), client, request)
+	require.NoError(t, err)
+	require.Len(t, deals, dwh.MaxLimit+1)
+	assert.Equal(t, big.NewInt(dwh.MaxLimit), deals[dwh.MaxLimit].GetDeal().GetId().Unwrap())
+	assert.Equal(t, &sonm.DealsRequest{Status: sonm.DealStatus_DEAL_ACCEPTED}, request)
+}
+
+func timeOf(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,250 @@
+package node
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/insonmnia/dwh"
+	"github.com/sonm-io/core/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// spotFrozenPeriod and maxForwardFrozenPeriod are the periods the market
+	// contract freezes BID funds for.
+	spotFrozenPeriod       = time.Hour
+	maxForwardFrozenPeriod = 24 * time.Hour
+)
+
+// budgetPolicy protects the account from spending more than configured on
+// orders and deals placed via the node.
+//
+// The policy must be locked from the check until the orders or the deal are
+// placed, otherwise concurrent requests could exceed the budget together.
+type budgetPolicy struct {
+	sync.Mutex
+
+	cfg     budgetPolicyConfig
+	remotes *remoteOptions
+}
+
+func newBudgetPolicy(cfg budgetPolicyConfig, remotes *remoteOptions) *budgetPolicy {
+	return &budgetPolicy{
+		cfg:     cfg,
+		remotes: remotes,
+	}
+}
+
+// CheckOrders verifies that placing all of the BID orders fits the budget.
+func (m *budgetPolicy) CheckOrders(ctx context.Context, orders ...*sonm.Order) error {
+	for _, order := range orders {
+		if err := m.checkPrice(order.GetPrice()); err != nil {
+			return err
+		}
+	}
+
+	rate, err := m.remotes.eth.OracleUSD().GetCurrentPrice(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not get USD/SNM rate: %s", err)
+	}
+
+	frozenSum := big.NewInt(0)
+	for _, order := range orders {
+		frozenSum.Add(frozenSum, costOf(order.GetPrice().Unwrap(), rate, frozenPeriod(order.GetDuration())))
+	}
+
+	balance, err := m.remotes.eth.SideToken().BalanceOf(ctx, m.address().Hex())
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not get side token balance: %s", err)
+	}
+	if balance.Cmp(frozenSum) < 0 {
+		return status.Errorf(codes.FailedPrecondition, "orders require %s SNM to be frozen, but the balance is %s SNM",
+			sonm.NewBigInt(frozenSum).ToPriceString(), sonm.NewBigInt(balance).ToPriceString())
+	}
+
+	// The matcher opens deals for placed orders on its own, so the limit
+	// is checked in advance.
+	if err := m.checkDeals(ctx, len(orders)); err != nil {
+		return err
+	}
+
+	if err := m.checkFrozen(ctx, frozenSum); err != nil {
+		return err
+	}
+
+	return m.checkSpendCap(ctx, frozenSum)
+}
+
+// CheckDeal verifies that opening the deal fits the budget. Only deals where
+// the node's account is the consumer are limited.
+func (m *budgetPolicy) CheckDeal(ctx context.Context, ask, bid *sonm.Order) error {
+	if bid.GetAuthorID().Unwrap() != m.address() {
+		return nil
+	}
+
+	if err := m.checkPrice(ask.GetPrice()); err != nil {
+		return err
+	}
+
+	return m.checkDeals(ctx, 1)
+}
+
+// checkDeals verifies that the given number of new consumer deals fits the
+// limit.
+func (m *budgetPolicy) checkDeals(ctx context.Context, count int) error {
+	if m.cfg.MaxDeals == 0 {
+		return nil
+	}
+
+	deals, err := getAllDeals(ctx, m.remotes.dwh, &sonm.DealsRequest{
+		Status:     sonm.DealStatus_DEAL_ACCEPTED,
+		ConsumerID: sonm.NewEthAddress(m.address()),
+	})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not get deals from DWH: %s", err)
+	}
+
+	if uint64(len(deals)+count) > m.cfg.MaxDeals {
+		return status.Errorf(codes.ResourceExhausted, "budget allows at most %d concurrent deals", m.cfg.MaxDeals)
+	}
+
+	return nil
+}
+
+func (m *budgetPolicy) checkPrice(price *sonm.BigInt) error {
+	maxPrice := m.cfg.MaxPrice.GetPerSecond()
+	if maxPrice == nil || maxPrice.IsZero() {
+		return nil
+	}
+
+	if price.Cmp(maxPrice) > 0 {
+		return status.Errorf(codes.InvalidArgument, "price %s USD/s exceeds the budget limit of %s USD/s",
+			price.ToPriceString(), maxPrice.ToPriceString())
+	}
+
+	return nil
+}
+
+// checkFrozen verifies that funds frozen in active BID orders and blocked in
+// consumer deals do not exceed the limit with the given amount added.
+func (m *budgetPolicy) checkFrozen(ctx context.Context, amount *big.Int) error {
+	maxFrozen := m.cfg.MaxFrozen.Unwrap()
+	if maxFrozen.Sign() == 0 {
+		return nil
+	}
+
+	orders, err := getAllOrders(ctx, m.remotes.dwh, &sonm.OrdersRequest{
+		Type:     sonm.OrderType_BID,
+		Status:   sonm.OrderStatus_ORDER_ACTIVE,
+		AuthorID: sonm.NewEthAddress(m.address()),
+	})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not get orders from DWH: %s", err)
+	}
+
+	deals, err := getAllDeals(ctx, m.remotes.dwh, &sonm.DealsRequest{
+		Status:     sonm.DealStatus_DEAL_ACCEPTED,
+		ConsumerID: sonm.NewEthAddress(m.address()),
+	})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not get deals from DWH: %s", err)
+	}
+
+	frozen := new(big.Int).Set(amount)
+	for _, order := range orders {
+		frozen.Add(frozen, order.GetOrder().GetFrozenSum().Unwrap())
+	}
+	for _, deal := range deals {
+		frozen.Add(frozen, deal.GetDeal().GetBlockedBalance().Unwrap())
+	}
+
+	if frozen.Cmp(maxFrozen) > 0 {
+		return status.Errorf(codes.ResourceExhausted, "total frozen sum of %s SNM would exceed the budget limit of %s SNM",
+			sonm.NewBigInt(frozen).ToPriceString(), sonm.NewBigInt(maxFrozen).ToPriceString())
+	}
+
+	return nil
+}
+
+// checkSpendCap verifies that funds frozen by BID orders placed within the
+// spend period do not exceed the cap with the given amount added. Funds of
+// cancelled orders are returned, so only orders that are still active or
+// have resulted in deals are counted.
+func (m *budgetPolicy) checkSpendCap(ctx context.Context, amount *big.Int) error {
+	spendCap := m.cfg.SpendCap.Unwrap()
+	if spendCap.Sign() == 0 {
+		return nil
+	}
+
+	orders, err := getAllOrders(ctx, m.remotes.dwh, &sonm.OrdersRequest{
+		Type:     sonm.OrderType_BID,
+		AuthorID: sonm.NewEthAddress(m.address()),
+		CreatedTS: &sonm.MaxMinTimestamp{
+			Min: &sonm.Timestamp{Seconds: time.Now().Add(-m.cfg.SpendPeriod).Unix()},
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "could not get orders from DWH: %s", err)
+	}
+
+	spent := new(big.Int).Set(amount)
+	for _, order := range orders {
+		if order.GetOrder().GetOrderStatus() != sonm.OrderStatus_ORDER_ACTIVE && order.GetOrder().GetDealID().IsZero() {
+			continue
+		}
+
+		spent.Add(spent, order.GetOrder().GetFrozenSum().Unwrap())
+	}
+
+	if spent.Cmp(spendCap) > 0 {
+		return status.Errorf(codes.ResourceExhausted, "spending of %s SNM within %s would exceed the budget cap of %s SNM",
+			sonm.NewBigInt(spent).ToPriceString(), m.cfg.SpendPeriod, sonm.NewBigInt(spendCap).ToPriceString())
+	}
+
+	return nil
+}
+
+func (m *budgetPolicy) address() common.Address {
+	return crypto.PubkeyToAddress(m.remotes.key.PublicKey)
+}
+
+// getAllOrders returns all orders matching the request, fetching them from
+// DWH page by page, because DWH limits the number of orders returned at once.
+func getAllOrders(ctx context.Context, client sonm.DWHClient, request *sonm.OrdersRequest) ([]*sonm.DWHOrder, error) {
+	page := *request
+	page.Limit = dwh.MaxLimit
+
+	var orders []*sonm.DWHOrder
+	for {
+		reply, err := client.GetOrders(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, reply.GetOrders()...)
+		if uint64(len(reply.GetOrders())) < page.Limit {
+			return orders, nil
+		}
+
+		page.Offset += page.Limit
+	}
+}
+
+// frozenPeriod returns the period the market contract freezes funds of a BID
+// order with the given duration in seconds for.
+func frozenPeriod(duration uint64) time.Duration {
+	period := time.Duration(duration) * time.Second
+	switch {
+	case duration == 0:
+		return spotFrozenPeriod
+	case period > maxForwardFrozenPeriod:
+		return maxForwardFrozenPeriod
+	default:
+		return period
+	}
+}
@@ -0,0 +1,232 @@
+package node
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain/simulated"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFrozenPeriod(t *testing.T) {
+	tests := []struct {
+		duration uint64
+		period   time.Duration
+	}{
+		{0, time.Hour},
+		{60, time.Minute},
+		{86400, 24 * time.Hour},
+		{172800, 24 * time.Hour},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.period, frozenPeriod(test.duration), "duration %d", test.duration)
+	}
+}
+
+// newTestBudgetPolicy returns the budget policy of an account with the given
+// balance, consumer deals and active BID orders. Prices are chosen so that
+// costs are equal to prices multiplied by seconds.
+func newTestBudgetPolicy(t *testing.T, controller *gomock.Controller, cfg budgetPolicyConfig, balance int64, deals []*sonm.DWHDeal, orders []*sonm.DWHOrder) (*budgetPolicy, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	backend := simulated.NewBackend(simulated.WithTokenPrice(testRate))
+	backend.MintSidechain(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(balance))
+
+	client := sonm.NewMockDWHClient(controller)
+	client.EXPECT().GetDeals(gomock.Any(), gomock.Any()).Return(&sonm.DWHDealsReply{Deals: deals}, nil).AnyTimes()
+	client.EXPECT().GetOrders(gomock.Any(), gomock.Any()).Return(&sonm.DWHOrdersReply{Orders: orders}, nil).AnyTimes()
+
+	return newBudgetPolicy(cfg, &remoteOptions{key: key, eth: backend, dwh: client}), crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func testBid(price int64, duration time.Duration) *sonm.Order {
+	return &sonm.Order{
+		OrderType: sonm.OrderType_BID,
+		Price:     sonm.NewBigIntFromInt(price),
+		Duration:  uint64(duration / time.Second),
+	}
+}
+
+func TestBudgetPolicyCheckOrders(t *testing.T) {
+	deal := &sonm.DWHDeal{Deal: &sonm.Deal{BlockedBalance: sonm.NewBigIntFromInt(2000)}}
+	order := &sonm.DWHOrder{Order: &sonm.Order{OrderStatus: sonm.OrderStatus_ORDER_ACTIVE, FrozenSum: sonm.NewBigIntFromInt(5000)}}
+
+	tests := []struct {
+		name    string
+		cfg     budgetPolicyConfig
+		balance int64
+		orders  []*sonm.Order
+		code    codes.Code
+	}{
+		{
+			name:    "no limits",
+			balance: 3600,
+			orders:  []*sonm.Order{testBid(1, 0)},
+			code:    codes.OK,
+		},
+		{
+			name:    "price exceeds the limit",
+			cfg:     budgetPolicyConfig{MaxPrice: &sonm.Price{PerSecond: sonm.NewBigIntFromInt(1)}},
+			balance: 100000,
+			orders:  []*sonm.Order{testBid(1, 0), testBid(2, 0)},
+			code:    codes.InvalidArgument,
+		},
+		{
+			name:    "balance is insufficient",
+			balance: 7199,
+			orders:  []*sonm.Order{testBid(1, 0), testBid(1, 0)},
+			code:    codes.FailedPrecondition,
+		},
+		{
+			name:    "forward orders freeze at most a day",
+			balance: 86400,
+			orders:  []*sonm.Order{testBid(1, 48*time.Hour)},
+			code:    codes.OK,
+		},
+		{
+			name:    "deals within the limit",
+			cfg:     budgetPolicyConfig{MaxDeals: 3},
+			balance: 100000,
+			orders:  []*sonm.Order{testBid(1, 0), testBid(1, 0)},
+			code:    codes.OK,
+		},
+		{
+			name:    "deals exceed the limit",
+			cfg:     budgetPolicyConfig{MaxDeals: 2},
+			balance: 100000,
+			orders:  []*sonm.Order{testBid(1, 0), testBid(1, 0)},
+			code:    codes.ResourceExhausted,
+		},
+		{
+			name:    "frozen sum within the limit",
+			cfg:     budgetPolicyConfig{MaxFrozen: tokenAmount{value: big.NewInt(10600)}},
+			balance: 100000,
+			orders:  []*sonm.Order{testBid(1, 0)},
+			code:    codes.OK,
+		},
+		{
+			name:    "frozen sum exceeds the limit",
+			cfg:     budgetPolicyConfig{MaxFrozen: tokenAmount{value: big.NewInt(10599)}},
+			balance: 100000,
+			orders:  []*sonm.Order{testBid(1, 0)},
+			code:    codes.ResourceExhausted,
+		},
+		{
+			name:    "spending within the cap",
+			cfg:     budgetPolicyConfig{SpendCap: tokenAmount{value: big.NewInt(12200)}, SpendPeriod: time.Hour},
+			balance: 100000,
+			orders:  []*sonm.Order{testBid(1, 2*time.Hour)},
+			code:    codes.OK,
+		},
+		{
+			name:    "spending exceeds the cap",
+			cfg:     budgetPolicyConfig{SpendCap: tokenAmount{value: big.NewInt(12199)}, SpendPeriod: time.Hour},
+			balance: 100000,
+			orders:  []*sonm.Order{testBid(1, 2*time.Hour)},
+			code:    codes.ResourceExhausted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			policy, _ := newTestBudgetPolicy(t, controller, test.cfg, test.balance, []*sonm.DWHDeal{deal}, []*sonm.DWHOrder{order})
+
+			err := policy.CheckOrders(context.Background(), test.orders...)
+			assert.Equal(t, test.code, status.Code(err), "%v", err)
+		})
+	}
+}
+
+func TestBudgetPolicySpendCapCountsActiveAndDealtOrders(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	orders := []*sonm.DWHOrder{
+		{Order: &sonm.Order{OrderStatus: sonm.OrderStatus_ORDER_ACTIVE, FrozenSum: sonm.NewBigIntFromInt(5000)}},
+		{Order: &sonm.Order{OrderStatus: sonm.OrderStatus_ORDER_INACTIVE, DealID: sonm.NewBigIntFromInt(1), FrozenSum: sonm.NewBigIntFromInt(3000)}},
+		// Funds of cancelled orders are returned.
+		{Order: &sonm.Order{OrderStatus: sonm.OrderStatus_ORDER_INACTIVE, FrozenSum: sonm.NewBigIntFromInt(100000)}},
+	}
+
+	cfg := budgetPolicyConfig{SpendCap: tokenAmount{value: big.NewInt(11600)}, SpendPeriod: time.Hour}
+	policy, _ := newTestBudgetPolicy(t, controller, cfg, 100000, nil, orders)
+	require.NoError(t, policy.CheckOrders(context.Background(), testBid(1, 0)))
+
+	cfg.SpendCap = tokenAmount{value: big.NewInt(11599)}
+	policy, _ = newTestBudgetPolicy(t, controller, cfg, 100000, nil, orders)
+	err := policy.CheckOrders(context.Background(), testBid(1, 0))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "%v", err)
+}
+
+func TestBudgetPolicyCheckDeal(t *testing.T) {
+	deal := &sonm.DWHDeal{Deal: &sonm.Deal{BlockedBalance: sonm.NewBigIntFromInt(2000)}}
+	cfg := budgetPolicyConfig{MaxPrice: &sonm.Price{PerSecond: sonm.NewBigIntFromInt(1)}, MaxDeals: 2}
+
+	tests := []struct {
+		name     string
+		consumer bool
+		price    int64
+		deals    []*sonm.DWHDeal
+		code     codes.Code
+	}{
+		{
+			name:  "deals of other consumers are not limited",
+			price: 2,
+			deals: []*sonm.DWHDeal{deal, deal},
+			code:  codes.OK,
+		},
+		{
+			name:     "deal within the limits",
+			consumer: true,
+			price:    1,
+			deals:    []*sonm.DWHDeal{deal},
+			code:     codes.OK,
+		},
+		{
+			name:     "price exceeds the limit",
+			consumer: true,
+			price:    2,
+			code:     codes.InvalidArgument,
+		},
+		{
+			name:     "deals exceed the limit",
+			consumer: true,
+			price:    1,
+			deals:    []*sonm.DWHDeal{deal, deal},
+			code:     codes.ResourceExhausted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			policy, addr := newTestBudgetPolicy(t, controller, cfg, 0, test.deals, nil)
+
+			bid := testBid(test.price, 0)
+			bid.AuthorID = sonm.NewEthAddress(common.HexToAddress("0x1"))
+			if test.consumer {
+				bid.AuthorID = sonm.NewEthAddress(addr)
+			}
+			ask := &sonm.Order{OrderType: sonm.OrderType_ASK, Price: sonm.NewBigIntFromInt(test.price)}
+
+			err := policy.CheckDeal(context.Background(), ask, bid)
+			assert.Equal(t, test.code, status.Code(err), "%v", err)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package node
 
 import (
+	"math/big"
+	"time"
+
 	"github.com/jinzhu/configor"
 	"github.com/sonm-io/core/accounts"
 	"github.com/sonm-io/core/blockchain"
@@ -9,6 +12,8 @@ import (
 	"github.com/sonm-io/core/insonmnia/logging"
 	"github.com/sonm-io/core/insonmnia/matcher"
 	"github.com/sonm-io/core/insonmnia/npp"
+	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util"
 )
 
 type nodeConfig struct {
@@ -17,6 +22,68 @@ type nodeConfig struct {
 	AllowInsecureConnection bool   `yaml:"allow_insecure_connection" default:"false"`
 }
 
+// tokenAmount is an amount of SNM written in config as a decimal number of
+// tokens, e.g. "12.5".
+type tokenAmount struct {
+	value *big.Int
+}
+
+func (m *tokenAmount) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var v string
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	value, err := util.StringToEtherPrice(v)
+	if err != nil {
+		return err
+	}
+
+	m.value = value
+	return nil
+}
+
+// Unwrap returns the amount in wei, which is zero if it is not set.
+func (m tokenAmount) Unwrap() *big.Int {
+	if m.value == nil {
+		return big.NewInt(0)
+	}
+
+	return new(big.Int).Set(m.value)
+}
+
+type accountantConfig struct {
+	PollDelay time.Duration `yaml:"poll_delay" default:"1m"`
+	// Horizon is how far ahead deal spendings are forecast.
+	Horizon time.Duration `yaml:"horizon" default:"1h"`
+	// Budget limits the total spending of consumer deals, including the
+	// forecast. Zero means no limit.
+	Budget tokenAmount `yaml:"budget"`
+	// AutoClose enables closing of deals that would overdraw the budget,
+	// otherwise they are only reported.
+	AutoClose bool `yaml:"auto_close" default:"false"`
+	// Bill periods are used to predict when suppliers bill deals and
+	// should match the ones of the Worker.
+	RegularBillPeriod time.Duration `yaml:"regular_deal_bill_period" default:"24h"`
+	SpotBillPeriod    time.Duration `yaml:"spot_deal_bill_period" default:"1h"`
+}
+
+// budgetPolicyConfig limits orders and deals the node places on behalf of
+// the consumer. Zero values mean no limit.
+type budgetPolicyConfig struct {
+	// MaxPrice limits the price of BID orders and opened deals.
+	MaxPrice *sonm.Price `yaml:"max_price"`
+	// MaxDeals limits the number of concurrent consumer deals.
+	MaxDeals uint64 `yaml:"max_deals"`
+	// MaxFrozen limits the total amount frozen in active BID orders and
+	// blocked in consumer deals.
+	MaxFrozen tokenAmount `yaml:"max_frozen"`
+	// SpendCap limits the amount frozen by BID orders placed within the
+	// spend period, except for cancelled ones.
+	SpendCap    tokenAmount   `yaml:"spend_cap"`
+	SpendPeriod time.Duration `yaml:"spend_period" default:"24h"`
+}
+
 type Config struct {
 	Node              nodeConfig          `yaml:"node"`
 	NPP               npp.Config          `yaml:"npp"`
@@ -27,6 +94,8 @@ type Config struct {
 	MetricsListenAddr string              `yaml:"metrics_listen_addr" default:"127.0.0.1:14003"`
 	Benchmarks        benchmarks.Config   `yaml:"benchmarks"`
 	Matcher           *matcher.YAMLConfig `yaml:"matcher"`
+	Accountant        accountantConfig    `yaml:"accountant"`
+	BudgetPolicy      budgetPolicyConfig  `yaml:"budget_policy"`
 }
 
 // NewConfig loads localNode config from given .yaml file
@@ -89,7 +89,24 @@ func (d *dealsAPI) Finish(ctx context.Context, req *pb.DealFinishRequest) (*pb.E
 }
 
 func (d *dealsAPI) Open(ctx context.Context, req *pb.OpenDealRequest) (*pb.Deal, error) {
+	ask, err := d.remotes.eth.Market().GetOrderInfo(ctx, req.GetAskID().Unwrap())
+	if err != nil {
+		return nil, fmt.Errorf("could not get ask order info from blockchain: %s", err)
+	}
+
+	bid, err := d.remotes.eth.Market().GetOrderInfo(ctx, req.GetBidID().Unwrap())
+	if err != nil {
+		return nil, fmt.Errorf("could not get bid order info from blockchain: %s", err)
+	}
+
+	d.remotes.budgetPolicy.Lock()
+	if err := d.remotes.budgetPolicy.CheckDeal(ctx, ask, bid); err != nil {
+		d.remotes.budgetPolicy.Unlock()
+		return nil, err
+	}
+
 	dealOrErr := <-d.remotes.eth.Market().OpenDeal(ctx, d.remotes.key, req.GetAskID().Unwrap(), req.GetBidID().Unwrap())
+	d.remotes.budgetPolicy.Unlock()
 	if dealOrErr.Err != nil {
 		return nil, fmt.Errorf("could not open deal in blockchain: %s", dealOrErr.Err)
 	}
@@ -97,6 +114,56 @@ func (d *dealsAPI) Open(ctx context.Context, req *pb.OpenDealRequest) (*pb.Deal,
 	return dealOrErr.Deal, nil
 }
 
+func (d *dealsAPI) Spending(ctx context.Context, _ *pb.Empty) (*pb.SpendingReply, error) {
+	return d.remotes.accountant.Spending(ctx)
+}
+
+func (d *dealsAPI) ChangeRequestsList(ctx context.Context, id *pb.BigInt) (*pb.DealChangeRequestsReply, error) {
+	return d.remotes.dwh.GetDealChangeRequests(ctx, id)
+}
+
+func (d *dealsAPI) CreateChangeRequest(ctx context.Context, req *pb.DealChangeRequest) (*pb.BigInt, error) {
+	id, err := d.remotes.eth.Market().CreateChangeRequest(ctx, d.remotes.key, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not create change request in blockchain: %s", err)
+	}
+
+	return pb.NewBigInt(id), nil
+}
+
+// ApproveChangeRequest accepts the counterparty's change request by creating
+// a matching one.
+func (d *dealsAPI) ApproveChangeRequest(ctx context.Context, id *pb.BigInt) (*pb.Empty, error) {
+	changeRequest, err := d.remotes.eth.Market().GetDealChangeRequestInfo(ctx, id.Unwrap())
+	if err != nil {
+		return nil, fmt.Errorf("could not get change request info from blockchain: %s", err)
+	}
+
+	if changeRequest.GetStatus() != pb.ChangeRequestStatus_REQUEST_CREATED {
+		return nil, fmt.Errorf("could not approve change request in status %s", changeRequest.GetStatus())
+	}
+
+	matching := &pb.DealChangeRequest{
+		DealID:   changeRequest.GetDealID(),
+		Duration: changeRequest.GetDuration(),
+		Price:    changeRequest.GetPrice(),
+	}
+
+	if _, err := d.remotes.eth.Market().CreateChangeRequest(ctx, d.remotes.key, matching); err != nil {
+		return nil, fmt.Errorf("could not approve change request in blockchain: %s", err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
+func (d *dealsAPI) CancelChangeRequest(ctx context.Context, id *pb.BigInt) (*pb.Empty, error) {
+	if err := d.remotes.eth.Market().CancelChangeRequest(ctx, d.remotes.key, id.Unwrap()); err != nil {
+		return nil, fmt.Errorf("could not cancel change request in blockchain: %s", err)
+	}
+
+	return &pb.Empty{}, nil
+}
+
 func newDealsAPI(opts *remoteOptions) (pb.DealManagementServer, error) {
 	return &dealsAPI{
 		remotes: opts,
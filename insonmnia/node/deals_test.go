@@ -0,0 +1,75 @@
+package node
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDealsAPI(t *testing.T, controller *gomock.Controller) (*dealsAPI, *blockchain.MockMarketAPI) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	market := blockchain.NewMockMarketAPI(controller)
+	eth := blockchain.NewMockAPI(controller)
+	eth.EXPECT().Market().AnyTimes().Return(market)
+
+	return &dealsAPI{ctx: context.Background(), remotes: &remoteOptions{key: key, eth: eth}}, market
+}
+
+func TestApproveChangeRequest(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deals, market := newTestDealsAPI(t, controller)
+
+	request := &sonm.DealChangeRequest{
+		Id:          sonm.NewBigIntFromInt(1),
+		DealID:      sonm.NewBigIntFromInt(10),
+		RequestType: sonm.OrderType_BID,
+		Duration:    7200,
+		Price:       sonm.NewBigIntFromInt(100),
+		Status:      sonm.ChangeRequestStatus_REQUEST_CREATED,
+	}
+	market.EXPECT().GetDealChangeRequestInfo(gomock.Any(), big.NewInt(1)).Return(request, nil)
+	// The request is approved by sending the matching one.
+	market.EXPECT().CreateChangeRequest(gomock.Any(), deals.remotes.key, &sonm.DealChangeRequest{
+		DealID:   request.GetDealID(),
+		Duration: 7200,
+		Price:    request.GetPrice(),
+	}).Return(big.NewInt(2), nil)
+
+	_, err := deals.ApproveChangeRequest(context.Background(), sonm.NewBigIntFromInt(1))
+	assert.NoError(t, err)
+}
+
+func TestApproveChangeRequestInFinalStatus(t *testing.T) {
+	statuses := []sonm.ChangeRequestStatus{
+		sonm.ChangeRequestStatus_REQUEST_ACCEPTED,
+		sonm.ChangeRequestStatus_REQUEST_REJECTED,
+		sonm.ChangeRequestStatus_REQUEST_CANCELED,
+	}
+
+	for _, status := range statuses {
+		t.Run(status.String(), func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			deals, market := newTestDealsAPI(t, controller)
+
+			// No matching request is sent, hence no CreateChangeRequest call.
+			market.EXPECT().GetDealChangeRequestInfo(gomock.Any(), big.NewInt(1)).
+				Return(&sonm.DealChangeRequest{DealID: sonm.NewBigIntFromInt(10), Status: status}, nil)
+
+			_, err := deals.ApproveChangeRequest(context.Background(), sonm.NewBigIntFromInt(1))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/blockchain"
 	pb "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
 	"go.uber.org/zap"
@@ -48,6 +49,89 @@ func (m *marketAPI) GetOrderByID(ctx context.Context, req *pb.ID) (*pb.Order, er
 }
 
 func (m *marketAPI) CreateOrder(ctx context.Context, req *pb.BidOrder) (*pb.Order, error) {
+	order, err := m.newOrder(req)
+	if err != nil {
+		return nil, err
+	}
+
+	m.remotes.budgetPolicy.Lock()
+	if err := m.remotes.budgetPolicy.CheckOrders(ctx, order); err != nil {
+		m.remotes.budgetPolicy.Unlock()
+		return nil, err
+	}
+
+	ordOrErr := <-m.remotes.eth.Market().PlaceOrder(ctx, m.remotes.key, order)
+	m.remotes.budgetPolicy.Unlock()
+	if ordOrErr.Err != nil {
+		return nil, fmt.Errorf("could not place order on blockchain: %s", ordOrErr.Err)
+	}
+
+	m.matchOrder(ordOrErr.Order)
+
+	return ordOrErr.Order, nil
+}
+
+// CreateOrders places all orders at once, so that their transactions are
+// mined together instead of one after another.
+func (m *marketAPI) CreateOrders(ctx context.Context, req *pb.CreateOrdersRequest) (*pb.OrderResultsReply, error) {
+	count := req.GetCount()
+	if count == 0 {
+		count = 1
+	}
+
+	var orders []*pb.Order
+	for _, bid := range req.GetOrders() {
+		for idx := uint64(0); idx < count; idx++ {
+			order, err := m.newOrder(bid)
+			if err != nil {
+				return nil, err
+			}
+
+			orders = append(orders, order)
+		}
+	}
+
+	if len(orders) == 0 {
+		return nil, fmt.Errorf("no orders specified")
+	}
+
+	m.remotes.budgetPolicy.Lock()
+	if err := m.remotes.budgetPolicy.CheckOrders(ctx, orders...); err != nil {
+		m.remotes.budgetPolicy.Unlock()
+		return nil, err
+	}
+
+	placed := make([]<-chan blockchain.OrderOrError, 0, len(orders))
+	for _, order := range orders {
+		placed = append(placed, m.remotes.eth.Market().PlaceOrder(ctx, m.remotes.key, order))
+	}
+
+	results := make([]blockchain.OrderOrError, 0, len(placed))
+	for _, ch := range placed {
+		results = append(results, <-ch)
+	}
+	m.remotes.budgetPolicy.Unlock()
+
+	reply := &pb.OrderResultsReply{Results: []*pb.OrderResult{}}
+	for _, ordOrErr := range results {
+		if ordOrErr.Err != nil {
+			reply.Results = append(reply.Results, &pb.OrderResult{
+				Error: fmt.Sprintf("could not place order on blockchain: %s", ordOrErr.Err),
+			})
+			continue
+		}
+
+		m.matchOrder(ordOrErr.Order)
+		reply.Results = append(reply.Results, &pb.OrderResult{
+			Id:    ordOrErr.Order.GetId(),
+			Order: ordOrErr.Order,
+		})
+	}
+
+	return reply, nil
+}
+
+func (m *marketAPI) newOrder(req *pb.BidOrder) (*pb.Order, error) {
 	knownBenchmarks := m.remotes.benchList.MapByCode()
 	givenBenchmarks := req.GetResources().GetBenchmarks()
 
@@ -75,7 +159,7 @@ func (m *marketAPI) CreateOrder(ctx context.Context, req *pb.BidOrder) (*pb.Orde
 		blacklist = req.GetBlacklist().Unwrap().Hex()
 	}
 
-	order := &pb.Order{
+	return &pb.Order{
 		OrderType:      pb.OrderType_BID,
 		OrderStatus:    pb.OrderStatus_ORDER_ACTIVE,
 		AuthorID:       pb.NewEthAddress(crypto.PubkeyToAddress(m.remotes.key.PublicKey)),
@@ -91,26 +175,22 @@ func (m *marketAPI) CreateOrder(ctx context.Context, req *pb.BidOrder) (*pb.Orde
 		Blacklist:     blacklist,
 		Tag:           []byte(req.GetTag()),
 		Benchmarks:    benchStruct,
-	}
-
-	ordOrErr := <-m.remotes.eth.Market().PlaceOrder(ctx, m.remotes.key, order)
-	if ordOrErr.Err != nil {
-		return nil, fmt.Errorf("could not place order on blockchain: %s", ordOrErr.Err)
-	}
+	}, nil
+}
 
+// matchOrder starts looking for a deal for the placed order in background.
+func (m *marketAPI) matchOrder(order *pb.Order) {
 	go func() {
-		deal, err := m.remotes.orderMatcher.CreateDealByOrder(m.remotes.ctx, ordOrErr.Order)
+		deal, err := m.remotes.orderMatcher.CreateDealByOrder(m.remotes.ctx, order)
 		if err != nil {
 			ctxlog.G(m.remotes.ctx).Warn("cannot open deal", zap.Error(err))
 			return
 		}
 
 		ctxlog.G(m.remotes.ctx).Info("opened deal for order",
-			zap.String("orderID", ordOrErr.Order.Id.Unwrap().String()),
+			zap.String("orderID", order.Id.Unwrap().String()),
 			zap.String("dealID", deal.Id.Unwrap().String()))
 	}()
-
-	return ordOrErr.Order, nil
 }
 
 func (m *marketAPI) CancelOrder(ctx context.Context, req *pb.ID) (*pb.Empty, error) {
@@ -126,6 +206,50 @@ func (m *marketAPI) CancelOrder(ctx context.Context, req *pb.ID) (*pb.Empty, err
 	return &pb.Empty{}, nil
 }
 
+func (m *marketAPI) CancelOrders(ctx context.Context, req *pb.OrderIDs) (*pb.OrderResultsReply, error) {
+	return m.cancelOrders(ctx, req.GetIds()), nil
+}
+
+func (m *marketAPI) PurgeOrders(ctx context.Context, _ *pb.Empty) (*pb.OrderResultsReply, error) {
+	// Only BID orders are purged, because ASK orders of the same account
+	// may be placed by Workers for their ask plans.
+	orders, err := getAllOrders(ctx, m.remotes.dwh, &pb.OrdersRequest{
+		Type:     pb.OrderType_BID,
+		Status:   pb.OrderStatus_ORDER_ACTIVE,
+		AuthorID: pb.NewEthAddress(crypto.PubkeyToAddress(m.remotes.key.PublicKey)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get orders from DWH: %s", err)
+	}
+
+	ids := make([]*pb.BigInt, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.GetOrder().GetId())
+	}
+
+	return m.cancelOrders(ctx, ids), nil
+}
+
+// cancelOrders cancels all orders at once, reporting the result of each.
+func (m *marketAPI) cancelOrders(ctx context.Context, ids []*pb.BigInt) *pb.OrderResultsReply {
+	canceled := make([]<-chan error, 0, len(ids))
+	for _, id := range ids {
+		canceled = append(canceled, m.remotes.eth.Market().CancelOrder(ctx, m.remotes.key, id.Unwrap()))
+	}
+
+	reply := &pb.OrderResultsReply{Results: []*pb.OrderResult{}}
+	for idx, ch := range canceled {
+		result := &pb.OrderResult{Id: ids[idx]}
+		if err := <-ch; err != nil {
+			result.Error = fmt.Sprintf("could not cancel order on blockchain: %s", err)
+		}
+
+		reply.Results = append(reply.Results, result)
+	}
+
+	return reply
+}
+
 func newMarketAPI(opts *remoteOptions) (pb.MarketServer, error) {
 	return &marketAPI{
 		remotes:       opts,
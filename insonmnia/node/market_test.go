@@ -0,0 +1,133 @@
+package node
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain/simulated"
+	"github.com/sonm-io/core/insonmnia/benchmarks"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type noopMatcher struct{}
+
+func (noopMatcher) CreateDealByOrder(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+	return nil, errors.New("no matching orders")
+}
+
+// newTestMarketAPI returns the market API of an account with the given
+// balance, of which only the allowance may be spent by the market.
+func newTestMarketAPI(t *testing.T, controller *gomock.Controller, balance, allowance int64) (*marketAPI, *simulated.Backend, *ecdsa.PrivateKey, *sonm.MockDWHClient) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	backend := simulated.NewBackend(simulated.WithTokenPrice(testRate))
+	backend.MintSidechain(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(balance))
+	_, err = backend.SideToken().Approve(context.Background(), key, backend.MarketAddress().Hex(), big.NewInt(allowance))
+	require.NoError(t, err)
+
+	benchmarksByCode := map[string]*sonm.Benchmark{}
+	for id := uint64(0); id < sonm.MinNumBenchmarks; id++ {
+		code := fmt.Sprintf("benchmark-%d", id)
+		benchmarksByCode[code] = &sonm.Benchmark{ID: id, Code: code}
+	}
+	benchList := benchmarks.NewMockBenchList(controller)
+	benchList.EXPECT().MapByCode().Return(benchmarksByCode).AnyTimes()
+
+	dwh := sonm.NewMockDWHClient(controller)
+	remotes := &remoteOptions{
+		ctx:          context.Background(),
+		key:          key,
+		eth:          backend,
+		dwh:          dwh,
+		benchList:    benchList,
+		orderMatcher: noopMatcher{},
+	}
+	remotes.budgetPolicy = newBudgetPolicy(budgetPolicyConfig{}, remotes)
+
+	return &marketAPI{remotes: remotes, ctx: remotes.ctx}, backend, key, dwh
+}
+
+func TestCreateOrdersReportsEachResult(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	// Both spot orders fit the balance, but the market may take funds for
+	// only one of them.
+	market, backend, _, _ := newTestMarketAPI(t, controller, 7200, 3600)
+
+	reply, err := market.CreateOrders(context.Background(), &sonm.CreateOrdersRequest{
+		Orders: []*sonm.BidOrder{{Price: &sonm.Price{PerSecond: sonm.NewBigIntFromInt(1)}}},
+		Count:  2,
+	})
+	require.NoError(t, err)
+	require.Len(t, reply.GetResults(), 2)
+
+	placed := reply.GetResults()[0]
+	assert.Empty(t, placed.GetError())
+	assert.Equal(t, placed.GetId(), placed.GetOrder().GetId())
+
+	order, err := backend.Market().GetOrderInfo(context.Background(), placed.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, sonm.OrderStatus_ORDER_ACTIVE, order.GetOrderStatus())
+
+	failed := reply.GetResults()[1]
+	assert.NotEmpty(t, failed.GetError())
+	assert.Nil(t, failed.GetId())
+	assert.Nil(t, failed.GetOrder())
+}
+
+func TestPurgeOrdersCancelsOnlyBids(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	market, backend, key, dwh := newTestMarketAPI(t, controller, 7200, 7200)
+
+	order := func(orderType sonm.OrderType) *sonm.Order {
+		placed := <-backend.Market().PlaceOrder(ctx, key, &sonm.Order{
+			OrderType:  orderType,
+			Price:      sonm.NewBigIntFromInt(1),
+			Benchmarks: &sonm.Benchmarks{Values: make([]uint64, sonm.MinNumBenchmarks)},
+		})
+		require.NoError(t, placed.Err)
+		return placed.Order
+	}
+	ask := order(sonm.OrderType_ASK)
+	bids := []*sonm.DWHOrder{{Order: order(sonm.OrderType_BID)}, {Order: order(sonm.OrderType_BID)}}
+
+	dwh.EXPECT().GetOrders(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *sonm.OrdersRequest) (*sonm.DWHOrdersReply, error) {
+			// ASK orders of the same account may be placed by Workers.
+			assert.Equal(t, sonm.OrderType_BID, request.GetType())
+			assert.Equal(t, sonm.OrderStatus_ORDER_ACTIVE, request.GetStatus())
+			assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), request.GetAuthorID().Unwrap())
+			return &sonm.DWHOrdersReply{Orders: bids}, nil
+		})
+
+	reply, err := market.PurgeOrders(ctx, &sonm.Empty{})
+	require.NoError(t, err)
+	require.Len(t, reply.GetResults(), 2)
+
+	for idx, result := range reply.GetResults() {
+		assert.Equal(t, bids[idx].GetOrder().GetId(), result.GetId())
+		assert.Empty(t, result.GetError())
+
+		bid, err := backend.Market().GetOrderInfo(ctx, result.GetId().Unwrap())
+		require.NoError(t, err)
+		assert.Equal(t, sonm.OrderStatus_ORDER_INACTIVE, bid.GetOrderStatus())
+	}
+
+	ask, err = backend.Market().GetOrderInfo(ctx, ask.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, sonm.OrderStatus_ORDER_ACTIVE, ask.GetOrderStatus())
+}
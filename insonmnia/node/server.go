@@ -42,6 +42,8 @@ type remoteOptions struct {
 	workerCreator workerClientCreator
 	benchList     benchmarks.BenchList
 	orderMatcher  matcher.Matcher
+	accountant    *accountant
+	budgetPolicy  *budgetPolicy
 }
 
 func (re *remoteOptions) getWorkerClientForDeal(ctx context.Context, id string) (*workerClient, io.Closer, error) {
@@ -123,12 +125,18 @@ func newRemoteOptions(ctx context.Context, key *ecdsa.PrivateKey, cfg *Config, c
 
 	var orderMatcher matcher.Matcher
 	if cfg.Matcher != nil {
+		strategy, err := matcher.NewStrategy(cfg.Matcher.Strategy, dwh)
+		if err != nil {
+			return nil, err
+		}
+
 		orderMatcher, err = matcher.NewMatcher(&matcher.Config{
 			Key:        key,
 			DWH:        dwh,
 			Eth:        eth,
 			PollDelay:  cfg.Matcher.PollDelay,
 			QueryLimit: cfg.Matcher.QueryLimit,
+			Strategy:   strategy,
 		})
 
 		if err != nil {
@@ -138,7 +146,7 @@ func newRemoteOptions(ctx context.Context, key *ecdsa.PrivateKey, cfg *Config, c
 		orderMatcher = matcher.NewDisabledMatcher()
 	}
 
-	return &remoteOptions{
+	opts := &remoteOptions{
 		ctx:           ctx,
 		key:           key,
 		eth:           eth,
@@ -146,7 +154,11 @@ func newRemoteOptions(ctx context.Context, key *ecdsa.PrivateKey, cfg *Config, c
 		workerCreator: workerFactory,
 		benchList:     benchList,
 		orderMatcher:  orderMatcher,
-	}, nil
+	}
+	opts.accountant = newAccountant(cfg.Accountant, opts)
+	opts.budgetPolicy = newBudgetPolicy(cfg.BudgetPolicy, opts)
+
+	return opts, nil
 }
 
 // Node is LocalNode instance
@@ -161,13 +173,16 @@ type Node struct {
 	srv     *grpc.Server
 
 	// services, responsible for request handling
-	worker    pb.WorkerManagementServer
-	market    pb.MarketServer
-	deals     pb.DealManagementServer
-	tasks     pb.TaskManagementServer
-	master    pb.MasterManagementServer
-	token     pb.TokenManagementServer
-	blacklist pb.BlacklistServer
+	worker       pb.WorkerManagementServer
+	market       pb.MarketServer
+	deals        pb.DealManagementServer
+	tasks        pb.TaskManagementServer
+	master       pb.MasterManagementServer
+	token        pb.TokenManagementServer
+	blacklist    pb.BlacklistServer
+	transactions pb.TransactionManagementServer
+
+	accountant *accountant
 }
 
 // New creates new Local Node instance
@@ -206,6 +221,7 @@ func New(ctx context.Context, config *Config, key *ecdsa.PrivateKey) (*Node, err
 	masterMgmt := newMasterManagementAPI(opts)
 	tokenMgmt := newTokenManagementAPI(opts)
 	blacklist := newBlacklistAPI(opts)
+	transactions := newTransactionsAPI(opts)
 
 	grpcServerOpts := []xgrpc.ServerOption{
 		xgrpc.DefaultTraceInterceptor(),
@@ -242,21 +258,26 @@ func New(ctx context.Context, config *Config, key *ecdsa.PrivateKey) (*Node, err
 	pb.RegisterBlacklistServer(srv, blacklist)
 	log.G(ctx).Info("blacklist management service registered")
 
+	pb.RegisterTransactionManagementServer(srv, transactions)
+	log.G(ctx).Info("transaction management service registered")
+
 	grpc_prometheus.Register(srv)
 
 	return &Node{
-		privKey:   key,
-		cfg:       config,
-		ctx:       ctx,
-		cancel:    cancel,
-		srv:       srv,
-		worker:    worker,
-		market:    market,
-		deals:     deals,
-		tasks:     tasks,
-		master:    masterMgmt,
-		token:     tokenMgmt,
-		blacklist: blacklist,
+		privKey:      key,
+		cfg:          config,
+		ctx:          ctx,
+		cancel:       cancel,
+		srv:          srv,
+		worker:       worker,
+		market:       market,
+		deals:        deals,
+		tasks:        tasks,
+		master:       masterMgmt,
+		token:        tokenMgmt,
+		blacklist:    blacklist,
+		transactions: transactions,
+		accountant:   opts.accountant,
 	}, nil
 }
 
@@ -277,6 +298,9 @@ func (n *Node) Serve() error {
 	wg := errgroup.Group{}
 	wg.Go(n.ServeHttp)
 	wg.Go(n.ServeGRPC)
+	wg.Go(func() error {
+		return n.accountant.Run(n.ctx)
+	})
 
 	return wg.Wait()
 }
@@ -374,6 +398,10 @@ func (n *Node) serveHttp() error {
 	if err != nil {
 		return err
 	}
+	err = srv.RegisterService((*pb.TransactionManagementServer)(nil), n.transactions)
+	if err != nil {
+		return err
+	}
 
 	n.httpSrv = srv
 	return srv.Serve()
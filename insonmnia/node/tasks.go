@@ -155,6 +155,29 @@ func (t *tasksAPI) PushTask(clientStream pb.TaskManagement_PushTaskServer) error
 	bytesCommitted := int64(0)
 	clientCompleted := false
 
+	// Resumable pushes start with the header carrying the offset the worker
+	// continues from.
+	if len(meta.digest) != 0 {
+		header, err := workerStream.Header()
+		if err != nil {
+			return fmt.Errorf("failed to receive meta from worker: %s", err)
+		}
+
+		offsets := header["offset"]
+		if len(offsets) == 0 {
+			return status.Errorf(codes.Internal, "worker has not reported push offset")
+		}
+
+		bytesCommitted, err = strconv.ParseInt(offsets[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse push offset: %s", err)
+		}
+
+		if err := clientStream.SendHeader(header); err != nil {
+			return fmt.Errorf("failed to send meta to client: %s", err)
+		}
+	}
+
 	for {
 		bytesRemaining := 0
 		if !clientCompleted {
@@ -217,6 +240,16 @@ func (t *tasksAPI) PushTask(clientStream pb.TaskManagement_PushTaskServer) error
 	}
 }
 
+func (t *tasksAPI) ImageLayers(ctx context.Context, request *pb.ImageLayersRequest) (*pb.ImageLayersReply, error) {
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(ctx, request.GetDealId())
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	return workerClient.ImageLayers(ctx, request)
+}
+
 func (t *tasksAPI) PullTask(req *pb.PullTaskRequest, srv pb.TaskManagement_PullTaskServer) error {
 	ctx := context.Background()
 	worker, cc, err := t.remotes.getWorkerClientForDeal(ctx, req.GetDealId())
@@ -263,6 +296,186 @@ type streamMeta struct {
 	ctx      context.Context
 	dealID   string
 	fileSize int64
+	digest   string
+}
+
+func (t *tasksAPI) Exec(clientStream pb.TaskManagement_ExecServer) error {
+	request, err := clientStream.Recv()
+	if err != nil {
+		return err
+	}
+
+	log.G(t.ctx).Info("handling Exec request", zap.String("task_id", request.GetId()))
+
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(clientStream.Context(), request.GetDealID().Unwrap().String())
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	workerStream, err := workerClient.Exec(clientStream.Context())
+	if err != nil {
+		return fmt.Errorf("failed to start exec on worker: %s", err)
+	}
+
+	if err := workerStream.Send(request); err != nil {
+		return fmt.Errorf("failed to send exec request to worker: %s", err)
+	}
+
+	go func() {
+		for {
+			request, err := clientStream.Recv()
+			if err != nil {
+				workerStream.CloseSend()
+				return
+			}
+
+			if err := workerStream.Send(request); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		reply, err := workerStream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := clientStream.Send(reply); err != nil {
+			return fmt.Errorf("failed to send exec output to client: %s", err)
+		}
+	}
+}
+
+func (t *tasksAPI) CopyTo(clientStream pb.TaskManagement_CopyToServer) error {
+	request, err := clientStream.Recv()
+	if err != nil {
+		return err
+	}
+
+	log.G(t.ctx).Info("handling CopyTo request", zap.String("task_id", request.GetId()), zap.String("path", request.GetPath()))
+
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(clientStream.Context(), request.GetDealID().Unwrap().String())
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	workerStream, err := workerClient.CopyTo(clientStream.Context())
+	if err != nil {
+		return fmt.Errorf("failed to start copying to worker: %s", err)
+	}
+
+	for {
+		if err := workerStream.Send(request); err != nil {
+			return fmt.Errorf("failed to send chunk to worker: %s", err)
+		}
+
+		request, err = clientStream.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to receive chunk from client: %s", err)
+		}
+	}
+
+	reply, err := workerStream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	return clientStream.SendAndClose(reply)
+}
+
+func (t *tasksAPI) CopyFrom(req *pb.CopyFromRequest, srv pb.TaskManagement_CopyFromServer) error {
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(srv.Context(), req.GetDealID().Unwrap().String())
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	copyClient, err := workerClient.CopyFrom(srv.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to start copying from worker: %s", err)
+	}
+
+	for {
+		chunk, err := copyClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failure during receiving chunk from worker: %s", err)
+		}
+
+		if err := srv.Send(chunk); err != nil {
+			return fmt.Errorf("failed to send chunk to client: %s", err)
+		}
+	}
+}
+
+func (t *tasksAPI) Watch(req *pb.WatchTasksRequest, srv pb.TaskManagement_WatchServer) error {
+	if req.GetDealID().IsZero() {
+		return status.Error(codes.InvalidArgument, "deal ID is required")
+	}
+
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(srv.Context(), req.GetDealID().Unwrap().String())
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	watchClient, err := workerClient.WatchTasks(srv.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to watch tasks on worker: %s", err)
+	}
+
+	for {
+		event, err := watchClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := srv.Send(event); err != nil {
+			return fmt.Errorf("failed to send task event to client: %s", err)
+		}
+	}
+}
+
+func (t *tasksAPI) Commit(ctx context.Context, req *pb.CommitTaskRequest) (*pb.TaskSnapshot, error) {
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(ctx, req.GetDealID().Unwrap().String())
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	return workerClient.CommitTask(ctx, req)
+}
+
+func (t *tasksAPI) Snapshots(ctx context.Context, req *pb.TaskSnapshotsRequest) (*pb.TaskSnapshotsReply, error) {
+	if req.GetDealID().IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "deal ID is required")
+	}
+
+	workerClient, cc, err := t.remotes.getWorkerClientForDeal(ctx, req.GetDealID().Unwrap().String())
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+
+	return workerClient.TaskSnapshots(ctx, req)
 }
 
 func (t *tasksAPI) extractStreamMeta(clientStream pb.TaskManagement_PushTaskServer) (*streamMeta, error) {
@@ -281,10 +494,19 @@ func (t *tasksAPI) extractStreamMeta(clientStream pb.TaskManagement_PushTaskServ
 		return nil, status.Errorf(codes.InvalidArgument, "`%s` required", "size")
 	}
 
-	ctx := metadata.NewOutgoingContext(context.Background(), metadata.New(map[string]string{
+	forwarded := map[string]string{
 		"deal": dealIDs[0],
 		"size": sizes[0],
-	}))
+	}
+
+	// Digest is optional and makes the push resumable.
+	digest := ""
+	if digests, ok := md["digest"]; ok && len(digests) > 0 {
+		digest = digests[0]
+		forwarded["digest"] = digest
+	}
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.New(forwarded))
 
 	v, _ := strconv.ParseInt(sizes[0], 10, 64)
 
@@ -292,6 +514,7 @@ func (t *tasksAPI) extractStreamMeta(clientStream pb.TaskManagement_PushTaskServ
 		ctx:      ctx,
 		dealID:   dealIDs[0],
 		fileSize: v,
+		digest:   digest,
 	}, nil
 }
 
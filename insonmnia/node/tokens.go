@@ -1,14 +1,37 @@
 package node
 
 import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
+	"github.com/sonm-io/core/blockchain"
 	"github.com/sonm-io/core/proto"
 	"golang.org/x/net/context"
 )
 
 type tokenAPI struct {
 	remotes *remoteOptions
+
+	mu          sync.Mutex
+	deposits    *transferLog
+	withdrawals *transferLog
+}
+
+// transferLog holds transfers of the node's key in one direction, rebuilt
+// from gatekeepers' logs. Blocks already searched are remembered, so each
+// lookup searches only new ones.
+type transferLog struct {
+	direction sonm.TokenTransferDirection
+	transfers []*sonm.TokenTransfer
+	// payInBlock is the next block of the source chain to search for
+	// pay-ins, and payoutBlock is the one of the destination chain to search
+	// for payouts.
+	payInBlock  uint64
+	payoutBlock uint64
 }
 
 func (t *tokenAPI) TestTokens(ctx context.Context, _ *sonm.Empty) (*sonm.Empty, error) {
@@ -38,6 +61,194 @@ func (t *tokenAPI) Balance(ctx context.Context, _ *sonm.Empty) (*sonm.BalanceRep
 	}, nil
 }
 
+func (t *tokenAPI) Deposit(ctx context.Context, amount *sonm.BigInt) (*sonm.TokenTransfer, error) {
+	return t.transfer(ctx, sonm.TokenTransferDirection_DEPOSIT, amount)
+}
+
+func (t *tokenAPI) Withdraw(ctx context.Context, amount *sonm.BigInt) (*sonm.TokenTransfer, error) {
+	return t.transfer(ctx, sonm.TokenTransferDirection_WITHDRAWAL, amount)
+}
+
+func (t *tokenAPI) Transfers(ctx context.Context, _ *sonm.Empty) (*sonm.TokenTransfersReply, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	reply := &sonm.TokenTransfersReply{Transfers: []*sonm.TokenTransfer{}}
+	for _, transfers := range []*transferLog{t.deposits, t.withdrawals} {
+		if err := t.sync(ctx, transfers); err != nil {
+			return nil, errors.Wrap(err, "cannot load transfers")
+		}
+
+		for _, transfer := range transfers.transfers {
+			transfer := *transfer
+			reply.Transfers = append(reply.Transfers, &transfer)
+		}
+	}
+
+	sort.SliceStable(reply.Transfers, func(i, j int) bool {
+		return reply.Transfers[i].GetCreatedAt().GetSeconds() < reply.Transfers[j].GetCreatedAt().GetSeconds()
+	})
+
+	return reply, nil
+}
+
+// sync searches gatekeepers' logs for transfers of the node's key made and
+// completed since the last search.
+func (t *tokenAPI) sync(ctx context.Context, transfers *transferLog) error {
+	addr := crypto.PubkeyToAddress(t.remotes.key.PublicKey)
+	source, destination := t.gatekeepers(transfers.direction)
+
+	payIns, lastBlock, err := source.PayIns(ctx, addr, transfers.payInBlock)
+	if err != nil {
+		return err
+	}
+
+	for _, payIn := range payIns {
+		transfers.transfers = append(transfers.transfers, &sonm.TokenTransfer{
+			Direction: transfers.direction,
+			Amount:    sonm.NewBigInt(payIn.Value),
+			TxNumber:  sonm.NewBigInt(payIn.TxNumber),
+			Status:    sonm.TokenTransferStatus_TRANSFER_PENDING,
+			CreatedAt: &sonm.Timestamp{Seconds: int64(payIn.TS)},
+		})
+	}
+	transfers.payInBlock = lastBlock + 1
+
+	payouts, lastBlock, err := destination.Payouts(ctx, addr, transfers.payoutBlock)
+	if err != nil {
+		return err
+	}
+
+	// Gatekeepers reset their transaction counters from time to time, hence
+	// the value is matched as well.
+	for _, payout := range payouts {
+		for _, transfer := range transfers.transfers {
+			if transfer.GetStatus() == sonm.TokenTransferStatus_TRANSFER_PENDING &&
+				transfer.GetTxNumber().Unwrap().Cmp(payout.TxNumber) == 0 &&
+				transfer.GetAmount().Unwrap().Cmp(payout.Value) == 0 {
+				transfer.Status = sonm.TokenTransferStatus_TRANSFER_COMPLETED
+				break
+			}
+		}
+	}
+	transfers.payoutBlock = lastBlock + 1
+
+	return nil
+}
+
+func (t *tokenAPI) Transfer(ctx context.Context, request *sonm.TokenTransferRequest) (*sonm.TokenTransactionReply, error) {
+	if request.GetTo().IsZero() {
+		return nil, errors.New("recipient address is required")
+	}
+	if request.GetAmount() == nil || request.GetAmount().Unwrap().Sign() <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	tx, err := t.token(request.GetChain()).Transfer(ctx, t.remotes.key, request.GetTo().Unwrap().Hex(), request.GetAmount().Unwrap())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot transfer tokens")
+	}
+
+	return t.wait(ctx, tx)
+}
+
+func (t *tokenAPI) Approve(ctx context.Context, request *sonm.TokenApproveRequest) (*sonm.TokenTransactionReply, error) {
+	if request.GetSpender().IsZero() {
+		return nil, errors.New("spender address is required")
+	}
+	if request.GetAmount() == nil || request.GetAmount().Unwrap().Sign() < 0 {
+		return nil, errors.New("amount must not be negative")
+	}
+
+	tx, err := t.token(request.GetChain()).Approve(ctx, t.remotes.key, request.GetSpender().Unwrap().Hex(), request.GetAmount().Unwrap())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot approve tokens")
+	}
+
+	return t.wait(ctx, tx)
+}
+
+func (t *tokenAPI) Allowance(ctx context.Context, request *sonm.TokenAllowanceRequest) (*sonm.TokenAllowanceReply, error) {
+	if request.GetSpender().IsZero() {
+		return nil, errors.New("spender address is required")
+	}
+
+	owner := crypto.PubkeyToAddress(t.remotes.key.PublicKey)
+	if !request.GetOwner().IsZero() {
+		owner = request.GetOwner().Unwrap()
+	}
+
+	allowance, err := t.token(request.GetChain()).AllowanceOf(ctx, owner.Hex(), request.GetSpender().Unwrap().Hex())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get allowance")
+	}
+
+	return &sonm.TokenAllowanceReply{Allowance: sonm.NewBigInt(allowance)}, nil
+}
+
+func (t *tokenAPI) token(chain sonm.TokenChain) blockchain.TokenAPI {
+	if chain == sonm.TokenChain_LIVE_CHAIN {
+		return t.remotes.eth.LiveToken()
+	}
+
+	return t.remotes.eth.SideToken()
+}
+
+func (t *tokenAPI) wait(ctx context.Context, tx *types.Transaction) (*sonm.TokenTransactionReply, error) {
+	receipt, err := t.remotes.eth.Transactions().Wait(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "transaction %s is not confirmed", tx.Hash().Hex())
+	}
+
+	// The transaction may have been re-sent with a higher gas price.
+	return &sonm.TokenTransactionReply{Hash: receipt.TxHash.Hex()}, nil
+}
+
+func (t *tokenAPI) transfer(ctx context.Context, direction sonm.TokenTransferDirection, amount *sonm.BigInt) (*sonm.TokenTransfer, error) {
+	if amount == nil || amount.Unwrap().Sign() <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	token := t.remotes.eth.LiveToken()
+	if direction == sonm.TokenTransferDirection_WITHDRAWAL {
+		token = t.remotes.eth.SideToken()
+	}
+	source, _ := t.gatekeepers(direction)
+
+	// Transactions of the key are mined in order, so there is no need to
+	// wait for the approval before paying in.
+	if _, err := token.Approve(ctx, t.remotes.key, source.Address().Hex(), amount.Unwrap()); err != nil {
+		return nil, errors.Wrap(err, "cannot approve gatekeeper to spend tokens")
+	}
+
+	txNumber, err := source.PayIn(ctx, t.remotes.key, amount.Unwrap())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot pay in tokens to gatekeeper")
+	}
+
+	return &sonm.TokenTransfer{
+		Direction: direction,
+		Amount:    amount,
+		TxNumber:  sonm.NewBigInt(txNumber),
+		Status:    sonm.TokenTransferStatus_TRANSFER_PENDING,
+		CreatedAt: &sonm.Timestamp{Seconds: time.Now().Unix()},
+	}, nil
+}
+
+// gatekeepers returns gatekeepers tokens are paid in to and paid out from
+// for the given direction.
+func (t *tokenAPI) gatekeepers(direction sonm.TokenTransferDirection) (blockchain.GatekeeperAPI, blockchain.GatekeeperAPI) {
+	if direction == sonm.TokenTransferDirection_WITHDRAWAL {
+		return t.remotes.eth.SideGatekeeper(), t.remotes.eth.LiveGatekeeper()
+	}
+
+	return t.remotes.eth.LiveGatekeeper(), t.remotes.eth.SideGatekeeper()
+}
+
 func newTokenManagementAPI(opts *remoteOptions) sonm.TokenManagementServer {
-	return &tokenAPI{remotes: opts}
+	return &tokenAPI{
+		remotes:     opts,
+		deposits:    &transferLog{direction: sonm.TokenTransferDirection_DEPOSIT},
+		withdrawals: &transferLog{direction: sonm.TokenTransferDirection_WITHDRAWAL},
+	}
 }
@@ -0,0 +1,43 @@
+package node
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sonm-io/core/blockchain/simulated"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenTransfersSurviveRestart(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	backend := simulated.NewBackend()
+	backend.MintLive(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000))
+	backend.MintSidechain(simulated.DefaultSideGatekeeperAddress, big.NewInt(1000))
+
+	remotes := &remoteOptions{key: key, eth: backend}
+	_, err = newTokenManagementAPI(remotes).Deposit(ctx, sonm.NewBigInt(big.NewInt(400)))
+	require.NoError(t, err)
+
+	// Another instance stands in for the restarted node.
+	tokens := newTokenManagementAPI(remotes)
+	reply, err := tokens.Transfers(ctx, &sonm.Empty{})
+	require.NoError(t, err)
+	require.Len(t, reply.GetTransfers(), 1)
+	assert.Equal(t, sonm.TokenTransferDirection_DEPOSIT, reply.GetTransfers()[0].GetDirection())
+	assert.Equal(t, big.NewInt(400), reply.GetTransfers()[0].GetAmount().Unwrap())
+	assert.Equal(t, sonm.TokenTransferStatus_TRANSFER_PENDING, reply.GetTransfers()[0].GetStatus())
+
+	require.NoError(t, backend.RelayTransfers())
+
+	reply, err = tokens.Transfers(ctx, &sonm.Empty{})
+	require.NoError(t, err)
+	require.Len(t, reply.GetTransfers(), 1)
+	assert.Equal(t, sonm.TokenTransferStatus_TRANSFER_COMPLETED, reply.GetTransfers()[0].GetStatus())
+}
@@ -0,0 +1,31 @@
+package node
+
+import (
+	"github.com/sonm-io/core/proto"
+	"golang.org/x/net/context"
+)
+
+type transactionsAPI struct {
+	remotes *remoteOptions
+}
+
+func newTransactionsAPI(opts *remoteOptions) sonm.TransactionManagementServer {
+	return &transactionsAPI{remotes: opts}
+}
+
+func (t *transactionsAPI) Pending(ctx context.Context, _ *sonm.Empty) (*sonm.PendingTransactionsReply, error) {
+	reply := &sonm.PendingTransactionsReply{Transactions: []*sonm.PendingTransaction{}}
+	for _, tx := range t.remotes.eth.Transactions().Pending() {
+		reply.Transactions = append(reply.Transactions, &sonm.PendingTransaction{
+			Chain:        tx.Chain,
+			Hash:         tx.Hash.Hex(),
+			From:         sonm.NewEthAddress(tx.From),
+			Nonce:        tx.Nonce,
+			GasPrice:     sonm.NewBigInt(tx.GasPrice),
+			SentAt:       &sonm.Timestamp{Seconds: tx.SentAt.Unix()},
+			Replacements: uint64(tx.Replacements),
+		})
+	}
+
+	return reply, nil
+}
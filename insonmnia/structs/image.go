@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/xdocker"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -14,6 +15,7 @@ type ImagePush struct {
 
 	dealId    string
 	imageSize int64
+	digest    string
 }
 
 func requireHeader(md metadata.MD, name string) (string, error) {
@@ -55,7 +57,17 @@ func NewImagePush(stream sonm.Worker_PushTaskServer) (*ImagePush, error) {
 		return nil, err
 	}
 
-	return &ImagePush{stream, dealId, imageSize}, nil
+	// Digest is optional for compatibility with older clients, which
+	// neither verify nor resume pushes.
+	digest := ""
+	if value, ok := md["digest"]; ok && len(value) > 0 {
+		digest = value[len(value)-1]
+		if err := xdocker.ValidateDigest(digest); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+
+	return &ImagePush{stream, dealId, imageSize, digest}, nil
 }
 
 func (p *ImagePush) DealId() string {
@@ -65,3 +77,8 @@ func (p *ImagePush) DealId() string {
 func (p *ImagePush) ImageSize() int64 {
 	return p.imageSize
 }
+
+// Digest returns the expected digest of the image archive, if specified.
+func (p *ImagePush) Digest() string {
+	return p.digest
+}
@@ -2,6 +2,7 @@ package structs
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/sonm-io/core/proto"
 )
@@ -9,8 +10,18 @@ import (
 var (
 	errDealRequired   = errors.New("deal is required")
 	errDealIdRequired = errors.New("deal id must be non-empty")
+	errStopTimeout    = errors.New("container stop timeout must be non-negative")
+	errRetryCount     = errors.New("maximum retry count can be specified only for \"on-failure\" restart policy")
 )
 
+var restartPolicies = map[string]bool{
+	"":               true,
+	"no":             true,
+	"always":         true,
+	"unless-stopped": true,
+	"on-failure":     true,
+}
+
 type StartTaskRequest struct {
 	*sonm.StartTaskRequest
 }
@@ -25,6 +36,14 @@ func NewStartTaskRequest(request *sonm.StartTaskRequest) (*StartTaskRequest, err
 		return nil, errDealIdRequired
 	}
 
+	if request.GetContainer().GetStopTimeout().GetNanoseconds() < 0 {
+		return nil, errStopTimeout
+	}
+
+	if err := validateRestartPolicy(request.GetContainer().GetRestartPolicy()); err != nil {
+		return nil, err
+	}
+
 	return &StartTaskRequest{request}, nil
 }
 
@@ -35,3 +54,19 @@ func (r *StartTaskRequest) GetDeal() *sonm.Deal {
 func (r *StartTaskRequest) GetDealId() string {
 	return r.GetDeal().GetId().Unwrap().String()
 }
+
+func validateRestartPolicy(policy *sonm.ContainerRestartPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	if !restartPolicies[policy.GetName()] {
+		return fmt.Errorf("unknown restart policy: %s", policy.GetName())
+	}
+
+	if policy.GetMaximumRetryCount() > 0 && policy.GetName() != "on-failure" {
+		return errRetryCount
+	}
+
+	return nil
+}
@@ -162,6 +162,21 @@ func newFromNamedTaskDealExtractor(worker *Worker, name string) DealExtractor {
 	}
 }
 
+// newWatchTasksDealExtractor extracts the deal from the WatchTasks request,
+// taking it either directly or from the task specified.
+func newWatchTasksDealExtractor(worker *Worker) DealExtractor {
+	fromTask := newFromTaskDealExtractor(worker)
+
+	return func(ctx context.Context, request interface{}) (structs.DealID, error) {
+		dealID := request.(*sonm.WatchTasksRequest).GetDealID()
+		if !dealID.IsZero() {
+			return structs.DealID(dealID.Unwrap().String()), nil
+		}
+
+		return fromTask(ctx, request)
+	}
+}
+
 func newRequestDealExtractor(fn func(request interface{}) (structs.DealID, error)) DealExtractor {
 	return newCustomDealExtractor(func(ctx context.Context, request interface{}) (structs.DealID, error) {
 		return fn(request)
@@ -1,6 +1,10 @@
 package worker
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jinzhu/configor"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -30,6 +34,40 @@ type WhitelistConfig struct {
 	Enabled             *bool    `yaml:"enabled" default:"true" required:"true"`
 	PrivilegedAddresses []string `yaml:"privileged_addresses"`
 	RefreshPeriod       uint     `yaml:"refresh_period" default:"60"`
+	// Signer is the address whitelist documents must be signed with.
+	// Only unsigned whitelists are accepted when empty.
+	Signer *common.Address `yaml:"signer"`
+}
+
+// GatewayConfig describes the port range used to publish task ports through
+// IPVS virtual services instead of Docker host ports.
+type GatewayConfig struct {
+	// Ports describes the [from, to) public port range.
+	Ports []uint16 `yaml:"ports" required:"true"`
+}
+
+func (m *GatewayConfig) validate() error {
+	if len(m.Ports) != 2 {
+		return errors.New("gateway port range must be specified as [from, to)")
+	}
+
+	if m.Ports[0] == 0 || m.Ports[0] >= m.Ports[1] {
+		return fmt.Errorf("invalid gateway port range [%d, %d)", m.Ports[0], m.Ports[1])
+	}
+
+	return nil
+}
+
+// DiskQuotaConfig describes how storage purchased with deals is enforced.
+type DiskQuotaConfig struct {
+	// StorageOpt enables limiting container writable layers with the "size"
+	// storage option. Requires overlay2 storage driver backed by XFS mounted
+	// with "pquota" option.
+	StorageOpt bool `yaml:"storage_opt" default:"false"`
+	// CheckPeriod is how often disk usage of containers is collected. Tasks
+	// of deals exceeding the purchased storage are stopped. Zero disables
+	// checking.
+	CheckPeriod time.Duration `yaml:"check_period" default:"1m"`
 }
 
 type DevConfig struct {
@@ -44,8 +82,11 @@ type Config struct {
 	NPP               npp.Config          `yaml:"npp"`
 	SSH               *SSHConfig          `yaml:"ssh" required:"false" `
 	PublicIPs         []string            `yaml:"public_ip_addrs" required:"false" `
+	Gateway           *GatewayConfig      `yaml:"gateway" required:"false"`
 	Plugins           plugin.Config       `yaml:"plugins"`
+	DiskQuota         DiskQuotaConfig     `yaml:"disk_quota"`
 	Storage           state.StorageConfig `yaml:"store"`
+	TransferDir       string              `yaml:"transfer_dir" default:"/var/lib/sonm/transfers"`
 	Benchmarks        benchmarks.Config   `yaml:"benchmarks"`
 	Whitelist         WhitelistConfig     `yaml:"whitelist"`
 	MetricsListenAddr string              `yaml:"metrics_listen_addr" default:"127.0.0.1:14000"`
@@ -67,5 +108,11 @@ func NewConfig(path string) (*Config, error) {
 		return nil, err
 	}
 
+	if cfg.Gateway != nil {
+		if err := cfg.Gateway.validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	return cfg, nil
 }
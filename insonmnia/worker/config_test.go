@@ -116,3 +116,34 @@ plugins:
 	assert.True(t, conf.Plugins.Overlay.Drivers.L2TP.Enabled)
 	assert.True(t, conf.Plugins.Overlay.Drivers.Tinc.Enabled)
 }
+
+func TestConfigGateway(t *testing.T) {
+	defer deleteTestConfigFile()
+	raw := `
+endpoint: "127.0.0.5:15010"
+master: 0x0000000000000000000000000000000000000001
+gateway:
+  ports: [32768, 33768]
+`
+	err := createTestConfigFile(raw)
+	assert.Nil(t, err)
+
+	conf, err := NewConfig(testWorkerConfigPath)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint16{32768, 33768}, conf.Gateway.Ports)
+}
+
+func TestConfigGatewayInvalidPortRange(t *testing.T) {
+	defer deleteTestConfigFile()
+	raw := `
+endpoint: "127.0.0.5:15010"
+master: 0x0000000000000000000000000000000000000001
+gateway:
+  ports: [33768, 32768]
+`
+	err := createTestConfigFile(raw)
+	assert.Nil(t, err)
+
+	_, err = NewConfig(testWorkerConfigPath)
+	assert.Error(t, err)
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"github.com/sonm-io/core/insonmnia/worker/plugin"
 	"github.com/sonm-io/core/util/multierror"
@@ -29,11 +31,12 @@ type containerDescriptor struct {
 	CommitedImageID string
 	description     Description
 	stats           types.StatsJSON
+	diskUsage       uint64
 
 	cleanup plugin.Cleanup
 }
 
-func newContainer(ctx context.Context, dockerClient *client.Client, d Description, tuners *plugin.Repository) (*containerDescriptor, error) {
+func newContainer(ctx context.Context, dockerClient *client.Client, d Description, tuners *plugin.Repository, storageOpt bool) (*containerDescriptor, error) {
 	log.G(ctx).Info("start container with application")
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -44,7 +47,8 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 		description: d,
 	}
 
-	// NOTE: command to launch must be specified via ENTRYPOINT and CMD in Dockerfile
+	// NOTE: when no entrypoint or args are specified the command to launch
+	// is taken from ENTRYPOINT and CMD in Dockerfile.
 	var config = container.Config{
 		AttachStdin:  false,
 		AttachStdout: false,
@@ -52,9 +56,24 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 
 		Image: filepath.Join(d.Registry, d.Image),
 		// TODO: set actual name
-		Labels:  map[string]string{overseerTag: ""},
-		Env:     d.FormatEnv(),
-		Volumes: make(map[string]struct{}),
+		Labels: map[string]string{
+			overseerTag: "",
+			dealIDTag:   d.DealId,
+			taskIDTag:   d.TaskId,
+		},
+		Env:         d.FormatEnv(),
+		Volumes:     make(map[string]struct{}),
+		Entrypoint:  d.Entrypoint,
+		Cmd:         d.Cmd,
+		WorkingDir:  d.WorkDir,
+		User:        d.User,
+		StopSignal:  d.StopSignal,
+		Healthcheck: d.HealthCheck,
+	}
+
+	if d.StopTimeout > 0 {
+		stopTimeout := int(d.StopTimeout / time.Second)
+		config.StopTimeout = &stopTimeout
 	}
 
 	// NOTE: all ports are EXPOSE as PublishAll
@@ -64,12 +83,18 @@ func newContainer(ctx context.Context, dockerClient *client.Client, d Descriptio
 	logOpts["max-size"] = "100m"
 	var hostConfig = container.HostConfig{
 		LogConfig:       container.LogConfig{Type: "json-file", Config: logOpts},
-		PublishAllPorts: true,
+		PublishAllPorts: !d.hidePorts,
 		RestartPolicy:   d.RestartPolicy,
 		AutoRemove:      d.autoremove,
 		Resources:       d.Resources.ToHostConfigResources(d.CGroupParent),
 	}
 
+	if quota := d.ContainerDiskQuota(); storageOpt && quota > 0 {
+		hostConfig.StorageOpt = map[string]string{
+			"size": strconv.FormatUint(quota, 10),
+		}
+	}
+
 	networkingConfig := network.NetworkingConfig{}
 
 	cleanup, err := tuners.Tune(ctx, &d, &hostConfig, &networkingConfig)
@@ -105,7 +130,7 @@ func (c *containerDescriptor) startContainer() error {
 	return nil
 }
 
-func (c *containerDescriptor) execCommand(cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (conn types.HijackedResponse, err error) {
+func (c *containerDescriptor) execCommand(cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (conn ExecConnection, err error) {
 	cfg := types.ExecConfig{
 		User:         "root",
 		Tty:          isTty,
@@ -125,10 +150,11 @@ func (c *containerDescriptor) execCommand(cmd []string, env []string, isTty bool
 		return
 	}
 
-	conn, err = c.client.ContainerExecAttach(c.ctx, execId.ID, cfg)
+	hijacked, err := c.client.ContainerExecAttach(c.ctx, execId.ID, cfg)
 	if err != nil {
 		log.G(c.ctx).Warn("ContainerExecAttach finished with error", zap.Error(err))
 	}
+	conn = ExecConnection{HijackedResponse: hijacked, id: execId.ID, client: c.client}
 
 	err = c.client.ContainerExecStart(c.ctx, execId.ID, types.ExecStartCheck{Detach: false, Tty: true})
 	if err != nil {
@@ -157,6 +183,12 @@ func (c *containerDescriptor) execCommand(cmd []string, env []string, isTty bool
 	return
 }
 
+// isRunning returns false once the container has died, which cancels its
+// context.
+func (c *containerDescriptor) isRunning() bool {
+	return c.ctx.Err() == nil
+}
+
 func (c *containerDescriptor) Kill() (err error) {
 	log.G(c.ctx).Info("kill the container", zap.String("id", c.ID))
 	if err = c.client.ContainerKill(context.Background(), c.ID, "SIGKILL"); err != nil {
@@ -166,6 +198,23 @@ func (c *containerDescriptor) Kill() (err error) {
 	return nil
 }
 
+// Stop stops the container gracefully by sending its stop signal and killing
+// it after the stop timeout expires.
+func (c *containerDescriptor) Stop() error {
+	log.G(c.ctx).Info("stop the container", zap.String("id", c.ID))
+
+	var timeout *time.Duration
+	if c.description.StopTimeout > 0 {
+		timeout = &c.description.StopTimeout
+	}
+
+	if err := c.client.ContainerStop(context.Background(), c.ID, timeout); err != nil {
+		log.G(c.ctx).Error("failed to stop the container", zap.String("id", c.ID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func (c *containerDescriptor) Remove() error {
 	log.G(c.ctx).Info("remove the container", zap.String("id", c.ID))
 	result := multierror.NewMultiError()
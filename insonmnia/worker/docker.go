@@ -2,8 +2,10 @@ package worker
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
 	"io/ioutil"
+	"strings"
 )
 
 type imageLoadStatus struct {
@@ -23,3 +25,58 @@ func decodeImageLoad(rd io.Reader) (imageLoadStatus, error) {
 
 	return status, nil
 }
+
+// loadMessage is a message of the JSON stream Docker replies with when
+// loading or pushing images.
+type loadMessage struct {
+	Stream string `json:"stream"`
+	Error  string `json:"error"`
+}
+
+// decodeImageLoadStream reads the reply to the image load request, returning
+// the first error reported or the status collected from all messages.
+func decodeImageLoadStream(rd io.Reader) (imageLoadStatus, error) {
+	var messages []string
+
+	decoder := json.NewDecoder(rd)
+	for {
+		var message loadMessage
+		if err := decoder.Decode(&message); err != nil {
+			if err == io.EOF {
+				break
+			}
+
+			return imageLoadStatus{}, err
+		}
+
+		if len(message.Error) != 0 {
+			return imageLoadStatus{}, errors.New(message.Error)
+		}
+
+		if status := strings.TrimSpace(message.Stream); len(status) != 0 {
+			messages = append(messages, status)
+		}
+	}
+
+	return imageLoadStatus{Status: strings.Join(messages, "\n")}, nil
+}
+
+// decodeImagePushStream reads the reply to the image push request, returning
+// the first error reported.
+func decodeImagePushStream(rd io.Reader) error {
+	decoder := json.NewDecoder(rd)
+	for {
+		var message loadMessage
+		if err := decoder.Decode(&message); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		if len(message.Error) != 0 {
+			return errors.New(message.Error)
+		}
+	}
+}
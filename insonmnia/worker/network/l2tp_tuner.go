@@ -145,6 +145,19 @@ func (t *L2TPTuner) Tune(ctx context.Context, net structs.Network, hostCfg *cont
 	}, nil
 }
 
+func (t *L2TPTuner) Restore(ctx context.Context, net types.NetworkResource) (Cleanup, bool) {
+	if net.Driver != "l2tp_net" {
+		return nil, false
+	}
+
+	return &L2TPCleaner{
+		ctx:        ctx,
+		cli:        t.cli,
+		networkID:  net.ID,
+		configPath: net.Options["config"],
+	}, true
+}
+
 func (t *L2TPTuner) writeConfig(netID string, opts map[string]string) (string, error) {
 	var data string
 	for k, v := range opts {
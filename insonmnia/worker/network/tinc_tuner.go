@@ -148,6 +148,18 @@ func (t *TincTuner) Tuned(ID string) bool {
 	return t.netDriver.HasNetwork(ID)
 }
 
+func (t *TincTuner) Restore(ctx context.Context, net types.NetworkResource) (Cleanup, bool) {
+	if net.Driver != "tinc" {
+		return nil, false
+	}
+
+	return &TincCleaner{
+		ctx:       ctx,
+		client:    t.client,
+		networkID: net.ID,
+	}, true
+}
+
 func (t *TincTuner) GenerateInvitation(ID string) (structs.Network, error) {
 	return t.netDriver.GenerateInvitation(ID)
 }
@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/network"
 	"github.com/sonm-io/core/insonmnia/structs"
@@ -18,4 +19,8 @@ type Tuner interface {
 	Tune(ctx context.Context, net structs.Network, hostConfig *container.HostConfig, netConfig *network.NetworkingConfig) (Cleanup, error)
 	GenerateInvitation(ID string) (structs.Network, error)
 	Tuned(ID string) bool
+	// Restore returns a cleanup for the network created by this tuner before
+	// the Worker restart. Returns false if the network is not the tuner's
+	// one.
+	Restore(ctx context.Context, net types.NetworkResource) (Cleanup, bool)
 }
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 	"os"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
@@ -9,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sonm-io/core/blockchain"
 	"github.com/sonm-io/core/insonmnia/benchmarks"
+	"github.com/sonm-io/core/insonmnia/gateway"
 	"github.com/sonm-io/core/insonmnia/matcher"
 	"github.com/sonm-io/core/insonmnia/state"
 	"github.com/sonm-io/core/insonmnia/worker/plugin"
@@ -42,6 +44,8 @@ type options struct {
 	plugins     *plugin.Repository
 	whitelist   Whitelist
 	matcher     matcher.Matcher
+	gateway     *gateway.Gateway
+	router      gateway.Router
 }
 
 func (m *options) validate() error {
@@ -103,6 +107,10 @@ func (m *options) SetupDefaults() error {
 		return err
 	}
 
+	if err := m.setupRouter(); err != nil {
+		return err
+	}
+
 	if err := m.setupSSH(); err != nil {
 		return err
 	}
@@ -209,7 +217,7 @@ func (m *options) setupWhitelist() error {
 			cfg.PrivilegedAddresses = append(cfg.PrivilegedAddresses, util.PubKeyToAddr(m.key.PublicKey).Hex())
 		}
 
-		m.whitelist = NewWhitelist(m.ctx, &cfg)
+		m.whitelist = NewWhitelist(m.ctx, &cfg, m.storage)
 	}
 	return nil
 }
@@ -217,12 +225,18 @@ func (m *options) setupWhitelist() error {
 func (m *options) setupMatcher() error {
 	if m.matcher == nil {
 		if m.cfg.Matcher != nil {
+			strategy, err := matcher.NewStrategy(m.cfg.Matcher.Strategy, m.dwh)
+			if err != nil {
+				return errors.Wrap(err, "cannot create matching strategy")
+			}
+
 			matcher, err := matcher.NewMatcher(&matcher.Config{
 				Key:        m.key,
 				DWH:        m.dwh,
 				Eth:        m.eth,
 				PollDelay:  m.cfg.Matcher.PollDelay,
 				QueryLimit: m.cfg.Matcher.QueryLimit,
+				Strategy:   strategy,
 			})
 			if err != nil {
 				return errors.Wrap(err, "cannot create matcher")
@@ -271,6 +285,25 @@ func (m *options) setupNetworkOptions() error {
 	return errors.New("failed to get public IPs")
 }
 
+// setupRouter prepares the router used to publish task ports through the
+// gateway. The router is left nil when no gateway is configured, meaning
+// that Docker host ports are published directly.
+func (m *options) setupRouter() error {
+	if m.router != nil || m.cfg.Gateway == nil {
+		return nil
+	}
+
+	gate, err := gateway.NewGateway(m.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize gateway: %s", err)
+	}
+
+	ports := m.cfg.Gateway.Ports
+	m.gateway = gate
+	m.router = gateway.NewRouter(m.ctx, gate, gateway.NewPortPool(ports[0], ports[1]-ports[0]))
+	return nil
+}
+
 func (m *options) setupSSH() error {
 	if m.ssh == nil {
 		m.ssh = nilSSH{}
@@ -280,7 +313,7 @@ func (m *options) setupSSH() error {
 
 func (m *options) setupOverseer() error {
 	if m.ovs == nil {
-		ovs, err := NewOverseer(m.ctx, m.plugins)
+		ovs, err := NewOverseer(m.ctx, m.plugins, m.cfg.DiskQuota)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/sonm-io/core/insonmnia/worker/plugin"
 	"github.com/sonm-io/core/insonmnia/worker/volume"
 	"github.com/sonm-io/core/util/multierror"
+	"github.com/sonm-io/core/util/xdocker"
 	"go.uber.org/zap"
 
 	"github.com/docker/docker/api/types"
@@ -28,7 +30,10 @@ import (
 )
 
 const overseerTag = "sonm.overseer"
+const dealIDTag = "sonm.deal"
+const taskIDTag = "sonm.task"
 const dieEvent = "die"
+const healthStatusEvent = "health_status"
 
 // Description for a target application.
 // TODO: Drop duplication (sonm.Container)
@@ -37,14 +42,24 @@ type Description struct {
 	Image         string
 	Auth          string
 	RestartPolicy container.RestartPolicy
+	HealthCheck   *container.HealthConfig
 	Resources     *pb.AskPlanResources
 	CGroupParent  string
+	Entrypoint    []string
 	Cmd           []string
+	WorkDir       string
+	User          string
+	StopSignal    string
+	StopTimeout   time.Duration
 	Env           map[string]string
 	TaskId        string
 	DealId        string
+	DiskQuota     uint64
 	CommitOnStop  bool
 	autoremove    bool
+	// hidePorts disables publishing container ports on the host, so they
+	// are reachable only through the gateway.
+	hidePorts bool
 
 	GPUDevices []gpu.GPUID
 
@@ -58,6 +73,27 @@ func (d *Description) ID() string {
 	return d.TaskId
 }
 
+func (d *Description) DealID() string {
+	return d.DealId
+}
+
+// StorageQuota returns the storage size in bytes purchased with the deal,
+// which is shared by all its tasks. Zero means no limit.
+func (d *Description) StorageQuota() uint64 {
+	return d.DiskQuota
+}
+
+// ContainerDiskQuota returns the maximum size of the container's writable
+// layer, which is the storage requested for the task or the whole storage
+// of the deal if not specified.
+func (d *Description) ContainerDiskQuota() uint64 {
+	if size := d.Resources.GetStorage().GetSize().GetBytes(); size > 0 {
+		return size
+	}
+
+	return d.DiskQuota
+}
+
 func (d *Description) Volumes() map[string]*pb.Volume {
 	return d.volumes
 }
@@ -78,6 +114,12 @@ func (d *Description) Networks() []structs.Network {
 	return d.networks
 }
 
+// IsGracefulStopRequired returns true if a container must be stopped using
+// its stop signal and timeout instead of being killed immediately.
+func (d *Description) IsGracefulStopRequired() bool {
+	return len(d.StopSignal) > 0 || d.StopTimeout > 0
+}
+
 func (d *Description) FormatEnv() []string {
 	vars := make([]string, 0, len(d.Env))
 	for k, v := range d.Env {
@@ -94,11 +136,32 @@ type ContainerInfo struct {
 	ImageName    string
 	StartAt      time.Time
 	Ports        nat.PortMap
-	PublicKey    ssh.PublicKey
+	PublicKey    ssh.PublicKey `json:"-"`
 	Cgroup       string
 	CgroupParent string
 	NetworkIDs   []string
 	DealID       string
+	RestartCount int
+	ExitCode     int
+	// IP describes the container address gateway routes forward traffic to.
+	IP string `json:",omitempty"`
+	// Routes describes IDs of gateway virtual services that publish
+	// container ports.
+	Routes []string `json:",omitempty"`
+}
+
+// ContainerStatus describes a container status change reported by the
+// overseer.
+type ContainerStatus struct {
+	Status pb.TaskStatusReply_Status
+	// RestartCount describes how many times the container has been restarted
+	// according to its restart policy.
+	RestartCount int
+	// ExitCode describes the exit code of the container's last run.
+	ExitCode int
+	// OOMKilled is set when the container has been killed because of
+	// running out of memory.
+	OOMKilled bool
 }
 
 func (c *ContainerInfo) IntoProto(ctx context.Context) *pb.TaskStatusReply {
@@ -125,6 +188,8 @@ func (c *ContainerInfo) IntoProto(ctx context.Context) *pb.TaskStatusReply {
 		Uptime:             uint64(time.Now().Sub(c.StartAt).Nanoseconds()),
 		Usage:              nil,
 		AllocatedResources: nil,
+		RestartCount:       uint32(c.RestartCount),
+		ExitCode:           int32(c.ExitCode),
 	}
 }
 
@@ -133,6 +198,10 @@ type ContainerMetrics struct {
 	cpu types.CPUStats
 	mem types.MemoryStats
 	net map[string]types.NetworkStats
+	// disk is the size of the container's writable layer and diskQuota is
+	// the storage purchased with the deal.
+	disk      uint64
+	diskQuota uint64
 }
 
 func (m *ContainerMetrics) Marshal() *pb.ResourceUsage {
@@ -156,16 +225,57 @@ func (m *ContainerMetrics) Marshal() *pb.ResourceUsage {
 			MaxUsage: m.mem.MaxUsage,
 		},
 		Network: network,
+		Storage: &pb.StorageUsage{
+			Bytes: m.disk,
+			Quota: m.diskQuota,
+		},
 	}
 }
 
-type ExecConnection types.HijackedResponse
+// ExecConnection is a hijacked connection to the command executed inside a
+// container.
+type ExecConnection struct {
+	types.HijackedResponse
+
+	id     string
+	client client.APIClient
+}
+
+// ExitCode waits for the executed command to finish and returns its exit code.
+func (c ExecConnection) ExitCode(ctx context.Context) (int, error) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		inspect, err := c.client.ContainerExecInspect(ctx, c.id)
+		if err != nil {
+			return 0, err
+		}
+
+		if !inspect.Running {
+			return inspect.ExitCode, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
 
 // Overseer watches all worker's applications.
 type Overseer interface {
 	// Load loads an image from the specified reader to the Docker.
 	Load(ctx context.Context, rd io.Reader) (imageLoadStatus, error)
 
+	// LoadImage loads images from the "docker save" archive read from the
+	// specified reader.
+	LoadImage(ctx context.Context, rd io.Reader) (imageLoadStatus, error)
+
+	// ImageLayers returns chain IDs of layers of all images the Docker has.
+	ImageLayers(ctx context.Context) (map[string]bool, error)
+
 	// Save saves an image from the Docker into the returned reader.
 	Save(ctx context.Context, imageID string) (types.ImageInspect, io.ReadCloser, error)
 
@@ -178,14 +288,44 @@ type Overseer interface {
 	//
 	// After successful starting an application becomes a target for accepting request, but not guarantees
 	// to complete them.
-	Start(ctx context.Context, description Description) (chan pb.TaskStatusReply_Status, ContainerInfo, error)
+	Start(ctx context.Context, description Description) (chan ContainerStatus, ContainerInfo, error)
+
+	// Restore re-adopts the container that was started before the Worker
+	// restart using the specified description.
+	//
+	// The returned status channel is nil if the container is not running.
+	Restore(ctx context.Context, containerID string, description Description) (chan ContainerStatus, ContainerInfo, error)
+
+	// RemoveOrphans kills and removes all containers that were started by
+	// the overseer, but are not known to it, i.e. orphans left from the
+	// previous launches.
+	RemoveOrphans(ctx context.Context) error
 
 	// Exec a given command in running container
-	Exec(ctx context.Context, Id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (types.HijackedResponse, error)
+	Exec(ctx context.Context, Id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (ExecConnection, error)
+
+	// CopyTo extracts a tar archive read from the given reader into the
+	// specified path inside the container.
+	CopyTo(ctx context.Context, id string, path string, content io.Reader) error
+
+	// CopyFrom returns the content of the specified path inside the
+	// container as a tar archive.
+	CopyFrom(ctx context.Context, id string, path string) (io.ReadCloser, error)
 
 	// Stop terminates the container.
 	Stop(ctx context.Context, containerID string) error
 
+	// Commit snapshots the container into an image tagged as
+	// "<deal>_<task>_<n>", pushing it to the registry unless it is empty.
+	// Snapshots are counted against the storage purchased with the deal.
+	Commit(ctx context.Context, containerID, registry, auth string) (*Snapshot, error)
+
+	// Snapshots returns snapshots of tasks of the given deal.
+	Snapshots(ctx context.Context, dealID string) ([]Snapshot, error)
+
+	// RemoveSnapshots removes local snapshots of tasks of the given deal.
+	RemoveSnapshots(ctx context.Context, dealID string) error
+
 	// Makes all cleanup related to closed deal
 	OnDealFinish(ctx context.Context, containerID string) error
 
@@ -201,11 +341,16 @@ type Overseer interface {
 	Close() error
 }
 
+// statusBufferSize is the number of container statuses that are kept until
+// the listener receives them.
+const statusBufferSize = 16
+
 type overseer struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	plugins *plugin.Repository
+	plugins   *plugin.Repository
+	diskQuota DiskQuotaConfig
 
 	client *client.Client
 
@@ -214,7 +359,12 @@ type overseer struct {
 	// protects containers map
 	mu         sync.Mutex
 	containers map[string]*containerDescriptor
-	statuses   map[string]chan pb.TaskStatusReply_Status
+	statuses   map[string]chan ContainerStatus
+	// snapshotUsage is the size of local snapshots by deals collected
+	// during the last check.
+	snapshotUsage map[string]uint64
+
+	snapshotMu sync.Mutex
 }
 
 func (o *overseer) supportGPU() bool {
@@ -222,7 +372,7 @@ func (o *overseer) supportGPU() bool {
 }
 
 // NewOverseer creates new overseer
-func NewOverseer(ctx context.Context, plugins *plugin.Repository) (Overseer, error) {
+func NewOverseer(ctx context.Context, plugins *plugin.Repository, diskQuota DiskQuotaConfig) (Overseer, error) {
 	dockerClient, err := client.NewEnvClient()
 	if err != nil {
 		return nil, err
@@ -230,16 +380,21 @@ func NewOverseer(ctx context.Context, plugins *plugin.Repository) (Overseer, err
 
 	ctx, cancel := context.WithCancel(ctx)
 	ovr := &overseer{
-		ctx:        ctx,
-		cancel:     cancel,
-		plugins:    plugins,
-		client:     dockerClient,
-		containers: make(map[string]*containerDescriptor),
-		statuses:   make(map[string]chan pb.TaskStatusReply_Status),
+		ctx:           ctx,
+		cancel:        cancel,
+		plugins:       plugins,
+		diskQuota:     diskQuota,
+		client:        dockerClient,
+		containers:    make(map[string]*containerDescriptor),
+		statuses:      make(map[string]chan ContainerStatus),
+		snapshotUsage: make(map[string]uint64),
 	}
 
 	go ovr.collectStats()
 	go ovr.watchEvents()
+	if diskQuota.CheckPeriod > 0 {
+		go ovr.watchDiskUsage()
+	}
 
 	return ovr, nil
 }
@@ -253,6 +408,9 @@ func (o *overseer) Info(ctx context.Context) (map[string]ContainerMetrics, error
 			cpu: container.stats.CPUStats,
 			mem: container.stats.MemoryStats,
 			net: container.stats.Networks,
+
+			disk:      container.diskUsage,
+			diskQuota: container.description.DiskQuota,
 		}
 
 		info[container.ID] = metrics
@@ -287,41 +445,11 @@ func (o *overseer) handleStreamingEvents(ctx context.Context, sinceUnix int64, f
 		case message := <-messages:
 			last = message.TimeNano
 
-			switch message.Status {
-			case dieEvent:
-				id := message.Actor.ID
-				log.G(ctx).Info("container has died", zap.String("id", id))
-
-				var c *containerDescriptor
-				o.mu.Lock()
-				c, containerFound := o.containers[id]
-				s, statusFound := o.statuses[id]
-				// We intentionally do not delete container from the map to save history for deal.
-				// It will be removed from that map after deal finishes and corresponding container would be deleted
-				delete(o.statuses, id)
-				o.mu.Unlock()
-
-				if !containerFound {
-					// NOTE: it could be orphaned container from our previous launch
-					log.G(ctx).Warn("unknown container with sonm tag will be removed", zap.String("id", id))
-					containerRemove(o.ctx, o.client, id)
-					continue
-				}
-				if statusFound {
-					s <- pb.TaskStatusReply_BROKEN
-					close(s)
-				}
-				if c.description.CommitOnStop {
-					log.G(ctx).Info("trying to upload container")
-					err := c.upload()
-					if err != nil {
-						log.G(ctx).Error("failed to commit container", zap.String("id", id), zap.Error(err))
-					}
-				}
-				if err := c.Cleanup(); err != nil {
-					log.G(ctx).Error("failed to clean up container", zap.String("id", id), zap.Error(err))
-				}
-				c.cancel()
+			switch {
+			case message.Status == dieEvent:
+				o.handleDieEvent(ctx, message.Actor.ID)
+			case strings.HasPrefix(message.Status, healthStatusEvent):
+				o.handleHealthStatusEvent(ctx, message.Actor.ID, message.Status)
 			default:
 				log.G(ctx).Warn("received unknown event", zap.String("status", message.Status))
 			}
@@ -329,6 +457,137 @@ func (o *overseer) handleStreamingEvents(ctx context.Context, sinceUnix int64, f
 	}
 }
 
+func (o *overseer) handleDieEvent(ctx context.Context, id string) {
+	log.G(ctx).Info("container has died", zap.String("id", id))
+
+	o.mu.Lock()
+	c, containerFound := o.containers[id]
+	o.mu.Unlock()
+
+	if !containerFound {
+		// NOTE: it could be orphaned container from our previous launch
+		log.G(ctx).Warn("unknown container with sonm tag will be removed", zap.String("id", id))
+		containerRemove(o.ctx, o.client, id)
+		return
+	}
+
+	status := ContainerStatus{Status: pb.TaskStatusReply_BROKEN}
+
+	cjson, err := o.client.ContainerInspect(ctx, id)
+	if err != nil {
+		log.G(ctx).Warn("failed to inspect died container", zap.String("id", id), zap.Error(err))
+	} else {
+		status.RestartCount = cjson.RestartCount
+		if cjson.State != nil {
+			status.ExitCode = cjson.State.ExitCode
+			status.OOMKilled = cjson.State.OOMKilled
+			if status.ExitCode == 0 {
+				status.Status = pb.TaskStatusReply_FINISHED
+			}
+
+			// The container is going to be restarted according to its restart
+			// policy, so it is not finished yet.
+			if cjson.State.Restarting || cjson.State.Running {
+				log.G(ctx).Info("container is restarting", zap.String("id", id),
+					zap.Int("exit_code", status.ExitCode), zap.Int("restart_count", status.RestartCount))
+				status.Status = pb.TaskStatusReply_RUNNING
+				o.notify(id, status)
+				return
+			}
+		}
+	}
+
+	o.mu.Lock()
+	s, statusFound := o.statuses[id]
+	// We intentionally do not delete container from the map to save history for deal.
+	// It will be removed from that map after deal finishes and corresponding container would be deleted
+	delete(o.statuses, id)
+	o.mu.Unlock()
+
+	if statusFound {
+		terminate(s, status)
+	}
+	if c.description.CommitOnStop {
+		log.G(ctx).Info("trying to upload container")
+		err := c.upload()
+		if err != nil {
+			log.G(ctx).Error("failed to commit container", zap.String("id", id), zap.Error(err))
+		}
+	}
+	if err := c.Cleanup(); err != nil {
+		log.G(ctx).Error("failed to clean up container", zap.String("id", id), zap.Error(err))
+	}
+	c.cancel()
+}
+
+func (o *overseer) handleHealthStatusEvent(ctx context.Context, id string, event string) {
+	health := strings.TrimSpace(strings.TrimPrefix(event, healthStatusEvent+":"))
+	log.G(ctx).Info("container health status has changed", zap.String("id", id), zap.String("health", health))
+
+	status := ContainerStatus{Status: pb.TaskStatusReply_RUNNING}
+	if health == types.Unhealthy {
+		status.Status = pb.TaskStatusReply_UNHEALTHY
+	}
+
+	cjson, err := o.client.ContainerInspect(ctx, id)
+	if err != nil {
+		log.G(ctx).Warn("failed to inspect container", zap.String("id", id), zap.Error(err))
+	} else {
+		status.RestartCount = cjson.RestartCount
+		if cjson.State != nil {
+			status.ExitCode = cjson.State.ExitCode
+		}
+	}
+
+	o.notify(id, status)
+}
+
+// notify sends the given intermediate status of a running container to its
+// listener, if any.
+//
+// The send never blocks, because it is done under the lock, which the
+// listener may wait for. Statuses that do not fit into the channel buffer
+// are dropped, since the listener lags far behind anyway.
+func (o *overseer) notify(id string, status ContainerStatus) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	s, ok := o.statuses[id]
+	if !ok {
+		return
+	}
+
+	select {
+	case s <- status:
+	default:
+		log.G(o.ctx).Warn("dropping container status, because the listener is busy",
+			zap.String("id", id), zap.Stringer("status", status.Status))
+	}
+}
+
+// terminate sends the given final status of a container to its status channel
+// and closes it.
+//
+// The send never blocks, because a listener may have gone away, leaving the
+// channel buffer full. The oldest statuses are dropped instead, since the
+// final one is the only one that matters. The channel must already be
+// removed from the statuses map, so that nothing else sends to it.
+func terminate(s chan ContainerStatus, status ContainerStatus) {
+	for {
+		select {
+		case s <- status:
+			close(s)
+			return
+		default:
+		}
+
+		select {
+		case <-s:
+		default:
+		}
+	}
+}
+
 func (o *overseer) watchEvents() {
 	backoff := NewBackoffTimer(time.Second, time.Second*32)
 	defer backoff.Stop()
@@ -337,6 +596,7 @@ func (o *overseer) watchEvents() {
 
 	filterArgs := filters.NewArgs()
 	filterArgs.Add("event", dieEvent)
+	filterArgs.Add("event", healthStatusEvent)
 	filterArgs.Add("label", overseerTag)
 
 	var err error
@@ -410,6 +670,129 @@ func (o *overseer) collectStats() {
 	}
 }
 
+// watchDiskUsage periodically collects sizes of containers' writable layers
+// and stops tasks of deals that use more storage than purchased.
+func (o *overseer) watchDiskUsage() {
+	t := time.NewTicker(o.diskQuota.CheckPeriod)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			o.collectDiskUsage()
+			o.enforceDiskQuotas()
+		case <-o.ctx.Done():
+			return
+		}
+	}
+}
+
+func (o *overseer) collectDiskUsage() {
+	o.mu.Lock()
+	ids := make([]string, 0, len(o.containers))
+	for id := range o.containers {
+		ids = append(ids, id)
+	}
+	o.mu.Unlock()
+
+	for _, id := range ids {
+		cjson, _, err := o.client.ContainerInspectWithRaw(o.ctx, id, true)
+		if err != nil {
+			log.G(o.ctx).Warn("failed to get container disk usage", zap.String("id", id), zap.Error(err))
+			continue
+		}
+
+		usage := uint64(0)
+		if cjson.SizeRw != nil && *cjson.SizeRw > 0 {
+			usage = uint64(*cjson.SizeRw)
+		}
+
+		o.mu.Lock()
+		if container, ok := o.containers[id]; ok {
+			container.diskUsage = usage
+		}
+		o.mu.Unlock()
+	}
+
+	snapshotUsage, err := o.collectSnapshotUsage(o.ctx)
+	if err != nil {
+		log.G(o.ctx).Warn("failed to get snapshots disk usage", zap.Error(err))
+		return
+	}
+
+	o.mu.Lock()
+	o.snapshotUsage = snapshotUsage
+	o.mu.Unlock()
+}
+
+// enforceDiskQuotas kills running containers of deals that use more storage
+// than purchased, counting their snapshots too.
+//
+// Only running containers are counted. Containers of finished tasks keep
+// their writable layers until the deal finishes, but counting them would
+// refuse every new task of the deal after a single quota breach.
+func (o *overseer) enforceDiskQuotas() {
+	usages := map[string]uint64{}
+	quotas := map[string]uint64{}
+	containers := map[string][]*containerDescriptor{}
+
+	o.mu.Lock()
+	for _, container := range o.containers {
+		if !container.isRunning() {
+			continue
+		}
+
+		dealID := container.description.DealId
+		if _, ok := usages[dealID]; !ok {
+			usages[dealID] = o.snapshotUsage[dealID]
+		}
+		usages[dealID] += container.diskUsage
+		quotas[dealID] = container.description.DiskQuota
+		containers[dealID] = append(containers[dealID], container)
+	}
+	o.mu.Unlock()
+
+	for dealID, usage := range usages {
+		quota := quotas[dealID]
+		if quota == 0 || usage <= quota {
+			continue
+		}
+
+		log.G(o.ctx).Warn("deal exceeds its storage quota, stopping tasks",
+			zap.String("deal", dealID), zap.Uint64("usage", usage), zap.Uint64("quota", quota))
+
+		for _, container := range containers[dealID] {
+			container.Kill()
+		}
+	}
+}
+
+// dealDiskUsage returns the total size of writable layers of the deal's
+// running containers and of its snapshots collected during the last check.
+func (o *overseer) dealDiskUsage(dealID string) uint64 {
+	usage := o.containersDiskUsage(dealID)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return usage + o.snapshotUsage[dealID]
+}
+
+// containersDiskUsage returns the total size of writable layers of the
+// deal's running containers collected during the last check.
+func (o *overseer) containersDiskUsage(dealID string) uint64 {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	usage := uint64(0)
+	for _, container := range o.containers {
+		if container.description.DealId == dealID && container.isRunning() {
+			usage += container.diskUsage
+		}
+	}
+
+	return usage
+}
+
 func (o *overseer) Load(ctx context.Context, rd io.Reader) (imageLoadStatus, error) {
 	source := types.ImageImportSource{
 		Source:     rd,
@@ -432,6 +815,39 @@ func (o *overseer) Load(ctx context.Context, rd io.Reader) (imageLoadStatus, err
 	return decodeImageLoad(response)
 }
 
+func (o *overseer) LoadImage(ctx context.Context, rd io.Reader) (imageLoadStatus, error) {
+	response, err := o.client.ImageLoad(ctx, rd, true)
+	if err != nil {
+		log.G(o.ctx).Error("failed to load an image", zap.Error(err))
+		return imageLoadStatus{}, err
+	}
+
+	defer response.Body.Close()
+
+	return decodeImageLoadStream(response.Body)
+}
+
+func (o *overseer) ImageLayers(ctx context.Context) (map[string]bool, error) {
+	images, err := o.client.ImageList(ctx, types.ImageListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	layers := map[string]bool{}
+	for _, image := range images {
+		inspect, _, err := o.client.ImageInspectWithRaw(ctx, image.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, chainID := range xdocker.ChainIDs(inspect.RootFS.Layers) {
+			layers[chainID] = true
+		}
+	}
+
+	return layers, nil
+}
+
 func (o *overseer) Save(ctx context.Context, imageID string) (types.ImageInspect, io.ReadCloser, error) {
 	imageInspect, _, err := o.client.ImageInspectWithRaw(ctx, imageID)
 	if err != nil {
@@ -469,23 +885,30 @@ func (o *overseer) Spool(ctx context.Context, d Description) error {
 	return nil
 }
 
-func (o *overseer) Start(ctx context.Context, description Description) (status chan pb.TaskStatusReply_Status, cinfo ContainerInfo, err error) {
+func (o *overseer) Start(ctx context.Context, description Description) (status chan ContainerStatus, cinfo ContainerInfo, err error) {
 	if description.IsGPURequired() && !o.supportGPU() {
 		err = fmt.Errorf("GPU required but not supported or disabled")
 		return
 	}
 
+	if quota := description.DiskQuota; quota > 0 {
+		if usage := o.dealDiskUsage(description.DealId); usage >= quota {
+			err = fmt.Errorf("deal has used %d of %d bytes of purchased storage", usage, quota)
+			return
+		}
+	}
+
 	// TODO: Well, we should refactor those dozens of arguments.
 	// Note: maybe will be better to make the "newContainer()" func as part of the overseer struct
 	// ( in that case we can access docker client and plugins repo from the Ovs instance. )
-	pr, err := newContainer(ctx, o.client, description, o.plugins)
+	pr, err := newContainer(ctx, o.client, description, o.plugins, o.diskQuota.StorageOpt)
 	if err != nil {
 		return
 	}
 
 	o.mu.Lock()
 	o.containers[pr.ID] = pr
-	status = make(chan pb.TaskStatusReply_Status)
+	status = make(chan ContainerStatus, statusBufferSize)
 	o.statuses[pr.ID] = status
 	o.mu.Unlock()
 
@@ -511,12 +934,141 @@ func (o *overseer) Start(ctx context.Context, description Description) (status c
 		Cgroup:       string(cjson.HostConfig.Cgroup),
 		CgroupParent: string(cjson.HostConfig.CgroupParent),
 		NetworkIDs:   networkIDs,
+		IP:           containerIP(cjson),
+	}
+
+	return status, cinfo, nil
+}
+
+// containerIP returns the address of the given container in the default
+// network or, if it is not connected to one, in any other network.
+func containerIP(cjson types.ContainerJSON) string {
+	if cjson.NetworkSettings == nil {
+		return ""
+	}
+	if cjson.NetworkSettings.DefaultNetworkSettings.IPAddress != "" {
+		return cjson.NetworkSettings.DefaultNetworkSettings.IPAddress
+	}
+	for _, endpoint := range cjson.NetworkSettings.Networks {
+		if endpoint != nil && endpoint.IPAddress != "" {
+			return endpoint.IPAddress
+		}
+	}
+
+	return ""
+}
+
+func (o *overseer) Restore(ctx context.Context, containerID string, description Description) (chan ContainerStatus, ContainerInfo, error) {
+	cjson, err := o.client.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, ContainerInfo{}, err
+	}
+
+	var networkIDs []string
+	var networks []types.NetworkResource
+	for k, endpoint := range cjson.NetworkSettings.Networks {
+		networkIDs = append(networkIDs, k)
+
+		net, err := o.client.NetworkInspect(ctx, endpoint.NetworkID, false)
+		if err != nil {
+			log.G(ctx).Warn("failed to inspect container network", zap.String("id", cjson.ID), zap.String("network", k), zap.Error(err))
+			continue
+		}
+		networks = append(networks, net)
+	}
+
+	containerCtx, cancel := context.WithCancel(o.ctx)
+	descriptor := &containerDescriptor{
+		ctx:         log.WithLogger(containerCtx, log.G(ctx).With(zap.String("id", cjson.ID))),
+		cancel:      cancel,
+		client:      o.client,
+		ID:          cjson.ID,
+		description: description,
+		// Volume and network plugins do not keep their state across restarts,
+		// so the cleanup is rebuilt from the description and the networks the
+		// container is connected to.
+		cleanup: o.plugins.Restore(o.ctx, &description, networks),
+	}
+
+	cinfo := ContainerInfo{
+		status:       pb.TaskStatusReply_RUNNING,
+		ID:           cjson.ID,
+		Ports:        cjson.NetworkSettings.Ports,
+		Cgroup:       string(cjson.HostConfig.Cgroup),
+		CgroupParent: string(cjson.HostConfig.CgroupParent),
+		NetworkIDs:   networkIDs,
+		IP:           containerIP(cjson),
+		RestartCount: cjson.RestartCount,
+	}
+
+	if cjson.State != nil {
+		cinfo.ExitCode = cjson.State.ExitCode
+		if cjson.State.Health != nil && cjson.State.Health.Status == types.Unhealthy {
+			cinfo.status = pb.TaskStatusReply_UNHEALTHY
+		}
 	}
 
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.containers[cjson.ID] = descriptor
+
+	if cjson.State == nil || !(cjson.State.Running || cjson.State.Restarting) {
+		descriptor.cancel()
+
+		if cjson.State != nil && cjson.State.ExitCode == 0 {
+			cinfo.status = pb.TaskStatusReply_FINISHED
+		} else {
+			cinfo.status = pb.TaskStatusReply_BROKEN
+		}
+
+		return nil, cinfo, nil
+	}
+
+	status := make(chan ContainerStatus, statusBufferSize)
+	o.statuses[cjson.ID] = status
+
 	return status, cinfo, nil
 }
 
-func (o *overseer) Exec(ctx context.Context, id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (ret types.HijackedResponse, err error) {
+func (o *overseer) RemoveOrphans(ctx context.Context) error {
+	filterArgs := filters.NewArgs()
+	filterArgs.Add("label", overseerTag)
+
+	containers, err := o.client.ContainerList(ctx, types.ContainerListOptions{All: true, Filters: filterArgs})
+	if err != nil {
+		return err
+	}
+
+	result := multierror.NewMultiError()
+	for _, c := range containers {
+		o.mu.Lock()
+		_, ok := o.containers[c.ID]
+		o.mu.Unlock()
+
+		if ok {
+			continue
+		}
+
+		log.G(ctx).Info("removing orphaned container", zap.String("id", c.ID),
+			zap.String("deal", c.Labels[dealIDTag]), zap.String("task", c.Labels[taskIDTag]))
+
+		if c.State == "running" {
+			if err := o.client.ContainerKill(ctx, c.ID, "SIGKILL"); err != nil {
+				result = multierror.Append(result, err)
+				continue
+			}
+		}
+
+		if err := containerRemove(ctx, o.client, c.ID); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func (o *overseer) Exec(ctx context.Context, id string, cmd []string, env []string, isTty bool, wCh <-chan ssh.Window) (ret ExecConnection, err error) {
 	o.mu.Lock()
 	descriptor, dok := o.containers[id]
 	o.mu.Unlock()
@@ -537,14 +1089,17 @@ func (o *overseer) Stop(ctx context.Context, containerid string) error {
 	o.mu.Unlock()
 
 	if sok {
-		status <- pb.TaskStatusReply_FINISHED
-		close(status)
+		terminate(status, ContainerStatus{Status: pb.TaskStatusReply_FINISHED})
 	}
 
 	if !dok {
 		return fmt.Errorf("no such container %s", containerid)
 	}
 
+	if descriptor.description.IsGracefulStopRequired() {
+		return descriptor.Stop()
+	}
+
 	return descriptor.Kill()
 }
 
@@ -580,3 +1135,12 @@ func (o *overseer) OnDealFinish(ctx context.Context, containerID string) error {
 func (o *overseer) Logs(ctx context.Context, id string, opts types.ContainerLogsOptions) (io.ReadCloser, error) {
 	return o.client.ContainerLogs(ctx, id, opts)
 }
+
+func (o *overseer) CopyTo(ctx context.Context, id string, path string, content io.Reader) error {
+	return o.client.CopyToContainer(ctx, id, path, content, types.CopyToContainerOptions{})
+}
+
+func (o *overseer) CopyFrom(ctx context.Context, id string, path string) (io.ReadCloser, error) {
+	rd, _, err := o.client.CopyFromContainer(ctx, id, path)
+	return rd, err
+}
@@ -4,6 +4,9 @@ import (
 	"archive/tar"
 	"bytes"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -11,6 +14,7 @@ import (
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/client"
 	"github.com/sonm-io/core/insonmnia/worker/plugin"
+	pb "github.com/sonm-io/core/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -19,7 +23,7 @@ import (
 
 func TestOvsSpool(t *testing.T) {
 	ctx := context.Background()
-	ovs, err := NewOverseer(ctx, plugin.EmptyRepository())
+	ovs, err := NewOverseer(ctx, plugin.EmptyRepository(), DiskQuotaConfig{})
 	defer ovs.Close()
 	require.NoError(t, err, "failed to create Overseer")
 	err = ovs.Spool(ctx, Description{Registry: "docker.io", Image: "alpine"})
@@ -101,7 +105,7 @@ func TestOvsSpawn(t *testing.T) {
 	assrt.NoError(err)
 	defer cl.Close()
 	ctx := context.Background()
-	ovs, err := NewOverseer(ctx, plugin.EmptyRepository())
+	ovs, err := NewOverseer(ctx, plugin.EmptyRepository(), DiskQuotaConfig{})
 	require.NoError(t, err)
 	ch, info, err := ovs.Start(ctx, Description{Registry: "", Image: "worker"})
 	require.NoError(t, err)
@@ -132,3 +136,130 @@ func TestOvsSpawn(t *testing.T) {
 	require.NoError(t, err)
 	wg.Wait()
 }
+
+// newFakeDockerClient returns a Docker client served by the handler, and a
+// function that stops the server.
+func newFakeDockerClient(t *testing.T, handler http.HandlerFunc) (*client.Client, func()) {
+	server := httptest.NewServer(handler)
+
+	cl, err := client.NewClient(server.URL, "", server.Client(), nil)
+	require.NoError(t, err)
+
+	return cl, server.Close
+}
+
+// newKillRecordingClient returns a Docker client, which records IDs of
+// containers it is asked to kill.
+func newKillRecordingClient(t *testing.T) (*client.Client, func() []string) {
+	mu := sync.Mutex{}
+	killed := []string{}
+
+	cl, stop := newFakeDockerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.URL.Path, "/")
+		if len(parts) >= 2 && parts[len(parts)-1] == "kill" {
+			mu.Lock()
+			killed = append(killed, parts[len(parts)-2])
+			mu.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return cl, func() []string {
+		stop()
+		mu.Lock()
+		defer mu.Unlock()
+		return killed
+	}
+}
+
+func newTestContainer(ctx context.Context, cl *client.Client, id, dealID string, usage, quota uint64, running bool) *containerDescriptor {
+	ctx, cancel := context.WithCancel(ctx)
+	if !running {
+		cancel()
+	}
+
+	return &containerDescriptor{
+		ctx:         ctx,
+		cancel:      cancel,
+		client:      cl,
+		ID:          id,
+		description: Description{DealId: dealID, DiskQuota: quota},
+		diskUsage:   usage,
+	}
+}
+
+func TestEnforceDiskQuotas(t *testing.T) {
+	ctx := context.Background()
+	cl, killed := newKillRecordingClient(t)
+
+	ovs := &overseer{
+		ctx:    ctx,
+		client: cl,
+		containers: map[string]*containerDescriptor{
+			// Running containers of the deal exceed the quota together.
+			"exceeding-1": newTestContainer(ctx, cl, "exceeding-1", "1", 60, 100, true),
+			"exceeding-2": newTestContainer(ctx, cl, "exceeding-2", "1", 50, 100, true),
+			"exceeding-3": newTestContainer(ctx, cl, "exceeding-3", "1", 1000, 100, false),
+			// Dead containers of the deal are not counted.
+			"dead":   newTestContainer(ctx, cl, "dead", "2", 1000, 100, false),
+			"within": newTestContainer(ctx, cl, "within", "2", 100, 100, true),
+			// No quota.
+			"unlimited": newTestContainer(ctx, cl, "unlimited", "3", 1000, 0, true),
+			// Snapshots of the deal are counted.
+			"snapshotted": newTestContainer(ctx, cl, "snapshotted", "4", 50, 100, true),
+		},
+		snapshotUsage: map[string]uint64{"2": 0, "4": 60},
+	}
+
+	ovs.enforceDiskQuotas()
+
+	assert.ElementsMatch(t, []string{"exceeding-1", "exceeding-2", "snapshotted"}, killed())
+}
+
+func TestStartRefusedOverDiskQuota(t *testing.T) {
+	ctx := context.Background()
+
+	ovs := &overseer{
+		ctx: ctx,
+		containers: map[string]*containerDescriptor{
+			"running": newTestContainer(ctx, nil, "running", "1", 100, 100, true),
+			"dead":    newTestContainer(ctx, nil, "dead", "2", 1000, 100, false),
+		},
+	}
+
+	_, _, err := ovs.Start(ctx, Description{DealId: "1", DiskQuota: 100})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "purchased storage")
+
+	// Tasks killed for exceeding the quota do not prevent new ones.
+	assert.Equal(t, uint64(0), ovs.dealDiskUsage("2"))
+	assert.Equal(t, uint64(100), ovs.dealDiskUsage("1"))
+}
+
+func TestTerminateWithFullStatusBuffer(t *testing.T) {
+	status := make(chan ContainerStatus, statusBufferSize)
+	for idx := 0; idx < statusBufferSize; idx++ {
+		status <- ContainerStatus{Status: pb.TaskStatusReply_RUNNING, RestartCount: idx}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		terminate(status, ContainerStatus{Status: pb.TaskStatusReply_BROKEN})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("terminal status send has blocked")
+	}
+
+	var statuses []ContainerStatus
+	for s := range status {
+		statuses = append(statuses, s)
+	}
+
+	require.Len(t, statuses, statusBufferSize)
+	assert.Equal(t, 1, statuses[0].RestartCount, "the oldest status must be dropped")
+	assert.Equal(t, pb.TaskStatusReply_BROKEN, statuses[statusBufferSize-1].Status)
+}
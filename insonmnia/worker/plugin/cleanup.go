@@ -14,6 +14,17 @@ type Cleanup interface {
 	Close() error
 }
 
+type nilCleanup struct{}
+
+func (nilCleanup) Close() error {
+	return nil
+}
+
+// NewNilCleanup returns a cleanup that does nothing.
+func NewNilCleanup() Cleanup {
+	return nilCleanup{}
+}
+
 type nestedCleanup struct {
 	children []Cleanup
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/network"
 	log "github.com/noxiouz/zapctx/ctxlog"
@@ -40,6 +41,12 @@ type GPUProvider interface {
 type VolumeProvider interface {
 	// ID returns a unique identifier that will be used as a new volume name.
 	ID() string
+	// DealID returns the ID of the deal the container belongs to. Volumes of
+	// drivers that keep data during the whole deal are bound to it.
+	DealID() string
+	// StorageQuota returns the maximum number of bytes volumes bound to the
+	// deal may occupy, zero means no limit.
+	StorageQuota() uint64
 	// Volumes returns volumes specified for configuring.
 	Volumes() map[string]*sonm.Volume
 	// Mounts returns all mounts whose source equals to the volume name
@@ -75,6 +82,7 @@ func NewRepository(ctx context.Context, cfg Config) (*Repository, error) {
 
 		driver, err := volume.NewVolumeDriver(ctx, ty,
 			volume.WithPluginSocketDir(cfg.SocketDir),
+			volume.WithRootDir(cfg.Volumes.Root),
 			volume.WithOptions(options),
 		)
 
@@ -216,7 +224,18 @@ func (r *Repository) TuneVolumes(ctx context.Context, provider VolumeProvider, c
 
 		id := fmt.Sprintf("%s/%s", provider.ID(), volumeName)
 
-		v, err := driver.CreateVolume(id, options.Settings)
+		// Volumes of deal drivers are removed when the deal finishes rather
+		// than the task, see ReleaseDeal.
+		dealDriver, isDealDriver := driver.(volume.DealVolumeDriver)
+		isDealDriver = isDealDriver && len(provider.DealID()) > 0
+
+		var v volume.Volume
+		var err error
+		if isDealDriver {
+			v, err = dealDriver.CreateDealVolume(provider.DealID(), volumeName, provider.StorageQuota(), options.Settings)
+		} else {
+			v, err = driver.CreateVolume(id, options.Settings)
+		}
 		if err != nil {
 			cleanup.Close()
 			return nil, err
@@ -240,12 +259,73 @@ func (r *Repository) TuneVolumes(ctx context.Context, provider VolumeProvider, c
 			}
 		}
 
-		cleanup.Add(&volumeCleanup{driver: driver, id: id})
+		if !isDealDriver {
+			cleanup.Add(&volumeCleanup{driver: driver, id: id})
+		}
 	}
 
 	return &cleanup, nil
 }
 
+// Restore rebuilds the cleanup of volumes and networks acquired for the given
+// provider before the Worker restart, so that they are freed when the
+// re-adopted container is removed. Networks are the ones the container is
+// connected to, unknown networks are left untouched.
+func (r *Repository) Restore(ctx context.Context, provider VolumeProvider, networks []types.NetworkResource) Cleanup {
+	cleanup := newNestedCleanup()
+
+	for volumeName, options := range provider.Volumes() {
+		if len(provider.Mounts(volumeName)) == 0 {
+			continue
+		}
+
+		driver, ok := r.volumes[options.Driver]
+		if !ok {
+			log.G(ctx).Warn("volume driver not supported", zap.String("volume", volumeName), zap.String("driver", options.Driver))
+			continue
+		}
+
+		if _, ok := driver.(volume.DealVolumeDriver); ok && len(provider.DealID()) > 0 {
+			continue
+		}
+
+		cleanup.Add(&volumeCleanup{driver: driver, id: fmt.Sprintf("%s/%s", provider.ID(), volumeName)})
+	}
+
+	for _, net := range networks {
+		for _, tuner := range r.networkTuners {
+			if c, ok := tuner.Restore(ctx, net); ok {
+				cleanup.Add(c)
+				break
+			}
+		}
+	}
+
+	return &cleanup
+}
+
+// ReleaseDeal removes volumes bound to the given deal. Must be called after
+// all tasks of the deal are removed.
+func (r *Repository) ReleaseDeal(dealID string) error {
+	errs := make([]error, 0)
+	for ty, driver := range r.volumes {
+		dealDriver, ok := driver.(volume.DealVolumeDriver)
+		if !ok {
+			continue
+		}
+
+		if err := dealDriver.ReleaseDeal(dealID); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", ty, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to release volumes of deal %s: %v", dealID, errs)
+	}
+
+	return nil
+}
+
 func (r *Repository) TuneNetworks(ctx context.Context, provider NetworkProvider, hostCfg *container.HostConfig, netCfg *network.NetworkingConfig) (Cleanup, error) {
 	log.G(ctx).Info("tuning networks")
 	cleanup := newNestedCleanup()
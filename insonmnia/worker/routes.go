@@ -0,0 +1,94 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/docker/go-connections/nat"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	pb "github.com/sonm-io/core/proto"
+	"go.uber.org/zap"
+)
+
+// routeTaskPorts publishes task ports through the gateway by registering a
+// virtual service per exposed container port, with the container port as its
+// only real service.
+//
+// Container ports are not published on the host in this case, so the gateway
+// is the only way to reach them. Container info ports are replaced with the
+// public endpoints of the gateway. Routes are deregistered on failure.
+func (m *Worker) routeTaskPorts(taskID string, info *ContainerInfo) (map[string]*pb.Endpoints, error) {
+	if len(m.publicIPs) == 0 {
+		return nil, errors.New("no public IPs to route task ports to")
+	}
+	if len(info.IP) == 0 {
+		return nil, errors.New("container has no IP address to route task ports to")
+	}
+
+	portMap := map[string]*pb.Endpoints{}
+	ports := nat.PortMap{}
+	var routes []string
+
+	for internalPort := range info.Ports {
+		routeID := fmt.Sprintf("%s_%s", taskID, internalPort)
+		virtualService, err := m.router.Register(routeID, internalPort.Proto())
+		if err != nil {
+			m.deregisterRoutes(routes)
+			return nil, fmt.Errorf("failed to register virtual service for port %s: %s", internalPort, err)
+		}
+		routes = append(routes, routeID)
+
+		route, err := virtualService.AddReal(routeID, info.IP, uint16(internalPort.Int()))
+		if err != nil {
+			m.deregisterRoutes(routes)
+			return nil, fmt.Errorf("failed to add real service for port %s: %s", internalPort, err)
+		}
+
+		// The virtual service listens on a local address, which may be
+		// behind NAT, so the public IP is advertised instead.
+		publicIP := m.publicIPs[0]
+		log.G(m.ctx).Info("routed task port through the gateway", zap.String("task_id", taskID),
+			zap.String("port", string(internalPort)), zap.String("host", route.Host),
+			zap.String("public_ip", publicIP), zap.Uint16("gateway_port", route.Port))
+
+		ports[internalPort] = []nat.PortBinding{{HostIP: publicIP, HostPort: strconv.Itoa(int(route.Port))}}
+		portMap[string(internalPort)] = &pb.Endpoints{
+			Endpoints: []*pb.SocketAddr{{Addr: publicIP, Port: uint32(route.Port)}},
+		}
+	}
+
+	info.Ports = ports
+	info.Routes = routes
+
+	return portMap, nil
+}
+
+// releaseTaskRoutes deregisters gateway routes of the given task, freeing
+// their ports. Routes are released only once, even if the task is stopped
+// and exits at the same time.
+func (m *Worker) releaseTaskRoutes(taskID string) {
+	m.mu.Lock()
+	var routes []string
+	if info, ok := m.containers[taskID]; ok && len(info.Routes) != 0 {
+		routes = info.Routes
+		info.Routes = nil
+		// Released gateway ports may be assigned to other tasks.
+		info.Ports = nil
+	}
+	m.mu.Unlock()
+
+	m.deregisterRoutes(routes)
+}
+
+func (m *Worker) deregisterRoutes(routes []string) {
+	if m.router == nil {
+		return
+	}
+
+	for _, id := range routes {
+		if err := m.router.Deregister(id); err != nil {
+			log.G(m.ctx).Warn("failed to deregister virtual service", zap.String("id", id), zap.Error(err))
+		}
+	}
+}
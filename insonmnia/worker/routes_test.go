@@ -0,0 +1,153 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/sonm-io/core/insonmnia/gateway"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRouter assigns sequential gateway ports on a private address and
+// records real services of registered virtual services.
+type fakeRouter struct {
+	mu           sync.Mutex
+	port         uint16
+	reals        map[string]string
+	deregistered []string
+}
+
+func newFakeRouter() *fakeRouter {
+	return &fakeRouter{port: 32768, reals: map[string]string{}}
+}
+
+func (r *fakeRouter) Register(ID string, protocol string) (gateway.VirtualService, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.port++
+	return &fakeVirtualService{router: r, id: ID, protocol: protocol, port: r.port}, nil
+}
+
+func (r *fakeRouter) Deregister(ID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deregistered = append(r.deregistered, ID)
+	return nil
+}
+
+func (r *fakeRouter) GetMetrics() (*gateway.Metrics, error) {
+	return &gateway.Metrics{}, nil
+}
+
+func (r *fakeRouter) Close() error {
+	return nil
+}
+
+type fakeVirtualService struct {
+	router   *fakeRouter
+	id       string
+	protocol string
+	port     uint16
+}
+
+func (s *fakeVirtualService) ID() string {
+	return s.id
+}
+
+func (s *fakeVirtualService) AddReal(ID string, host string, port uint16) (*gateway.Route, error) {
+	s.router.mu.Lock()
+	defer s.router.mu.Unlock()
+
+	s.router.reals[s.id] = fmt.Sprintf("%s:%d", host, port)
+
+	return &gateway.Route{
+		ID:          ID,
+		Protocol:    s.protocol,
+		Host:        "10.0.0.1",
+		Port:        s.port,
+		BackendHost: host,
+		BackendPort: port,
+	}, nil
+}
+
+func (s *fakeVirtualService) RemoveReal(ID string) error {
+	return nil
+}
+
+func newTestRoutingWorker(router gateway.Router) *Worker {
+	return &Worker{
+		options: &options{
+			ctx:       context.Background(),
+			router:    router,
+			publicIPs: []string{"12.34.56.78"},
+		},
+		containers:  map[string]*ContainerInfo{},
+		nameMapping: map[string]string{},
+		taskEvents:  newTaskEventBroadcaster(),
+	}
+}
+
+func TestRouteTaskPorts(t *testing.T) {
+	router := newFakeRouter()
+	worker := newTestRoutingWorker(router)
+
+	// Ports are not published on the host, so they have no bindings.
+	info := ContainerInfo{
+		IP:    "172.17.0.2",
+		Ports: nat.PortMap{"80/tcp": nil},
+	}
+
+	portMap, err := worker.routeTaskPorts("task", &info)
+	require.NoError(t, err)
+
+	// Traffic goes to the container itself rather than to a host port.
+	assert.Equal(t, map[string]string{"task_80/tcp": "172.17.0.2:80"}, router.reals)
+	// The public IP is advertised instead of the local gateway address.
+	assert.Equal(t, map[string]*pb.Endpoints{
+		"80/tcp": {Endpoints: []*pb.SocketAddr{{Addr: "12.34.56.78", Port: 32769}}},
+	}, portMap)
+	assert.Equal(t, nat.PortMap{"80/tcp": {{HostIP: "12.34.56.78", HostPort: "32769"}}}, info.Ports)
+	assert.Equal(t, []string{"task_80/tcp"}, info.Routes)
+}
+
+func TestRouteTaskPortsWithoutContainerIP(t *testing.T) {
+	router := newFakeRouter()
+	worker := newTestRoutingWorker(router)
+
+	info := ContainerInfo{Ports: nat.PortMap{"80/tcp": nil}}
+
+	_, err := worker.routeTaskPorts("task", &info)
+	require.Error(t, err)
+	assert.Empty(t, router.reals)
+}
+
+func TestRoutesReleasedOnTaskExit(t *testing.T) {
+	router := newFakeRouter()
+	worker := newTestRoutingWorker(router)
+
+	info := ContainerInfo{
+		IP:    "172.17.0.2",
+		Ports: nat.PortMap{"80/tcp": nil},
+	}
+	_, err := worker.routeTaskPorts("task", &info)
+	require.NoError(t, err)
+	worker.saveContainerInfo("task", info)
+
+	// The status channel is closed once the container has exited.
+	status := make(chan ContainerStatus)
+	close(status)
+	worker.listenForStatus(status, "task")
+
+	assert.Equal(t, []string{"task_80/tcp"}, router.deregistered)
+
+	// Routes are released only once.
+	worker.releaseTaskRoutes("task")
+	assert.Equal(t, []string{"task_80/tcp"}, router.deregistered)
+}
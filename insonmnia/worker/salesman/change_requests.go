@@ -0,0 +1,91 @@
+package salesman
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/sonm-io/core/proto"
+)
+
+type ChangeRequestsConfig struct {
+	// AutoApprove enables approval of consumers' change requests that fit
+	// into the bounds below.
+	AutoApprove bool `yaml:"auto_approve" default:"false"`
+	// MinPricePercent is the lowest acceptable price relative to the ask
+	// plan's price.
+	MinPricePercent uint64 `yaml:"min_price_percent" default:"100"`
+	// MaxDuration limits the duration of forward deals, zero means no limit.
+	MaxDuration time.Duration `yaml:"max_duration"`
+}
+
+func (m *Salesman) maybeApproveChangeRequests(ctx context.Context, plan *sonm.AskPlan, deal *sonm.Deal) error {
+	if !m.config.ChangeRequests.AutoApprove {
+		return nil
+	}
+
+	reply, err := m.dwh.GetDealChangeRequests(ctx, deal.GetId())
+	if err != nil {
+		return fmt.Errorf("could not get change requests from DWH: %s", err)
+	}
+
+	for _, request := range reply.GetRequests() {
+		if request.GetRequestType() != sonm.OrderType_BID || request.GetStatus() != sonm.ChangeRequestStatus_REQUEST_CREATED {
+			continue
+		}
+
+		// DWH may lag behind the blockchain, so the request could already
+		// be accepted or cancelled.
+		request, err := m.eth.Market().GetDealChangeRequestInfo(ctx, request.GetId().Unwrap())
+		if err != nil {
+			return fmt.Errorf("could not get change request info: %s", err)
+		}
+		if request.GetStatus() != sonm.ChangeRequestStatus_REQUEST_CREATED {
+			continue
+		}
+
+		if err := m.checkChangeRequest(plan, deal, request); err != nil {
+			m.log.Debugf("skipping change request %s for deal %s: %s",
+				request.GetId().Unwrap().String(), deal.GetId().Unwrap().String(), err)
+			continue
+		}
+
+		matching := &sonm.DealChangeRequest{
+			DealID:   deal.GetId(),
+			Duration: request.GetDuration(),
+			Price:    request.GetPrice(),
+		}
+		if _, err := m.eth.Market().CreateChangeRequest(ctx, m.ethkey, matching); err != nil {
+			return fmt.Errorf("could not approve change request %s: %s", request.GetId().Unwrap().String(), err)
+		}
+		m.log.Infof("approved change request %s for deal %s", request.GetId().Unwrap().String(), deal.GetId().Unwrap().String())
+	}
+
+	return nil
+}
+
+func (m *Salesman) checkChangeRequest(plan *sonm.AskPlan, deal *sonm.Deal, request *sonm.DealChangeRequest) error {
+	cfg := m.config.ChangeRequests
+
+	if deal.IsSpot() {
+		if request.GetDuration() != 0 {
+			return fmt.Errorf("duration of spot deal can not be changed")
+		}
+	} else {
+		if request.GetDuration() == 0 {
+			return fmt.Errorf("forward deal can not be turned into spot one")
+		}
+		if cfg.MaxDuration != 0 && time.Duration(request.GetDuration())*time.Second > cfg.MaxDuration {
+			return fmt.Errorf("duration %ds exceeds the limit of %s", request.GetDuration(), cfg.MaxDuration)
+		}
+	}
+
+	minPrice := big.NewInt(0).Mul(plan.GetPrice().GetPerSecond().Unwrap(), big.NewInt(0).SetUint64(cfg.MinPricePercent))
+	minPrice.Div(minPrice, big.NewInt(100))
+	if request.GetPrice().Unwrap().Cmp(minPrice) < 0 {
+		return fmt.Errorf("price %s is lower than the minimum of %s", request.GetPrice().Unwrap().String(), minPrice.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package salesman
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain/simulated"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCheckChangeRequest(t *testing.T) {
+	plan := &sonm.AskPlan{Price: &sonm.Price{PerSecond: sonm.NewBigIntFromInt(100)}}
+
+	tests := []struct {
+		name     string
+		cfg      ChangeRequestsConfig
+		duration uint64
+		request  *sonm.DealChangeRequest
+		valid    bool
+	}{
+		{
+			name:    "spot deal price at the plan price",
+			cfg:     ChangeRequestsConfig{MinPricePercent: 100},
+			request: &sonm.DealChangeRequest{Price: sonm.NewBigIntFromInt(100)},
+			valid:   true,
+		},
+		{
+			name:    "spot deal price below the minimum",
+			cfg:     ChangeRequestsConfig{MinPricePercent: 100},
+			request: &sonm.DealChangeRequest{Price: sonm.NewBigIntFromInt(99)},
+		},
+		{
+			name:    "spot deal price at the lowered minimum",
+			cfg:     ChangeRequestsConfig{MinPricePercent: 90},
+			request: &sonm.DealChangeRequest{Price: sonm.NewBigIntFromInt(90)},
+			valid:   true,
+		},
+		{
+			name:    "spot deal turned into forward one",
+			cfg:     ChangeRequestsConfig{MinPricePercent: 100},
+			request: &sonm.DealChangeRequest{Duration: 3600, Price: sonm.NewBigIntFromInt(100)},
+		},
+		{
+			name:     "forward deal turned into spot one",
+			cfg:      ChangeRequestsConfig{MinPricePercent: 100},
+			duration: 3600,
+			request:  &sonm.DealChangeRequest{Price: sonm.NewBigIntFromInt(100)},
+		},
+		{
+			name:     "forward deal prolonged without limit",
+			cfg:      ChangeRequestsConfig{MinPricePercent: 100},
+			duration: 3600,
+			request:  &sonm.DealChangeRequest{Duration: 86400, Price: sonm.NewBigIntFromInt(100)},
+			valid:    true,
+		},
+		{
+			name:     "forward deal prolonged within the limit",
+			cfg:      ChangeRequestsConfig{MinPricePercent: 100, MaxDuration: 2 * time.Hour},
+			duration: 3600,
+			request:  &sonm.DealChangeRequest{Duration: 7200, Price: sonm.NewBigIntFromInt(100)},
+			valid:    true,
+		},
+		{
+			name:     "forward deal prolonged beyond the limit",
+			cfg:      ChangeRequestsConfig{MinPricePercent: 100, MaxDuration: 2 * time.Hour},
+			duration: 3600,
+			request:  &sonm.DealChangeRequest{Duration: 7201, Price: sonm.NewBigIntFromInt(100)},
+		},
+		{
+			name:     "forward deal price below the minimum",
+			cfg:      ChangeRequestsConfig{MinPricePercent: 100},
+			duration: 3600,
+			request:  &sonm.DealChangeRequest{Duration: 3600, Price: sonm.NewBigIntFromInt(99)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			salesman := &Salesman{options: &options{config: &YAMLConfig{ChangeRequests: test.cfg}}}
+			deal := &sonm.Deal{Duration: test.duration, Price: sonm.NewBigIntFromInt(100)}
+
+			err := salesman.checkChangeRequest(plan, deal, test.request)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMaybeApproveChangeRequests(t *testing.T) {
+	ctx := context.Background()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	workerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	consumerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	backend := simulated.NewBackend(simulated.WithTime(time.Now()))
+	backend.MintSidechain(crypto.PubkeyToAddress(consumerKey.PublicKey), payment(100000))
+	_, err = backend.SideToken().Approve(ctx, consumerKey, backend.MarketAddress().Hex(), payment(100000))
+	require.NoError(t, err)
+
+	order := func(orderType sonm.OrderType) *sonm.Order {
+		return &sonm.Order{
+			OrderType:  orderType,
+			Duration:   3600,
+			Price:      sonm.NewBigInt(pricePerSecond),
+			Benchmarks: &sonm.Benchmarks{Values: make([]uint64, sonm.MinNumBenchmarks)},
+		}
+	}
+	ask := <-backend.Market().PlaceOrder(ctx, workerKey, order(sonm.OrderType_ASK))
+	require.NoError(t, ask.Err)
+	bid := <-backend.Market().PlaceOrder(ctx, consumerKey, order(sonm.OrderType_BID))
+	require.NoError(t, bid.Err)
+	opened := <-backend.Market().OpenDeal(ctx, consumerKey, ask.Order.GetId().Unwrap(), bid.Order.GetId().Unwrap())
+	require.NoError(t, opened.Err)
+	deal := opened.Deal
+
+	requestChange := func(price *big.Int) *sonm.DealChangeRequest {
+		id, err := backend.Market().CreateChangeRequest(ctx, consumerKey, &sonm.DealChangeRequest{
+			DealID:   deal.GetId(),
+			Duration: 7200,
+			Price:    sonm.NewBigInt(price),
+		})
+		require.NoError(t, err)
+
+		request, err := backend.Market().GetDealChangeRequestInfo(ctx, id)
+		require.NoError(t, err)
+		return request
+	}
+
+	dwh := sonm.NewMockDWHClient(controller)
+	salesman := &Salesman{
+		options: &options{
+			log:    zap.NewNop().Sugar(),
+			eth:    backend,
+			dwh:    dwh,
+			ethkey: workerKey,
+			config: &YAMLConfig{ChangeRequests: ChangeRequestsConfig{AutoApprove: true, MinPricePercent: 100}},
+		},
+	}
+	plan := &sonm.AskPlan{Price: &sonm.Price{PerSecond: sonm.NewBigInt(pricePerSecond)}}
+
+	cheap := requestChange(new(big.Int).Sub(pricePerSecond, big.NewInt(1)))
+	dwh.EXPECT().GetDealChangeRequests(gomock.Any(), deal.GetId()).
+		Return(&sonm.DealChangeRequestsReply{Requests: []*sonm.DealChangeRequest{cheap}}, nil)
+	require.NoError(t, salesman.maybeApproveChangeRequests(ctx, plan, deal))
+
+	cheap, err = backend.Market().GetDealChangeRequestInfo(ctx, cheap.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, sonm.ChangeRequestStatus_REQUEST_CREATED, cheap.GetStatus())
+
+	// DWH may still report the replaced request, which must be skipped.
+	fair := requestChange(pricePerSecond)
+	dwh.EXPECT().GetDealChangeRequests(gomock.Any(), deal.GetId()).
+		Return(&sonm.DealChangeRequestsReply{Requests: []*sonm.DealChangeRequest{cheap, fair}}, nil)
+	require.NoError(t, salesman.maybeApproveChangeRequests(ctx, plan, deal))
+
+	fair, err = backend.Market().GetDealChangeRequestInfo(ctx, fair.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, sonm.ChangeRequestStatus_REQUEST_ACCEPTED, fair.GetStatus())
+
+	deal, err = backend.Market().GetDealInfo(ctx, deal.GetId().Unwrap())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7200), deal.GetDuration())
+}
+
+func TestMaybeApproveChangeRequestsDisabled(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	// Neither DWH nor the blockchain are queried.
+	salesman := &Salesman{
+		options: &options{
+			dwh:    sonm.NewMockDWHClient(controller),
+			config: &YAMLConfig{ChangeRequests: ChangeRequestsConfig{MinPricePercent: 100}},
+		},
+	}
+
+	assert.NoError(t, salesman.maybeApproveChangeRequests(context.Background(), &sonm.AskPlan{}, &sonm.Deal{}))
+}
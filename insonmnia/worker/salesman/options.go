@@ -10,6 +10,7 @@ import (
 	"github.com/sonm-io/core/insonmnia/matcher"
 	"github.com/sonm-io/core/insonmnia/resource"
 	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util/multierror"
 	"go.uber.org/zap"
 )
@@ -20,6 +21,7 @@ type options struct {
 	resources     *resource.Scheduler
 	hardware      *hardware.Hardware
 	eth           blockchain.API
+	dwh           sonm.DWHClient
 	cGroupManager cgroups.CGroupManager
 	matcher       matcher.Matcher
 	ethkey        *ecdsa.PrivateKey
@@ -52,6 +54,11 @@ func WithEth(eth blockchain.API) Option {
 		opts.eth = eth
 	}
 }
+func WithDWH(dwh sonm.DWHClient) Option {
+	return func(opts *options) {
+		opts.dwh = dwh
+	}
+}
 func WithCGroupManager(cGroupManager cgroups.CGroupManager) Option {
 	return func(opts *options) {
 		opts.cGroupManager = cGroupManager
@@ -95,6 +102,10 @@ func (m *options) Validate() error {
 		err = multierror.Append(err, errors.New("WithEth option is required"))
 	}
 
+	if m.dwh == nil {
+		err = multierror.Append(err, errors.New("WithDWH option is required"))
+	}
+
 	if m.cGroupManager == nil {
 		err = multierror.Append(err, errors.New("WithCGroupManager option is required"))
 	}
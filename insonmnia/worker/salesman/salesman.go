@@ -35,11 +35,12 @@ type Config struct {
 }
 
 type YAMLConfig struct {
-	RegularBillPeriod    time.Duration `yaml:"regular_deal_bill_period" default:"24h"`
-	SpotBillPeriod       time.Duration `yaml:"spot_deal_bill_period" default:"1h"`
-	SyncStepTimeout      time.Duration `yaml:"sync_step_timeout" default:"2m"`
-	SyncInterval         time.Duration `yaml:"sync_interval" default:"10s"`
-	MatcherRetryInterval time.Duration `yaml:"matcher_retry_interval" default:"10s"`
+	RegularBillPeriod    time.Duration        `yaml:"regular_deal_bill_period" default:"24h"`
+	SpotBillPeriod       time.Duration        `yaml:"spot_deal_bill_period" default:"1h"`
+	SyncStepTimeout      time.Duration        `yaml:"sync_step_timeout" default:"2m"`
+	SyncInterval         time.Duration        `yaml:"sync_interval" default:"10s"`
+	MatcherRetryInterval time.Duration        `yaml:"matcher_retry_interval" default:"10s"`
+	ChangeRequests       ChangeRequestsConfig `yaml:"change_requests"`
 }
 
 type Salesman struct {
@@ -301,7 +302,7 @@ func (m *Salesman) restoreState() error {
 			}
 		}
 	}
-	//TODO: restore tasks
+	// Tasks are restored by the Worker after ask plans and their resources.
 	return nil
 }
 
@@ -355,6 +356,9 @@ func (m *Salesman) checkDeal(ctx context.Context, plan *sonm.AskPlan, deal *sonm
 		m.log.Debugf("succesefully removed closed deal %s from ask plan %s", deal.GetId().Unwrap().String(), plan.GetID())
 		return nil
 	} else {
+		if err := m.maybeApproveChangeRequests(ctx, plan, deal); err != nil {
+			m.log.Warnf("could not approve change requests for deal %s: %s", deal.GetId().Unwrap().String(), err)
+		}
 		errClose := m.maybeCloseDeal(ctx, deal)
 		errBill := m.maybeBillDeal(ctx, deal)
 		if errBill != nil && errClose != nil {
@@ -371,7 +375,11 @@ func (m *Salesman) checkDeal(ctx context.Context, plan *sonm.AskPlan, deal *sonm
 }
 
 func (m *Salesman) maybeBillDeal(ctx context.Context, deal *sonm.Deal) error {
-	start := deal.StartTime.Unix()
+	lastBill := deal.GetStartTime().Unix()
+	if deal.GetLastBillTS() != nil && deal.GetLastBillTS().GetSeconds() > 0 {
+		lastBill = deal.GetLastBillTS().Unix()
+	}
+
 	var billPeriod time.Duration
 	if deal.IsSpot() {
 		billPeriod = m.config.SpotBillPeriod
@@ -379,7 +387,7 @@ func (m *Salesman) maybeBillDeal(ctx context.Context, deal *sonm.Deal) error {
 		billPeriod = m.config.RegularBillPeriod
 	}
 
-	if time.Now().Sub(start) > billPeriod {
+	if time.Now().Sub(lastBill) > billPeriod {
 		if err := <-m.eth.Market().Bill(ctx, m.ethkey, deal.GetId().Unwrap()); err != nil {
 			return err
 		}
@@ -0,0 +1,176 @@
+package salesman
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/blockchain/simulated"
+	"github.com/sonm-io/core/insonmnia/cgroups"
+	"github.com/sonm-io/core/insonmnia/hardware"
+	"github.com/sonm-io/core/insonmnia/resource"
+	"github.com/sonm-io/core/insonmnia/state"
+	"github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// pricePerSecond is chosen so that, with the default oracle rate, payments
+// are equal to price multiplied by seconds.
+var pricePerSecond = big.NewInt(1e12)
+
+func payment(seconds int64) *big.Int {
+	return new(big.Int).Mul(pricePerSecond, big.NewInt(seconds))
+}
+
+// simulatedMatcher opens deals with the given bid, like the real matcher
+// does with the best matching one found in DWH.
+type simulatedMatcher struct {
+	backend *simulated.Backend
+	key     *ecdsa.PrivateKey
+	bidID   *big.Int
+}
+
+func (m *simulatedMatcher) CreateDealByOrder(ctx context.Context, order *sonm.Order) (*sonm.Deal, error) {
+	deal := <-m.backend.Market().OpenDeal(ctx, m.key, order.GetId().Unwrap(), m.bidID)
+	return deal.Deal, deal.Err
+}
+
+func newTestHardware() *hardware.Hardware {
+	return &hardware.Hardware{
+		CPU: &sonm.CPU{Device: &sonm.CPUDevice{Cores: 2}, Benchmarks: map[uint64]*sonm.Benchmark{}},
+		RAM: &sonm.RAM{Device: &sonm.RAMDevice{Total: 1 << 30, Available: 1 << 30}, Benchmarks: map[uint64]*sonm.Benchmark{}},
+		Network: &sonm.Network{
+			In:            1e6,
+			Out:           1e6,
+			BenchmarksIn:  map[uint64]*sonm.Benchmark{},
+			BenchmarksOut: map[uint64]*sonm.Benchmark{},
+		},
+		Storage: &sonm.Storage{Device: &sonm.StorageDevice{BytesAvailable: 1 << 30}, Benchmarks: map[uint64]*sonm.Benchmark{}},
+	}
+}
+
+// nextDeal returns the first deal the Worker is notified about that
+// satisfies the condition.
+func nextDeal(t *testing.T, deals <-chan *sonm.Deal, condition func(deal *sonm.Deal) bool) *sonm.Deal {
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case deal := <-deals:
+			if condition(deal) {
+				return deal
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for deal")
+		}
+	}
+}
+
+// TestAskPlanLifecycle checks the way from an ask plan to the deal the Worker
+// starts tasks for, which is then billed and closed.
+func TestAskPlanLifecycle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dir, err := ioutil.TempDir("", "salesman")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	storage, err := state.NewState(ctx, &state.StorageConfig{Endpoint: filepath.Join(dir, "worker.boltdb"), Bucket: "sonm"})
+	require.NoError(t, err)
+
+	workerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	consumerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	worker := crypto.PubkeyToAddress(workerKey.PublicKey)
+	consumer := crypto.PubkeyToAddress(consumerKey.PublicKey)
+
+	backend := simulated.NewBackend(simulated.WithTime(time.Now()))
+	backend.MintSidechain(consumer, payment(10000))
+	_, err = backend.SideToken().Approve(ctx, consumerKey, backend.MarketAddress().Hex(), payment(10000))
+	require.NoError(t, err)
+
+	bid := <-backend.Market().PlaceOrder(ctx, consumerKey, &sonm.Order{
+		OrderType:  sonm.OrderType_BID,
+		Price:      sonm.NewBigInt(pricePerSecond),
+		Benchmarks: &sonm.Benchmarks{Values: make([]uint64, sonm.MinNumBenchmarks)},
+	})
+	require.NoError(t, bid.Err)
+
+	hw := newTestHardware()
+	_, cGroupManager, err := cgroups.NewCgroupManager("", nil)
+	require.NoError(t, err)
+
+	salesman, err := NewSalesman(
+		WithLogger(zap.NewNop().Sugar()),
+		WithStorage(storage),
+		WithResources(resource.NewScheduler(ctx, hw)),
+		WithHardware(hw),
+		WithEth(backend),
+		WithDWH(sonm.NewMockDWHClient(controller)),
+		WithCGroupManager(cGroupManager),
+		WithMatcher(&simulatedMatcher{backend: backend, key: workerKey, bidID: bid.Order.GetId().Unwrap()}),
+		WithEthkey(workerKey),
+		WithConfig(&YAMLConfig{
+			SpotBillPeriod:       time.Nanosecond,
+			SyncStepTimeout:      time.Second,
+			SyncInterval:         10 * time.Millisecond,
+			MatcherRetryInterval: 10 * time.Millisecond,
+		}),
+	)
+	require.NoError(t, err)
+
+	planID, err := salesman.CreateAskPlan(&sonm.AskPlan{
+		Price:     &sonm.Price{PerSecond: sonm.NewBigInt(pricePerSecond)},
+		Resources: sonm.NewEmptyAskPlanResources(),
+	})
+	require.NoError(t, err)
+
+	deals := salesman.Run(ctx)
+
+	deal := nextDeal(t, deals, func(deal *sonm.Deal) bool { return true })
+	dealID := deal.GetId().Unwrap()
+	assert.Equal(t, sonm.DealStatus_DEAL_ACCEPTED, deal.GetStatus())
+	assert.Equal(t, worker, deal.GetSupplierID().Unwrap())
+	assert.Equal(t, consumer, deal.GetConsumerID().Unwrap())
+
+	// The salesman bills the deal for the time passed.
+	backend.AdvanceTime(time.Hour)
+	nextDeal(t, deals, func(deal *sonm.Deal) bool {
+		return deal.GetTotalPayout().Unwrap().Cmp(payment(3600)) == 0
+	})
+
+	balance, err := backend.SideToken().BalanceOf(ctx, worker.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, payment(3600), balance)
+
+	plan, err := salesman.AskPlanByDeal(sonm.NewBigInt(dealID))
+	require.NoError(t, err)
+	assert.Equal(t, planID, plan.GetID())
+
+	// Once the consumer closes the deal, the Worker is notified to stop its
+	// tasks and the ask plan is released for a new order.
+	require.NoError(t, <-backend.Market().CloseDeal(ctx, consumerKey, dealID, false))
+	nextDeal(t, deals, func(deal *sonm.Deal) bool {
+		return deal.GetStatus() == sonm.DealStatus_DEAL_CLOSED
+	})
+
+	_, err = salesman.AskPlanByDeal(sonm.NewBigInt(dealID))
+	for idx := 0; err == nil && idx < 100; idx++ {
+		time.Sleep(10 * time.Millisecond)
+		_, err = salesman.AskPlanByDeal(sonm.NewBigInt(dealID))
+	}
+	assert.Error(t, err)
+}
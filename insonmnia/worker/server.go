@@ -15,6 +15,7 @@ import (
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gliderlabs/ssh"
 	"github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/mohae/deepcopy"
 	log "github.com/noxiouz/zapctx/ctxlog"
@@ -34,6 +35,7 @@ import (
 	bm "github.com/sonm-io/core/insonmnia/benchmarks"
 	"github.com/sonm-io/core/insonmnia/hardware"
 	"github.com/sonm-io/core/insonmnia/resource"
+	"github.com/sonm-io/core/insonmnia/state"
 	"github.com/sonm-io/core/insonmnia/structs"
 	"github.com/sonm-io/core/insonmnia/worker/volume"
 	pb "github.com/sonm-io/core/proto"
@@ -60,6 +62,7 @@ var (
 		workerAPIPrefix + "CreateAskPlan",
 		workerAPIPrefix + "RemoveAskPlan",
 		workerAPIPrefix + "PurgeAskPlans",
+		workerAPIPrefix + "Whitelist",
 	}
 )
 
@@ -90,6 +93,14 @@ type Worker struct {
 	// TODO: It's doubtful that we should keep this map here instead in the Overseer.
 	containers map[string]*ContainerInfo
 
+	// Persistent task states used to re-adopt tasks after the Worker restart.
+	//
+	// WARNING: This must be protected using `mu`.
+	tasks       map[string]*taskState
+	taskStorage *state.KeyedStorage
+	// Task lifecycle events broadcaster used by WatchTasks.
+	taskEvents *taskEventBroadcaster
+
 	controlGroup  cgroups.CGroup
 	cGroupManager cgroups.CGroupManager
 	listener      *npp.Listener
@@ -107,6 +118,8 @@ func NewWorker(opts ...Option) (m *Worker, err error) {
 		options:     o,
 		containers:  make(map[string]*ContainerInfo),
 		nameMapping: make(map[string]string),
+		tasks:       make(map[string]*taskState),
+		taskEvents:  newTaskEventBroadcaster(),
 	}
 
 	if err := m.SetupDefaults(); err != nil {
@@ -264,10 +277,24 @@ func (m *Worker) setupAuthorization() error {
 		auth.Allow(taskAPIPrefix+"JoinNetwork").With(newDealAuthorization(m.ctx, m, newFromNamedTaskDealExtractor(m, "TaskID"))),
 		auth.Allow(taskAPIPrefix+"StartTask").With(newDealAuthorization(m.ctx, m, newFieldDealExtractor())),
 		auth.Allow(taskAPIPrefix+"TaskLogs").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"Exec").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"CopyTo").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"CopyFrom").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"WatchTasks").With(newMultiAuth(
+			managementAuth,
+			newDealAuthorization(m.ctx, m, newWatchTasksDealExtractor(m)),
+		)),
 		auth.Allow(taskAPIPrefix+"PushTask").With(newDealAuthorization(m.ctx, m, newContextDealExtractor())),
 		auth.Allow(taskAPIPrefix+"PullTask").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
 			return structs.DealID(request.(*pb.PullTaskRequest).DealId), nil
 		}))),
+		auth.Allow(taskAPIPrefix+"ImageLayers").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
+			return structs.DealID(request.(*pb.ImageLayersRequest).GetDealId()), nil
+		}))),
+		auth.Allow(taskAPIPrefix+"CommitTask").With(newDealAuthorization(m.ctx, m, newFromTaskDealExtractor(m))),
+		auth.Allow(taskAPIPrefix+"TaskSnapshots").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
+			return structs.DealID(request.(*pb.TaskSnapshotsRequest).GetDealID().Unwrap().String()), nil
+		}))),
 		auth.Allow(taskAPIPrefix+"GetDealInfo").With(newDealAuthorization(m.ctx, m, newRequestDealExtractor(func(request interface{}) (structs.DealID, error) {
 			return structs.DealID(request.(*pb.ID).GetId()), nil
 		}))),
@@ -336,22 +363,39 @@ func (m *Worker) listenDeals(dealsCh <-chan *pb.Deal) {
 func (m *Worker) cancelDealTasks(deal *pb.Deal) error {
 	dealID := deal.GetId().Unwrap().String()
 	var toDelete []*ContainerInfo
+	var taskIDs []string
+	var routes []string
 
 	m.mu.Lock()
 	for key, container := range m.containers {
 		if container.DealID == dealID {
 			toDelete = append(toDelete, container)
+			taskIDs = append(taskIDs, key)
+			routes = append(routes, container.Routes...)
+			container.Routes = nil
 			delete(m.containers, key)
 		}
 	}
 	m.mu.Unlock()
 
+	m.removeTaskStates(taskIDs...)
+	m.deregisterRoutes(routes)
+
 	result := multierror.NewMultiError()
 	for _, container := range toDelete {
 		if err := m.ovs.OnDealFinish(m.ctx, container.ID); err != nil {
 			result = multierror.Append(result, err)
 		}
 	}
+	if err := m.ovs.RemoveSnapshots(m.ctx, dealID); err != nil {
+		result = multierror.Append(result, err)
+	}
+	if err := m.plugins.ReleaseDeal(dealID); err != nil {
+		result = multierror.Append(result, err)
+	}
+	if err := m.removeTransfers("*", dealID, ""); err != nil {
+		result = multierror.Append(result, err)
+	}
 	return result.ErrorOrNil()
 }
 
@@ -445,15 +489,39 @@ func (m *Worker) setStatus(status *pb.TaskStatusReply, id string) {
 	}
 }
 
-func (m *Worker) listenForStatus(statusListener chan pb.TaskStatusReply_Status, id string) {
-	select {
-	case newStatus, ok := <-statusListener:
-		if !ok {
+func (m *Worker) listenForStatus(statusListener chan ContainerStatus, id string) {
+	for {
+		select {
+		case newStatus, ok := <-statusListener:
+			if !ok {
+				// The channel is closed after the final status, so the
+				// task has exited and its gateway ports are no longer
+				// needed.
+				m.releaseTaskRoutes(id)
+				return
+			}
+
+			restarted := false
+			var dealID *pb.BigInt
+			m.mu.Lock()
+			if info, ok := m.containers[id]; ok {
+				restarted = newStatus.RestartCount > info.RestartCount
+				info.RestartCount = newStatus.RestartCount
+				info.ExitCode = newStatus.ExitCode
+				dealID, _ = pb.NewBigIntFromString(info.DealID)
+			}
+			m.mu.Unlock()
+
+			m.setStatus(&pb.TaskStatusReply{Status: newStatus.Status}, id)
+
+			if restarted {
+				m.publishTaskEvent(id, dealID, pb.TaskEvent_RESTARTED, newStatus)
+			} else {
+				m.publishTaskEvent(id, dealID, taskEventType(newStatus), newStatus)
+			}
+		case <-m.ctx.Done():
 			return
 		}
-		m.setStatus(&pb.TaskStatusReply{Status: newStatus}, id)
-	case <-m.ctx.Done():
-		return
 	}
 }
 
@@ -467,6 +535,25 @@ func transformRestartPolicy(p *pb.ContainerRestartPolicy) container.RestartPolic
 	return restartPolicy
 }
 
+func transformHealthCheck(h *pb.HealthCheck) *container.HealthConfig {
+	if h == nil {
+		return nil
+	}
+
+	healthCheck := &container.HealthConfig{
+		Interval:    h.GetInterval().Unwrap(),
+		Timeout:     h.GetTimeout().Unwrap(),
+		StartPeriod: h.GetStartPeriod().Unwrap(),
+		Retries:     int(h.GetRetries()),
+	}
+
+	if len(h.GetCommand()) > 0 {
+		healthCheck.Test = append([]string{"CMD"}, h.GetCommand()...)
+	}
+
+	return healthCheck
+}
+
 func (m *Worker) PushTask(stream pb.Worker_PushTaskServer) error {
 	log.G(m.ctx).Info("handling PushTask request")
 	if err := m.eventAuthorization.Authorize(stream.Context(), auth.Event(taskAPIPrefix+"PushTask"), nil); err != nil {
@@ -477,9 +564,14 @@ func (m *Worker) PushTask(stream pb.Worker_PushTaskServer) error {
 	if err != nil {
 		return err
 	}
-	log.G(m.ctx).Info("pushing image", zap.Int64("size", request.ImageSize()))
+	log.G(m.ctx).Info("pushing image", zap.Int64("size", request.ImageSize()), zap.String("digest", request.Digest()))
 
-	result, err := m.ovs.Load(stream.Context(), newChunkReader(stream))
+	var result imageLoadStatus
+	if len(request.Digest()) == 0 {
+		result, err = m.ovs.Load(stream.Context(), newChunkReader(stream))
+	} else {
+		result, err = m.pushImage(stream, request)
+	}
 	if err != nil {
 		return err
 	}
@@ -517,33 +609,51 @@ func (m *Worker) PullTask(request *pb.PullTaskRequest, stream pb.Worker_PullTask
 	}
 	imageID := tagged.String()
 
-	log.G(ctx).Debug("pulling image", zap.String("imageID", imageID))
+	if request.GetSnapshot() != 0 {
+		if imageID, err = m.snapshotImage(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	log.G(ctx).Debug("pulling image", zap.String("imageID", imageID), zap.Int64("offset", request.GetOffset()))
 
-	info, rd, err := m.ovs.Save(stream.Context(), imageID)
+	return m.pullImage(stream.Context(), imageID, request, stream)
+}
+
+// snapshotImage returns the name of the task's snapshot requested to pull.
+func (m *Worker) snapshotImage(ctx context.Context, request *pb.PullTaskRequest) (string, error) {
+	snapshots, err := m.ovs.Snapshots(ctx, request.GetDealId())
 	if err != nil {
-		return err
+		return "", status.Errorf(codes.Internal, "failed to list snapshots: %v", err)
 	}
-	defer rd.Close()
 
-	stream.SendHeader(metadata.Pairs("size", strconv.FormatInt(info.Size, 10)))
-
-	streaming := true
-	buf := make([]byte, 1*1024*1024)
-	for streaming {
-		n, err := rd.Read(buf)
-		if err != nil {
-			if err == io.EOF {
-				streaming = false
-			} else {
-				return err
+	for _, snapshot := range snapshots {
+		if snapshot.TaskID == request.GetTaskId() && snapshot.Serial == request.GetSnapshot() {
+			if len(snapshot.Name) == 0 {
+				return snapshot.ImageID, nil
 			}
+
+			return snapshot.Name, nil
 		}
-		if err := stream.Send(&pb.Chunk{Chunk: buf[:n]}); err != nil {
-			return err
+	}
+
+	return "", status.Errorf(codes.NotFound, "no snapshot %d of task %s", request.GetSnapshot(), request.GetTaskId())
+}
+
+func (m *Worker) ImageLayers(ctx context.Context, request *pb.ImageLayersRequest) (*pb.ImageLayersReply, error) {
+	layers, err := m.ovs.ImageLayers(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list image layers: %v", err)
+	}
+
+	reply := &pb.ImageLayersReply{ChainIDs: []string{}}
+	for _, chainID := range request.GetChainIDs() {
+		if layers[chainID] {
+			reply.ChainIDs = append(reply.ChainIDs, chainID)
 		}
 	}
 
-	return nil
+	return reply, nil
 }
 
 func (m *Worker) StartTask(ctx context.Context, request *pb.StartTaskRequest) (*pb.StartTaskReply, error) {
@@ -651,35 +761,48 @@ func (m *Worker) startTask(ctx context.Context, request *structs.StartTaskReques
 		Image:         request.Container.Image,
 		Registry:      request.Container.Registry,
 		Auth:          request.Container.Auth,
-		RestartPolicy: transformRestartPolicy(nil),
+		RestartPolicy: transformRestartPolicy(request.Container.GetRestartPolicy()),
+		HealthCheck:   transformHealthCheck(request.Container.GetHealthCheck()),
 		CGroupParent:  cgroup.Suffix(),
 		Resources:     request.Resources,
 		DealId:        request.GetDealId(),
+		DiskQuota:     ask.GetResources().GetStorage().GetSize().GetBytes(),
 		TaskId:        taskID,
 		CommitOnStop:  request.Container.CommitOnStop,
+		Entrypoint:    request.Container.Entrypoint,
+		Cmd:           request.Container.Args,
+		WorkDir:       request.Container.WorkDir,
+		User:          request.Container.User,
+		StopSignal:    request.Container.StopSignal,
+		StopTimeout:   request.Container.GetStopTimeout().Unwrap(),
 		GPUDevices:    gpuids,
 		Env:           request.Container.Env,
 		volumes:       request.Container.Volumes,
 		mounts:        mounts,
 		networks:      networks,
+		hidePorts:     m.router != nil,
 	}
 
 	// TODO: Detect whether it's the first time allocation. If so - release resources on error.
 
 	m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_SPOOLING}, taskID)
+	m.publishTaskEvent(taskID, dealID, pb.TaskEvent_SPOOLING, ContainerStatus{})
 	log.G(m.ctx).Info("spooling an image")
 	if err := m.ovs.Spool(ctx, d); err != nil {
 		log.G(ctx).Error("failed to Spool an image", zap.Error(err))
 		m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, taskID)
+		m.publishTaskEvent(taskID, dealID, pb.TaskEvent_BROKEN, ContainerStatus{})
 		return nil, status.Errorf(codes.Internal, "failed to Spool %v", err)
 	}
 
 	m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_SPAWNING}, taskID)
+	m.publishTaskEvent(taskID, dealID, pb.TaskEvent_SPAWNING, ContainerStatus{})
 	log.G(ctx).Info("spawning an image")
 	statusListener, containerInfo, err := m.ovs.Start(m.ctx, d)
 	if err != nil {
 		log.G(ctx).Error("failed to spawn an image", zap.Error(err))
 		m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, taskID)
+		m.publishTaskEvent(taskID, dealID, pb.TaskEvent_BROKEN, ContainerStatus{})
 		return nil, status.Errorf(codes.Internal, "failed to Spawn %v", err)
 	}
 	containerInfo.PublicKey = publicKey
@@ -693,38 +816,63 @@ func (m *Worker) startTask(ctx context.Context, request *structs.StartTaskReques
 		NetworkIDs: containerInfo.NetworkIDs,
 	}
 
-	for internalPort, portBindings := range containerInfo.Ports {
-		if len(portBindings) < 1 {
-			continue
+	if m.router != nil {
+		portMap, err := m.routeTaskPorts(taskID, &containerInfo)
+		if err != nil {
+			log.G(ctx).Error("failed to route task ports through the gateway", zap.Error(err))
+			if err := m.ovs.OnDealFinish(m.ctx, containerInfo.ID); err != nil {
+				log.G(ctx).Warn("failed to cleanup the container", zap.Error(err))
+			}
+			m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, taskID)
+			m.publishTaskEvent(taskID, dealID, pb.TaskEvent_BROKEN, ContainerStatus{})
+			return nil, status.Errorf(codes.Internal, "failed to route task ports: %v", err)
 		}
 
-		var socketAddrs []*pb.SocketAddr
-		var pubPortBindings []nat.PortBinding
-
-		for _, portBinding := range portBindings {
-			hostPort := portBinding.HostPort
-			hostPortInt, err := nat.ParsePort(hostPort)
-			if err != nil {
-				m.resources.ReleaseTask(taskID)
-				return nil, err
+		reply.PortMap = portMap
+	} else {
+		for internalPort, portBindings := range containerInfo.Ports {
+			if len(portBindings) < 1 {
+				continue
 			}
 
-			for _, publicIP := range m.publicIPs {
-				socketAddrs = append(socketAddrs, &pb.SocketAddr{
-					Addr: publicIP,
-					Port: uint32(hostPortInt),
-				})
+			var socketAddrs []*pb.SocketAddr
+			var pubPortBindings []nat.PortBinding
 
-				pubPortBindings = append(pubPortBindings, nat.PortBinding{HostIP: publicIP, HostPort: hostPort})
+			for _, portBinding := range portBindings {
+				hostPort := portBinding.HostPort
+				hostPortInt, err := nat.ParsePort(hostPort)
+				if err != nil {
+					m.resources.ReleaseTask(taskID)
+					return nil, err
+				}
+
+				for _, publicIP := range m.publicIPs {
+					socketAddrs = append(socketAddrs, &pb.SocketAddr{
+						Addr: publicIP,
+						Port: uint32(hostPortInt),
+					})
+
+					pubPortBindings = append(pubPortBindings, nat.PortBinding{HostIP: publicIP, HostPort: hostPort})
+				}
 			}
-		}
 
-		containerInfo.Ports[internalPort] = pubPortBindings
+			containerInfo.Ports[internalPort] = pubPortBindings
 
-		reply.PortMap[string(internalPort)] = &pb.Endpoints{Endpoints: socketAddrs}
+			reply.PortMap[string(internalPort)] = &pb.Endpoints{Endpoints: socketAddrs}
+		}
 	}
 
 	m.saveContainerInfo(taskID, containerInfo)
+	m.saveTaskState(taskID, &taskState{
+		AskPlanID:     ask.ID,
+		PublicKeyData: request.Container.PublicKeyData,
+		Info:          containerInfo,
+		Description:   d,
+		Volumes:       d.volumes,
+		Mounts:        d.mounts,
+	})
+
+	m.publishTaskEvent(taskID, dealID, pb.TaskEvent_RUNNING, ContainerStatus{})
 
 	go m.listenForStatus(statusListener, taskID)
 
@@ -745,6 +893,10 @@ func (m *Worker) StopTask(ctx context.Context, request *pb.ID) (*pb.Empty, error
 		return nil, status.Errorf(codes.NotFound, "no job with id %s", request.Id)
 	}
 
+	m.releaseTaskRoutes(request.Id)
+	// Stopped tasks must not be re-adopted after the Worker restart.
+	m.removeTaskStates(request.Id)
+
 	if err := m.ovs.Stop(ctx, containerInfo.ID); err != nil {
 		log.G(ctx).Error("failed to Stop container", zap.Error(err))
 		m.setStatus(&pb.TaskStatusReply{Status: pb.TaskStatusReply_BROKEN}, request.Id)
@@ -822,6 +974,219 @@ func (m *Worker) TaskLogs(request *pb.TaskLogsRequest, server pb.Worker_TaskLogs
 	}
 }
 
+// Exec executes a command inside the task's container, streaming its stdin,
+// stdout and stderr.
+func (m *Worker) Exec(stream pb.Worker_ExecServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	log.G(m.ctx).Info("handling Exec request", zap.String("id", request.GetId()), zap.Strings("cmd", request.GetCmd()))
+	if err := m.eventAuthorization.Authorize(stream.Context(), auth.Event(taskAPIPrefix+"Exec"), request); err != nil {
+		return err
+	}
+
+	if len(request.GetCmd()) == 0 {
+		return status.Error(codes.InvalidArgument, "command is required")
+	}
+
+	cid, ok := m.getContainerIdByTaskId(request.GetId())
+	if !ok {
+		return status.Errorf(codes.NotFound, "no job with id %s", request.GetId())
+	}
+
+	env := make([]string, 0, len(request.GetEnv()))
+	for key, value := range request.GetEnv() {
+		env = append(env, key+"="+value)
+	}
+
+	wCh := make(chan ssh.Window, 1)
+	if size := request.GetSize(); size != nil {
+		wCh <- ssh.Window{Width: int(size.GetWidth()), Height: int(size.GetHeight())}
+	}
+
+	conn, err := m.ovs.Exec(stream.Context(), cid, request.GetCmd(), env, request.GetTty(), wCh)
+	if err != nil {
+		close(wCh)
+		return err
+	}
+	defer conn.Close()
+
+	go func() {
+		defer close(wCh)
+		defer conn.CloseWrite()
+
+		if _, err := conn.Conn.Write(request.GetStdin()); err != nil {
+			return
+		}
+
+		for {
+			request, err := stream.Recv()
+			if err != nil {
+				return
+			}
+
+			if size := request.GetSize(); size != nil {
+				select {
+				case wCh <- ssh.Window{Width: int(size.GetWidth()), Height: int(size.GetHeight())}:
+				case <-stream.Context().Done():
+					return
+				}
+			}
+
+			if _, err := conn.Conn.Write(request.GetStdin()); err != nil {
+				return
+			}
+		}
+	}()
+
+	stdout := &execStreamWriter{stream: stream}
+	stderr := &execStreamWriter{stream: stream, stderr: true}
+	if request.GetTty() {
+		_, err = io.Copy(stdout, conn.Reader)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, conn.Reader)
+	}
+	if err != nil {
+		return err
+	}
+
+	exitCode, err := conn.ExitCode(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.ExecReply{Exited: true, ExitCode: int32(exitCode)})
+}
+
+// CopyTo extracts a tar archive streamed by the client into the task's
+// container.
+func (m *Worker) CopyTo(stream pb.Worker_CopyToServer) error {
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	log.G(m.ctx).Info("handling CopyTo request", zap.String("id", request.GetId()), zap.String("path", request.GetPath()))
+	if err := m.eventAuthorization.Authorize(stream.Context(), auth.Event(taskAPIPrefix+"CopyTo"), request); err != nil {
+		return err
+	}
+
+	cid, ok := m.getContainerIdByTaskId(request.GetId())
+	if !ok {
+		return status.Errorf(codes.NotFound, "no job with id %s", request.GetId())
+	}
+
+	if err := m.ovs.CopyTo(stream.Context(), cid, request.GetPath(), newCopyToReader(stream, request.GetChunk())); err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&pb.Empty{})
+}
+
+// CopyFrom streams the content of the given path inside the task's
+// container as a tar archive.
+func (m *Worker) CopyFrom(request *pb.CopyFromRequest, stream pb.Worker_CopyFromServer) error {
+	log.G(m.ctx).Info("handling CopyFrom request", zap.Any("request", request))
+	if err := m.eventAuthorization.Authorize(stream.Context(), auth.Event(taskAPIPrefix+"CopyFrom"), request); err != nil {
+		return err
+	}
+
+	cid, ok := m.getContainerIdByTaskId(request.GetId())
+	if !ok {
+		return status.Errorf(codes.NotFound, "no job with id %s", request.GetId())
+	}
+
+	reader, err := m.ovs.CopyFrom(stream.Context(), cid, request.GetPath())
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	buffer := make([]byte, 100*1024)
+	for {
+		readCnt, err := reader.Read(buffer)
+		if readCnt != 0 {
+			if err := stream.Send(&pb.Chunk{Chunk: buffer[:readCnt]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// CommitTask snapshots the task's container, optionally pushing the
+// snapshot to the registry.
+func (m *Worker) CommitTask(ctx context.Context, request *pb.CommitTaskRequest) (*pb.TaskSnapshot, error) {
+	log.G(m.ctx).Info("handling CommitTask request", zap.String("id", request.GetId()), zap.String("registry", request.GetRegistry()))
+
+	cid, ok := m.getContainerIdByTaskId(request.GetId())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no job with id %s", request.GetId())
+	}
+
+	snapshot, err := m.ovs.Commit(ctx, cid, request.GetRegistry(), request.GetAuth())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit task: %v", err)
+	}
+
+	return snapshot.Marshal(), nil
+}
+
+// TaskSnapshots lists snapshots of the deal's tasks available on the Worker.
+func (m *Worker) TaskSnapshots(ctx context.Context, request *pb.TaskSnapshotsRequest) (*pb.TaskSnapshotsReply, error) {
+	log.G(m.ctx).Info("handling TaskSnapshots request", zap.Any("request", request))
+
+	snapshots, err := m.ovs.Snapshots(ctx, request.GetDealID().Unwrap().String())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list snapshots: %v", err)
+	}
+
+	reply := &pb.TaskSnapshotsReply{Snapshots: make([]*pb.TaskSnapshot, 0, len(snapshots))}
+	for idx := range snapshots {
+		reply.Snapshots = append(reply.Snapshots, snapshots[idx].Marshal())
+	}
+
+	return reply, nil
+}
+
+// WatchTasks streams task lifecycle events.
+//
+// Deal consumers must limit events either to their deal or to their task,
+// while the Worker's owner is allowed to watch all tasks.
+func (m *Worker) WatchTasks(request *pb.WatchTasksRequest, stream pb.Worker_WatchTasksServer) error {
+	log.G(m.ctx).Info("handling WatchTasks request", zap.Any("request", request))
+	if err := m.eventAuthorization.Authorize(stream.Context(), auth.Event(taskAPIPrefix+"WatchTasks"), request); err != nil {
+		return err
+	}
+
+	events, unsubscribe := m.taskEvents.Subscribe(request)
+	defer unsubscribe()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "task events are consumed too slowly")
+			}
+
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-m.ctx.Done():
+			return status.Error(codes.Unavailable, "worker is shutting down")
+		}
+	}
+}
+
 //TODO: proper request
 func (m *Worker) JoinNetwork(ctx context.Context, request *pb.WorkerJoinNetworkRequest) (*pb.NetworkSpec, error) {
 	spec, err := m.plugins.JoinNetwork(request.NetworkID)
@@ -846,7 +1211,7 @@ func (m *Worker) TaskStatus(ctx context.Context, req *pb.ID) (*pb.TaskStatusRepl
 
 	var metric ContainerMetrics
 	// If a container has been stoped, ovs.Info has no metrics for such container
-	if info.status == pb.TaskStatusReply_RUNNING {
+	if info.status == pb.TaskStatusReply_RUNNING || info.status == pb.TaskStatusReply_UNHEALTHY {
 		metrics, err := m.ovs.Info(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "cannot get container metrics: %s", err.Error())
@@ -921,6 +1286,7 @@ func (m *Worker) runBenchmarks() error {
 
 func (m *Worker) setupResources() error {
 	m.resources = resource.NewScheduler(m.ctx, m.hardware)
+	m.taskStorage = state.NewKeyedStorage(tasksStorageKey, m.storage)
 	return nil
 }
 
@@ -931,6 +1297,7 @@ func (m *Worker) setupSalesman() error {
 		salesman.WithResources(m.resources),
 		salesman.WithHardware(m.hardware),
 		salesman.WithEth(m.eth),
+		salesman.WithDWH(m.dwh),
 		salesman.WithCGroupManager(m.cGroupManager),
 		salesman.WithMatcher(m.matcher),
 		salesman.WithEthkey(m.key),
@@ -941,6 +1308,10 @@ func (m *Worker) setupSalesman() error {
 	}
 	m.salesman = salesman
 
+	if err := m.restoreTasks(); err != nil {
+		return err
+	}
+
 	ch := m.salesman.Run(m.ctx)
 	go m.listenDeals(ch)
 	return nil
@@ -1065,15 +1436,24 @@ func (m *Worker) execBenchmarkContainerWithResults(d Description) (map[string]*b
 	stdoutBuf := bytes.Buffer{}
 	stderrBuf := bytes.Buffer{}
 
-	select {
-	case s := <-statusChan:
-		if s == pb.TaskStatusReply_FINISHED || s == pb.TaskStatusReply_BROKEN {
-			if _, err := stdcopy.StdCopy(&stdoutBuf, &stderrBuf, reader); err != nil {
-				return nil, fmt.Errorf("cannot read logs into buffer: %v", err)
+	// Wait until the container finishes, skipping intermediate statuses,
+	// like health status changes.
+	for finished := false; !finished; {
+		select {
+		case s, ok := <-statusChan:
+			if !ok {
+				finished = true
+				break
+			}
+			if s.Status == pb.TaskStatusReply_FINISHED || s.Status == pb.TaskStatusReply_BROKEN {
+				if _, err := stdcopy.StdCopy(&stdoutBuf, &stderrBuf, reader); err != nil {
+					return nil, fmt.Errorf("cannot read logs into buffer: %v", err)
+				}
+				finished = true
 			}
+		case <-m.ctx.Done():
+			return nil, m.ctx.Err()
 		}
-	case <-m.ctx.Done():
-		return nil, m.ctx.Err()
 	}
 
 	resultsMap, err := parseBenchmarkResult(stdoutBuf.Bytes())
@@ -1170,6 +1550,12 @@ func (m *Worker) PurgeAskPlans(ctx context.Context, _ *pb.Empty) (*pb.Empty, err
 	return &pb.Empty{}, nil
 }
 
+// Whitelist returns the effective image whitelist policy.
+func (m *Worker) Whitelist(ctx context.Context, _ *pb.Empty) (*pb.WhitelistReply, error) {
+	log.G(m.ctx).Info("handling Whitelist request")
+	return m.whitelist.Info(), nil
+}
+
 func (m *Worker) GetDealInfo(ctx context.Context, id *pb.ID) (*pb.DealInfoReply, error) {
 	log.G(m.ctx).Info("handling GetDealInfo request")
 
@@ -1211,7 +1597,8 @@ func (m *Worker) getDealInfo(dealID *pb.BigInt) (*pb.DealInfoReply, error) {
 			// task is running or preparing to start
 			if c.status == pb.TaskStatusReply_SPOOLING ||
 				c.status == pb.TaskStatusReply_SPAWNING ||
-				c.status == pb.TaskStatusReply_RUNNING {
+				c.status == pb.TaskStatusReply_RUNNING ||
+				c.status == pb.TaskStatusReply_UNHEALTHY {
 				running.Statuses[id] = task
 			} else {
 				completed.Statuses[id] = task
@@ -1260,4 +1647,10 @@ func (m *Worker) Close() {
 	if m.certRotator != nil {
 		m.certRotator.Close()
 	}
+	if m.router != nil {
+		m.router.Close()
+	}
+	if m.gateway != nil {
+		m.gateway.Close()
+	}
 }
@@ -0,0 +1,291 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/distribution/reference"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	pb "github.com/sonm-io/core/proto"
+	"go.uber.org/zap"
+)
+
+const (
+	snapshotTag = "sonm.snapshot"
+	// snapshotSizeTag is the size of changes committed from the container.
+	snapshotSizeTag = "sonm.snapshot.size"
+)
+
+// Snapshot describes an image committed from the task's container.
+type Snapshot struct {
+	DealID string
+	TaskID string
+	// Serial is the snapshot's number within the task, starting from 1.
+	Serial    uint64
+	Name      string
+	ImageID   string
+	CreatedAt time.Time
+	Size      uint64
+	// LayerSize is the size of changes committed from the container, which
+	// is counted against the storage purchased with the deal.
+	LayerSize uint64
+}
+
+func (m *Snapshot) Marshal() *pb.TaskSnapshot {
+	// Deal IDs are always numeric, since they come from the blockchain.
+	dealID, _ := pb.NewBigIntFromString(m.DealID)
+
+	return &pb.TaskSnapshot{
+		TaskID:    m.TaskID,
+		DealID:    dealID,
+		Serial:    m.Serial,
+		Name:      m.Name,
+		ImageID:   m.ImageID,
+		CreatedAt: &pb.Timestamp{Seconds: m.CreatedAt.Unix()},
+		Size:      m.Size,
+	}
+}
+
+// snapshotReference returns the name the task's snapshot is tagged with.
+//
+// Snapshots are named after the task's image, i.e. "<image>:<deal>_<task>_<n>",
+// and are placed under the registry if specified, keeping only the last
+// component of the image name, i.e. "<registry>/<name>:<deal>_<task>_<n>".
+func snapshotReference(d Description, registry string, serial uint64) (reference.NamedTagged, error) {
+	named, err := reference.ParseNormalizedNamed(filepath.Join(d.Registry, d.Image))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(registry) != 0 {
+		named, err = reference.ParseNormalizedNamed(strings.TrimSuffix(registry, "/") + "/" + path.Base(reference.Path(named)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid registry \"%s\": %v", registry, err)
+		}
+	}
+
+	return reference.WithTag(reference.TrimNamed(named), snapshotVersion(d.DealId, d.TaskId, serial))
+}
+
+func snapshotVersion(dealID, taskID string, serial uint64) string {
+	return fmt.Sprintf("%s_%s_%d", dealID, taskID, serial)
+}
+
+func (o *overseer) Commit(ctx context.Context, containerID, registry, auth string) (*Snapshot, error) {
+	o.mu.Lock()
+	descriptor, ok := o.containers[containerID]
+	o.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown container %s", containerID)
+	}
+
+	d := descriptor.description
+
+	// Serial numbers are derived from the existing snapshots, hence commits
+	// must not interleave.
+	o.snapshotMu.Lock()
+	defer o.snapshotMu.Unlock()
+
+	snapshots, err := o.listSnapshots(ctx, dealIDTag+"="+d.DealId)
+	if err != nil {
+		return nil, err
+	}
+
+	serial := uint64(1)
+	for _, snapshot := range snapshots {
+		if snapshot.TaskID == d.TaskId && snapshot.Serial >= serial {
+			serial = snapshot.Serial + 1
+		}
+	}
+
+	cjson, _, err := o.client.ContainerInspectWithRaw(ctx, containerID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	layerSize := uint64(0)
+	if cjson.SizeRw != nil && *cjson.SizeRw > 0 {
+		layerSize = uint64(*cjson.SizeRw)
+	}
+
+	// Snapshots are kept until the deal finishes, taking the storage
+	// purchased with it along with the containers.
+	if quota := d.DiskQuota; quota > 0 {
+		usage := o.containersDiskUsage(d.DealId) + layerSize
+		for _, snapshot := range snapshots {
+			usage += snapshot.LayerSize
+		}
+
+		if usage > quota {
+			return nil, fmt.Errorf("snapshot would make the deal use %d of %d bytes of purchased storage", usage, quota)
+		}
+	}
+
+	name, err := snapshotReference(d, registry, serial)
+	if err != nil {
+		return nil, err
+	}
+
+	log.G(ctx).Info("committing container", zap.String("id", containerID), zap.Stringer("name", name))
+
+	resp, err := o.client.ContainerCommit(ctx, containerID, types.ContainerCommitOptions{
+		Reference: name.String(),
+		Comment:   fmt.Sprintf("snapshot %d of task %s", serial, d.TaskId),
+		Changes: []string{
+			fmt.Sprintf("LABEL %s=%s", dealIDTag, d.DealId),
+			fmt.Sprintf("LABEL %s=%s", taskIDTag, d.TaskId),
+			fmt.Sprintf("LABEL %s=%d", snapshotTag, serial),
+			fmt.Sprintf("LABEL %s=%d", snapshotSizeTag, layerSize),
+		},
+		Pause: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit container: %v", err)
+	}
+
+	inspect, _, err := o.client.ImageInspectWithRaw(ctx, resp.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{
+		DealID:    d.DealId,
+		TaskID:    d.TaskId,
+		Serial:    serial,
+		Name:      name.String(),
+		ImageID:   resp.ID,
+		Size:      uint64(inspect.Size),
+		LayerSize: layerSize,
+	}
+
+	if createdAt, err := time.Parse(time.RFC3339Nano, inspect.Created); err == nil {
+		snapshot.CreatedAt = createdAt
+	}
+
+	if len(registry) == 0 {
+		return snapshot, nil
+	}
+
+	log.G(ctx).Info("pushing snapshot", zap.Stringer("name", name))
+
+	rd, err := o.client.ImagePush(ctx, name.String(), types.ImagePushOptions{RegistryAuth: auth})
+	if err != nil {
+		return nil, fmt.Errorf("snapshot %s is committed, but failed to push: %v", name, err)
+	}
+	defer rd.Close()
+
+	if err := decodeImagePushStream(rd); err != nil {
+		return nil, fmt.Errorf("snapshot %s is committed, but failed to push: %v", name, err)
+	}
+
+	return snapshot, nil
+}
+
+func (o *overseer) Snapshots(ctx context.Context, dealID string) ([]Snapshot, error) {
+	return o.listSnapshots(ctx, dealIDTag+"="+dealID)
+}
+
+// listSnapshots returns snapshots having the given labels, ordered by task
+// and serial number.
+func (o *overseer) listSnapshots(ctx context.Context, labels ...string) ([]Snapshot, error) {
+	filterArgs := filters.NewArgs()
+	filterArgs.Add("label", snapshotTag)
+	for _, label := range labels {
+		filterArgs.Add("label", label)
+	}
+
+	images, err := o.client.ImageList(ctx, types.ImageListOptions{Filters: filterArgs})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]Snapshot, 0, len(images))
+	for _, image := range images {
+		serial, err := strconv.ParseUint(image.Labels[snapshotTag], 10, 64)
+		if err != nil {
+			log.G(ctx).Warn("skipping snapshot with malformed serial", zap.String("id", image.ID), zap.Error(err))
+			continue
+		}
+
+		snapshot := Snapshot{
+			DealID:    image.Labels[dealIDTag],
+			TaskID:    image.Labels[taskIDTag],
+			Serial:    serial,
+			ImageID:   image.ID,
+			CreatedAt: time.Unix(image.Created, 0),
+			Size:      uint64(image.Size),
+		}
+
+		// Snapshots without the label are counted as empty.
+		if size, err := strconv.ParseUint(image.Labels[snapshotSizeTag], 10, 64); err == nil {
+			snapshot.LayerSize = size
+		}
+
+		version := ":" + snapshotVersion(snapshot.DealID, snapshot.TaskID, serial)
+		for _, tag := range image.RepoTags {
+			if strings.HasSuffix(tag, version) {
+				snapshot.Name = tag
+				break
+			}
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].TaskID != snapshots[j].TaskID {
+			return snapshots[i].TaskID < snapshots[j].TaskID
+		}
+
+		return snapshots[i].Serial < snapshots[j].Serial
+	})
+
+	return snapshots, nil
+}
+
+// RemoveSnapshots removes local snapshots of all tasks of the deal.
+func (o *overseer) RemoveSnapshots(ctx context.Context, dealID string) error {
+	o.snapshotMu.Lock()
+	defer o.snapshotMu.Unlock()
+
+	snapshots, err := o.listSnapshots(ctx, dealIDTag+"="+dealID)
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots {
+		removeOpts := types.ImageRemoveOptions{Force: true, PruneChildren: true}
+		if _, err := o.client.ImageRemove(ctx, snapshot.ImageID, removeOpts); err != nil {
+			return fmt.Errorf("failed to remove snapshot %s: %v", snapshot.ImageID, err)
+		}
+	}
+
+	o.mu.Lock()
+	delete(o.snapshotUsage, dealID)
+	o.mu.Unlock()
+
+	return nil
+}
+
+// collectSnapshotUsage returns sizes of local snapshots by deals.
+func (o *overseer) collectSnapshotUsage(ctx context.Context) (map[string]uint64, error) {
+	snapshots, err := o.listSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := map[string]uint64{}
+	for _, snapshot := range snapshots {
+		usage[snapshot.DealID] += snapshot.LayerSize
+	}
+
+	return usage, nil
+}
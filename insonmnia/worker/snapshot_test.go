@@ -0,0 +1,116 @@
+package worker
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func TestSnapshotReference(t *testing.T) {
+	d := Description{Image: "sonm/app:latest", DealId: "42", TaskId: "task"}
+
+	name, err := snapshotReference(d, "", 3)
+	require.NoError(t, err)
+	assert.Equal(t, "docker.io/sonm/app:42_task_3", name.String())
+
+	name, err = snapshotReference(d, "registry.example.com/user/", 4)
+	require.NoError(t, err)
+	assert.Equal(t, "registry.example.com/user/app:42_task_4", name.String())
+
+	_, err = snapshotReference(d, "Invalid Registry", 1)
+	assert.Error(t, err)
+}
+
+// newSnapshotsClient returns a Docker client, which lists the given images
+// with a container of the given size, and records IDs of removed images.
+func newSnapshotsClient(t *testing.T, images []types.ImageSummary, sizeRw int64) (*client.Client, func() []string) {
+	mu := sync.Mutex{}
+	removed := []string{}
+
+	cl, stop := newFakeDockerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/images/json"):
+			json.NewEncoder(w).Encode(images)
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/containers/container/json"):
+			json.NewEncoder(w).Encode(types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{ID: "container", SizeRw: &sizeRw},
+			})
+		case r.Method == http.MethodDelete && strings.Contains(r.URL.Path, "/images/"):
+			mu.Lock()
+			removed = append(removed, path.Base(r.URL.Path))
+			mu.Unlock()
+			w.Write([]byte("[]"))
+		default:
+			http.Error(w, "unexpected request", http.StatusInternalServerError)
+		}
+	})
+
+	return cl, func() []string {
+		stop()
+		mu.Lock()
+		defer mu.Unlock()
+		return removed
+	}
+}
+
+func testSnapshotImage(id, dealID, taskID string, serial, size uint64) types.ImageSummary {
+	return types.ImageSummary{
+		ID: id,
+		Labels: map[string]string{
+			dealIDTag:       dealID,
+			taskIDTag:       taskID,
+			snapshotTag:     strconv.FormatUint(serial, 10),
+			snapshotSizeTag: strconv.FormatUint(size, 10),
+		},
+	}
+}
+
+func TestCommitRefusedOverDiskQuota(t *testing.T) {
+	ctx := context.Background()
+	cl, stop := newSnapshotsClient(t, []types.ImageSummary{
+		testSnapshotImage("snapshot-1", "42", "task", 1, 40),
+		testSnapshotImage("snapshot-2", "42", "other", 1, 20),
+	}, 50)
+	defer stop()
+
+	container := newTestContainer(ctx, cl, "container", "42", 0, 100, true)
+	container.description.Image = "sonm/app"
+	container.description.TaskId = "task"
+	ovs := &overseer{
+		ctx:        ctx,
+		client:     cl,
+		containers: map[string]*containerDescriptor{"container": container},
+	}
+
+	_, err := ovs.Commit(ctx, "container", "", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "110 of 100 bytes")
+}
+
+func TestRemoveSnapshotsOfDeal(t *testing.T) {
+	ctx := context.Background()
+	cl, removed := newSnapshotsClient(t, []types.ImageSummary{
+		testSnapshotImage("snapshot-1", "42", "task", 1, 40),
+		testSnapshotImage("snapshot-2", "42", "removed-task", 1, 20),
+	}, 0)
+
+	ovs := &overseer{
+		ctx:           ctx,
+		client:        cl,
+		snapshotUsage: map[string]uint64{"42": 60},
+	}
+
+	require.NoError(t, ovs.RemoveSnapshots(ctx, "42"))
+	assert.ElementsMatch(t, []string{"snapshot-1", "snapshot-2"}, removed())
+	assert.Equal(t, uint64(0), ovs.dealDiskUsage("42"))
+}
@@ -0,0 +1,120 @@
+package worker
+
+import (
+	"sync"
+	"time"
+
+	pb "github.com/sonm-io/core/proto"
+)
+
+const taskEventsBufferSize = 128
+
+type taskEventSubscriber struct {
+	filter *pb.WatchTasksRequest
+	ch     chan *pb.TaskEvent
+}
+
+func (s *taskEventSubscriber) matches(event *pb.TaskEvent) bool {
+	if id := s.filter.GetId(); len(id) != 0 && id != event.GetId() {
+		return false
+	}
+
+	if dealID := s.filter.GetDealID(); !dealID.IsZero() && dealID.Cmp(event.GetDealID()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// taskEventBroadcaster fans task lifecycle events out to all subscribers.
+//
+// Subscribers that are unable to keep up with events are dropped by closing
+// their channels.
+type taskEventBroadcaster struct {
+	mu          sync.Mutex
+	subscribers map[*taskEventSubscriber]struct{}
+}
+
+func newTaskEventBroadcaster() *taskEventBroadcaster {
+	return &taskEventBroadcaster{
+		subscribers: map[*taskEventSubscriber]struct{}{},
+	}
+}
+
+// Subscribe returns a channel of events matching the given filter and a
+// function that must be called to unsubscribe.
+func (b *taskEventBroadcaster) Subscribe(filter *pb.WatchTasksRequest) (<-chan *pb.TaskEvent, func()) {
+	subscriber := &taskEventSubscriber{
+		filter: filter,
+		ch:     make(chan *pb.TaskEvent, taskEventsBufferSize),
+	}
+
+	b.mu.Lock()
+	b.subscribers[subscriber] = struct{}{}
+	b.mu.Unlock()
+
+	return subscriber.ch, func() { b.unsubscribe(subscriber) }
+}
+
+func (b *taskEventBroadcaster) unsubscribe(subscriber *taskEventSubscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[subscriber]; ok {
+		delete(b.subscribers, subscriber)
+		close(subscriber.ch)
+	}
+}
+
+func (b *taskEventBroadcaster) Publish(event *pb.TaskEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for subscriber := range b.subscribers {
+		if !subscriber.matches(event) {
+			continue
+		}
+
+		select {
+		case subscriber.ch <- event:
+		default:
+			delete(b.subscribers, subscriber)
+			close(subscriber.ch)
+		}
+	}
+}
+
+// publishTaskEvent notifies task watchers about the task lifecycle event.
+func (m *Worker) publishTaskEvent(id string, dealID *pb.BigInt, eventType pb.TaskEvent_Type, status ContainerStatus) {
+	m.taskEvents.Publish(&pb.TaskEvent{
+		Id:           id,
+		DealID:       dealID,
+		Type:         eventType,
+		Timestamp:    &pb.Timestamp{Seconds: time.Now().Unix()},
+		ExitCode:     int32(status.ExitCode),
+		RestartCount: uint32(status.RestartCount),
+	})
+}
+
+// taskEventType maps the container status into the task event type.
+func taskEventType(status ContainerStatus) pb.TaskEvent_Type {
+	switch status.Status {
+	case pb.TaskStatusReply_SPOOLING:
+		return pb.TaskEvent_SPOOLING
+	case pb.TaskStatusReply_SPAWNING:
+		return pb.TaskEvent_SPAWNING
+	case pb.TaskStatusReply_RUNNING:
+		return pb.TaskEvent_RUNNING
+	case pb.TaskStatusReply_FINISHED:
+		return pb.TaskEvent_FINISHED
+	case pb.TaskStatusReply_BROKEN:
+		if status.OOMKilled {
+			return pb.TaskEvent_OOM_KILLED
+		}
+		return pb.TaskEvent_BROKEN
+	case pb.TaskStatusReply_UNHEALTHY:
+		return pb.TaskEvent_UNHEALTHY
+	default:
+		return pb.TaskEvent_UNKNOWN
+	}
+}
@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"testing"
+
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskEventBroadcasterFilter(t *testing.T) {
+	b := newTaskEventBroadcaster()
+
+	all, unsubscribeAll := b.Subscribe(&pb.WatchTasksRequest{})
+	defer unsubscribeAll()
+	byDeal, unsubscribeByDeal := b.Subscribe(&pb.WatchTasksRequest{DealID: pb.NewBigIntFromInt(42)})
+	defer unsubscribeByDeal()
+	byTask, unsubscribeByTask := b.Subscribe(&pb.WatchTasksRequest{Id: "task-2"})
+	defer unsubscribeByTask()
+
+	b.Publish(&pb.TaskEvent{Id: "task-1", DealID: pb.NewBigIntFromInt(42), Type: pb.TaskEvent_RUNNING})
+	b.Publish(&pb.TaskEvent{Id: "task-2", DealID: pb.NewBigIntFromInt(43), Type: pb.TaskEvent_FINISHED})
+
+	require.Len(t, all, 2)
+	require.Len(t, byDeal, 1)
+	require.Len(t, byTask, 1)
+
+	assert.Equal(t, "task-1", (<-byDeal).GetId())
+	assert.Equal(t, "task-2", (<-byTask).GetId())
+}
+
+func TestTaskEventBroadcasterDropsSlowSubscriber(t *testing.T) {
+	b := newTaskEventBroadcaster()
+
+	events, unsubscribe := b.Subscribe(&pb.WatchTasksRequest{})
+	defer unsubscribe()
+
+	for i := 0; i < taskEventsBufferSize+1; i++ {
+		b.Publish(&pb.TaskEvent{Id: "task", Type: pb.TaskEvent_RESTARTED})
+	}
+
+	for i := 0; i < taskEventsBufferSize; i++ {
+		_, ok := <-events
+		require.True(t, ok)
+	}
+
+	_, ok := <-events
+	assert.False(t, ok)
+}
+
+func TestTaskEventType(t *testing.T) {
+	assert.Equal(t, pb.TaskEvent_BROKEN, taskEventType(ContainerStatus{Status: pb.TaskStatusReply_BROKEN}))
+	assert.Equal(t, pb.TaskEvent_OOM_KILLED, taskEventType(ContainerStatus{Status: pb.TaskStatusReply_BROKEN, OOMKilled: true}))
+	assert.Equal(t, pb.TaskEvent_UNHEALTHY, taskEventType(ContainerStatus{Status: pb.TaskStatusReply_UNHEALTHY}))
+}
@@ -0,0 +1,164 @@
+package worker
+
+import (
+	"fmt"
+
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/insonmnia/worker/volume"
+	pb "github.com/sonm-io/core/proto"
+	"go.uber.org/zap"
+)
+
+const tasksStorageKey = "tasks"
+
+// taskState describes a task persisted in the Worker's state storage, which
+// allows to re-adopt its container after the Worker restart.
+type taskState struct {
+	AskPlanID     string        `json:"ask_plan_id"`
+	PublicKeyData string        `json:"public_key_data"`
+	Info          ContainerInfo `json:"info"`
+	// Description keeps only exported fields, hence volumes and mounts are
+	// persisted separately. Networks are not, because they are restored from
+	// the container itself.
+	Description Description           `json:"description"`
+	Volumes     map[string]*pb.Volume `json:"volumes"`
+	Mounts      []volume.Mount        `json:"mounts"`
+}
+
+// saveTaskState persists the given task state, replacing the previous one.
+func (m *Worker) saveTaskState(id string, task *taskState) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tasks[id] = task
+	if err := m.taskStorage.Save(m.tasks); err != nil {
+		log.G(m.ctx).Warn("failed to save task state", zap.String("task_id", id), zap.Error(err))
+	}
+}
+
+// removeTaskStates removes the specified tasks from the persistent storage.
+func (m *Worker) removeTaskStates(ids ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, id := range ids {
+		delete(m.tasks, id)
+	}
+
+	if err := m.taskStorage.Save(m.tasks); err != nil {
+		log.G(m.ctx).Warn("failed to remove task states", zap.Strings("task_ids", ids), zap.Error(err))
+	}
+}
+
+// restoreTasks re-adopts task containers that have survived the Worker
+// restart.
+//
+// Must be called after ask plans are restored, but before the Salesman
+// starts to sync them with the blockchain, so that tasks of deals closed
+// in the meantime are stopped the usual way.
+// Tasks, which ask plans no longer hold their deals, are considered
+// orphaned, as well as unknown containers, so they are killed and removed.
+func (m *Worker) restoreTasks() error {
+	tasks := map[string]*taskState{}
+	if err := m.taskStorage.Load(&tasks); err != nil {
+		return fmt.Errorf("could not restore tasks: %s", err)
+	}
+
+	for id, task := range tasks {
+		if err := m.restoreTask(id, task); err != nil {
+			log.S(m.ctx).Warnf("dropping task %s: %s", id, err)
+			continue
+		}
+
+		m.tasks[id] = task
+		log.S(m.ctx).Infof("restored task %s for deal %s", id, task.Info.DealID)
+	}
+
+	if err := m.taskStorage.Save(m.tasks); err != nil {
+		return fmt.Errorf("could not save restored tasks: %s", err)
+	}
+
+	if err := m.ovs.RemoveOrphans(m.ctx); err != nil {
+		log.S(m.ctx).Warnf("failed to remove orphaned containers: %s", err)
+	}
+
+	return nil
+}
+
+func (m *Worker) restoreTask(id string, task *taskState) error {
+	task.Description.volumes = task.Volumes
+	task.Description.mounts = task.Mounts
+
+	statusListener, containerInfo, err := m.ovs.Restore(m.ctx, task.Info.ID, task.Description)
+	if err != nil {
+		return fmt.Errorf("failed to inspect container %s: %s", task.Info.ID, err)
+	}
+
+	if !m.isDealActive(task.AskPlanID, task.Info.DealID) {
+		if err := m.ovs.OnDealFinish(m.ctx, containerInfo.ID); err != nil {
+			log.S(m.ctx).Warnf("failed to cleanup orphaned container %s: %s", containerInfo.ID, err)
+		}
+		if err := m.ovs.RemoveSnapshots(m.ctx, task.Info.DealID); err != nil {
+			log.S(m.ctx).Warnf("failed to remove snapshots of finished deal %s: %s", task.Info.DealID, err)
+		}
+
+		return fmt.Errorf("deal %s is no longer bound to ask plan %s", task.Info.DealID, task.AskPlanID)
+	}
+
+	if statusListener != nil {
+		if err := m.resources.ConsumeTask(task.AskPlanID, id, task.Description.Resources); err != nil {
+			if err := m.ovs.OnDealFinish(m.ctx, containerInfo.ID); err != nil {
+				log.S(m.ctx).Warnf("failed to cleanup container %s: %s", containerInfo.ID, err)
+			}
+
+			return fmt.Errorf("could not consume resources: %s", err)
+		}
+	}
+
+	publicKey, err := parsePublicKey(task.PublicKeyData)
+	if err != nil {
+		log.S(m.ctx).Warnf("failed to parse public key for task %s: %s", id, err)
+	}
+
+	// Ports, image name and start time are taken from the persisted state,
+	// because they are rewritten after container has been started.
+	info := task.Info
+	info.status = containerInfo.status
+	info.Cgroup = containerInfo.Cgroup
+	info.CgroupParent = containerInfo.CgroupParent
+	info.NetworkIDs = containerInfo.NetworkIDs
+	info.IP = containerInfo.IP
+	info.PublicKey = publicKey
+
+	// The gateway is flushed on startup, so routes of running tasks must be
+	// registered again. Note that gateway ports may change.
+	if len(info.Routes) != 0 {
+		info.Routes = nil
+		if m.router != nil && statusListener != nil {
+			info.Ports = containerInfo.Ports
+			if _, err := m.routeTaskPorts(id, &info); err != nil {
+				log.S(m.ctx).Warnf("failed to route ports of task %s: %s", id, err)
+			}
+		}
+		task.Info = info
+	}
+
+	m.saveContainerInfo(id, info)
+
+	if statusListener != nil {
+		go m.listenForStatus(statusListener, id)
+	}
+
+	return nil
+}
+
+// isDealActive checks whether the specified ask plan still exists and is
+// bound to the given deal.
+func (m *Worker) isDealActive(askPlanID string, dealID string) bool {
+	plan, err := m.salesman.AskPlan(askPlanID)
+	if err != nil {
+		return false
+	}
+
+	return !plan.GetDealID().IsZero() && plan.GetDealID().Unwrap().String() == dealID
+}
@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStateMarshalJSON(t *testing.T) {
+	publicKey, err := parsePublicKey("ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCxXoK0txr+SYMFRjlFW9yC8oO2VbGMBJT3wnd2+XXZr9Mvc5bFMm+e2xkX0p+bU9pjyf/NlNm1i6LTGQLHXkZ4rLxcm9uZfgLmDfxA+NWb7Oy/CkHVJ+NNGz/l6kzXu8MVbAaTObHDWp3HiZd3iM2SYCeJzzTyWuCZJ8eoR7cLmhtq+pnYYTtQpGumpt+1JDFkXLSB0NhhhNYyyfo+OgVMz2ztVvMkbUzXPc8JRsZVaQ5X1yrqOLngkmhhWdVbzrldVHkdJEG29rNf3yH1cwg5Ae7VjkTGMTdqpsnlnFHR7PCAyHYFdS6PBKKY3JEuHyFwCh2KUEZTGXHjmMxYDVqR")
+	require.NoError(t, err)
+
+	startAt := time.Now().UTC().Truncate(time.Second)
+	task := &taskState{
+		AskPlanID:     "ask-plan",
+		PublicKeyData: "ssh-rsa AAAA",
+		Info: ContainerInfo{
+			status:    pb.TaskStatusReply_RUNNING,
+			ID:        "container-id",
+			ImageName: "httpd:latest",
+			StartAt:   startAt,
+			Ports: nat.PortMap{
+				"80/tcp": []nat.PortBinding{{HostIP: "8.8.8.8", HostPort: "32768"}},
+			},
+			PublicKey: publicKey,
+			DealID:    "42",
+		},
+		Description: Description{
+			Image:       "httpd:latest",
+			DealId:      "42",
+			TaskId:      "task-id",
+			Entrypoint:  []string{"/bin/sh", "-c"},
+			StopTimeout: 10 * time.Second,
+			Resources: &pb.AskPlanResources{
+				GPU: &pb.AskPlanGPU{Hashes: []string{"hash"}},
+			},
+		},
+	}
+
+	data, err := json.Marshal(task)
+	require.NoError(t, err)
+
+	restored := &taskState{}
+	require.NoError(t, json.Unmarshal(data, restored))
+
+	assert.Equal(t, task.AskPlanID, restored.AskPlanID)
+	assert.Equal(t, task.PublicKeyData, restored.PublicKeyData)
+	assert.Equal(t, task.Info.ID, restored.Info.ID)
+	assert.Equal(t, task.Info.ImageName, restored.Info.ImageName)
+	assert.Equal(t, task.Info.Ports, restored.Info.Ports)
+	assert.Equal(t, task.Info.DealID, restored.Info.DealID)
+	assert.True(t, task.Info.StartAt.Equal(restored.Info.StartAt))
+	// Public key is restored from the raw key data.
+	assert.Nil(t, restored.Info.PublicKey)
+	assert.Equal(t, task.Description, restored.Description)
+}
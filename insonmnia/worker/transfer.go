@@ -0,0 +1,296 @@
+package worker
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"github.com/sonm-io/core/insonmnia/structs"
+	pb "github.com/sonm-io/core/proto"
+	"github.com/sonm-io/core/util/multierror"
+	"github.com/sonm-io/core/util/xdocker"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	pushTransferDir = "push"
+	pullTransferDir = "pull"
+	pullChunkSize   = 1 * 1024 * 1024
+)
+
+// Image archives being transferred are kept in files named after the deal
+// they belong to, i.e. "<transfer_dir>/<push|pull>/<dealID>-<name>", so the
+// interrupted transfers can be resumed, until the deal finishes.
+
+func (m *Worker) transferPath(kind, dealID, name string) (string, error) {
+	for _, part := range []string{dealID, name} {
+		if len(part) == 0 || strings.ContainsAny(part, `/\`) || strings.Contains(part, "..") {
+			return "", status.Errorf(codes.InvalidArgument, "invalid transfer name component \"%s\"", part)
+		}
+	}
+
+	dir := filepath.Join(m.cfg.TransferDir, kind)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, dealID+"-"+name), nil
+}
+
+// removeTransfers removes files of image transfers of the given kind, "*"
+// meaning all kinds, that belong to the deal except the ones starting with
+// the non-empty keep path.
+func (m *Worker) removeTransfers(kind, dealID, keep string) error {
+	paths, err := filepath.Glob(filepath.Join(m.cfg.TransferDir, kind, dealID+"-*"))
+	if err != nil {
+		return err
+	}
+
+	result := multierror.NewMultiError()
+	for _, path := range paths {
+		if len(keep) != 0 && strings.HasPrefix(path, keep) {
+			continue
+		}
+
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// pushImage receives the image archive into a file, which survives broken
+// connections, so the client is able to resume the push from the offset
+// sent in the response header. The archive is loaded once it is complete
+// and its digest is verified.
+func (m *Worker) pushImage(stream pb.Worker_PushTaskServer, request *structs.ImagePush) (imageLoadStatus, error) {
+	path, err := m.transferPath(pushTransferDir, request.DealId(), strings.TrimPrefix(request.Digest(), "sha256:"))
+	if err != nil {
+		return imageLoadStatus{}, err
+	}
+
+	// Only the latest push of the deal can be resumed.
+	if err := m.removeTransfers(pushTransferDir, request.DealId(), path); err != nil {
+		log.G(m.ctx).Warn("failed to remove stale image transfers", zap.Error(err))
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return imageLoadStatus{}, err
+	}
+
+	offset, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		file.Close()
+		return imageLoadStatus{}, err
+	}
+
+	if offset > request.ImageSize() {
+		if err := file.Truncate(0); err != nil {
+			file.Close()
+			return imageLoadStatus{}, err
+		}
+		if offset, err = file.Seek(0, io.SeekStart); err != nil {
+			file.Close()
+			return imageLoadStatus{}, err
+		}
+	}
+
+	log.G(m.ctx).Info("receiving image archive", zap.String("path", path), zap.Int64("offset", offset))
+
+	if err := stream.SendHeader(metadata.Pairs("offset", strconv.FormatInt(offset, 10))); err != nil {
+		file.Close()
+		return imageLoadStatus{}, err
+	}
+
+	received, err := io.Copy(file, newChunkReader(stream))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	// Received data is kept on errors to resume the push later.
+	if err != nil {
+		return imageLoadStatus{}, err
+	}
+
+	size := offset + received
+	if size < request.ImageSize() {
+		return imageLoadStatus{}, status.Errorf(codes.Aborted, "image archive is incomplete: %d of %d bytes received", size, request.ImageSize())
+	}
+
+	defer os.Remove(path)
+
+	if size > request.ImageSize() {
+		return imageLoadStatus{}, status.Errorf(codes.InvalidArgument, "image archive exceeds the declared size of %d bytes", request.ImageSize())
+	}
+
+	file, err = os.Open(path)
+	if err != nil {
+		return imageLoadStatus{}, err
+	}
+	defer file.Close()
+
+	digest, err := xdocker.Digest(file)
+	if err != nil {
+		return imageLoadStatus{}, err
+	}
+
+	if digest != request.Digest() {
+		return imageLoadStatus{}, status.Errorf(codes.DataLoss, "image archive digest mismatch: expected %s, got %s", request.Digest(), digest)
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return imageLoadStatus{}, err
+	}
+
+	_, err = xdocker.ReadLayers(file)
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return imageLoadStatus{}, err
+	}
+
+	switch err {
+	case nil:
+		return m.ovs.LoadImage(stream.Context(), file)
+	case xdocker.ErrNotImageArchive:
+		// Plain filesystem archives are imported as before.
+		return m.ovs.Load(stream.Context(), file)
+	default:
+		return imageLoadStatus{}, status.Errorf(codes.InvalidArgument, "malformed image archive: %v", err)
+	}
+}
+
+// pullImage streams the saved image archive of the task or its snapshot
+// starting from the requested offset.
+//
+// The archive is saved on the first request and kept to be able to resume
+// the pull. Requests starting from the beginning save the image again, since
+// the task could have been committed since then.
+func (m *Worker) pullImage(ctx context.Context, imageID string, request *pb.PullTaskRequest, stream pb.Worker_PullTaskServer) error {
+	name := request.GetTaskId()
+	if request.GetSnapshot() != 0 {
+		name = fmt.Sprintf("%s_%d", name, request.GetSnapshot())
+	}
+
+	path, err := m.transferPath(pullTransferDir, request.GetDealId(), name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(path); request.GetOffset() == 0 || os.IsNotExist(err) {
+		if err := m.saveImage(ctx, imageID, path); err != nil {
+			return err
+		}
+	}
+
+	digest, err := ioutil.ReadFile(path + ".digest")
+	if err != nil {
+		return err
+	}
+
+	if len(request.GetDigest()) != 0 && request.GetDigest() != string(digest) {
+		return status.Errorf(codes.FailedPrecondition, "image archive has changed since the pull started, it must be restarted")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if request.GetOffset() < 0 || request.GetOffset() > info.Size() {
+		return status.Errorf(codes.OutOfRange, "offset %d is out of archive bounds [0, %d]", request.GetOffset(), info.Size())
+	}
+
+	if _, err := file.Seek(request.GetOffset(), io.SeekStart); err != nil {
+		return err
+	}
+
+	stream.SendHeader(metadata.Pairs(
+		"size", strconv.FormatInt(info.Size(), 10),
+		"digest", string(digest),
+		"offset", strconv.FormatInt(request.GetOffset(), 10),
+	))
+
+	buf := make([]byte, pullChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.Chunk{Chunk: buf[:n]}); err != nil {
+				return err
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// saveImage saves the image archive into the given path along with its
+// digest.
+func (m *Worker) saveImage(ctx context.Context, imageID, path string) error {
+	_, rd, err := m.ovs.Save(ctx, imageID)
+	if err != nil {
+		return err
+	}
+	defer rd.Close()
+
+	// Concurrent pulls of the same image save it at once, so each one uses
+	// its own temporary files.
+	file, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	digest, err := xdocker.Digest(io.TeeReader(rd, file))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save image: %v", err)
+	}
+
+	if err := writeFileAtomically(path+".digest", []byte(digest)); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// writeFileAtomically writes the data into a temporary file, which then
+// replaces the given one, so that readers never see partially written data.
+func writeFileAtomically(path string, data []byte) error {
+	file, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
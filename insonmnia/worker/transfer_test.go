@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/golang/mock/gomock"
+	"github.com/sonm-io/core/util/xdocker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func TestSaveImageConcurrently(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dir, err := ioutil.TempDir("", "transfer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	content := strings.Repeat("image", 1<<16)
+	ovs := NewMockOverseer(controller)
+	ovs.EXPECT().Save(gomock.Any(), "image").
+		DoAndReturn(func(ctx context.Context, imageID string) (types.ImageInspect, io.ReadCloser, error) {
+			return types.ImageInspect{}, ioutil.NopCloser(strings.NewReader(content)), nil
+		}).
+		AnyTimes()
+
+	worker := &Worker{options: &options{ovs: ovs}}
+	path := filepath.Join(dir, "42-task")
+
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 8)
+	for idx := 0; idx < cap(errs); idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- worker.saveImage(context.Background(), "image", path)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(data))
+
+	digest, err := xdocker.Digest(strings.NewReader(content))
+	require.NoError(t, err)
+	savedDigest, err := ioutil.ReadFile(path + ".digest")
+	require.NoError(t, err)
+	assert.Equal(t, digest, string(savedDigest))
+
+	// Temporary files are removed.
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+}
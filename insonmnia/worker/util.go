@@ -135,3 +135,50 @@ func (r *chunkReader) Read(p []byte) (n int, err error) {
 
 	return size, nil
 }
+
+type copyToReader struct {
+	stream sonm.Worker_CopyToServer
+	buf    []byte
+}
+
+// newCopyToReader constructs a reader over the CopyTo stream, starting with
+// the chunk already received within the first request.
+func newCopyToReader(stream sonm.Worker_CopyToServer, chunk []byte) io.Reader {
+	return &copyToReader{stream: stream, buf: chunk}
+}
+
+func (r *copyToReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		request, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.buf = request.GetChunk()
+	}
+
+	size := copy(p, r.buf)
+	r.buf = r.buf[size:]
+
+	return size, nil
+}
+
+// execStreamWriter forwards the output of the executed command to the Exec
+// stream.
+type execStreamWriter struct {
+	stream sonm.Worker_ExecServer
+	stderr bool
+}
+
+func (w *execStreamWriter) Write(p []byte) (int, error) {
+	reply := &sonm.ExecReply{Stdout: p}
+	if w.stderr {
+		reply = &sonm.ExecReply{Stderr: p}
+	}
+
+	if err := w.stream.Send(reply); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
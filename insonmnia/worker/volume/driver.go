@@ -35,6 +35,18 @@ type VolumeDriver interface {
 	Close() error
 }
 
+// DealVolumeDriver specifies volume driver interface for volumes that
+// outlive tasks, being shared by all tasks of a deal until it finishes.
+type DealVolumeDriver interface {
+	VolumeDriver
+	// CreateDealVolume creates a volume with the given name for the deal or
+	// returns the existing one. The quota limits the total size of all
+	// volumes of the deal in bytes, zero means no limit.
+	CreateDealVolume(dealID, name string, quota uint64, options map[string]string) (Volume, error)
+	// ReleaseDeal removes all volumes of the deal.
+	ReleaseDeal(dealID string) error
+}
+
 type nilVolumeDriver struct{}
 
 func (nilVolumeDriver) CreateVolume(name string, options map[string]string) (Volume, error) {
@@ -64,6 +76,12 @@ func NewVolumeDriver(ctx context.Context, ty string, options ...Option) (VolumeD
 	switch ty {
 	case drivers.CIFS.String():
 		return NewCIFSVolumeDriver(ctx, options...)
+	case drivers.NFS.String():
+		return NewNFSVolumeDriver(ctx, options...)
+	case localDriverName:
+		return NewLocalVolumeDriver(ctx, options...)
+	case s3DriverName:
+		return NewS3VolumeDriver(ctx, options...)
 	default:
 		return nil, fmt.Errorf("unknown volume driver: %s", ty)
 	}
@@ -0,0 +1,234 @@
+// Local directory Docker integration.
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"go.uber.org/zap"
+)
+
+const (
+	localDriverName = "local"
+)
+
+// localVolumeDriver keeps volumes in host directories, one directory per
+// deal, which is shared by all tasks of the deal.
+//
+// When the deal has a storage quota its directory is backed by a loop-mounted
+// filesystem image of the quota size, which prevents tasks from using more
+// disk space than the deal allows.
+type localVolumeDriver struct {
+	rootDir string
+	logger  *zap.Logger
+
+	mu sync.Mutex
+}
+
+// NewLocalVolumeDriver constructs a new local directory volume driver.
+func NewLocalVolumeDriver(ctx context.Context, options ...Option) (VolumeDriver, error) {
+	opts, err := newOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	rootDir := filepath.Join(opts.RootDir, localDriverName)
+	if err := os.MkdirAll(rootDir, 0755); err != nil {
+		return nil, err
+	}
+
+	log.G(ctx).Info("local volume plugin has been initialized", zap.String("root", rootDir))
+
+	return &localVolumeDriver{
+		rootDir: rootDir,
+		logger:  log.G(ctx),
+	}, nil
+}
+
+func (d *localVolumeDriver) CreateVolume(name string, options map[string]string) (Volume, error) {
+	d.logger.Info("creating volume", zap.String("name", name))
+
+	path := filepath.Join(d.rootDir, name)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, err
+	}
+
+	return &localVolume{path: path}, nil
+}
+
+func (d *localVolumeDriver) RemoveVolume(name string) error {
+	d.logger.Info("removing volume", zap.String("name", name))
+
+	return os.RemoveAll(filepath.Join(d.rootDir, name))
+}
+
+func (d *localVolumeDriver) CreateDealVolume(dealID, name string, quota uint64, options map[string]string) (Volume, error) {
+	d.logger.Info("creating deal volume", zap.String("deal", dealID), zap.String("name", name), zap.Uint64("quota", quota))
+
+	if err := validatePathComponent(dealID); err != nil {
+		return nil, fmt.Errorf("invalid deal ID: %v", err)
+	}
+	if err := validatePathComponent(name); err != nil {
+		return nil, fmt.Errorf("invalid volume name: %v", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	dealDir := filepath.Join(d.rootDir, dealID)
+	if err := os.MkdirAll(dealDir, 0755); err != nil {
+		return nil, err
+	}
+
+	if quota > 0 {
+		if err := d.mountQuota(dealDir, quota); err != nil {
+			return nil, fmt.Errorf("failed to set up storage quota: %v", err)
+		}
+	}
+
+	path := filepath.Join(dealDir, name)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return nil, err
+	}
+
+	return &localVolume{path: path}, nil
+}
+
+// mountQuota mounts a filesystem image of the quota size on the deal
+// directory unless it is already mounted.
+func (d *localVolumeDriver) mountQuota(dealDir string, quota uint64) error {
+	mounted, err := isMountpoint(dealDir)
+	if err != nil {
+		return err
+	}
+	if mounted {
+		return nil
+	}
+
+	image := dealDir + ".img"
+	if _, err := os.Stat(image); os.IsNotExist(err) {
+		if err := createImage(image, quota); err != nil {
+			os.Remove(image)
+			return err
+		}
+	}
+
+	return run("mount", "-o", "loop", image, dealDir)
+}
+
+func (d *localVolumeDriver) ReleaseDeal(dealID string) error {
+	d.logger.Info("releasing deal volumes", zap.String("deal", dealID))
+
+	if err := validatePathComponent(dealID); err != nil {
+		return fmt.Errorf("invalid deal ID: %v", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	dealDir := filepath.Join(d.rootDir, dealID)
+	if _, err := os.Stat(dealDir); os.IsNotExist(err) {
+		return nil
+	}
+
+	mounted, err := isMountpoint(dealDir)
+	if err != nil {
+		return err
+	}
+	if mounted {
+		if err := run("umount", dealDir); err != nil {
+			return err
+		}
+	}
+
+	if err := os.RemoveAll(dealDir); err != nil {
+		return err
+	}
+
+	if err := os.Remove(dealDir + ".img"); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (d *localVolumeDriver) Close() error {
+	d.logger.Info("shutting down volume plugin")
+	return nil
+}
+
+type localVolume struct {
+	path string
+}
+
+func (v *localVolume) Configure(m Mount, cfg *container.HostConfig) error {
+	cfg.Mounts = append(cfg.Mounts, mount.Mount{
+		Type:        mount.TypeBind,
+		Source:      v.path,
+		Target:      m.Target,
+		ReadOnly:    m.ReadOnly(),
+		Consistency: mount.ConsistencyDefault,
+	})
+
+	return nil
+}
+
+// createImage creates a sparse ext4 filesystem image of the given size.
+func createImage(path string, size uint64) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err := file.Truncate(int64(size)); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return run("mkfs.ext4", "-q", "-F", "-m", "0", path)
+}
+
+// isMountpoint returns true if something is mounted on the given directory,
+// i.e. it resides on another device than its parent.
+func isMountpoint(path string) (bool, error) {
+	var stat, parentStat syscall.Stat_t
+	if err := syscall.Stat(path, &stat); err != nil {
+		return false, err
+	}
+	if err := syscall.Stat(filepath.Dir(path), &parentStat); err != nil {
+		return false, err
+	}
+
+	return stat.Dev != parentStat.Dev, nil
+}
+
+func validatePathComponent(name string) error {
+	if len(name) == 0 || name == "." || name == ".." || strings.ContainsRune(name, filepath.Separator) {
+		return fmt.Errorf("\"%s\" is not a valid path component", name)
+	}
+
+	return nil
+}
+
+func run(name string, args ...string) error {
+	output, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s failed: %v: %s", name, err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package volume
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalDealVolume(t *testing.T) {
+	root, err := ioutil.TempDir("", "local-volume")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	driver, err := NewLocalVolumeDriver(context.Background(), WithRootDir(root))
+	require.NoError(t, err)
+	dealDriver := driver.(DealVolumeDriver)
+
+	v, err := dealDriver.CreateDealVolume("42", "data", 0, map[string]string{})
+	require.NoError(t, err)
+
+	cfg := &container.HostConfig{}
+	require.NoError(t, v.Configure(Mount{Source: "task/data", Target: "/data", Permission: RW}, cfg))
+	require.Len(t, cfg.Mounts, 1)
+	assert.Equal(t, mount.TypeBind, cfg.Mounts[0].Type)
+	assert.Equal(t, filepath.Join(root, localDriverName, "42", "data"), cfg.Mounts[0].Source)
+	assert.Equal(t, "/data", cfg.Mounts[0].Target)
+	assert.False(t, cfg.Mounts[0].ReadOnly)
+
+	path := filepath.Join(cfg.Mounts[0].Source, "file")
+	require.NoError(t, ioutil.WriteFile(path, []byte("content"), 0644))
+
+	// Volumes are shared between tasks of the same deal.
+	_, err = dealDriver.CreateDealVolume("42", "data", 0, map[string]string{})
+	require.NoError(t, err)
+	_, err = os.Stat(path)
+	assert.NoError(t, err)
+
+	require.NoError(t, dealDriver.ReleaseDeal("42"))
+	_, err = os.Stat(filepath.Join(root, localDriverName, "42"))
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, dealDriver.ReleaseDeal("42"))
+}
+
+func TestLocalDealVolumeInvalidName(t *testing.T) {
+	root, err := ioutil.TempDir("", "local-volume")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	driver, err := NewLocalVolumeDriver(context.Background(), WithRootDir(root))
+	require.NoError(t, err)
+	dealDriver := driver.(DealVolumeDriver)
+
+	_, err = dealDriver.CreateDealVolume("42", "../escape", 0, map[string]string{})
+	assert.Error(t, err)
+
+	_, err = dealDriver.CreateDealVolume("..", "data", 0, map[string]string{})
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package volume
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 )
 
@@ -83,3 +84,31 @@ func parseSpec(spec string) ([]string, error) {
 func errInvalidSpec(spec string) error {
 	return fmt.Errorf("invalid volume specification: %s", spec)
 }
+
+// mountOptionValue restricts values of mount options given by volumes, so
+// that they can neither be split into several options nor escape the
+// command line.
+var mountOptionValue = regexp.MustCompile(`^[a-zA-Z0-9._-]*$`)
+
+// validateMountOptions checks that every option of the comma-separated list
+// given by a volume is allowed.
+//
+// Volume settings come from consumers, who must not be able to change
+// options affecting the host, like credentials or cache directories.
+func validateMountOptions(options string, allowed map[string]bool) error {
+	if len(options) == 0 {
+		return nil
+	}
+
+	for _, option := range strings.Split(options, ",") {
+		parts := strings.SplitN(option, "=", 2)
+		if !allowed[parts[0]] {
+			return fmt.Errorf("mount option \"%s\" is not allowed", parts[0])
+		}
+		if len(parts) == 2 && !mountOptionValue.MatchString(parts[1]) {
+			return fmt.Errorf("invalid value of mount option \"%s\"", parts[0])
+		}
+	}
+
+	return nil
+}
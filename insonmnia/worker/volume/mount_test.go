@@ -71,3 +71,14 @@ func TestNewMountInvalidPerm(t *testing.T) {
 	assert.Equal(t, Mount{}, mount)
 	assert.Error(t, err)
 }
+
+func TestValidateMountOptions(t *testing.T) {
+	allowed := map[string]bool{"ro": true, "uid": true}
+
+	assert.NoError(t, validateMountOptions("", allowed))
+	assert.NoError(t, validateMountOptions("ro,uid=1000", allowed))
+	assert.Error(t, validateMountOptions("passwd_file=/etc/shadow", allowed))
+	assert.Error(t, validateMountOptions("ro,", allowed))
+	assert.Error(t, validateMountOptions("uid=1000;reboot", allowed))
+	assert.Error(t, validateMountOptions("uid=/", allowed))
+}
@@ -0,0 +1,205 @@
+// Network File System (NFS) Docker integration.
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/ContainX/docker-volume-netshare/netshare/drivers"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/go-connections/sockets"
+	"github.com/docker/go-plugins-helpers/volume"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultNFSVersion = 4
+)
+
+// nfsMountOptions are NFS mount options volumes are allowed to specify.
+var nfsMountOptions = map[string]bool{
+	"ro":           true,
+	"rw":           true,
+	"vers":         true,
+	"nfsvers":      true,
+	"minorversion": true,
+	"proto":        true,
+	"port":         true,
+	"rsize":        true,
+	"wsize":        true,
+	"timeo":        true,
+	"retrans":      true,
+	"hard":         true,
+	"soft":         true,
+	"nolock":       true,
+	"noac":         true,
+	"actimeo":      true,
+	"acregmin":     true,
+	"acregmax":     true,
+	"acdirmin":     true,
+	"acdirmax":     true,
+	"noatime":      true,
+	"nodiratime":   true,
+	"sec":          true,
+}
+
+// nfsShare matches shares in "host:/path" format. The share is passed to the
+// mount command through a shell, so no other characters are allowed.
+var nfsShare = regexp.MustCompile(`^[a-zA-Z0-9.-]+:/[a-zA-Z0-9._/-]*$`)
+
+type nfsVolumeDriver struct {
+	volume.Driver
+
+	listener net.Listener
+	// options are driver-wide mount options.
+	options string
+
+	logger *zap.Logger
+}
+
+// NewNFSVolumeDriver constructs and runs a new NFS volume driver within the
+// provided error group.
+//
+// The driver accepts "version" parameter, which is either 3 or 4, and
+// "options" parameter with default mount options. Volumes require "share"
+// setting in "host:/path" format and optionally specify additional "options"
+// from the allowed ones.
+func NewNFSVolumeDriver(ctx context.Context, options ...Option) (VolumeDriver, error) {
+	opts, err := newOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	version := defaultNFSVersion
+	if value, ok := opts.Params["version"]; ok {
+		version, err = strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NFS version \"%s\": %v", value, err)
+		}
+		if version != 3 && version != 4 {
+			return nil, fmt.Errorf("unsupported NFS version: %d", version)
+		}
+	}
+
+	driverName := drivers.NFS.String()
+
+	rootDir := filepath.Join(opts.RootDir, driverName)
+	socketPath, err := fullSocketPath(opts.SocketDir, driverName)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := sockets.NewUnixSocket(socketPath, syscall.Getgid())
+	if err != nil {
+		return nil, err
+	}
+
+	driver := drivers.NewNFSDriver(rootDir, version, "")
+	handle := volume.NewHandler(driver)
+
+	go func() {
+		log.G(ctx).Info("NFS volume plugin has been initialized")
+		handle.Serve(listener)
+	}()
+
+	return &nfsVolumeDriver{driver, listener, opts.Params["options"], log.G(ctx)}, nil
+}
+
+func (d *nfsVolumeDriver) CreateVolume(name string, options map[string]string) (Volume, error) {
+	d.logger.Info("creating volume", zap.String("name", name))
+
+	// The underlying driver parses shares from names containing this
+	// separator, bypassing the validation below.
+	if strings.Contains(name, drivers.ShareSplitIndentifer) {
+		return nil, fmt.Errorf("NFS volume name must not contain \"%s\"", drivers.ShareSplitIndentifer)
+	}
+
+	share := options[drivers.ShareOpt]
+	if len(share) == 0 {
+		return nil, fmt.Errorf("NFS volume requires \"%s\" option", drivers.ShareOpt)
+	}
+	if !nfsShare.MatchString(share) {
+		return nil, fmt.Errorf("invalid NFS share \"%s\"", share)
+	}
+	if err := validateMountOptions(options["options"], nfsMountOptions); err != nil {
+		return nil, err
+	}
+
+	// Only the validated settings are passed to the underlying driver, which
+	// would otherwise accept any of its own options.
+	mountOptions := d.options
+	if extra := options["options"]; len(extra) > 0 {
+		if len(mountOptions) > 0 {
+			mountOptions += ","
+		}
+		mountOptions += extra
+	}
+
+	request := &volume.CreateRequest{
+		Name: name,
+		Options: map[string]string{
+			drivers.ShareOpt: share,
+		},
+	}
+	if len(mountOptions) > 0 {
+		request.Options[drivers.NfsOptions] = mountOptions
+	}
+
+	if err := d.Create(request); err != nil {
+		return nil, err
+	}
+
+	return &nfsVolume{}, nil
+}
+
+func (d *nfsVolumeDriver) RemoveVolume(name string) error {
+	d.logger.Info("removing volume", zap.String("name", name))
+
+	request := &volume.RemoveRequest{
+		Name: name,
+	}
+
+	if err := d.Remove(request); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *nfsVolumeDriver) Close() error {
+	d.logger.Info("shutting down volume plugin")
+	return d.listener.Close()
+}
+
+type nfsVolume struct {
+}
+
+func (v *nfsVolume) Configure(m Mount, cfg *container.HostConfig) error {
+	cfg.Mounts = append(cfg.Mounts, mount.Mount{
+		Type:        mount.TypeVolume,
+		Source:      m.Source,
+		Target:      m.Target,
+		ReadOnly:    m.ReadOnly(),
+		Consistency: mount.ConsistencyDefault,
+
+		VolumeOptions: &mount.VolumeOptions{
+			NoCopy: false,
+			Labels: map[string]string{},
+			DriverConfig: &mount.Driver{
+				Name:    drivers.NFS.String(),
+				Options: map[string]string{},
+			},
+		},
+	})
+
+	return nil
+}
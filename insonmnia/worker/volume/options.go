@@ -10,6 +10,10 @@ type Option func(options interface{}) error
 // Options describes generic volume plugin options.
 type Options struct {
 	SocketDir string
+	// RootDir is the directory where volumes are mounted on the host.
+	RootDir string
+	// Params are driver specific parameters from the config.
+	Params map[string]string
 }
 
 // WithPluginSocketDir constructs an option that specifies the plugin
@@ -26,15 +30,50 @@ func WithPluginSocketDir(path string) Option {
 	}
 }
 
+// WithRootDir constructs an option that specifies the directory where
+// volumes are mounted on the host.
+func WithRootDir(path string) Option {
+	return func(o interface{}) error {
+		option, ok := o.(*Options)
+		if !ok {
+			return fmt.Errorf("invalid option type: %T", o)
+		}
+
+		option.RootDir = path
+		return nil
+	}
+}
+
 // WithOptions constructs an option that forwards the given generic options
 // to the plugin.
 func WithOptions(options map[string]string) Option {
 	return func(o interface{}) error {
-		switch o.(type) {
+		switch option := o.(type) {
 		case *Options:
+			option.Params = options
 			return nil
 		default:
 			return fmt.Errorf("invalid option type: %T", o)
 		}
 	}
 }
+
+func newOptions(options ...Option) (*Options, error) {
+	opts := &Options{
+		SocketDir: pluginSockDir,
+		RootDir:   defaultRootDir,
+		Params:    map[string]string{},
+	}
+
+	for _, option := range options {
+		if err := option(opts); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Params == nil {
+		opts.Params = map[string]string{}
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,264 @@
+// S3-compatible object storage Docker integration via FUSE.
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	log "github.com/noxiouz/zapctx/ctxlog"
+	"go.uber.org/zap"
+)
+
+const (
+	s3DriverName = "s3"
+	s3fsBinary   = "s3fs"
+)
+
+// s3MountOptions are s3fs options volumes are allowed to specify.
+var s3MountOptions = map[string]bool{
+	"ro":                  true,
+	"uid":                 true,
+	"gid":                 true,
+	"umask":               true,
+	"mp_umask":            true,
+	"endpoint":            true,
+	"storage_class":       true,
+	"default_acl":         true,
+	"retries":             true,
+	"multipart_size":      true,
+	"parallel_count":      true,
+	"connect_timeout":     true,
+	"readwrite_timeout":   true,
+	"stat_cache_expire":   true,
+	"max_stat_cache_size": true,
+	"enable_noobj_cache":  true,
+	"nomultipart":         true,
+	"sigv2":               true,
+}
+
+// s3Bucket matches bucket names, which also must not look like s3fs flags.
+var s3Bucket = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// s3Credentials are settings that either come from the driver altogether,
+// or from the volume.
+var s3Credentials = []string{"endpoint", "access_key", "secret_key"}
+
+// s3VolumeDriver mounts buckets of S3-compatible object storages, like AWS
+// S3 or MinIO, on the host using s3fs FUSE filesystem and then binds them
+// into containers.
+type s3VolumeDriver struct {
+	rootDir string
+	// defaults are driver-wide settings.
+	defaults map[string]string
+	logger   *zap.Logger
+
+	mu      sync.Mutex
+	volumes map[string]*s3Volume
+}
+
+// NewS3VolumeDriver constructs a new S3 volume driver.
+//
+// The driver accepts "endpoint", "access_key", "secret_key" and "options"
+// parameters. Volumes require "bucket" setting and optionally specify "path"
+// within the bucket and additional "options" from the allowed ones.
+//
+// When the driver has credentials, volumes use them with the driver's
+// endpoint and cannot override either. Otherwise volumes must specify their
+// own credentials and may specify their endpoint.
+func NewS3VolumeDriver(ctx context.Context, options ...Option) (VolumeDriver, error) {
+	opts, err := newOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := exec.LookPath(s3fsBinary); err != nil {
+		return nil, fmt.Errorf("%s is required for S3 volumes: %v", s3fsBinary, err)
+	}
+
+	rootDir := filepath.Join(opts.RootDir, s3DriverName)
+	if err := os.MkdirAll(rootDir, 0700); err != nil {
+		return nil, err
+	}
+
+	log.G(ctx).Info("S3 volume plugin has been initialized", zap.String("root", rootDir))
+
+	return &s3VolumeDriver{
+		rootDir:  rootDir,
+		defaults: opts.Params,
+		logger:   log.G(ctx),
+		volumes:  map[string]*s3Volume{},
+	}, nil
+}
+
+func (d *s3VolumeDriver) CreateVolume(name string, options map[string]string) (Volume, error) {
+	d.logger.Info("creating volume", zap.String("name", name))
+
+	settings, err := d.settings(options)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := settings["bucket"]
+	if len(bucket) == 0 {
+		return nil, fmt.Errorf("S3 volume requires \"bucket\" option")
+	}
+	if !s3Bucket.MatchString(bucket) {
+		return nil, fmt.Errorf("invalid S3 bucket name \"%s\"", bucket)
+	}
+	if len(settings["access_key"]) == 0 || len(settings["secret_key"]) == 0 {
+		return nil, fmt.Errorf("S3 volume requires \"access_key\" and \"secret_key\" options")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if v, ok := d.volumes[name]; ok {
+		return v, nil
+	}
+
+	key := strings.Replace(name, string(filepath.Separator), "_", -1)
+	v := &s3Volume{
+		path:       filepath.Join(d.rootDir, key),
+		passwdFile: filepath.Join(d.rootDir, "."+key+".passwd"),
+	}
+
+	if err := os.MkdirAll(v.path, 0755); err != nil {
+		return nil, err
+	}
+
+	credentials := fmt.Sprintf("%s:%s\n", settings["access_key"], settings["secret_key"])
+	if err := ioutil.WriteFile(v.passwdFile, []byte(credentials), 0600); err != nil {
+		v.remove()
+		return nil, err
+	}
+
+	source := bucket
+	if path := strings.Trim(settings["path"], "/"); len(path) > 0 {
+		source = fmt.Sprintf("%s:/%s", bucket, path)
+	}
+
+	args := []string{source, v.path, "-o", "passwd_file=" + v.passwdFile, "-o", "allow_other"}
+	if endpoint := settings["endpoint"]; len(endpoint) > 0 {
+		// Path-style requests are required for self-hosted storages like
+		// MinIO, which usually have no wildcard DNS for buckets.
+		args = append(args, "-o", "url="+endpoint, "-o", "use_path_request_style")
+	}
+	for _, extra := range []string{d.defaults["options"], settings["options"]} {
+		if len(extra) > 0 {
+			args = append(args, "-o", extra)
+		}
+	}
+
+	if err := run(s3fsBinary, args...); err != nil {
+		v.remove()
+		return nil, err
+	}
+
+	d.volumes[name] = v
+
+	return v, nil
+}
+
+// settings returns settings of the volume with the given options, which are
+// validated, because they come from consumers.
+func (d *s3VolumeDriver) settings(options map[string]string) (map[string]string, error) {
+	if err := validateMountOptions(options["options"], s3MountOptions); err != nil {
+		return nil, err
+	}
+
+	settings := map[string]string{
+		"bucket":  options["bucket"],
+		"path":    options["path"],
+		"options": options["options"],
+	}
+
+	if len(d.defaults["access_key"]) > 0 {
+		for _, key := range s3Credentials {
+			if _, ok := options[key]; ok {
+				return nil, fmt.Errorf("S3 volume cannot override \"%s\" option of the Worker", key)
+			}
+
+			settings[key] = d.defaults[key]
+		}
+	} else {
+		settings["endpoint"] = d.defaults["endpoint"]
+		for _, key := range s3Credentials {
+			if value, ok := options[key]; ok {
+				settings[key] = value
+			}
+		}
+	}
+
+	if strings.ContainsAny(settings["access_key"]+settings["secret_key"], ":\n") {
+		return nil, fmt.Errorf("S3 volume credentials must not contain colons or line breaks")
+	}
+	if endpoint := settings["endpoint"]; len(endpoint) > 0 {
+		// Commas would split the endpoint into several s3fs options.
+		if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || strings.Contains(endpoint, ",") {
+			return nil, fmt.Errorf("invalid S3 endpoint \"%s\"", endpoint)
+		}
+	}
+
+	return settings, nil
+}
+
+func (d *s3VolumeDriver) RemoveVolume(name string) error {
+	d.logger.Info("removing volume", zap.String("name", name))
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	v, ok := d.volumes[name]
+	if !ok {
+		return nil
+	}
+
+	if err := run("fusermount", "-u", v.path); err != nil {
+		return err
+	}
+
+	delete(d.volumes, name)
+
+	return v.remove()
+}
+
+func (d *s3VolumeDriver) Close() error {
+	d.logger.Info("shutting down volume plugin")
+	return nil
+}
+
+type s3Volume struct {
+	path       string
+	passwdFile string
+}
+
+func (v *s3Volume) Configure(m Mount, cfg *container.HostConfig) error {
+	cfg.Mounts = append(cfg.Mounts, mount.Mount{
+		Type:        mount.TypeBind,
+		Source:      v.path,
+		Target:      m.Target,
+		ReadOnly:    m.ReadOnly(),
+		Consistency: mount.ConsistencyDefault,
+	})
+
+	return nil
+}
+
+func (v *s3Volume) remove() error {
+	if err := os.Remove(v.passwdFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return os.RemoveAll(v.path)
+}
@@ -0,0 +1,49 @@
+package volume
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3VolumeSettingsWithWorkerCredentials(t *testing.T) {
+	d := &s3VolumeDriver{defaults: map[string]string{
+		"endpoint":   "http://127.0.0.1:9000",
+		"access_key": "worker",
+		"secret_key": "secret",
+		"options":    "use_cache=/tmp",
+	}}
+
+	settings, err := d.settings(map[string]string{"bucket": "data", "options": "ro"})
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:9000", settings["endpoint"])
+	assert.Equal(t, "worker", settings["access_key"])
+	assert.Equal(t, "ro", settings["options"])
+
+	for _, key := range []string{"endpoint", "access_key", "secret_key"} {
+		_, err := d.settings(map[string]string{"bucket": "data", key: "value"})
+		assert.Error(t, err, key)
+	}
+
+	_, err = d.settings(map[string]string{"bucket": "data", "options": "passwd_file=/etc/shadow"})
+	assert.Error(t, err)
+}
+
+func TestS3VolumeSettingsWithVolumeCredentials(t *testing.T) {
+	d := &s3VolumeDriver{defaults: map[string]string{"endpoint": "http://127.0.0.1:9000"}}
+
+	settings, err := d.settings(map[string]string{"bucket": "data", "access_key": "consumer", "secret_key": "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:9000", settings["endpoint"])
+	assert.Equal(t, "consumer", settings["access_key"])
+
+	settings, err = d.settings(map[string]string{"bucket": "data", "endpoint": "https://s3.amazonaws.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://s3.amazonaws.com", settings["endpoint"])
+
+	_, err = d.settings(map[string]string{"bucket": "data", "endpoint": "http://host,use_cache=/"})
+	assert.Error(t, err)
+	_, err = d.settings(map[string]string{"bucket": "data", "access_key": "a:b", "secret_key": "secret"})
+	assert.Error(t, err)
+}
@@ -5,7 +5,10 @@ import (
 	"path/filepath"
 )
 
-const pluginSockDir = "/run/docker/plugins"
+const (
+	pluginSockDir  = "/run/docker/plugins"
+	defaultRootDir = "/var/lib/docker-volumes"
+)
 
 func fullSocketPath(dir, address string) (string, error) {
 	if err := os.MkdirAll(dir, 0755); err != nil {
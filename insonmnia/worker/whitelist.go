@@ -1,36 +1,67 @@
 package worker
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/docker/distribution/reference"
 	dc "github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	log "github.com/noxiouz/zapctx/ctxlog"
 	"github.com/pkg/errors"
 	"github.com/sonm-io/core/insonmnia/auth"
+	"github.com/sonm-io/core/insonmnia/state"
+	pb "github.com/sonm-io/core/proto"
 	"github.com/sonm-io/core/util"
 	"go.uber.org/zap"
 )
 
+// Whitelist documents are fetched from the configured URL. When the signer
+// is configured the document must be of the form
+//
+//   {"payload": {"serial": 42, "records": {...}}, "signature": "0x..."}
+//
+// where the signature is made by the signer's key over the Keccak-256 hash
+// of the payload exactly as it appears in the document. Serial numbers must
+// never decrease, which prevents rolling the whitelist back to a previous
+// version.
+//
+// Otherwise, only plain maps of records are accepted, since signed documents
+// could not be verified.
+
+const (
+	whitelistStorageKey = "whitelist"
+	// maxWhitelistSize limits the size of whitelist documents.
+	maxWhitelistSize = 16 * 1024 * 1024
+)
+
 type Whitelist interface {
 	Allowed(ctx context.Context, registry string, image string, auth string) (bool, reference.Named, error)
+	// Info describes the effective whitelist policy.
+	Info() *pb.WhitelistReply
 }
 
-func NewWhitelist(ctx context.Context, config *WhitelistConfig) Whitelist {
+func NewWhitelist(ctx context.Context, config *WhitelistConfig, storage *state.Storage) Whitelist {
 	if config.Enabled != nil && !*config.Enabled {
 		return &disabledWhitelist{}
 	}
 
 	wl := whitelist{
 		superusers: make(map[string]struct{}),
+		url:        config.Url,
+		signer:     config.Signer,
 	}
 
 	for _, su := range config.PrivilegedAddresses {
@@ -38,6 +69,16 @@ func NewWhitelist(ctx context.Context, config *WhitelistConfig) Whitelist {
 		wl.superusers[parsed.Hex()] = struct{}{}
 	}
 
+	if storage != nil {
+		wl.storage = state.NewKeyedStorage(whitelistStorageKey, storage)
+
+		// The cached whitelist is used until the fresh one is fetched, so
+		// the policy survives restarts while the URL is unreachable.
+		if err := wl.restore(ctx); err != nil {
+			log.G(ctx).Warn("could not restore cached whitelist", zap.Error(err))
+		}
+	}
+
 	go wl.updateRoutine(ctx, config.Url, config.RefreshPeriod)
 
 	return &wl
@@ -47,10 +88,55 @@ type WhitelistRecord struct {
 	AllowedHashes []string `json:"allowed_hashes"`
 }
 
+type whitelistPayload struct {
+	Serial  uint64                     `json:"serial"`
+	Records map[string]WhitelistRecord `json:"records"`
+
+	// raw is the signed payload as it appears in the document.
+	raw []byte
+}
+
+type signedWhitelist struct {
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	Signature string          `json:"signature,omitempty"`
+}
+
+type whitelistCache struct {
+	Document  []byte    `json:"document"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SignWhitelist makes a whitelist document from the given payload signed
+// with the specified key.
+func SignWhitelist(payload []byte, key *ecdsa.PrivateKey) ([]byte, error) {
+	// The payload is compacted as the document is, so the signature
+	// matches the payload as it appears in the document.
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, payload); err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(crypto.Keccak256(compacted.Bytes()), key)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(signedWhitelist{Payload: compacted.Bytes(), Signature: hexutil.Encode(signature)})
+}
+
 type whitelist struct {
 	superusers map[string]struct{}
-	Records    map[string]WhitelistRecord
-	RecordsMu  sync.RWMutex
+	url        string
+	signer     *common.Address
+	storage    *state.KeyedStorage
+
+	Records   map[string]WhitelistRecord
+	RecordsMu sync.RWMutex
+	serial    uint64
+	// payload is the signed payload of the current document.
+	payload   []byte
+	updatedAt time.Time
+	lastError error
 }
 
 func (w *whitelist) updateRoutine(ctx context.Context, url string, updatePeriod uint) error {
@@ -66,6 +152,10 @@ func (w *whitelist) updateRoutine(ctx context.Context, url string, updatePeriod
 			if err != nil {
 				log.G(ctx).Error("could not load whitelist", zap.Error(err))
 			}
+
+			w.RecordsMu.Lock()
+			w.lastError = err
+			w.RecordsMu.Unlock()
 		}
 	}
 }
@@ -86,20 +176,162 @@ func (w *whitelist) load(ctx context.Context, url string) error {
 }
 
 func (w *whitelist) fillFromJsonReader(ctx context.Context, jsonReader io.Reader) error {
-	decoder := json.NewDecoder(jsonReader)
-	r := make(map[string]WhitelistRecord)
-	err := decoder.Decode(&r)
+	document, err := ioutil.ReadAll(io.LimitReader(jsonReader, maxWhitelistSize))
+	if err != nil {
+		return errors.Wrap(err, "could not read whitelist data")
+	}
+
+	updatedAt := time.Now()
+	if err := w.update(document, updatedAt); err != nil {
+		return err
+	}
+
+	if w.storage != nil {
+		if err := w.storage.Save(whitelistCache{Document: document, UpdatedAt: updatedAt}); err != nil {
+			log.G(ctx).Warn("could not cache whitelist", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// restore applies the whitelist document cached by the previous update.
+func (w *whitelist) restore(ctx context.Context) error {
+	cache := whitelistCache{}
+	if err := w.storage.Load(&cache); err != nil {
+		return err
+	}
+
+	if len(cache.Document) == 0 {
+		return nil
+	}
+
+	if err := w.update(cache.Document, cache.UpdatedAt); err != nil {
+		return err
+	}
+
+	log.G(ctx).Info("restored cached whitelist", zap.Time("updated_at", cache.UpdatedAt))
+
+	return nil
+}
+
+// update verifies the whitelist document and makes it effective.
+func (w *whitelist) update(document []byte, updatedAt time.Time) error {
+	payload, err := w.decode(document)
 	if err != nil {
-		return errors.Wrap(err, "could not decode whitelist data")
+		return err
 	}
 
 	w.RecordsMu.Lock()
-	w.Records = r
-	w.RecordsMu.Unlock()
+	defer w.RecordsMu.Unlock()
+
+	// Only serials of verified documents are meaningful. A new document
+	// must have a greater serial, so that a different document with the same
+	// serial could not replace the current one.
+	if w.payload != nil && payload.Serial <= w.serial && !bytes.Equal(payload.raw, w.payload) {
+		return fmt.Errorf("whitelist serial %d is not greater than the current one %d", payload.Serial, w.serial)
+	}
+
+	w.Records = payload.Records
+	w.serial = payload.Serial
+	w.payload = payload.raw
+	w.updatedAt = updatedAt
+
+	return nil
+}
+
+func (w *whitelist) decode(document []byte) (*whitelistPayload, error) {
+	signed := signedWhitelist{}
+	// Plain maps of records may fail to decode as signed documents.
+	json.Unmarshal(document, &signed)
+
+	if len(signed.Payload) == 0 {
+		if w.signer != nil {
+			return nil, errors.New("whitelist is not signed")
+		}
+
+		records := make(map[string]WhitelistRecord)
+		if err := json.Unmarshal(document, &records); err != nil {
+			return nil, errors.Wrap(err, "could not decode whitelist data")
+		}
+
+		return &whitelistPayload{Records: records}, nil
+	}
+
+	if w.signer == nil {
+		return nil, errors.New("whitelist is signed, but no signer is configured to verify it")
+	}
+
+	if err := verifyWhitelistSignature(signed.Payload, signed.Signature, *w.signer); err != nil {
+		return nil, err
+	}
+
+	payload := &whitelistPayload{}
+	if err := json.Unmarshal(signed.Payload, payload); err != nil {
+		return nil, errors.Wrap(err, "could not decode whitelist payload")
+	}
+
+	if payload.Records == nil {
+		payload.Records = make(map[string]WhitelistRecord)
+	}
+	payload.raw = signed.Payload
+
+	return payload, nil
+}
+
+func verifyWhitelistSignature(payload []byte, signature string, signer common.Address) error {
+	sign, err := hexutil.Decode(signature)
+	if err != nil {
+		return errors.Wrap(err, "malformed whitelist signature")
+	}
+
+	publicKey, err := crypto.SigToPub(crypto.Keccak256(payload), sign)
+	if err != nil {
+		return errors.Wrap(err, "invalid whitelist signature")
+	}
+
+	if addr := crypto.PubkeyToAddress(*publicKey); addr != signer {
+		return fmt.Errorf("whitelist is signed by %s instead of %s", addr.Hex(), signer.Hex())
+	}
 
 	return nil
 }
 
+func (w *whitelist) Info() *pb.WhitelistReply {
+	w.RecordsMu.RLock()
+	defer w.RecordsMu.RUnlock()
+
+	reply := &pb.WhitelistReply{
+		Enabled: true,
+		Url:     w.url,
+		Serial:  w.serial,
+		Records: make(map[string]*pb.WhitelistRecord, len(w.Records)),
+	}
+
+	if w.signer != nil {
+		reply.Signer = w.signer.Hex()
+	}
+
+	for addr := range w.superusers {
+		reply.PrivilegedAddresses = append(reply.PrivilegedAddresses, addr)
+	}
+	sort.Strings(reply.PrivilegedAddresses)
+
+	if !w.updatedAt.IsZero() {
+		reply.UpdatedAt = &pb.Timestamp{Seconds: w.updatedAt.Unix()}
+	}
+
+	for name, record := range w.Records {
+		reply.Records[name] = &pb.WhitelistRecord{AllowedHashes: record.AllowedHashes}
+	}
+
+	if w.lastError != nil {
+		reply.LastError = w.lastError.Error()
+	}
+
+	return reply
+}
+
 func (w *whitelist) digestAllowed(name string, digest string) (bool, error) {
 	ref, err := reference.ParseNormalizedNamed(name)
 	if err != nil {
@@ -183,3 +415,7 @@ func (w *disabledWhitelist) Allowed(ctx context.Context, registry string, image
 
 	return true, ref, nil
 }
+
+func (w *disabledWhitelist) Info() *pb.WhitelistReply {
+	return &pb.WhitelistReply{Enabled: false}
+}
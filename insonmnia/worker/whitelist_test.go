@@ -1,13 +1,20 @@
 package worker
 
 import (
+	"bytes"
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/sonm-io/core/insonmnia/auth"
+	"github.com/sonm-io/core/insonmnia/state"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -55,3 +62,139 @@ func TestWhitelistAllowed(t *testing.T) {
 	assert.True(t, allowed)
 	assert.NoError(t, err)
 }
+
+const signedWhitelistPayload = `
+{
+  "serial": 2,
+  "records": {
+    "docker.io/sonm/eth-claymore": {
+      "allowed_hashes": [
+        "sha256:b5f9a9e47fa319607ed339789ef6692d4937ae5910b86e0ab929d035849e491e"
+      ]
+    }
+  }
+}`
+
+func TestWhitelistSigned(t *testing.T) {
+	document, err := SignWhitelist([]byte(signedWhitelistPayload), key)
+	require.NoError(t, err)
+
+	ctx := walletCtx(addr)
+	w := whitelist{signer: &addr}
+	require.NoError(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+
+	allowed, _, err := w.Allowed(ctx, "", "sonm/eth-claymore@sha256:b5f9a9e47fa319607ed339789ef6692d4937ae5910b86e0ab929d035849e491e", "")
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, uint64(2), w.Info().GetSerial())
+	assert.Equal(t, addr.Hex(), w.Info().GetSigner())
+}
+
+func TestWhitelistSignedErrors(t *testing.T) {
+	otherKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	ctx := walletCtx(addr)
+	w := whitelist{signer: &addr}
+
+	// Unsigned.
+	assert.Error(t, w.fillFromJsonReader(ctx, strings.NewReader(`{"docker.io/sonm/eth-claymore": {"allowed_hashes": []}}`)))
+
+	// Signed by someone else.
+	document, err := SignWhitelist([]byte(signedWhitelistPayload), otherKey)
+	require.NoError(t, err)
+	assert.Error(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+
+	// Tampered.
+	document, err = SignWhitelist([]byte(signedWhitelistPayload), key)
+	require.NoError(t, err)
+	tampered := bytes.Replace(document, []byte("eth-claymore"), []byte("eth-evil"), 1)
+	assert.Error(t, w.fillFromJsonReader(ctx, bytes.NewReader(tampered)))
+
+	assert.Empty(t, w.Records)
+}
+
+func TestWhitelistSerialRollback(t *testing.T) {
+	ctx := walletCtx(addr)
+	w := whitelist{signer: &addr}
+
+	document, err := SignWhitelist([]byte(signedWhitelistPayload), key)
+	require.NoError(t, err)
+	require.NoError(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+
+	document, err = SignWhitelist([]byte(`{"serial": 1, "records": {}}`), key)
+	require.NoError(t, err)
+	assert.Error(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+	assert.Len(t, w.Records, 1)
+
+	document, err = SignWhitelist([]byte(`{"serial": 3, "records": {}}`), key)
+	require.NoError(t, err)
+	require.NoError(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+	assert.Len(t, w.Records, 0)
+	assert.Equal(t, uint64(3), w.serial)
+}
+
+func TestWhitelistSameSerial(t *testing.T) {
+	ctx := walletCtx(addr)
+	w := whitelist{signer: &addr}
+
+	document, err := SignWhitelist([]byte(signedWhitelistPayload), key)
+	require.NoError(t, err)
+	require.NoError(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+
+	// The same document is fetched on every update.
+	require.NoError(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+	assert.Len(t, w.Records, 1)
+
+	document, err = SignWhitelist([]byte(`{"serial": 2, "records": {}}`), key)
+	require.NoError(t, err)
+	assert.Error(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+	assert.Len(t, w.Records, 1)
+	assert.Equal(t, uint64(2), w.serial)
+}
+
+func TestWhitelistWithoutSigner(t *testing.T) {
+	ctx := walletCtx(addr)
+	w := whitelist{}
+
+	// Signed documents cannot be verified.
+	document, err := SignWhitelist([]byte(signedWhitelistPayload), key)
+	require.NoError(t, err)
+	assert.Error(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+	assert.Empty(t, w.Records)
+
+	require.NoError(t, w.fillFromJsonReader(ctx, strings.NewReader(`{"docker.io/sonm/eth-claymore": {"allowed_hashes": []}}`)))
+	assert.Len(t, w.Records, 1)
+	require.NoError(t, w.fillFromJsonReader(ctx, strings.NewReader(`{}`)))
+	assert.Len(t, w.Records, 0)
+}
+
+func TestWhitelistCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "whitelist")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	storage, err := state.NewState(context.Background(), &state.StorageConfig{
+		Endpoint: filepath.Join(dir, "worker.boltdb"),
+		Bucket:   "sonm",
+	})
+	require.NoError(t, err)
+
+	ctx := walletCtx(addr)
+	w := whitelist{signer: &addr, storage: state.NewKeyedStorage(whitelistStorageKey, storage)}
+
+	document, err := SignWhitelist([]byte(signedWhitelistPayload), key)
+	require.NoError(t, err)
+	require.NoError(t, w.fillFromJsonReader(ctx, bytes.NewReader(document)))
+
+	restored := whitelist{signer: &addr, storage: state.NewKeyedStorage(whitelistStorageKey, storage)}
+	require.NoError(t, restored.restore(ctx))
+	assert.Equal(t, w.Records, restored.Records)
+	assert.Equal(t, uint64(2), restored.serial)
+
+	// The cache is verified as well, since the signer may have changed.
+	otherAddr := common.HexToAddress("0x8125721C2413d99a33E351e1F6Bb4e56b6b633FD")
+	restored = whitelist{signer: &otherAddr, storage: state.NewKeyedStorage(whitelistStorageKey, storage)}
+	assert.Error(t, restored.restore(ctx))
+	assert.Empty(t, restored.Records)
+}
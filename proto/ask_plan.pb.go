@@ -41,6 +41,7 @@ It has these top-level messages:
 	StorageDevice
 	Storage
 	NetworkSpec
+	HealthCheck
 	Container
 	SortingOption
 	DealsRequest
@@ -73,6 +74,17 @@ It has these top-level messages:
 	MaxMinTimestamp
 	CmpUint64
 	BlacklistQuery
+	MarketStatsRequest
+	MarketStatsBucket
+	MarketStatsReply
+	DealsHistoryRequest
+	DealsHistoryReply
+	DWHOrderEvent
+	DWHDealEvent
+	FailedEventsRequest
+	FailedEvent
+	FailedEventsReply
+	ReplayFailedEventsRequest
 	Empty
 	ID
 	EthID
@@ -81,6 +93,7 @@ It has these top-level messages:
 	CPUUsage
 	MemoryUsage
 	NetworkUsage
+	StorageUsage
 	ResourceUsage
 	ContainerRestartPolicy
 	TaskLogsRequest
@@ -94,6 +107,10 @@ It has these top-level messages:
 	DataSizeRate
 	Price
 	GetOrdersReply
+	CreateOrdersRequest
+	OrderIDs
+	OrderResult
+	OrderResultsReply
 	Benchmarks
 	Deal
 	Order
@@ -107,10 +124,21 @@ It has these top-level messages:
 	TaskListRequest
 	DealFinishRequest
 	DealsReply
+	DealSpending
+	SpendingReply
 	OpenDealRequest
 	WorkerRemoveRequest
 	WorkerListReply
 	BalanceReply
+	TokenTransfer
+	TokenTransfersReply
+	TokenTransferRequest
+	TokenApproveRequest
+	TokenAllowanceRequest
+	TokenAllowanceReply
+	TokenTransactionReply
+	PendingTransaction
+	PendingTransactionsReply
 	HandshakeRequest
 	DiscoverResponse
 	HandshakeResponse
@@ -133,9 +161,24 @@ It has these top-level messages:
 	TaskListReply
 	DevicesReply
 	PullTaskRequest
+	ImageLayersRequest
+	ImageLayersReply
+	TerminalSize
+	ExecRequest
+	ExecReply
+	CopyToRequest
+	CopyFromRequest
+	WatchTasksRequest
+	TaskEvent
 	DealInfoReply
 	TaskStatusReply
 	StatusMapReply
+	CommitTaskRequest
+	TaskSnapshot
+	TaskSnapshotsRequest
+	TaskSnapshotsReply
+	WhitelistRecord
+	WhitelistReply
 */
 package sonm
 
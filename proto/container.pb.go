@@ -52,6 +52,64 @@ func (m *NetworkSpec) GetAddr() string {
 	return ""
 }
 
+type HealthCheck struct {
+	// Command describes a command executed inside the container to check
+	// its health. Zero exit code means that the container is healthy.
+	// When empty, the health check defined in the image is used.
+	Command []string `protobuf:"bytes,1,rep,name=command" json:"command,omitempty"`
+	// Interval describes the time to wait between checks.
+	Interval *Duration `protobuf:"bytes,2,opt,name=interval" json:"interval,omitempty"`
+	// Timeout describes the time to wait before considering the check to
+	// have hung.
+	Timeout *Duration `protobuf:"bytes,3,opt,name=timeout" json:"timeout,omitempty"`
+	// Retries describes the number of consecutive failures needed to
+	// consider the container as unhealthy.
+	Retries uint32 `protobuf:"varint,4,opt,name=retries" json:"retries,omitempty"`
+	// StartPeriod describes the time for the container to initialize before
+	// failed checks start to count.
+	StartPeriod *Duration `protobuf:"bytes,5,opt,name=startPeriod" json:"startPeriod,omitempty"`
+}
+
+func (m *HealthCheck) Reset()                    { *m = HealthCheck{} }
+func (m *HealthCheck) String() string            { return proto.CompactTextString(m) }
+func (*HealthCheck) ProtoMessage()               {}
+func (*HealthCheck) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1} }
+
+func (m *HealthCheck) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetInterval() *Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetTimeout() *Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+func (m *HealthCheck) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *HealthCheck) GetStartPeriod() *Duration {
+	if m != nil {
+		return m.StartPeriod
+	}
+	return nil
+}
+
 type Container struct {
 	// Image describes a Docker image name. Required.
 	Image string `protobuf:"bytes,1,opt,name=image" json:"image,omitempty"`
@@ -75,12 +133,36 @@ type Container struct {
 	// TODO: Dragons nearby - beware of injection attacks.
 	Mounts   []string       `protobuf:"bytes,9,rep,name=mounts" json:"mounts,omitempty"`
 	Networks []*NetworkSpec `protobuf:"bytes,10,rep,name=networks" json:"networks,omitempty"`
+	// Entrypoint overrides the default ENTRYPOINT of the image. When empty
+	// the image's own ENTRYPOINT is used.
+	Entrypoint []string `protobuf:"bytes,11,rep,name=entrypoint" json:"entrypoint,omitempty"`
+	// Args overrides the default CMD of the image, i.e. arguments passed to
+	// the entrypoint.
+	Args []string `protobuf:"bytes,12,rep,name=args" json:"args,omitempty"`
+	// WorkDir describes the working directory for the command executed
+	// inside the container.
+	WorkDir string `protobuf:"bytes,13,opt,name=workDir" json:"workDir,omitempty"`
+	// User describes the user (name or UID[:GID]) the command is run as.
+	User string `protobuf:"bytes,14,opt,name=user" json:"user,omitempty"`
+	// StopSignal describes the signal to stop the container, for example
+	// "SIGTERM". Defaults to the image's STOPSIGNAL.
+	StopSignal string `protobuf:"bytes,15,opt,name=stopSignal" json:"stopSignal,omitempty"`
+	// StopTimeout describes how long to wait after sending the stop signal
+	// before the container is forcefully killed.
+	StopTimeout *Duration `protobuf:"bytes,16,opt,name=stopTimeout" json:"stopTimeout,omitempty"`
+	// RestartPolicy describes whether the container should be restarted
+	// after its exit. Possible names are "no", "on-failure", "always" and
+	// "unless-stopped". The maximum retry count is used only with the
+	// "on-failure" policy.
+	RestartPolicy *ContainerRestartPolicy `protobuf:"bytes,17,opt,name=restartPolicy" json:"restartPolicy,omitempty"`
+	// HealthCheck describes how to check whether the container is healthy.
+	HealthCheck *HealthCheck `protobuf:"bytes,18,opt,name=healthCheck" json:"healthCheck,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
 func (m *Container) String() string            { return proto.CompactTextString(m) }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2} }
 
 func (m *Container) GetImage() string {
 	if m != nil {
@@ -145,37 +227,107 @@ func (m *Container) GetNetworks() []*NetworkSpec {
 	return nil
 }
 
+func (m *Container) GetEntrypoint() []string {
+	if m != nil {
+		return m.Entrypoint
+	}
+	return nil
+}
+
+func (m *Container) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *Container) GetWorkDir() string {
+	if m != nil {
+		return m.WorkDir
+	}
+	return ""
+}
+
+func (m *Container) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Container) GetStopSignal() string {
+	if m != nil {
+		return m.StopSignal
+	}
+	return ""
+}
+
+func (m *Container) GetStopTimeout() *Duration {
+	if m != nil {
+		return m.StopTimeout
+	}
+	return nil
+}
+
+func (m *Container) GetRestartPolicy() *ContainerRestartPolicy {
+	if m != nil {
+		return m.RestartPolicy
+	}
+	return nil
+}
+
+func (m *Container) GetHealthCheck() *HealthCheck {
+	if m != nil {
+		return m.HealthCheck
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NetworkSpec)(nil), "sonm.NetworkSpec")
+	proto.RegisterType((*HealthCheck)(nil), "sonm.HealthCheck")
 	proto.RegisterType((*Container)(nil), "sonm.Container")
 }
 
 func init() { proto.RegisterFile("container.proto", fileDescriptor4) }
 
 var fileDescriptor4 = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x6b, 0xe3, 0x30,
-	0x10, 0xc5, 0x71, 0x3e, 0x9c, 0x89, 0x97, 0xdd, 0x15, 0xcb, 0x22, 0x4c, 0x29, 0xc6, 0xf4, 0x10,
-	0x0a, 0xf5, 0x21, 0x85, 0x10, 0x72, 0x6d, 0x03, 0x85, 0x42, 0x03, 0x0e, 0xf4, 0xee, 0x38, 0x22,
-	0x35, 0x89, 0x25, 0x23, 0xc9, 0x2e, 0xfe, 0x7d, 0xbd, 0xf4, 0x67, 0x15, 0x7d, 0x38, 0x38, 0x6d,
-	0x2f, 0xbd, 0xcd, 0x1b, 0xbf, 0x79, 0x7a, 0xf3, 0xc6, 0xf0, 0x3b, 0x63, 0x54, 0xa6, 0x39, 0x25,
-	0x3c, 0x2e, 0x39, 0x93, 0x0c, 0xf5, 0x05, 0xa3, 0x45, 0xe0, 0xd7, 0xec, 0x58, 0x15, 0xc4, 0xf4,
-	0xa2, 0x37, 0x07, 0x26, 0x4f, 0x44, 0xbe, 0x32, 0x7e, 0xd8, 0x94, 0x24, 0x43, 0x08, 0xfa, 0xb2,
-	0x29, 0x09, 0x76, 0x42, 0x67, 0x3a, 0x4e, 0x74, 0x8d, 0x16, 0x30, 0x62, 0xa5, 0xcc, 0x19, 0x15,
-	0xb8, 0x17, 0xba, 0xd3, 0xc9, 0xec, 0x32, 0x56, 0x4a, 0x71, 0x67, 0x2e, 0x5e, 0x1b, 0xc2, 0x8a,
-	0x4a, 0xde, 0x24, 0x2d, 0x1d, 0xfd, 0x87, 0xa1, 0xa8, 0xb6, 0x94, 0x48, 0xec, 0x6a, 0x3d, 0x8b,
-	0xd4, 0x2b, 0xe9, 0x6e, 0xc7, 0x71, 0xdf, 0xbc, 0xa2, 0xea, 0x60, 0x09, 0x7e, 0x57, 0x04, 0xfd,
-	0x01, 0xf7, 0x40, 0x1a, 0x6b, 0x44, 0x95, 0xe8, 0x1f, 0x0c, 0xea, 0xf4, 0x58, 0x11, 0xdc, 0xd3,
-	0x3d, 0x03, 0x96, 0xbd, 0x85, 0x13, 0xbd, 0xbb, 0x30, 0xbe, 0x6b, 0xb7, 0x55, 0xbc, 0xbc, 0x48,
-	0xf7, 0xed, 0x12, 0x06, 0xa0, 0x00, 0x3c, 0x4e, 0xf6, 0xb9, 0x90, 0xbc, 0xb1, 0x02, 0x27, 0xac,
-	0xfd, 0x54, 0xf2, 0xc5, 0xba, 0xd4, 0x35, 0xba, 0x82, 0x5f, 0x65, 0xb5, 0x3d, 0xe6, 0xd9, 0x23,
-	0x69, 0xee, 0x53, 0x99, 0x5a, 0xb3, 0xe7, 0x4d, 0x14, 0x81, 0x9f, 0xb1, 0xa2, 0xc8, 0xe5, 0x9a,
-	0x6e, 0x24, 0x2b, 0xf1, 0x20, 0x74, 0xa6, 0x5e, 0x72, 0xd6, 0x43, 0xd7, 0xe0, 0x12, 0x5a, 0xe3,
-	0x91, 0xce, 0x0e, 0x9b, 0xec, 0x4e, 0x6e, 0xe3, 0x15, 0xad, 0x4d, 0x6a, 0x8a, 0x84, 0xe6, 0x30,
-	0x32, 0xf7, 0x11, 0xd8, 0xd3, 0xfc, 0x8b, 0xcf, 0xfc, 0x67, 0xf3, 0xd9, 0x26, 0x6d, 0xc9, 0x2a,
-	0xe9, 0x82, 0x55, 0x54, 0x0a, 0x3c, 0x0e, 0x5d, 0x95, 0xb4, 0x41, 0xe8, 0x06, 0x3c, 0x6a, 0xce,
-	0x24, 0x30, 0x68, 0xc1, 0xbf, 0x5f, 0x8e, 0x97, 0x9c, 0x28, 0xc1, 0x1c, 0xbc, 0xd6, 0xcf, 0x4f,
-	0x0e, 0x10, 0x3c, 0x80, 0xdf, 0xf5, 0xf5, 0xcd, 0x6c, 0xd4, 0x9d, 0x9d, 0xcc, 0x7c, 0xe3, 0xc2,
-	0x0c, 0x75, 0x94, 0xb6, 0x43, 0xfd, 0x5f, 0xde, 0x7e, 0x04, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x3f,
-	0xbf, 0x2d, 0xbe, 0x02, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6b, 0xdb, 0x30,
+	0x14, 0xc6, 0x4d, 0xd3, 0x38, 0xcf, 0x4e, 0xd3, 0x8a, 0x31, 0x84, 0x19, 0x25, 0x84, 0x1d, 0x42,
+	0x61, 0x61, 0xa4, 0x50, 0x4a, 0x8f, 0x6b, 0x0a, 0x85, 0xc1, 0x3a, 0x9c, 0xb1, 0xbb, 0xe2, 0x88,
+	0x44, 0xc4, 0x96, 0x8c, 0x24, 0x67, 0xf8, 0xef, 0xdb, 0x69, 0x7f, 0xd0, 0xee, 0x43, 0x92, 0x9d,
+	0x2a, 0x6b, 0x76, 0xd8, 0x29, 0xef, 0xc7, 0xf7, 0x7e, 0xe8, 0x7b, 0x9f, 0x03, 0xc3, 0x4c, 0x70,
+	0x4d, 0x18, 0xa7, 0x72, 0x5a, 0x4a, 0xa1, 0x05, 0x3a, 0x55, 0x82, 0x17, 0xc9, 0x90, 0x71, 0xf3,
+	0xcb, 0x19, 0x71, 0xe1, 0x24, 0xde, 0x89, 0xbc, 0x2a, 0xa8, 0xf3, 0xc6, 0x3f, 0x03, 0x88, 0xbe,
+	0x50, 0xfd, 0x43, 0xc8, 0xed, 0xa2, 0xa4, 0x19, 0x42, 0x70, 0xaa, 0xeb, 0x92, 0xe2, 0x60, 0x14,
+	0x4c, 0xfa, 0xa9, 0xb5, 0xd1, 0x1d, 0xf4, 0x44, 0xa9, 0x99, 0xe0, 0x0a, 0x9f, 0x8c, 0x3a, 0x93,
+	0x68, 0x76, 0x35, 0x35, 0x2d, 0xa7, 0x5e, 0xdd, 0xf4, 0xd9, 0x01, 0x1e, 0xb9, 0x96, 0x75, 0xda,
+	0xc2, 0xd1, 0x5b, 0x38, 0x53, 0xd5, 0x92, 0x53, 0x8d, 0x3b, 0xb6, 0x5f, 0xe3, 0x99, 0x29, 0x64,
+	0xb5, 0x92, 0xf8, 0xd4, 0x4d, 0x31, 0x76, 0x72, 0x0f, 0xb1, 0xdf, 0x04, 0x5d, 0x40, 0x67, 0x4b,
+	0xeb, 0x66, 0x11, 0x63, 0xa2, 0x37, 0xd0, 0xdd, 0x91, 0xbc, 0xa2, 0xf8, 0xc4, 0xc6, 0x9c, 0x73,
+	0x7f, 0x72, 0x17, 0x8c, 0x7f, 0x05, 0x10, 0x3d, 0x51, 0x92, 0xeb, 0xcd, 0xc3, 0x86, 0x66, 0x5b,
+	0x84, 0xa1, 0x97, 0x89, 0xa2, 0x20, 0x7c, 0x85, 0x83, 0x51, 0x67, 0xd2, 0x4f, 0x5b, 0x17, 0x5d,
+	0x43, 0xc8, 0xb8, 0xa6, 0x72, 0x47, 0x72, 0xdb, 0x26, 0x9a, 0x9d, 0xbb, 0xc7, 0xcc, 0x2b, 0x49,
+	0xcc, 0xf4, 0x74, 0x9f, 0x47, 0x13, 0xe8, 0x69, 0x56, 0x50, 0x51, 0xb9, 0xf5, 0x5f, 0x43, 0xdb,
+	0xb4, 0x99, 0x27, 0xa9, 0x96, 0x8c, 0x2a, 0xfb, 0xa4, 0x41, 0xda, 0xba, 0xe8, 0x23, 0x44, 0x4a,
+	0x13, 0xa9, 0xbf, 0x52, 0xc9, 0xc4, 0x0a, 0x77, 0x8f, 0xf6, 0xf1, 0x21, 0xe3, 0xdf, 0x5d, 0xe8,
+	0x3f, 0xb4, 0xa7, 0x34, 0x6f, 0x66, 0x05, 0x59, 0xb7, 0x07, 0x71, 0x0e, 0x4a, 0x20, 0x94, 0x74,
+	0xcd, 0x94, 0x96, 0x75, 0x43, 0xc6, 0xde, 0xb7, 0xdc, 0x56, 0x7a, 0xd3, 0x30, 0x6e, 0x6d, 0xf4,
+	0x1e, 0x06, 0x65, 0xb5, 0xcc, 0x59, 0xf6, 0x99, 0xd6, 0x73, 0xa2, 0x49, 0x43, 0xfc, 0x61, 0x10,
+	0x8d, 0x21, 0x36, 0x34, 0x31, 0xfd, 0xcc, 0x17, 0x5a, 0x94, 0x76, 0xd9, 0x30, 0x3d, 0x88, 0xa1,
+	0x6b, 0xe8, 0x50, 0xbe, 0xc3, 0x3d, 0xab, 0x03, 0xec, 0xde, 0xb1, 0xdf, 0x76, 0xfa, 0xc8, 0x77,
+	0x4e, 0x01, 0x06, 0x84, 0x6e, 0xa1, 0xe7, 0xb4, 0xa6, 0x70, 0x68, 0xf1, 0xef, 0xfe, 0xc6, 0x7f,
+	0x77, 0xe9, 0x46, 0x35, 0x0d, 0xd8, 0xa8, 0xa6, 0x10, 0x15, 0xd7, 0x0a, 0xf7, 0xed, 0xf1, 0x1a,
+	0x0f, 0x7d, 0x80, 0x90, 0x3b, 0xc9, 0x29, 0x0c, 0xb6, 0xe1, 0xe5, 0x2b, 0x21, 0xa6, 0x7b, 0x08,
+	0xba, 0x02, 0xa0, 0xa6, 0x71, 0x29, 0x18, 0xd7, 0x38, 0xb2, 0xad, 0xbc, 0x88, 0x25, 0x4a, 0xae,
+	0x15, 0x8e, 0x6d, 0xc6, 0xda, 0xe6, 0x90, 0xa6, 0x78, 0xce, 0x24, 0x1e, 0x58, 0x8a, 0x5a, 0xd7,
+	0xa0, 0x2b, 0x45, 0x25, 0x3e, 0x77, 0xb4, 0x1a, 0xdb, 0x4c, 0x50, 0x5a, 0x94, 0x0b, 0xb6, 0xe6,
+	0x24, 0xc7, 0x43, 0x9b, 0xf1, 0x22, 0xee, 0xf8, 0xa2, 0xfc, 0xd6, 0x88, 0xe8, 0xe2, 0x5f, 0xc7,
+	0xdf, 0x43, 0xd0, 0x27, 0x18, 0x48, 0xea, 0xd4, 0x20, 0x72, 0x96, 0xd5, 0xf8, 0x72, 0x14, 0x1c,
+	0x21, 0x2e, 0xf5, 0x31, 0xe9, 0x61, 0x09, 0xba, 0x81, 0x68, 0xf3, 0xf2, 0x2d, 0x60, 0x34, 0x0a,
+	0x5e, 0x98, 0xf2, 0x3e, 0x92, 0xd4, 0x47, 0x25, 0xb7, 0x10, 0xb6, 0xc7, 0xfb, 0x9f, 0x2f, 0x2f,
+	0x79, 0x82, 0xd8, 0x3f, 0xe2, 0x91, 0xda, 0xb1, 0x5f, 0x1b, 0xcd, 0x62, 0xb7, 0x88, 0x2b, 0xf2,
+	0x3a, 0x2d, 0xcf, 0xec, 0x1f, 0xd2, 0xcd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x19, 0xb4,
+	0x95, 0xc8, 0x04, 0x00, 0x00,
 }
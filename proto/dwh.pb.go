@@ -118,6 +118,55 @@ func (x BlacklistOption) String() string {
 }
 func (BlacklistOption) EnumDescriptor() ([]byte, []int) { return fileDescriptor5, []int{3} }
 
+type DWHEventType int32
+
+const (
+	DWHEventType_CREATED DWHEventType = 0
+	DWHEventType_UPDATED DWHEventType = 1
+	// DELETED events are sent regardless of filters and contain only the ID
+	// of the removed entity.
+	DWHEventType_DELETED DWHEventType = 2
+)
+
+var DWHEventType_name = map[int32]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "DELETED",
+}
+var DWHEventType_value = map[string]int32{
+	"CREATED": 0,
+	"UPDATED": 1,
+	"DELETED": 2,
+}
+
+func (x DWHEventType) String() string {
+	return proto.EnumName(DWHEventType_name, int32(x))
+}
+func (DWHEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor5, []int{4} }
+
+type MarketStatsRequest_Granularity int32
+
+const (
+	MarketStatsRequest_HOUR MarketStatsRequest_Granularity = 0
+	MarketStatsRequest_DAY  MarketStatsRequest_Granularity = 1
+)
+
+var MarketStatsRequest_Granularity_name = map[int32]string{
+	0: "HOUR",
+	1: "DAY",
+}
+var MarketStatsRequest_Granularity_value = map[string]int32{
+	"HOUR": 0,
+	"DAY":  1,
+}
+
+func (x MarketStatsRequest_Granularity) String() string {
+	return proto.EnumName(MarketStatsRequest_Granularity_name, int32(x))
+}
+func (MarketStatsRequest_Granularity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor5, []int{31, 0}
+}
+
 type SortingOption struct {
 	Field string       `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
 	Order SortingOrder `protobuf:"varint,2,opt,name=order,enum=sonm.SortingOrder" json:"order,omitempty"`
@@ -1510,6 +1559,435 @@ func (m *BlacklistQuery) GetOption() BlacklistOption {
 	return BlacklistOption_WithoutMatching
 }
 
+type MarketStatsRequest struct {
+	// From is the inclusive start of the requested interval.
+	From *Timestamp `protobuf:"bytes,1,opt,name=from" json:"from,omitempty"`
+	// To is the exclusive end of the requested interval. Defaults to now.
+	To          *Timestamp                     `protobuf:"bytes,2,opt,name=to" json:"to,omitempty"`
+	Granularity MarketStatsRequest_Granularity `protobuf:"varint,3,opt,name=granularity,enum=sonm.MarketStatsRequest_Granularity" json:"granularity,omitempty"`
+	// OrderType limits order price statistics to either asks or bids.
+	OrderType  OrderType                `protobuf:"varint,4,opt,name=orderType,enum=sonm.OrderType" json:"orderType,omitempty"`
+	Netflags   *CmpUint64               `protobuf:"bytes,5,opt,name=netflags" json:"netflags,omitempty"`
+	Benchmarks map[uint64]*MaxMinUint64 `protobuf:"bytes,6,rep,name=benchmarks" json:"benchmarks,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// PricePerBenchmark enables normalization of order prices by the value
+	// of the benchmark specified in PriceBenchmarkID.
+	PricePerBenchmark bool   `protobuf:"varint,7,opt,name=pricePerBenchmark" json:"pricePerBenchmark,omitempty"`
+	PriceBenchmarkID  uint64 `protobuf:"varint,8,opt,name=priceBenchmarkID" json:"priceBenchmarkID,omitempty"`
+	// Percentiles of order price to calculate in each bucket, in [1, 99].
+	Percentiles []uint32 `protobuf:"varint,9,rep,packed,name=percentiles" json:"percentiles,omitempty"`
+}
+
+func (m *MarketStatsRequest) Reset()                    { *m = MarketStatsRequest{} }
+func (m *MarketStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*MarketStatsRequest) ProtoMessage()               {}
+func (*MarketStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{31} }
+
+func (m *MarketStatsRequest) GetFrom() *Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *MarketStatsRequest) GetTo() *Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *MarketStatsRequest) GetGranularity() MarketStatsRequest_Granularity {
+	if m != nil {
+		return m.Granularity
+	}
+	return MarketStatsRequest_HOUR
+}
+
+func (m *MarketStatsRequest) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return OrderType_ANY
+}
+
+func (m *MarketStatsRequest) GetNetflags() *CmpUint64 {
+	if m != nil {
+		return m.Netflags
+	}
+	return nil
+}
+
+func (m *MarketStatsRequest) GetBenchmarks() map[uint64]*MaxMinUint64 {
+	if m != nil {
+		return m.Benchmarks
+	}
+	return nil
+}
+
+func (m *MarketStatsRequest) GetPricePerBenchmark() bool {
+	if m != nil {
+		return m.PricePerBenchmark
+	}
+	return false
+}
+
+func (m *MarketStatsRequest) GetPriceBenchmarkID() uint64 {
+	if m != nil {
+		return m.PriceBenchmarkID
+	}
+	return 0
+}
+
+func (m *MarketStatsRequest) GetPercentiles() []uint32 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+type MarketStatsBucket struct {
+	StartTime *Timestamp `protobuf:"bytes,1,opt,name=startTime" json:"startTime,omitempty"`
+	// Orders is the number of orders created within the bucket.
+	Orders      uint64  `protobuf:"varint,2,opt,name=orders" json:"orders,omitempty"`
+	MedianPrice *BigInt `protobuf:"bytes,3,opt,name=medianPrice" json:"medianPrice,omitempty"`
+	// PercentilePrices are in the same order as the requested percentiles.
+	PercentilePrices []*BigInt `protobuf:"bytes,4,rep,name=percentilePrices" json:"percentilePrices,omitempty"`
+	OpenedDeals      uint64    `protobuf:"varint,5,opt,name=openedDeals" json:"openedDeals,omitempty"`
+	ClosedDeals      uint64    `protobuf:"varint,6,opt,name=closedDeals" json:"closedDeals,omitempty"`
+	TotalPayout      *BigInt   `protobuf:"bytes,7,opt,name=totalPayout" json:"totalPayout,omitempty"`
+	// ActiveSuppliers is the number of distinct suppliers having at least
+	// one deal active within the bucket.
+	ActiveSuppliers uint64 `protobuf:"varint,8,opt,name=activeSuppliers" json:"activeSuppliers,omitempty"`
+}
+
+func (m *MarketStatsBucket) Reset()                    { *m = MarketStatsBucket{} }
+func (m *MarketStatsBucket) String() string            { return proto.CompactTextString(m) }
+func (*MarketStatsBucket) ProtoMessage()               {}
+func (*MarketStatsBucket) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{32} }
+
+func (m *MarketStatsBucket) GetStartTime() *Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *MarketStatsBucket) GetOrders() uint64 {
+	if m != nil {
+		return m.Orders
+	}
+	return 0
+}
+
+func (m *MarketStatsBucket) GetMedianPrice() *BigInt {
+	if m != nil {
+		return m.MedianPrice
+	}
+	return nil
+}
+
+func (m *MarketStatsBucket) GetPercentilePrices() []*BigInt {
+	if m != nil {
+		return m.PercentilePrices
+	}
+	return nil
+}
+
+func (m *MarketStatsBucket) GetOpenedDeals() uint64 {
+	if m != nil {
+		return m.OpenedDeals
+	}
+	return 0
+}
+
+func (m *MarketStatsBucket) GetClosedDeals() uint64 {
+	if m != nil {
+		return m.ClosedDeals
+	}
+	return 0
+}
+
+func (m *MarketStatsBucket) GetTotalPayout() *BigInt {
+	if m != nil {
+		return m.TotalPayout
+	}
+	return nil
+}
+
+func (m *MarketStatsBucket) GetActiveSuppliers() uint64 {
+	if m != nil {
+		return m.ActiveSuppliers
+	}
+	return 0
+}
+
+type MarketStatsReply struct {
+	Buckets []*MarketStatsBucket `protobuf:"bytes,1,rep,name=buckets" json:"buckets,omitempty"`
+}
+
+func (m *MarketStatsReply) Reset()                    { *m = MarketStatsReply{} }
+func (m *MarketStatsReply) String() string            { return proto.CompactTextString(m) }
+func (*MarketStatsReply) ProtoMessage()               {}
+func (*MarketStatsReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{33} }
+
+func (m *MarketStatsReply) GetBuckets() []*MarketStatsBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type DealsHistoryRequest struct {
+	Status     DealStatus  `protobuf:"varint,1,opt,name=status,enum=sonm.DealStatus" json:"status,omitempty"`
+	SupplierID *EthAddress `protobuf:"bytes,2,opt,name=supplierID" json:"supplierID,omitempty"`
+	ConsumerID *EthAddress `protobuf:"bytes,3,opt,name=consumerID" json:"consumerID,omitempty"`
+	Limit      uint64      `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+	Offset     uint64      `protobuf:"varint,5,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *DealsHistoryRequest) Reset()                    { *m = DealsHistoryRequest{} }
+func (m *DealsHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*DealsHistoryRequest) ProtoMessage()               {}
+func (*DealsHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{34} }
+
+func (m *DealsHistoryRequest) GetStatus() DealStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DealStatus_DEAL_UNKNOWN
+}
+
+func (m *DealsHistoryRequest) GetSupplierID() *EthAddress {
+	if m != nil {
+		return m.SupplierID
+	}
+	return nil
+}
+
+func (m *DealsHistoryRequest) GetConsumerID() *EthAddress {
+	if m != nil {
+		return m.ConsumerID
+	}
+	return nil
+}
+
+func (m *DealsHistoryRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DealsHistoryRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type DealsHistoryReply struct {
+	// Deals have only their IDs, parties, timing, status and benchmarks set.
+	Deals []*Deal `protobuf:"bytes,1,rep,name=deals" json:"deals,omitempty"`
+}
+
+func (m *DealsHistoryReply) Reset()                    { *m = DealsHistoryReply{} }
+func (m *DealsHistoryReply) String() string            { return proto.CompactTextString(m) }
+func (*DealsHistoryReply) ProtoMessage()               {}
+func (*DealsHistoryReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{35} }
+
+func (m *DealsHistoryReply) GetDeals() []*Deal {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+type DWHOrderEvent struct {
+	Type  DWHEventType `protobuf:"varint,1,opt,name=type,enum=sonm.DWHEventType" json:"type,omitempty"`
+	Order *DWHOrder    `protobuf:"bytes,2,opt,name=order" json:"order,omitempty"`
+}
+
+func (m *DWHOrderEvent) Reset()                    { *m = DWHOrderEvent{} }
+func (m *DWHOrderEvent) String() string            { return proto.CompactTextString(m) }
+func (*DWHOrderEvent) ProtoMessage()               {}
+func (*DWHOrderEvent) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{36} }
+
+func (m *DWHOrderEvent) GetType() DWHEventType {
+	if m != nil {
+		return m.Type
+	}
+	return DWHEventType_CREATED
+}
+
+func (m *DWHOrderEvent) GetOrder() *DWHOrder {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+type DWHDealEvent struct {
+	Type DWHEventType `protobuf:"varint,1,opt,name=type,enum=sonm.DWHEventType" json:"type,omitempty"`
+	Deal *DWHDeal     `protobuf:"bytes,2,opt,name=deal" json:"deal,omitempty"`
+}
+
+func (m *DWHDealEvent) Reset()                    { *m = DWHDealEvent{} }
+func (m *DWHDealEvent) String() string            { return proto.CompactTextString(m) }
+func (*DWHDealEvent) ProtoMessage()               {}
+func (*DWHDealEvent) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{37} }
+
+func (m *DWHDealEvent) GetType() DWHEventType {
+	if m != nil {
+		return m.Type
+	}
+	return DWHEventType_CREATED
+}
+
+func (m *DWHDealEvent) GetDeal() *DWHDeal {
+	if m != nil {
+		return m.Deal
+	}
+	return nil
+}
+
+type FailedEventsRequest struct {
+	Limit     uint64 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
+	Offset    uint64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	WithCount bool   `protobuf:"varint,3,opt,name=withCount" json:"withCount,omitempty"`
+}
+
+func (m *FailedEventsRequest) Reset()                    { *m = FailedEventsRequest{} }
+func (m *FailedEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*FailedEventsRequest) ProtoMessage()               {}
+func (*FailedEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{38} }
+
+func (m *FailedEventsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *FailedEventsRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *FailedEventsRequest) GetWithCount() bool {
+	if m != nil {
+		return m.WithCount
+	}
+	return false
+}
+
+type FailedEvent struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=blockNumber" json:"blockNumber,omitempty"`
+	// Type is the name of the event, e.g. "OrderPlacedData".
+	Type string `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	// Data is the JSON-encoded event payload.
+	Data        string     `protobuf:"bytes,4,opt,name=data" json:"data,omitempty"`
+	Attempts    uint64     `protobuf:"varint,5,opt,name=attempts" json:"attempts,omitempty"`
+	LastError   string     `protobuf:"bytes,6,opt,name=lastError" json:"lastError,omitempty"`
+	NextRetryTS *Timestamp `protobuf:"bytes,7,opt,name=nextRetryTS" json:"nextRetryTS,omitempty"`
+}
+
+func (m *FailedEvent) Reset()                    { *m = FailedEvent{} }
+func (m *FailedEvent) String() string            { return proto.CompactTextString(m) }
+func (*FailedEvent) ProtoMessage()               {}
+func (*FailedEvent) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{39} }
+
+func (m *FailedEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FailedEvent) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *FailedEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *FailedEvent) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *FailedEvent) GetNextRetryTS() *Timestamp {
+	if m != nil {
+		return m.NextRetryTS
+	}
+	return nil
+}
+
+type FailedEventsReply struct {
+	Events []*FailedEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	Count  uint64         `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *FailedEventsReply) Reset()                    { *m = FailedEventsReply{} }
+func (m *FailedEventsReply) String() string            { return proto.CompactTextString(m) }
+func (*FailedEventsReply) ProtoMessage()               {}
+func (*FailedEventsReply) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{40} }
+
+func (m *FailedEventsReply) GetEvents() []*FailedEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *FailedEventsReply) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplayFailedEventsRequest struct {
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+}
+
+func (m *ReplayFailedEventsRequest) Reset()                    { *m = ReplayFailedEventsRequest{} }
+func (m *ReplayFailedEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReplayFailedEventsRequest) ProtoMessage()               {}
+func (*ReplayFailedEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{41} }
+
+func (m *ReplayFailedEventsRequest) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SortingOption)(nil), "sonm.SortingOption")
 	proto.RegisterType((*DealsRequest)(nil), "sonm.DealsRequest")
@@ -1542,10 +2020,23 @@ func init() {
 	proto.RegisterType((*MaxMinTimestamp)(nil), "sonm.MaxMinTimestamp")
 	proto.RegisterType((*CmpUint64)(nil), "sonm.CmpUint64")
 	proto.RegisterType((*BlacklistQuery)(nil), "sonm.BlacklistQuery")
+	proto.RegisterType((*MarketStatsRequest)(nil), "sonm.MarketStatsRequest")
+	proto.RegisterType((*MarketStatsBucket)(nil), "sonm.MarketStatsBucket")
+	proto.RegisterType((*MarketStatsReply)(nil), "sonm.MarketStatsReply")
+	proto.RegisterType((*DealsHistoryRequest)(nil), "sonm.DealsHistoryRequest")
+	proto.RegisterType((*DealsHistoryReply)(nil), "sonm.DealsHistoryReply")
+	proto.RegisterType((*DWHOrderEvent)(nil), "sonm.DWHOrderEvent")
+	proto.RegisterType((*DWHDealEvent)(nil), "sonm.DWHDealEvent")
+	proto.RegisterType((*FailedEventsRequest)(nil), "sonm.FailedEventsRequest")
+	proto.RegisterType((*FailedEvent)(nil), "sonm.FailedEvent")
+	proto.RegisterType((*FailedEventsReply)(nil), "sonm.FailedEventsReply")
+	proto.RegisterType((*ReplayFailedEventsRequest)(nil), "sonm.ReplayFailedEventsRequest")
 	proto.RegisterEnum("sonm.CmpOp", CmpOp_name, CmpOp_value)
 	proto.RegisterEnum("sonm.SortingOrder", SortingOrder_name, SortingOrder_value)
 	proto.RegisterEnum("sonm.ProfileRole", ProfileRole_name, ProfileRole_value)
 	proto.RegisterEnum("sonm.BlacklistOption", BlacklistOption_name, BlacklistOption_value)
+	proto.RegisterEnum("sonm.DWHEventType", DWHEventType_name, DWHEventType_value)
+	proto.RegisterEnum("sonm.MarketStatsRequest_Granularity", MarketStatsRequest_Granularity_name, MarketStatsRequest_Granularity_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1571,6 +2062,23 @@ type DWHClient interface {
 	GetValidators(ctx context.Context, in *ValidatorsRequest, opts ...grpc.CallOption) (*ValidatorsReply, error)
 	GetDealChangeRequests(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*DealChangeRequestsReply, error)
 	GetWorkers(ctx context.Context, in *WorkersRequest, opts ...grpc.CallOption) (*WorkersReply, error)
+	// GetMarketStats returns historical market statistics bucketed by time.
+	GetMarketStats(ctx context.Context, in *MarketStatsRequest, opts ...grpc.CallOption) (*MarketStatsReply, error)
+	// GetDealsHistory returns deals from the history, which unlike other
+	// DWH tables keeps deals after they are closed, most recent first.
+	GetDealsHistory(ctx context.Context, in *DealsHistoryRequest, opts ...grpc.CallOption) (*DealsHistoryReply, error)
+	// SubscribeOrders streams orders matching the given filters as they are
+	// placed or updated. Pagination and sorting options are ignored.
+	SubscribeOrders(ctx context.Context, in *OrdersRequest, opts ...grpc.CallOption) (DWH_SubscribeOrdersClient, error)
+	// SubscribeDeals streams deals matching the given filters as they are
+	// opened or updated. Pagination and sorting options are ignored.
+	SubscribeDeals(ctx context.Context, in *DealsRequest, opts ...grpc.CallOption) (DWH_SubscribeDealsClient, error)
+	// GetFailedEvents lists blockchain events that have failed to be
+	// processed and are waiting for retry. Requires admin permissions.
+	GetFailedEvents(ctx context.Context, in *FailedEventsRequest, opts ...grpc.CallOption) (*FailedEventsReply, error)
+	// ReplayFailedEvents schedules the given failed events, or all of them if
+	// no IDs are specified, for immediate retry. Requires admin permissions.
+	ReplayFailedEvents(ctx context.Context, in *ReplayFailedEventsRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type dWHClient struct {
@@ -1689,6 +2197,106 @@ func (c *dWHClient) GetWorkers(ctx context.Context, in *WorkersRequest, opts ...
 	return out, nil
 }
 
+func (c *dWHClient) GetMarketStats(ctx context.Context, in *MarketStatsRequest, opts ...grpc.CallOption) (*MarketStatsReply, error) {
+	out := new(MarketStatsReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetMarketStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHClient) GetDealsHistory(ctx context.Context, in *DealsHistoryRequest, opts ...grpc.CallOption) (*DealsHistoryReply, error) {
+	out := new(DealsHistoryReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetDealsHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHClient) SubscribeOrders(ctx context.Context, in *OrdersRequest, opts ...grpc.CallOption) (DWH_SubscribeOrdersClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_DWH_serviceDesc.Streams[0], c.cc, "/sonm.DWH/SubscribeOrders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dWHSubscribeOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DWH_SubscribeOrdersClient interface {
+	Recv() (*DWHOrderEvent, error)
+	grpc.ClientStream
+}
+
+type dWHSubscribeOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *dWHSubscribeOrdersClient) Recv() (*DWHOrderEvent, error) {
+	m := new(DWHOrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dWHClient) SubscribeDeals(ctx context.Context, in *DealsRequest, opts ...grpc.CallOption) (DWH_SubscribeDealsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_DWH_serviceDesc.Streams[1], c.cc, "/sonm.DWH/SubscribeDeals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dWHSubscribeDealsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DWH_SubscribeDealsClient interface {
+	Recv() (*DWHDealEvent, error)
+	grpc.ClientStream
+}
+
+type dWHSubscribeDealsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dWHSubscribeDealsClient) Recv() (*DWHDealEvent, error) {
+	m := new(DWHDealEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dWHClient) GetFailedEvents(ctx context.Context, in *FailedEventsRequest, opts ...grpc.CallOption) (*FailedEventsReply, error) {
+	out := new(FailedEventsReply)
+	err := grpc.Invoke(ctx, "/sonm.DWH/GetFailedEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dWHClient) ReplayFailedEvents(ctx context.Context, in *ReplayFailedEventsRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.DWH/ReplayFailedEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for DWH service
 
 type DWHServer interface {
@@ -1704,6 +2312,23 @@ type DWHServer interface {
 	GetValidators(context.Context, *ValidatorsRequest) (*ValidatorsReply, error)
 	GetDealChangeRequests(context.Context, *BigInt) (*DealChangeRequestsReply, error)
 	GetWorkers(context.Context, *WorkersRequest) (*WorkersReply, error)
+	// GetMarketStats returns historical market statistics bucketed by time.
+	GetMarketStats(context.Context, *MarketStatsRequest) (*MarketStatsReply, error)
+	// GetDealsHistory returns deals from the history, which unlike other
+	// DWH tables keeps deals after they are closed, most recent first.
+	GetDealsHistory(context.Context, *DealsHistoryRequest) (*DealsHistoryReply, error)
+	// SubscribeOrders streams orders matching the given filters as they are
+	// placed or updated. Pagination and sorting options are ignored.
+	SubscribeOrders(*OrdersRequest, DWH_SubscribeOrdersServer) error
+	// SubscribeDeals streams deals matching the given filters as they are
+	// opened or updated. Pagination and sorting options are ignored.
+	SubscribeDeals(*DealsRequest, DWH_SubscribeDealsServer) error
+	// GetFailedEvents lists blockchain events that have failed to be
+	// processed and are waiting for retry. Requires admin permissions.
+	GetFailedEvents(context.Context, *FailedEventsRequest) (*FailedEventsReply, error)
+	// ReplayFailedEvents schedules the given failed events, or all of them if
+	// no IDs are specified, for immediate retry. Requires admin permissions.
+	ReplayFailedEvents(context.Context, *ReplayFailedEventsRequest) (*Empty, error)
 }
 
 func RegisterDWHServer(s *grpc.Server, srv DWHServer) {
@@ -1926,6 +2551,120 @@ func _DWH_GetWorkers_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DWH_GetMarketStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHServer).GetMarketStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWH/GetMarketStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHServer).GetMarketStats(ctx, req.(*MarketStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DWH_GetDealsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DealsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHServer).GetDealsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWH/GetDealsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHServer).GetDealsHistory(ctx, req.(*DealsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DWH_SubscribeOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DWHServer).SubscribeOrders(m, &dWHSubscribeOrdersServer{stream})
+}
+
+type DWH_SubscribeOrdersServer interface {
+	Send(*DWHOrderEvent) error
+	grpc.ServerStream
+}
+
+type dWHSubscribeOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *dWHSubscribeOrdersServer) Send(m *DWHOrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DWH_SubscribeDeals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DealsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DWHServer).SubscribeDeals(m, &dWHSubscribeDealsServer{stream})
+}
+
+type DWH_SubscribeDealsServer interface {
+	Send(*DWHDealEvent) error
+	grpc.ServerStream
+}
+
+type dWHSubscribeDealsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dWHSubscribeDealsServer) Send(m *DWHDealEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DWH_GetFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHServer).GetFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWH/GetFailedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHServer).GetFailedEvents(ctx, req.(*FailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DWH_ReplayFailedEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayFailedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DWHServer).ReplayFailedEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DWH/ReplayFailedEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DWHServer).ReplayFailedEvents(ctx, req.(*ReplayFailedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DWH_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.DWH",
 	HandlerType: (*DWHServer)(nil),
@@ -1978,8 +2717,35 @@ var _DWH_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkers",
 			Handler:    _DWH_GetWorkers_Handler,
 		},
+		{
+			MethodName: "GetMarketStats",
+			Handler:    _DWH_GetMarketStats_Handler,
+		},
+		{
+			MethodName: "GetDealsHistory",
+			Handler:    _DWH_GetDealsHistory_Handler,
+		},
+		{
+			MethodName: "GetFailedEvents",
+			Handler:    _DWH_GetFailedEvents_Handler,
+		},
+		{
+			MethodName: "ReplayFailedEvents",
+			Handler:    _DWH_ReplayFailedEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeOrders",
+			Handler:       _DWH_SubscribeOrders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeDeals",
+			Handler:       _DWH_SubscribeDeals_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "dwh.proto",
 }
 
@@ -2220,6 +2986,120 @@ var _DWH_GetWorkersCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.WorkersRequest"),
 }
 
+var _DWH_GetMarketStatsCmd = &cobra.Command{
+	Use:   "getMarketStats",
+	Short: "Make the GetMarketStats method call, input-type: sonm.MarketStatsRequest output-type: sonm.MarketStatsReply",
+	RunE: grpccmd.RunE(
+		"GetMarketStats",
+		"sonm.MarketStatsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_GetMarketStatsCmd_gen = &cobra.Command{
+	Use:   "getMarketStats-gen",
+	Short: "Generate JSON for method call of GetMarketStats (input-type: sonm.MarketStatsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.MarketStatsRequest"),
+}
+
+var _DWH_GetDealsHistoryCmd = &cobra.Command{
+	Use:   "getDealsHistory",
+	Short: "Make the GetDealsHistory method call, input-type: sonm.DealsHistoryRequest output-type: sonm.DealsHistoryReply",
+	RunE: grpccmd.RunE(
+		"GetDealsHistory",
+		"sonm.DealsHistoryRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_GetDealsHistoryCmd_gen = &cobra.Command{
+	Use:   "getDealsHistory-gen",
+	Short: "Generate JSON for method call of GetDealsHistory (input-type: sonm.DealsHistoryRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.DealsHistoryRequest"),
+}
+
+var _DWH_SubscribeOrdersCmd = &cobra.Command{
+	Use:   "subscribeOrders",
+	Short: "Make the SubscribeOrders method call, input-type: sonm.OrdersRequest output-type: sonm.DWHOrderEvent",
+	RunE: grpccmd.RunE(
+		"SubscribeOrders",
+		"sonm.OrdersRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_SubscribeOrdersCmd_gen = &cobra.Command{
+	Use:   "subscribeOrders-gen",
+	Short: "Generate JSON for method call of SubscribeOrders (input-type: sonm.OrdersRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.OrdersRequest"),
+}
+
+var _DWH_SubscribeDealsCmd = &cobra.Command{
+	Use:   "subscribeDeals",
+	Short: "Make the SubscribeDeals method call, input-type: sonm.DealsRequest output-type: sonm.DWHDealEvent",
+	RunE: grpccmd.RunE(
+		"SubscribeDeals",
+		"sonm.DealsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_SubscribeDealsCmd_gen = &cobra.Command{
+	Use:   "subscribeDeals-gen",
+	Short: "Generate JSON for method call of SubscribeDeals (input-type: sonm.DealsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.DealsRequest"),
+}
+
+var _DWH_GetFailedEventsCmd = &cobra.Command{
+	Use:   "getFailedEvents",
+	Short: "Make the GetFailedEvents method call, input-type: sonm.FailedEventsRequest output-type: sonm.FailedEventsReply",
+	RunE: grpccmd.RunE(
+		"GetFailedEvents",
+		"sonm.FailedEventsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_GetFailedEventsCmd_gen = &cobra.Command{
+	Use:   "getFailedEvents-gen",
+	Short: "Generate JSON for method call of GetFailedEvents (input-type: sonm.FailedEventsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.FailedEventsRequest"),
+}
+
+var _DWH_ReplayFailedEventsCmd = &cobra.Command{
+	Use:   "replayFailedEvents",
+	Short: "Make the ReplayFailedEvents method call, input-type: sonm.ReplayFailedEventsRequest output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"ReplayFailedEvents",
+		"sonm.ReplayFailedEventsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDWHClient(cc)
+		},
+	),
+}
+
+var _DWH_ReplayFailedEventsCmd_gen = &cobra.Command{
+	Use:   "replayFailedEvents-gen",
+	Short: "Generate JSON for method call of ReplayFailedEvents (input-type: sonm.ReplayFailedEventsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.ReplayFailedEventsRequest"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_DWHCmd)
@@ -2248,6 +3128,18 @@ func init() {
 		_DWH_GetDealChangeRequestsCmd_gen,
 		_DWH_GetWorkersCmd,
 		_DWH_GetWorkersCmd_gen,
+		_DWH_GetMarketStatsCmd,
+		_DWH_GetMarketStatsCmd_gen,
+		_DWH_GetDealsHistoryCmd,
+		_DWH_GetDealsHistoryCmd_gen,
+		_DWH_SubscribeOrdersCmd,
+		_DWH_SubscribeOrdersCmd_gen,
+		_DWH_SubscribeDealsCmd,
+		_DWH_SubscribeDealsCmd_gen,
+		_DWH_GetFailedEventsCmd,
+		_DWH_GetFailedEventsCmd_gen,
+		_DWH_ReplayFailedEventsCmd,
+		_DWH_ReplayFailedEventsCmd_gen,
 	)
 }
 
@@ -2256,141 +3148,181 @@ func init() {
 func init() { proto.RegisterFile("dwh.proto", fileDescriptor5) }
 
 var fileDescriptor5 = []byte{
-	// 2163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x72, 0xdb, 0xc8,
-	0x11, 0x26, 0xf8, 0x0b, 0x36, 0x7f, 0x35, 0x92, 0xd6, 0x5c, 0xc6, 0x71, 0x49, 0xf0, 0xee, 0x46,
-	0x56, 0x6c, 0x79, 0x2d, 0x27, 0x1b, 0xe7, 0xa7, 0x92, 0x92, 0x48, 0x45, 0xd2, 0xc6, 0xb2, 0x6c,
-	0x48, 0x1b, 0xe5, 0x18, 0x88, 0x18, 0x49, 0x53, 0x02, 0x01, 0x06, 0x18, 0xca, 0xe6, 0x31, 0x95,
-	0x9c, 0x52, 0x39, 0xe5, 0x0d, 0x72, 0xdf, 0x7b, 0xaa, 0xf2, 0x02, 0xa9, 0xbc, 0x43, 0xaa, 0x72,
-	0xca, 0x7b, 0xa4, 0xe6, 0x07, 0x83, 0x01, 0x08, 0xca, 0x56, 0xd5, 0x56, 0xb2, 0x37, 0x4e, 0xf7,
-	0x37, 0x83, 0x99, 0x9e, 0xee, 0xaf, 0xbb, 0x87, 0x50, 0x77, 0xdf, 0x5e, 0x6d, 0x4d, 0xc2, 0x80,
-	0x06, 0xa8, 0x1c, 0x05, 0xfe, 0xb8, 0xdf, 0x3c, 0x27, 0x97, 0xc4, 0xa7, 0x42, 0xd6, 0x5f, 0x1a,
-	0x3b, 0xe1, 0x35, 0xa6, 0x13, 0xcf, 0x19, 0x61, 0x29, 0xea, 0x10, 0x9f, 0x01, 0x7d, 0xe2, 0xc4,
-	0x02, 0x4a, 0xc6, 0x38, 0xa2, 0xce, 0x78, 0x22, 0x04, 0xd6, 0x31, 0xb4, 0x4e, 0x82, 0x90, 0x12,
-	0xff, 0xf2, 0x78, 0x42, 0x49, 0xe0, 0xa3, 0x15, 0xa8, 0x5c, 0x10, 0xec, 0xb9, 0x3d, 0x63, 0xcd,
-	0xd8, 0xa8, 0xdb, 0x62, 0x80, 0x36, 0xa0, 0x12, 0x84, 0x2e, 0x0e, 0x7b, 0xc5, 0x35, 0x63, 0xa3,
-	0xbd, 0x8d, 0xb6, 0xd8, 0xb2, 0x5b, 0xf1, 0x4c, 0xa6, 0xb1, 0x05, 0xc0, 0xfa, 0xba, 0x0a, 0xcd,
-	0x21, 0x76, 0xbc, 0xc8, 0xc6, 0xbf, 0x9b, 0xe2, 0x88, 0xa2, 0x0d, 0xa8, 0x46, 0xd4, 0xa1, 0xd3,
-	0x88, 0xaf, 0xd8, 0xde, 0xee, 0x8a, 0xb9, 0x0c, 0x73, 0xc2, 0xe5, 0xb6, 0xd4, 0xa3, 0xcf, 0x01,
-	0xa2, 0xe9, 0x64, 0xe2, 0x11, 0x1c, 0x1e, 0x0e, 0xf9, 0x97, 0x1a, 0x31, 0x7a, 0x8f, 0x5e, 0xed,
-	0xb8, 0x6e, 0x88, 0xa3, 0xc8, 0xd6, 0x30, 0x6c, 0xc6, 0x28, 0xf0, 0xa3, 0xe9, 0x98, 0xcf, 0x28,
-	0x2d, 0x9a, 0x91, 0x60, 0xd0, 0x63, 0x30, 0xc7, 0x4e, 0x44, 0x39, 0xbe, 0xbc, 0x00, 0xaf, 0x10,
-	0xc8, 0x82, 0x8a, 0x13, 0x5d, 0x1f, 0x0e, 0x7b, 0x15, 0x0e, 0x6d, 0x0a, 0xe8, 0x2e, 0xb9, 0x3c,
-	0xf4, 0xa9, 0x2d, 0x54, 0x0c, 0x73, 0x4e, 0xdc, 0xc3, 0x61, 0xaf, 0x9a, 0x87, 0xe1, 0x2a, 0xb4,
-	0x05, 0xa6, 0x3b, 0x0d, 0x1d, 0x66, 0xe0, 0x5e, 0x8d, 0xc3, 0xa4, 0x05, 0x8f, 0x9c, 0x77, 0x47,
-	0xc4, 0xff, 0x8a, 0xf8, 0xf4, 0x8b, 0x1f, 0xd8, 0x0a, 0x83, 0x3e, 0x85, 0xca, 0x24, 0x24, 0x23,
-	0xdc, 0x33, 0x39, 0xb8, 0xa3, 0x83, 0x77, 0xc9, 0xa5, 0x2d, 0xb4, 0xe8, 0xfb, 0x60, 0xfa, 0x98,
-	0x5e, 0x78, 0xce, 0x65, 0xd4, 0xab, 0xeb, 0xc8, 0xc1, 0x78, 0x12, 0xaf, 0x19, 0x03, 0xd0, 0x2f,
-	0xa0, 0xcb, 0x36, 0xec, 0x62, 0x9f, 0x12, 0x3a, 0x7b, 0x89, 0x6f, 0xb0, 0xd7, 0x03, 0x7e, 0x23,
-	0xcb, 0x62, 0x52, 0x4a, 0x65, 0xcf, 0x81, 0xd9, 0x02, 0xec, 0x34, 0xa9, 0x05, 0x1a, 0xb7, 0x2c,
-	0x90, 0x05, 0xa3, 0x5d, 0x80, 0x73, 0xec, 0x8f, 0xae, 0x98, 0x9f, 0x46, 0xbd, 0xe6, 0x5a, 0x69,
-	0xa3, 0xb1, 0x6d, 0x25, 0xde, 0x10, 0x7b, 0xcc, 0xd6, 0xae, 0x02, 0xed, 0xf9, 0x34, 0x9c, 0xd9,
-	0xda, 0x2c, 0xe6, 0x9e, 0x1e, 0x19, 0x13, 0xda, 0x6b, 0xad, 0x19, 0x1b, 0x65, 0x5b, 0x0c, 0xd0,
-	0x47, 0x50, 0x0d, 0x2e, 0x2e, 0x22, 0x4c, 0x7b, 0x6d, 0x2e, 0x96, 0x23, 0xf4, 0x14, 0xcc, 0x48,
-	0xf8, 0x68, 0xd4, 0xeb, 0xf0, 0xef, 0x2d, 0xa7, 0x3d, 0x97, 0xfb, 0xbc, 0xad, 0x40, 0xe8, 0x3e,
-	0xd4, 0xdf, 0x12, 0x7a, 0x35, 0x08, 0xa6, 0x3e, 0xed, 0x75, 0xd7, 0x8c, 0x0d, 0xd3, 0x4e, 0x04,
-	0xfd, 0x37, 0xd0, 0xc9, 0xec, 0x0d, 0x75, 0xa1, 0x74, 0x8d, 0x67, 0xdc, 0xb5, 0xcb, 0x36, 0xfb,
-	0xc9, 0x42, 0xe5, 0xc6, 0xf1, 0xa6, 0x58, 0x3a, 0x70, 0xde, 0x45, 0x0b, 0xc0, 0x4f, 0x8a, 0x2f,
-	0x0c, 0xeb, 0x4b, 0x68, 0x0d, 0xcf, 0x0e, 0xe4, 0xf1, 0x27, 0xde, 0x0c, 0x3d, 0x84, 0x8a, 0xcb,
-	0x46, 0x3d, 0x83, 0xef, 0xb7, 0x25, 0xed, 0x23, 0x30, 0xb6, 0xd0, 0x31, 0x2b, 0x8c, 0xf8, 0x16,
-	0x8b, 0xc2, 0x0a, 0x7c, 0x60, 0xfd, 0xad, 0x08, 0x35, 0x09, 0x44, 0x0f, 0xa0, 0xcc, 0xa0, 0x7c,
-	0x63, 0x8d, 0x6d, 0x48, 0xac, 0x6c, 0x73, 0x39, 0xea, 0x6b, 0xae, 0x23, 0x16, 0x49, 0x3c, 0x65,
-	0x33, 0xc7, 0x53, 0x4a, 0x1c, 0x33, 0xef, 0x14, 0x9b, 0x39, 0x4e, 0x51, 0x16, 0xd8, 0xb9, 0xfb,
-	0xdf, 0x86, 0x95, 0x38, 0x76, 0x07, 0x38, 0xa4, 0xe4, 0x82, 0x8c, 0x1c, 0x8a, 0x23, 0x1e, 0x5c,
-	0x4d, 0x3b, 0x57, 0xc7, 0xe6, 0xc4, 0xd1, 0x9b, 0x9a, 0x53, 0x15, 0x73, 0xf2, 0x74, 0xe8, 0x73,
-	0x58, 0x76, 0x46, 0x94, 0xdc, 0xe0, 0xc1, 0x95, 0xe3, 0x5f, 0x62, 0xe9, 0x56, 0x3c, 0xf0, 0x4c,
-	0x3b, 0x4f, 0x65, 0xfd, 0xdd, 0x80, 0x55, 0x66, 0x9c, 0x41, 0xe0, 0xbb, 0x84, 0xb9, 0x84, 0x62,
-	0xaf, 0x4f, 0xa0, 0xca, 0xec, 0x75, 0x38, 0x94, 0x96, 0x4c, 0x87, 0xb7, 0xd4, 0x25, 0x5e, 0x59,
-	0xcc, 0xf7, 0xca, 0xd2, 0x42, 0xaf, 0x2c, 0xdf, 0xd9, 0x2b, 0x2b, 0x19, 0xaf, 0xb4, 0x7e, 0x0b,
-	0xcb, 0xd9, 0xbd, 0x33, 0x47, 0x7a, 0xce, 0xb9, 0x51, 0x8a, 0xa4, 0x37, 0x2d, 0x27, 0x7e, 0xa0,
-	0xe0, 0xb6, 0x06, 0x5b, 0xe0, 0x58, 0x7f, 0xac, 0x42, 0x8b, 0x93, 0xfc, 0x1d, 0xcd, 0xf2, 0x10,
-	0xca, 0x74, 0x36, 0xc1, 0x32, 0x69, 0x48, 0x6e, 0xe2, 0x0b, 0x9d, 0xce, 0x26, 0xd8, 0xe6, 0x4a,
-	0xf4, 0x48, 0xe5, 0x87, 0x12, 0x87, 0x2d, 0x69, 0xb0, 0x4c, 0x82, 0x78, 0x0c, 0xa6, 0x33, 0xa5,
-	0x57, 0xc1, 0xad, 0xe4, 0x1d, 0x23, 0xd0, 0x0b, 0x68, 0xf3, 0xed, 0xe3, 0x70, 0xe2, 0x84, 0x74,
-	0xa6, 0x58, 0x7c, 0x7e, 0x4e, 0x06, 0x97, 0xa2, 0xeb, 0xea, 0x5d, 0xe8, 0xba, 0xfe, 0xc1, 0x74,
-	0xdd, 0x78, 0x1f, 0x5d, 0xef, 0xc3, 0xca, 0x28, 0xc4, 0x0e, 0x0d, 0xc2, 0x74, 0x70, 0x35, 0x17,
-	0x33, 0x6e, 0xee, 0x04, 0x34, 0x48, 0xb1, 0x6e, 0x8b, 0xfb, 0xc1, 0x43, 0xcd, 0xc6, 0x1f, 0x44,
-	0xbb, 0xcf, 0xa1, 0xce, 0x17, 0xc7, 0xee, 0xe9, 0x09, 0xe7, 0xd8, 0xc6, 0xf6, 0xaa, 0x7e, 0xca,
-	0xd3, 0xb8, 0xac, 0xb0, 0x13, 0x5c, 0x12, 0x15, 0x9d, 0xfc, 0xa8, 0xe8, 0x2e, 0x8c, 0x8a, 0xa5,
-	0x3b, 0x47, 0x05, 0xfa, 0x1f, 0x70, 0xf5, 0xef, 0x0d, 0x58, 0x3d, 0x72, 0xe8, 0xe8, 0x2a, 0xae,
-	0x79, 0x54, 0x38, 0xdc, 0x87, 0x22, 0x71, 0x73, 0x43, 0xa1, 0x48, 0xdc, 0x3b, 0xb2, 0x43, 0xea,
-	0x58, 0xe5, 0x6c, 0xb0, 0xbf, 0x82, 0xf6, 0xf0, 0xec, 0x20, 0xfe, 0x3a, 0x8b, 0xf3, 0xcf, 0xa0,
-	0xca, 0x2b, 0xaf, 0x38, 0xc6, 0xdb, 0x2a, 0x63, 0x88, 0xba, 0x4c, 0x6a, 0x17, 0x84, 0xf6, 0x9f,
-	0x8b, 0x60, 0xc6, 0x50, 0xb4, 0x1e, 0x57, 0x79, 0xe2, 0x24, 0x0d, 0xcd, 0x4b, 0x64, 0x79, 0xc7,
-	0xf9, 0x38, 0xcf, 0x2d, 0xc5, 0xa2, 0xf9, 0x1e, 0xb8, 0x06, 0x0d, 0x29, 0x7f, 0xe5, 0x8c, 0x31,
-	0x3f, 0x6e, 0xdd, 0xd6, 0x45, 0xe8, 0x33, 0x68, 0xcb, 0x21, 0x3f, 0x65, 0x38, 0xe3, 0x07, 0xaf,
-	0xdb, 0x19, 0x29, 0x63, 0xf6, 0x58, 0x32, 0x9f, 0x40, 0xf2, 0x54, 0xe8, 0x09, 0xd4, 0x07, 0xca,
-	0x71, 0xab, 0x7a, 0xd0, 0x69, 0x2e, 0xab, 0x10, 0xd6, 0x5f, 0x4b, 0xd0, 0x4a, 0xb1, 0x23, 0x6a,
-	0xab, 0xab, 0x2d, 0xf3, 0xcb, 0xfc, 0xf6, 0x15, 0xa9, 0x7d, 0x8d, 0xad, 0x2a, 0x22, 0x95, 0x2b,
-	0x66, 0xb2, 0x62, 0x66, 0xca, 0x2d, 0x4e, 0x05, 0x2d, 0x3d, 0x81, 0x7a, 0x44, 0x9d, 0x90, 0x32,
-	0x83, 0xc8, 0xea, 0x74, 0xde, 0x44, 0x0a, 0x81, 0x1e, 0x41, 0x0d, 0xfb, 0x2e, 0x07, 0x9b, 0xf9,
-	0xe0, 0x58, 0x8f, 0xb6, 0xa0, 0x41, 0x03, 0xea, 0x78, 0xaf, 0x9d, 0x59, 0x30, 0xa5, 0x92, 0x1d,
-	0xd3, 0x7b, 0xd0, 0x01, 0x5a, 0x56, 0x81, 0xc5, 0x59, 0xc5, 0xfa, 0x83, 0x01, 0xf5, 0xe1, 0xd9,
-	0xc1, 0x59, 0x10, 0x5e, 0xe3, 0x30, 0x65, 0x2b, 0xe3, 0xbd, 0xb6, 0xda, 0x84, 0x5a, 0xe4, 0x39,
-	0x37, 0xf8, 0x96, 0xab, 0x8b, 0x01, 0x2c, 0x10, 0x47, 0x81, 0x7f, 0x41, 0xc2, 0x31, 0x76, 0xf9,
-	0xb5, 0x99, 0x76, 0x22, 0xb0, 0xfe, 0x55, 0x84, 0xce, 0xeb, 0x30, 0xb8, 0x20, 0x1e, 0x56, 0x34,
-	0xf0, 0x29, 0x94, 0xc3, 0xc0, 0xc3, 0xb2, 0xd1, 0x91, 0x89, 0x4c, 0x82, 0xec, 0xc0, 0xc3, 0x36,
-	0x57, 0xa3, 0x1f, 0x43, 0x8b, 0xcc, 0x05, 0xcf, 0x02, 0x4e, 0x4f, 0x23, 0x51, 0x0f, 0x6a, 0x23,
-	0x19, 0x21, 0x22, 0x8c, 0xe2, 0x21, 0x42, 0x50, 0xf6, 0x59, 0x74, 0x89, 0xc0, 0xe1, 0xbf, 0xd1,
-	0xcf, 0xa0, 0x7d, 0xee, 0x39, 0xa3, 0x6b, 0x8f, 0x44, 0xf4, 0xcd, 0x14, 0x87, 0x33, 0x99, 0x01,
-	0x57, 0xa4, 0x5d, 0x53, 0x3a, 0x3b, 0x83, 0x4d, 0x68, 0xab, 0x9a, 0x4f, 0x5b, 0xb5, 0x85, 0xf4,
-	0x6d, 0xde, 0x99, 0xbe, 0xeb, 0x59, 0x9e, 0x7b, 0x0d, 0xad, 0xc4, 0xba, 0x8c, 0xe6, 0x1e, 0x81,
-	0x39, 0x91, 0x82, 0x74, 0x69, 0x1c, 0xdb, 0x57, 0xa9, 0x17, 0x30, 0xdd, 0xbf, 0x8b, 0x50, 0x93,
-	0x58, 0xd6, 0x93, 0x7e, 0x15, 0xdd, 0xea, 0x32, 0x52, 0x8f, 0x3e, 0x81, 0x56, 0x1e, 0xd1, 0xa5,
-	0x85, 0xcc, 0xf8, 0x1a, 0xb5, 0xf1, 0xdf, 0xec, 0xaa, 0xd2, 0x64, 0x16, 0x0f, 0xf9, 0x9a, 0xd1,
-	0x20, 0x08, 0x27, 0x81, 0x16, 0xb5, 0xa6, 0x9d, 0x16, 0x32, 0x4e, 0x3c, 0x8c, 0xd8, 0x86, 0x71,
-	0x14, 0x91, 0xc0, 0x77, 0x3c, 0x7e, 0x0f, 0xa6, 0x9d, 0x91, 0x22, 0x0b, 0x9a, 0x29, 0x32, 0xac,
-	0xf1, 0x8f, 0xa5, 0x64, 0xe8, 0x01, 0x80, 0x28, 0x7b, 0x77, 0xa2, 0xeb, 0x88, 0x87, 0x6d, 0xd9,
-	0xd6, 0x24, 0x89, 0x7e, 0x97, 0xb8, 0xa2, 0x95, 0x54, 0x7a, 0x26, 0x61, 0x3b, 0x26, 0x91, 0x72,
-	0x17, 0xec, 0xf2, 0xf8, 0x34, 0xed, 0xb4, 0xd0, 0xfa, 0x93, 0x01, 0x5d, 0x35, 0x8e, 0x63, 0x62,
-	0x13, 0x6a, 0xc1, 0x5b, 0xff, 0x56, 0x5b, 0xc7, 0x80, 0x6f, 0x34, 0x51, 0x4e, 0xa0, 0xad, 0xed,
-	0x85, 0x79, 0xd0, 0x5d, 0x76, 0x72, 0x1f, 0xea, 0x8e, 0x90, 0x61, 0xd6, 0x1f, 0x95, 0x36, 0xea,
-	0x76, 0x22, 0x48, 0x1c, 0xac, 0xa4, 0x3b, 0xd8, 0x3f, 0x0d, 0x58, 0xfa, 0xb5, 0xe3, 0x11, 0x97,
-	0x25, 0x21, 0xc5, 0x09, 0x3f, 0x82, 0xf6, 0x4d, 0x2c, 0x14, 0x1e, 0x64, 0xe4, 0x97, 0x7e, 0x19,
-	0xd8, 0xff, 0xb7, 0xa7, 0xf8, 0x0d, 0x74, 0xf4, 0xa3, 0x30, 0xf3, 0x3d, 0x05, 0x50, 0x3b, 0x8c,
-	0x43, 0x50, 0x1e, 0x42, 0x41, 0x6d, 0x0d, 0xb2, 0x20, 0x0c, 0x07, 0x50, 0x57, 0x70, 0xb4, 0xa6,
-	0xd5, 0x4d, 0xf3, 0xb7, 0x11, 0xd7, 0x4e, 0x5a, 0xdc, 0x89, 0x81, 0xf5, 0x0a, 0xee, 0xf1, 0x2c,
-	0xad, 0x37, 0x71, 0xaa, 0xed, 0x31, 0x43, 0x29, 0x90, 0x9b, 0xbc, 0xa7, 0x35, 0x3d, 0xfa, 0x04,
-	0x5b, 0x01, 0xad, 0xaf, 0x8b, 0xb0, 0x34, 0xa7, 0x7f, 0x4f, 0x55, 0x97, 0x24, 0xab, 0xe2, 0x2d,
-	0x2d, 0xd0, 0x33, 0x68, 0xc8, 0xaf, 0xb0, 0x96, 0x47, 0xb6, 0x38, 0x73, 0x9d, 0x90, 0x8e, 0x49,
-	0xe5, 0xf3, 0xf2, 0xa2, 0x7c, 0x5e, 0x59, 0x9c, 0xcf, 0x9f, 0xa9, 0x86, 0xaa, 0xca, 0xbf, 0xf6,
-	0xb1, 0xf4, 0x34, 0xfd, 0x6c, 0x99, 0xc6, 0xea, 0x89, 0x5e, 0xde, 0x2f, 0x2a, 0x01, 0x14, 0xc2,
-	0xfa, 0x8b, 0x01, 0x0d, 0x66, 0xae, 0xd7, 0xce, 0x6c, 0x8c, 0xfd, 0x0f, 0xed, 0x06, 0xb7, 0xa0,
-	0x31, 0x71, 0x66, 0xd8, 0xdd, 0x19, 0x2b, 0xaf, 0x98, 0xab, 0x06, 0x34, 0x00, 0xdb, 0xd4, 0x44,
-	0x7c, 0xe0, 0xf4, 0x44, 0x96, 0x4d, 0xf3, 0x9b, 0x52, 0x08, 0xc6, 0x3e, 0x6d, 0x51, 0x13, 0xa8,
-	0xd8, 0x7b, 0x0c, 0xe6, 0xd1, 0x7b, 0x6b, 0x83, 0x18, 0xf1, 0x8d, 0xb2, 0xcf, 0x31, 0x34, 0xd5,
-	0x5e, 0x44, 0xf6, 0xaa, 0xbd, 0x15, 0xe3, 0x74, 0xe4, 0xa8, 0x3a, 0xc6, 0x8e, 0xf5, 0x0b, 0xc2,
-	0xe6, 0x1f, 0x06, 0x34, 0x34, 0x4a, 0xbf, 0x13, 0x99, 0x6d, 0x43, 0x43, 0x85, 0xe5, 0x2d, 0x85,
-	0x8f, 0x0e, 0xe2, 0x04, 0x48, 0x69, 0x48, 0xce, 0xa7, 0x14, 0xcb, 0x93, 0x27, 0x02, 0x9e, 0x0f,
-	0x72, 0x9e, 0x7c, 0x32, 0xc5, 0xca, 0x4a, 0xdc, 0x5d, 0x89, 0xfa, 0x5c, 0x0c, 0xac, 0x6d, 0x68,
-	0xea, 0x0d, 0x16, 0xeb, 0xca, 0xc6, 0xce, 0xbb, 0xb8, 0x2b, 0x1b, 0x3b, 0xef, 0xb8, 0x84, 0xf8,
-	0xf2, 0xfc, 0xec, 0xa7, 0xf5, 0x2b, 0xa8, 0xab, 0x6e, 0x1a, 0x3d, 0x48, 0x26, 0x64, 0xfd, 0x87,
-	0x4f, 0x7f, 0x90, 0x4c, 0x9f, 0xd7, 0x13, 0xdf, 0x3a, 0x83, 0x4e, 0xa6, 0x69, 0x45, 0xeb, 0xfa,
-	0x92, 0x73, 0x4e, 0xc6, 0x57, 0x5d, 0xd7, 0x57, 0xcd, 0x81, 0x10, 0xdf, 0xfa, 0x12, 0xea, 0x8a,
-	0xce, 0x93, 0xc3, 0x8b, 0x83, 0x89, 0x01, 0xfa, 0x1e, 0x98, 0xc1, 0x04, 0x87, 0xcc, 0xc8, 0xb2,
-	0xea, 0x6b, 0xa8, 0x3c, 0x70, 0x3c, 0xb1, 0x95, 0xd2, 0xba, 0xd6, 0xd2, 0x97, 0x28, 0xc7, 0xee,
-	0x72, 0xe3, 0x4f, 0xa0, 0x1a, 0x70, 0xc2, 0x97, 0x1f, 0x59, 0xcd, 0x14, 0x7c, 0x32, 0x1b, 0x48,
-	0xd0, 0xe6, 0x3a, 0x54, 0xf8, 0xf7, 0x51, 0x15, 0x8a, 0x7b, 0x6f, 0xba, 0x05, 0x54, 0x83, 0xd2,
-	0xfe, 0xe9, 0x5e, 0xd7, 0x60, 0x3f, 0x5e, 0x9e, 0xee, 0x75, 0x8b, 0x9b, 0xeb, 0xd0, 0xd4, 0x5f,
-	0xfb, 0x99, 0x62, 0x27, 0x1a, 0x75, 0x0b, 0xc8, 0x84, 0xf2, 0x10, 0x47, 0xa3, 0xae, 0xb1, 0xf9,
-	0x05, 0x34, 0xb4, 0x5a, 0x17, 0x35, 0xa0, 0xb6, 0xe3, 0xcf, 0xd8, 0xcf, 0x6e, 0x01, 0x35, 0xc1,
-	0x3c, 0x91, 0x1d, 0x51, 0xd7, 0x60, 0xa3, 0x81, 0xec, 0x76, 0xba, 0xc5, 0xcd, 0x97, 0xd0, 0xc9,
-	0x6c, 0x0c, 0x2d, 0x43, 0xe7, 0x8c, 0xd0, 0xab, 0x60, 0x4a, 0xe3, 0x7e, 0xbb, 0x5b, 0x40, 0x08,
-	0xda, 0x87, 0xfe, 0xc8, 0x9b, 0xba, 0x78, 0xc7, 0x77, 0x8f, 0x9c, 0xf0, 0xba, 0x6b, 0xa0, 0x2e,
-	0x34, 0x8f, 0x7d, 0x6f, 0xa6, 0x50, 0xc5, 0xed, 0xff, 0x54, 0xa0, 0x34, 0x3c, 0x3b, 0x40, 0x3f,
-	0x04, 0x73, 0x1f, 0x53, 0xfe, 0xb0, 0x8a, 0xd0, 0xfc, 0x23, 0x73, 0x7f, 0x39, 0xf5, 0xb0, 0x2a,
-	0xc2, 0xd4, 0x2a, 0xa0, 0xa7, 0xd0, 0x96, 0xd3, 0x86, 0x98, 0x3a, 0xc4, 0x8b, 0x50, 0xca, 0x83,
-	0xfa, 0xe9, 0xf7, 0x58, 0xab, 0x80, 0x8e, 0x60, 0x49, 0x4e, 0x48, 0x1e, 0xe0, 0xd0, 0x77, 0x72,
-	0xde, 0xd9, 0xd4, 0x97, 0x3f, 0xce, 0x57, 0x8a, 0xef, 0xbf, 0x80, 0xfa, 0x3e, 0xa6, 0xa2, 0xbf,
-	0x47, 0xcb, 0x39, 0xcf, 0x34, 0xfd, 0x95, 0x74, 0x7f, 0xaf, 0x66, 0x1e, 0xf0, 0x8d, 0xa4, 0xdf,
-	0x27, 0xe2, 0x8d, 0xe4, 0xbe, 0x5a, 0x2c, 0x5c, 0xe9, 0x19, 0x74, 0xe2, 0x3d, 0xe4, 0x1b, 0x21,
-	0xf3, 0xc4, 0x60, 0x15, 0xd0, 0x4f, 0xa1, 0xb1, 0x8f, 0x69, 0x5c, 0xb1, 0xa3, 0xd5, 0x54, 0x69,
-	0x9e, 0xb5, 0x79, 0xaa, 0xb0, 0xb7, 0x0a, 0x68, 0x8b, 0xdb, 0x5c, 0x4a, 0x0f, 0xfd, 0x8b, 0x00,
-	0x35, 0x94, 0x6b, 0x1f, 0x0e, 0xfb, 0xe9, 0x3a, 0xdf, 0x2a, 0xa0, 0x9f, 0x43, 0x73, 0x1f, 0x53,
-	0xe5, 0x33, 0xe8, 0xa3, 0x8c, 0x77, 0x67, 0xce, 0x97, 0x2e, 0x03, 0xad, 0x02, 0xda, 0x81, 0xd6,
-	0x3e, 0xa6, 0x49, 0x7d, 0x83, 0xee, 0x65, 0xca, 0x18, 0xb5, 0xe1, 0xd5, 0x79, 0x85, 0x58, 0xe2,
-	0x97, 0xb0, 0x1a, 0xdf, 0x7a, 0xaa, 0x06, 0xc9, 0x18, 0xea, 0xbb, 0x0b, 0x4a, 0x0f, 0xed, 0xba,
-	0x61, 0x1f, 0xd3, 0xb3, 0x98, 0xfa, 0x05, 0x3c, 0x9d, 0xc5, 0xfa, 0x28, 0x23, 0xe5, 0x33, 0xcf,
-	0xab, 0xfc, 0xff, 0xbb, 0xe7, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xca, 0xe6, 0xe0, 0x75, 0x15,
-	0x1c, 0x00, 0x00,
+	// 2806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xe7, 0x92, 0x14, 0x2f, 0x87, 0x14, 0x49, 0x8d, 0x2c, 0x9b, 0xe1, 0x3f, 0x7f, 0x47, 0x5e,
+	0x3b, 0xa9, 0xa2, 0xda, 0x4a, 0xac, 0x34, 0xa9, 0x7b, 0x41, 0x03, 0x59, 0x54, 0x24, 0xa5, 0xb6,
+	0xa5, 0x8c, 0xe5, 0xaa, 0x79, 0x28, 0xd0, 0x15, 0x77, 0x24, 0x2d, 0xb4, 0xdc, 0x65, 0x77, 0x87,
+	0xb6, 0xf9, 0xd8, 0xcb, 0x53, 0xd1, 0xa7, 0x7e, 0x83, 0xbe, 0xe7, 0xbd, 0x68, 0xbf, 0x40, 0xd1,
+	0xc7, 0x02, 0x7d, 0x2c, 0x50, 0xa0, 0x9f, 0xa4, 0x98, 0xeb, 0xce, 0x2e, 0x77, 0x25, 0x0b, 0x48,
+	0xdb, 0xbc, 0x71, 0xce, 0xf9, 0xcd, 0xec, 0xcc, 0x99, 0x73, 0x7e, 0xe7, 0xcc, 0x0c, 0xa1, 0xe9,
+	0xbe, 0x3a, 0xdf, 0x98, 0x44, 0x21, 0x0d, 0x51, 0x35, 0x0e, 0x83, 0xf1, 0xa0, 0x7d, 0xe2, 0x9d,
+	0x79, 0x01, 0x15, 0xb2, 0xc1, 0xd2, 0xd8, 0x89, 0x2e, 0x08, 0x9d, 0xf8, 0xce, 0x88, 0x48, 0x51,
+	0xd7, 0x0b, 0x18, 0x30, 0xf0, 0x1c, 0x25, 0xa0, 0xde, 0x98, 0xc4, 0xd4, 0x19, 0x4f, 0x84, 0xc0,
+	0x3e, 0x80, 0xc5, 0xe7, 0x61, 0x44, 0xbd, 0xe0, 0xec, 0x60, 0x42, 0xbd, 0x30, 0x40, 0x37, 0x60,
+	0xe1, 0xd4, 0x23, 0xbe, 0xdb, 0xb7, 0x56, 0xad, 0xb5, 0x26, 0x16, 0x0d, 0xb4, 0x06, 0x0b, 0x61,
+	0xe4, 0x92, 0xa8, 0x5f, 0x5e, 0xb5, 0xd6, 0x3a, 0x9b, 0x68, 0x83, 0x0d, 0xbb, 0xa1, 0x7a, 0x32,
+	0x0d, 0x16, 0x00, 0xfb, 0xab, 0x1a, 0xb4, 0x87, 0xc4, 0xf1, 0x63, 0x4c, 0x7e, 0x31, 0x25, 0x31,
+	0x45, 0x6b, 0x50, 0x8b, 0xa9, 0x43, 0xa7, 0x31, 0x1f, 0xb1, 0xb3, 0xd9, 0x13, 0x7d, 0x19, 0xe6,
+	0x39, 0x97, 0x63, 0xa9, 0x47, 0x1f, 0x02, 0xc4, 0xd3, 0xc9, 0xc4, 0xf7, 0x48, 0xb4, 0x3f, 0xe4,
+	0x5f, 0x6a, 0x29, 0xf4, 0x0e, 0x3d, 0xdf, 0x72, 0xdd, 0x88, 0xc4, 0x31, 0x36, 0x30, 0xac, 0xc7,
+	0x28, 0x0c, 0xe2, 0xe9, 0x98, 0xf7, 0xa8, 0x14, 0xf5, 0x48, 0x30, 0xe8, 0x3e, 0x34, 0xc6, 0x4e,
+	0x4c, 0x39, 0xbe, 0x5a, 0x80, 0xd7, 0x08, 0x64, 0xc3, 0x82, 0x13, 0x5f, 0xec, 0x0f, 0xfb, 0x0b,
+	0x1c, 0xda, 0x16, 0xd0, 0xc7, 0xde, 0xd9, 0x7e, 0x40, 0xb1, 0x50, 0x31, 0xcc, 0x89, 0xe7, 0xee,
+	0x0f, 0xfb, 0xb5, 0x3c, 0x0c, 0x57, 0xa1, 0x0d, 0x68, 0xb8, 0xd3, 0xc8, 0x61, 0x06, 0xee, 0xd7,
+	0x39, 0x4c, 0x5a, 0xf0, 0xa9, 0xf3, 0xfa, 0xa9, 0x17, 0xbc, 0xf0, 0x02, 0xfa, 0xc9, 0x77, 0xb0,
+	0xc6, 0xa0, 0x77, 0x61, 0x61, 0x12, 0x79, 0x23, 0xd2, 0x6f, 0x70, 0x70, 0xd7, 0x04, 0x3f, 0xf6,
+	0xce, 0xb0, 0xd0, 0xa2, 0x6f, 0x43, 0x23, 0x20, 0xf4, 0xd4, 0x77, 0xce, 0xe2, 0x7e, 0xd3, 0x44,
+	0x6e, 0x8f, 0x27, 0x6a, 0x4c, 0x05, 0x40, 0x9f, 0x42, 0x8f, 0x4d, 0xd8, 0x25, 0x01, 0xf5, 0xe8,
+	0xec, 0x09, 0x79, 0x49, 0xfc, 0x3e, 0xf0, 0x1d, 0x59, 0x16, 0x9d, 0x52, 0x2a, 0x3c, 0x07, 0x66,
+	0x03, 0xb0, 0xd5, 0xa4, 0x06, 0x68, 0x5d, 0x32, 0x40, 0x16, 0x8c, 0x1e, 0x03, 0x9c, 0x90, 0x60,
+	0x74, 0xce, 0xfc, 0x34, 0xee, 0xb7, 0x57, 0x2b, 0x6b, 0xad, 0x4d, 0x3b, 0xf1, 0x06, 0xe5, 0x31,
+	0x1b, 0x8f, 0x35, 0x68, 0x27, 0xa0, 0xd1, 0x0c, 0x1b, 0xbd, 0x98, 0x7b, 0xfa, 0xde, 0xd8, 0xa3,
+	0xfd, 0xc5, 0x55, 0x6b, 0xad, 0x8a, 0x45, 0x03, 0xdd, 0x84, 0x5a, 0x78, 0x7a, 0x1a, 0x13, 0xda,
+	0xef, 0x70, 0xb1, 0x6c, 0xa1, 0x0f, 0xa0, 0x11, 0x0b, 0x1f, 0x8d, 0xfb, 0x5d, 0xfe, 0xbd, 0xe5,
+	0xb4, 0xe7, 0x72, 0x9f, 0xc7, 0x1a, 0x84, 0xde, 0x86, 0xe6, 0x2b, 0x8f, 0x9e, 0x6f, 0x87, 0xd3,
+	0x80, 0xf6, 0x7b, 0xab, 0xd6, 0x5a, 0x03, 0x27, 0x82, 0xc1, 0x17, 0xd0, 0xcd, 0xcc, 0x0d, 0xf5,
+	0xa0, 0x72, 0x41, 0x66, 0xdc, 0xb5, 0xab, 0x98, 0xfd, 0x64, 0xa1, 0xf2, 0xd2, 0xf1, 0xa7, 0xa4,
+	0x5f, 0x2e, 0xdc, 0x68, 0x01, 0xf8, 0x7e, 0xf9, 0x91, 0x65, 0x7f, 0x0e, 0x8b, 0xc3, 0xe3, 0x3d,
+	0xb9, 0xfc, 0x89, 0x3f, 0x43, 0x77, 0x61, 0xc1, 0x65, 0xad, 0xbe, 0xc5, 0xe7, 0xbb, 0x28, 0xed,
+	0x23, 0x30, 0x58, 0xe8, 0x98, 0x15, 0x46, 0x7c, 0x8a, 0x65, 0x61, 0x05, 0xde, 0xb0, 0xff, 0x58,
+	0x86, 0xba, 0x04, 0xa2, 0xdb, 0x50, 0x65, 0x50, 0x3e, 0xb1, 0xd6, 0x26, 0x24, 0x56, 0xc6, 0x5c,
+	0x8e, 0x06, 0x86, 0xeb, 0x88, 0x41, 0x74, 0x1b, 0xad, 0xe7, 0x78, 0x4a, 0x85, 0x63, 0xe6, 0xe4,
+	0x0c, 0x3b, 0xe7, 0x14, 0x55, 0x81, 0xcd, 0xca, 0xd1, 0x26, 0xdc, 0x50, 0xb1, 0xbb, 0x4d, 0x22,
+	0xea, 0x9d, 0x7a, 0x23, 0x87, 0x92, 0x98, 0x07, 0x57, 0x1b, 0xe7, 0xea, 0x58, 0x1f, 0x15, 0xbd,
+	0xa9, 0x3e, 0x35, 0xd1, 0x27, 0x4f, 0x87, 0x3e, 0x84, 0x65, 0x67, 0x44, 0xbd, 0x97, 0x64, 0xfb,
+	0xdc, 0x09, 0xce, 0x88, 0x74, 0x2b, 0x1e, 0x78, 0x0d, 0x9c, 0xa7, 0xb2, 0xff, 0x6c, 0xc1, 0x0a,
+	0x33, 0xce, 0x76, 0x18, 0xb8, 0x1e, 0x73, 0x09, 0xcd, 0x5e, 0xf7, 0xa0, 0xc6, 0xec, 0xb5, 0x3f,
+	0xec, 0x5b, 0x39, 0xe1, 0x2d, 0x75, 0x89, 0x57, 0x96, 0xf3, 0xbd, 0xb2, 0x52, 0xe8, 0x95, 0xd5,
+	0x6b, 0x7b, 0xe5, 0x42, 0xc6, 0x2b, 0xed, 0x9f, 0xc3, 0x72, 0x76, 0xee, 0xcc, 0x91, 0x3e, 0xe2,
+	0xdc, 0x28, 0x45, 0x7d, 0xcb, 0xfc, 0x4e, 0x0a, 0x8e, 0x0d, 0x58, 0x81, 0x63, 0xfd, 0xa6, 0x06,
+	0x8b, 0x9c, 0xe4, 0xaf, 0x69, 0x96, 0xbb, 0x50, 0xa5, 0xb3, 0x09, 0x91, 0x49, 0x43, 0x72, 0x13,
+	0x1f, 0xe8, 0x68, 0x36, 0x21, 0x98, 0x2b, 0xd1, 0xfb, 0x3a, 0x3f, 0x54, 0x38, 0x6c, 0xc9, 0x80,
+	0x65, 0x12, 0xc4, 0x7d, 0x68, 0x38, 0x53, 0x7a, 0x1e, 0x5e, 0x4a, 0xde, 0x0a, 0x81, 0x1e, 0x41,
+	0x87, 0x4f, 0x9f, 0x44, 0x13, 0x27, 0xa2, 0x33, 0xcd, 0xe2, 0xf3, 0x7d, 0x32, 0xb8, 0x14, 0x5d,
+	0xd7, 0xae, 0x43, 0xd7, 0xcd, 0x37, 0xa6, 0xeb, 0xd6, 0x55, 0x74, 0xbd, 0x0b, 0x37, 0x46, 0x11,
+	0x71, 0x68, 0x18, 0xa5, 0x83, 0xab, 0x5d, 0xcc, 0xb8, 0xb9, 0x1d, 0xd0, 0x76, 0x8a, 0x75, 0x17,
+	0xb9, 0x1f, 0xdc, 0x35, 0x6c, 0xfc, 0x46, 0xb4, 0xfb, 0x11, 0x34, 0xf9, 0xe0, 0xc4, 0x3d, 0x7a,
+	0xce, 0x39, 0xb6, 0xb5, 0xb9, 0x62, 0xae, 0xf2, 0x48, 0x95, 0x15, 0x38, 0xc1, 0x25, 0x51, 0xd1,
+	0xcd, 0x8f, 0x8a, 0x5e, 0x61, 0x54, 0x2c, 0x5d, 0x3b, 0x2a, 0xd0, 0x7f, 0x81, 0xab, 0x7f, 0x69,
+	0xc1, 0xca, 0x53, 0x87, 0x8e, 0xce, 0x55, 0xcd, 0xa3, 0xc3, 0xe1, 0x6d, 0x28, 0x7b, 0x6e, 0x6e,
+	0x28, 0x94, 0x3d, 0xf7, 0x9a, 0xec, 0x90, 0x5a, 0x56, 0x35, 0x1b, 0xec, 0xcf, 0xa0, 0x33, 0x3c,
+	0xde, 0x53, 0x5f, 0x67, 0x71, 0xfe, 0x1e, 0xd4, 0x78, 0xe5, 0xa5, 0x62, 0xbc, 0xa3, 0x33, 0x06,
+	0x47, 0x61, 0xa9, 0x2d, 0x08, 0xed, 0xdf, 0x95, 0xa1, 0xa1, 0xa0, 0xe8, 0x8e, 0xaa, 0xf2, 0xc4,
+	0x4a, 0x5a, 0x86, 0x97, 0xc8, 0xf2, 0x8e, 0xf3, 0x71, 0x9e, 0x5b, 0x8a, 0x41, 0x73, 0x75, 0x68,
+	0x15, 0x5a, 0x52, 0xfe, 0xcc, 0x19, 0x13, 0xbe, 0xdc, 0x26, 0x36, 0x45, 0xe8, 0x3d, 0xe8, 0xc8,
+	0x26, 0x5f, 0x65, 0x34, 0xe3, 0x0b, 0x6f, 0xe2, 0x8c, 0x94, 0x31, 0xbb, 0x92, 0xcc, 0x27, 0x90,
+	0x3c, 0x15, 0x7a, 0x00, 0xcd, 0x6d, 0xed, 0xb8, 0x35, 0x33, 0xe8, 0x0c, 0x97, 0xd5, 0x08, 0xfb,
+	0x0f, 0x15, 0x58, 0x4c, 0xb1, 0x23, 0xea, 0xe8, 0xad, 0xad, 0xf2, 0xcd, 0xfc, 0xe6, 0x15, 0xa9,
+	0x03, 0x83, 0xad, 0x16, 0x44, 0x2a, 0x57, 0x6d, 0x56, 0x9c, 0x0a, 0x66, 0xca, 0x2d, 0x4e, 0xb9,
+	0x8a, 0x99, 0x28, 0xa6, 0x4e, 0x44, 0x99, 0x41, 0xfa, 0xf5, 0x02, 0x13, 0x69, 0x04, 0x7a, 0x1f,
+	0xea, 0x24, 0x70, 0x39, 0xb8, 0x91, 0x0f, 0x56, 0x7a, 0xb4, 0x01, 0x2d, 0x1a, 0x52, 0xc7, 0x3f,
+	0x74, 0x66, 0xe1, 0x94, 0xf6, 0x9b, 0x39, 0x73, 0x30, 0x01, 0x46, 0x56, 0x81, 0xe2, 0xac, 0x62,
+	0xff, 0xda, 0x82, 0xe6, 0xf0, 0x78, 0xef, 0x38, 0x8c, 0x2e, 0x48, 0x94, 0xb2, 0x95, 0x75, 0xa5,
+	0xad, 0xd6, 0xa1, 0x1e, 0xfb, 0xce, 0x4b, 0x72, 0xc9, 0xd6, 0x29, 0x00, 0x0b, 0xc4, 0x51, 0x18,
+	0x9c, 0x7a, 0xd1, 0x98, 0xb8, 0x7c, 0xdb, 0x1a, 0x38, 0x11, 0xd8, 0xff, 0x28, 0x43, 0xf7, 0x30,
+	0x0a, 0x4f, 0x3d, 0x9f, 0x68, 0x1a, 0x78, 0x17, 0xaa, 0x51, 0xe8, 0x93, 0xbe, 0x65, 0x26, 0x32,
+	0x09, 0xc2, 0xa1, 0x4f, 0x30, 0x57, 0xa3, 0xef, 0xc1, 0xa2, 0x37, 0x17, 0x3c, 0x05, 0x9c, 0x9e,
+	0x46, 0xa2, 0x3e, 0xd4, 0x47, 0x32, 0x42, 0x44, 0x18, 0xa9, 0x26, 0x42, 0x50, 0x0d, 0x58, 0x74,
+	0x89, 0xc0, 0xe1, 0xbf, 0xd1, 0x0f, 0xa1, 0x73, 0xe2, 0x3b, 0xa3, 0x0b, 0xdf, 0x8b, 0xe9, 0x17,
+	0x53, 0x12, 0xcd, 0x64, 0x06, 0xbc, 0x21, 0xed, 0x9a, 0xd2, 0xe1, 0x0c, 0x36, 0xa1, 0xad, 0x5a,
+	0x3e, 0x6d, 0xd5, 0x0b, 0xe9, 0xbb, 0x71, 0x6d, 0xfa, 0x6e, 0x66, 0x79, 0xee, 0x10, 0x16, 0x13,
+	0xeb, 0x32, 0x9a, 0x7b, 0x1f, 0x1a, 0x13, 0x29, 0x48, 0x97, 0xc6, 0xca, 0xbe, 0x5a, 0x5d, 0xc0,
+	0x74, 0xff, 0x2c, 0x43, 0x5d, 0x62, 0xd9, 0x99, 0xf4, 0x45, 0x7c, 0xa9, 0xcb, 0x48, 0x3d, 0xba,
+	0x07, 0x8b, 0x79, 0x44, 0x97, 0x16, 0x32, 0xe3, 0x1b, 0xd4, 0xc6, 0x7f, 0xb3, 0xad, 0x4a, 0x93,
+	0x99, 0x6a, 0xf2, 0x31, 0xe3, 0xed, 0x30, 0x9a, 0x84, 0x46, 0xd4, 0x36, 0x70, 0x5a, 0xc8, 0x38,
+	0x71, 0x3f, 0x66, 0x13, 0x26, 0x71, 0xec, 0x85, 0x81, 0xe3, 0xf3, 0x7d, 0x68, 0xe0, 0x8c, 0x14,
+	0xd9, 0xd0, 0x4e, 0x91, 0x61, 0x9d, 0x7f, 0x2c, 0x25, 0x43, 0xb7, 0x01, 0x44, 0xd9, 0xbb, 0x15,
+	0x5f, 0xc4, 0x3c, 0x6c, 0xab, 0xd8, 0x90, 0x24, 0xfa, 0xc7, 0x9e, 0x2b, 0x8e, 0x92, 0x55, 0x6c,
+	0x48, 0xd8, 0x8c, 0xbd, 0x58, 0xbb, 0x0b, 0x71, 0x79, 0x7c, 0x36, 0x70, 0x5a, 0x68, 0xff, 0xd6,
+	0x82, 0x9e, 0x6e, 0xab, 0x98, 0x58, 0x87, 0x7a, 0xf8, 0x2a, 0xb8, 0xd4, 0xd6, 0x0a, 0xf0, 0xb5,
+	0x26, 0xca, 0x09, 0x74, 0x8c, 0xb9, 0x30, 0x0f, 0xba, 0xce, 0x4c, 0xde, 0x86, 0xa6, 0x23, 0x64,
+	0x84, 0x9d, 0x8f, 0x2a, 0x6b, 0x4d, 0x9c, 0x08, 0x12, 0x07, 0xab, 0x98, 0x0e, 0xf6, 0x57, 0x0b,
+	0x96, 0x7e, 0xe2, 0xf8, 0x9e, 0xcb, 0x92, 0x90, 0xe6, 0x84, 0xef, 0x42, 0xe7, 0xa5, 0x12, 0x0a,
+	0x0f, 0xb2, 0xf2, 0x4b, 0xbf, 0x0c, 0xec, 0x7f, 0x7b, 0xa6, 0xf8, 0x29, 0x74, 0xcd, 0xa5, 0x30,
+	0xf3, 0x7d, 0x00, 0xa0, 0x67, 0xa8, 0x42, 0x50, 0x2e, 0x42, 0x43, 0xb1, 0x01, 0x29, 0x08, 0xc3,
+	0x6d, 0x68, 0x6a, 0x38, 0x5a, 0x35, 0xea, 0xa6, 0xf9, 0xdd, 0x50, 0xb5, 0x93, 0x11, 0x77, 0xa2,
+	0x61, 0x3f, 0x83, 0x5b, 0x3c, 0x4b, 0x9b, 0x87, 0x38, 0x7d, 0xec, 0x69, 0x44, 0x52, 0x20, 0x27,
+	0x79, 0xcb, 0x38, 0xf4, 0x98, 0x1d, 0xb0, 0x06, 0xda, 0x5f, 0x95, 0x61, 0x69, 0x4e, 0x7f, 0x45,
+	0x55, 0x97, 0x24, 0xab, 0xf2, 0x25, 0x47, 0xa0, 0x87, 0xd0, 0x92, 0x5f, 0x61, 0x47, 0x1e, 0x79,
+	0xc4, 0x99, 0x3b, 0x09, 0x99, 0x98, 0x54, 0x3e, 0xaf, 0x16, 0xe5, 0xf3, 0x85, 0xe2, 0x7c, 0xfe,
+	0x50, 0x1f, 0xa8, 0x6a, 0xfc, 0x6b, 0x6f, 0x49, 0x4f, 0x33, 0xd7, 0x96, 0x39, 0x58, 0x3d, 0x30,
+	0xcb, 0xfb, 0xa2, 0x12, 0x40, 0x23, 0xec, 0xdf, 0x5b, 0xd0, 0x62, 0xe6, 0x3a, 0x74, 0x66, 0x63,
+	0x12, 0xbc, 0xe9, 0x69, 0x70, 0x03, 0x5a, 0x13, 0x67, 0x46, 0xdc, 0xad, 0xb1, 0xf6, 0x8a, 0x2c,
+	0xd4, 0x04, 0xb0, 0x49, 0x4d, 0xc4, 0x07, 0x8e, 0x9e, 0xf7, 0x2b, 0x05, 0x93, 0xd2, 0x08, 0xc6,
+	0x3e, 0x1d, 0x51, 0x13, 0xe8, 0xd8, 0xbb, 0x0f, 0x8d, 0xa7, 0x57, 0xd6, 0x06, 0x0a, 0xf1, 0xb5,
+	0xb2, 0xcf, 0x01, 0xb4, 0xf5, 0x5c, 0x44, 0xf6, 0xaa, 0xbf, 0x12, 0xed, 0x74, 0xe4, 0xe8, 0x3a,
+	0x06, 0x2b, 0x7d, 0x41, 0xd8, 0xfc, 0xc5, 0x82, 0x96, 0x41, 0xe9, 0xd7, 0x22, 0xb3, 0x4d, 0x68,
+	0xe9, 0xb0, 0xbc, 0xa4, 0xf0, 0x31, 0x41, 0x9c, 0x00, 0x29, 0x8d, 0xbc, 0x93, 0x29, 0x25, 0x72,
+	0xe5, 0x89, 0x80, 0xe7, 0x83, 0x9c, 0x2b, 0x9f, 0xb4, 0x90, 0xad, 0x44, 0x9c, 0xae, 0x44, 0x7d,
+	0x2e, 0x1a, 0xf6, 0x26, 0xb4, 0xcd, 0x03, 0x16, 0x3b, 0x95, 0x8d, 0x9d, 0xd7, 0xea, 0x54, 0x36,
+	0x76, 0x5e, 0x73, 0x89, 0x17, 0xc8, 0xf5, 0xb3, 0x9f, 0xf6, 0x8f, 0xa1, 0xa9, 0x4f, 0xd3, 0xe8,
+	0x76, 0xd2, 0x21, 0xeb, 0x3f, 0xbc, 0xfb, 0xed, 0xa4, 0xfb, 0xbc, 0xde, 0x0b, 0xec, 0x63, 0xe8,
+	0x66, 0x0e, 0xad, 0xe8, 0x8e, 0x39, 0xe4, 0x9c, 0x93, 0xf1, 0x51, 0xef, 0x98, 0xa3, 0xe6, 0x40,
+	0xbc, 0xc0, 0xfe, 0x1c, 0x9a, 0x9a, 0xce, 0x93, 0xc5, 0x8b, 0x85, 0x89, 0x06, 0xfa, 0x16, 0x34,
+	0xc2, 0x09, 0x89, 0x98, 0x91, 0x65, 0xd5, 0xd7, 0xd2, 0x79, 0xe0, 0x60, 0x82, 0xb5, 0xd2, 0xbe,
+	0x30, 0xd2, 0x97, 0x28, 0xc7, 0xae, 0xb3, 0xe3, 0x0f, 0xa0, 0x16, 0x72, 0xc2, 0x97, 0x1f, 0x59,
+	0xc9, 0x14, 0x7c, 0x32, 0x1b, 0x48, 0x90, 0xfd, 0xa7, 0x2a, 0xa0, 0xa7, 0xfc, 0xf1, 0x80, 0xf1,
+	0x82, 0x0e, 0x9f, 0xbb, 0x50, 0x3d, 0x8d, 0xc2, 0x71, 0x91, 0x59, 0xb8, 0x12, 0xbd, 0x03, 0x65,
+	0x1a, 0x16, 0x99, 0xa5, 0x4c, 0x43, 0xf4, 0x19, 0xb4, 0xce, 0x22, 0x27, 0x98, 0xfa, 0x4e, 0xe4,
+	0xd1, 0x99, 0x64, 0xc0, 0x7b, 0xea, 0xa4, 0x9d, 0xfd, 0xe8, 0xc6, 0x6e, 0x82, 0xc5, 0x66, 0x47,
+	0x46, 0x07, 0xa1, 0x22, 0xcc, 0x7e, 0x35, 0x9f, 0x47, 0x13, 0x44, 0xea, 0xb2, 0x65, 0xe1, 0xaa,
+	0xcb, 0x96, 0xbd, 0xd4, 0x1d, 0x49, 0x8d, 0x47, 0xe8, 0x5a, 0xe1, 0x14, 0x2f, 0xbb, 0x28, 0xb9,
+	0x0f, 0x4b, 0x9c, 0x85, 0x0f, 0x49, 0xa4, 0x61, 0xf2, 0xe6, 0x71, 0x5e, 0xc1, 0x6e, 0x4f, 0xb9,
+	0x50, 0x4b, 0xf6, 0x87, 0xb2, 0x3a, 0x9b, 0x93, 0xb3, 0x53, 0xf4, 0x84, 0x44, 0x23, 0x16, 0x60,
+	0xac, 0x06, 0x6e, 0xae, 0x56, 0xd6, 0x16, 0xb1, 0x29, 0xfa, 0x4f, 0x5c, 0x79, 0xac, 0x42, 0xcb,
+	0xd8, 0x10, 0xd4, 0x80, 0xea, 0xde, 0xc1, 0x0b, 0xdc, 0x2b, 0xa1, 0x3a, 0x54, 0x86, 0x5b, 0x5f,
+	0xf6, 0x2c, 0xfb, 0x5f, 0x65, 0x58, 0x32, 0x6c, 0xf4, 0x78, 0x3a, 0xba, 0x20, 0x34, 0x7d, 0xa6,
+	0xb4, 0xae, 0x3c, 0x53, 0xde, 0xd4, 0x77, 0x18, 0x65, 0x49, 0xb2, 0xbc, 0xc5, 0x52, 0xc6, 0x98,
+	0xb8, 0x9e, 0x13, 0x1c, 0xf2, 0xa4, 0x57, 0xc9, 0x4b, 0x19, 0x06, 0x00, 0x3d, 0x82, 0x5e, 0x62,
+	0x10, 0x2e, 0x52, 0xd5, 0x50, 0xba, 0xd3, 0x1c, 0x8a, 0x59, 0x37, 0x9c, 0x90, 0x80, 0xb8, 0xfc,
+	0x2a, 0x5e, 0x9e, 0xa3, 0x4d, 0x11, 0x43, 0x8c, 0xfc, 0x30, 0x56, 0x08, 0x71, 0x28, 0x32, 0x45,
+	0xd9, 0xe3, 0x6e, 0xfd, 0xaa, 0xe3, 0xee, 0x1a, 0x74, 0x45, 0x8d, 0xfd, 0x5c, 0x5e, 0x16, 0xa8,
+	0xd2, 0x3c, 0x2b, 0xb6, 0x77, 0xa0, 0x97, 0xf2, 0x43, 0x96, 0x52, 0x1e, 0x42, 0xfd, 0x84, 0x1b,
+	0x3b, 0x53, 0xe7, 0xcc, 0x6d, 0x06, 0x56, 0x38, 0xfb, 0x6f, 0x96, 0xb8, 0x2a, 0x8e, 0xf7, 0xbc,
+	0x98, 0x86, 0xd1, 0xec, 0x9b, 0xf9, 0x44, 0xa7, 0xf3, 0x70, 0x35, 0x3f, 0x0f, 0x2f, 0x98, 0x79,
+	0xd8, 0xfe, 0x18, 0x96, 0xd2, 0x4b, 0x62, 0xb6, 0x59, 0x4d, 0x3f, 0xa2, 0x98, 0xcf, 0x1f, 0x42,
+	0x61, 0xff, 0x8c, 0xbf, 0xbb, 0x70, 0xe6, 0xd8, 0x79, 0x49, 0x02, 0x8a, 0xde, 0x93, 0x77, 0xd5,
+	0x96, 0xf9, 0xc0, 0x39, 0x3c, 0xde, 0xe3, 0x5a, 0xe3, 0xba, 0xfa, 0x9e, 0xf9, 0x12, 0x3a, 0x7f,
+	0xdb, 0x26, 0x94, 0xf6, 0x97, 0xd0, 0x96, 0x2f, 0x31, 0xd7, 0x1b, 0xfd, 0x8e, 0x7c, 0xb6, 0x11,
+	0x83, 0x67, 0x1e, 0x7f, 0xb8, 0xca, 0x76, 0x60, 0xf9, 0x33, 0xc7, 0xf3, 0x89, 0xcb, 0xfb, 0x6a,
+	0xb2, 0xd6, 0x56, 0xb3, 0xf2, 0xad, 0x56, 0x2e, 0xae, 0x5e, 0x2a, 0xd9, 0xea, 0xe5, 0xef, 0x16,
+	0xb4, 0x8c, 0x6f, 0xcc, 0xdd, 0x81, 0xad, 0x42, 0xeb, 0xc4, 0x0f, 0x47, 0x17, 0xcf, 0xa6, 0xe3,
+	0x13, 0x69, 0x89, 0x2a, 0x36, 0x45, 0xec, 0x40, 0x4c, 0x55, 0xbd, 0xdb, 0x94, 0x6b, 0x43, 0x50,
+	0x75, 0x1d, 0xea, 0xa8, 0x1b, 0x0a, 0xf6, 0x9b, 0xd5, 0xba, 0x0e, 0xa5, 0x64, 0x3c, 0xa1, 0x2a,
+	0xe6, 0x74, 0x9b, 0xcd, 0xd1, 0x77, 0x62, 0xba, 0x13, 0x45, 0x61, 0xc4, 0xc3, 0xad, 0x89, 0x13,
+	0x01, 0x2b, 0xac, 0x03, 0xf2, 0x9a, 0x62, 0x42, 0xa3, 0x59, 0x71, 0xd1, 0x6a, 0x62, 0xec, 0x23,
+	0x58, 0x4a, 0x5b, 0x4e, 0x54, 0x66, 0x35, 0xc2, 0x9b, 0xd2, 0x57, 0xe4, 0xad, 0x8d, 0x01, 0xc4,
+	0x12, 0x50, 0x50, 0x99, 0x3d, 0x80, 0xb7, 0xd8, 0x48, 0xce, 0x2c, 0x6f, 0x57, 0x7a, 0x50, 0xf1,
+	0x5c, 0x31, 0x74, 0x15, 0xb3, 0x9f, 0xeb, 0x77, 0x60, 0x81, 0xe7, 0x7a, 0x54, 0x83, 0xf2, 0xce,
+	0x17, 0x82, 0x49, 0x77, 0x8f, 0x76, 0x7a, 0x16, 0xfb, 0xf1, 0xe4, 0x68, 0xa7, 0x57, 0x5e, 0xbf,
+	0x03, 0x6d, 0xf3, 0x65, 0x9d, 0x29, 0xb6, 0xe2, 0x51, 0xaf, 0xc4, 0xe8, 0x77, 0x48, 0xe2, 0x51,
+	0xcf, 0x5a, 0xff, 0x04, 0x5a, 0xc6, 0xbd, 0x12, 0x6a, 0x41, 0x7d, 0x2b, 0x98, 0xb1, 0x9f, 0xbd,
+	0x12, 0x6a, 0x43, 0x43, 0x31, 0x47, 0xcf, 0x62, 0xad, 0x6d, 0x19, 0x5b, 0xbd, 0xf2, 0xfa, 0x13,
+	0xe8, 0x66, 0x8a, 0x00, 0xb4, 0x0c, 0xdd, 0x63, 0x8f, 0x9e, 0x87, 0x53, 0xaa, 0xee, 0xb6, 0x7b,
+	0x25, 0x84, 0xa0, 0xb3, 0x1f, 0x8c, 0xfc, 0xa9, 0x4b, 0xb6, 0x02, 0x97, 0x31, 0x4a, 0xcf, 0x42,
+	0x3d, 0x68, 0x1f, 0x04, 0xfe, 0x4c, 0xa3, 0xca, 0xeb, 0x1f, 0x73, 0x2f, 0xd7, 0x3e, 0xcc, 0xa6,
+	0xb1, 0x8d, 0x77, 0xb6, 0x8e, 0x76, 0x86, 0xbd, 0x12, 0x6b, 0xbc, 0x38, 0x1c, 0xf2, 0x86, 0xc5,
+	0x1a, 0xc3, 0x9d, 0x27, 0x3b, 0xac, 0x51, 0xde, 0xfc, 0x55, 0x13, 0x2a, 0xc3, 0xe3, 0x3d, 0xf4,
+	0x31, 0x34, 0x76, 0x09, 0x15, 0xdc, 0x89, 0xe6, 0xdf, 0x81, 0x07, 0xcb, 0x29, 0xf7, 0x17, 0xfb,
+	0x65, 0x97, 0xd0, 0x07, 0xd0, 0x91, 0xdd, 0x86, 0x84, 0x3a, 0x9e, 0x1f, 0xa3, 0x14, 0xc7, 0x0e,
+	0xd2, 0x51, 0x63, 0x97, 0xd0, 0x53, 0x58, 0x92, 0x1d, 0x92, 0x37, 0x32, 0xf4, 0x7f, 0x39, 0x4f,
+	0x61, 0xfa, 0xcb, 0x6f, 0xe5, 0x2b, 0xc5, 0xf7, 0x1f, 0x41, 0x73, 0x97, 0xd0, 0x03, 0x91, 0xa1,
+	0x96, 0x73, 0x5e, 0x52, 0x06, 0x37, 0xd2, 0xa4, 0xa0, 0x7b, 0xee, 0xf1, 0x89, 0xa4, 0x9f, 0x10,
+	0xd4, 0x44, 0x72, 0x1f, 0x16, 0x0a, 0x47, 0x7a, 0x08, 0x5d, 0x35, 0x87, 0x7c, 0x23, 0x64, 0x78,
+	0xc9, 0x2e, 0xa1, 0x1f, 0x40, 0x6b, 0x97, 0x50, 0x75, 0xa9, 0x86, 0x56, 0x52, 0xb7, 0x67, 0x59,
+	0x9b, 0xa7, 0xee, 0xde, 0xec, 0x12, 0xda, 0xe0, 0x36, 0x97, 0xd2, 0xfd, 0xe0, 0x34, 0x44, 0x2d,
+	0xcd, 0xe1, 0xfb, 0xc3, 0x41, 0xfa, 0x2a, 0xce, 0x2e, 0xa1, 0x1f, 0x41, 0x7b, 0x97, 0x50, 0xed,
+	0x6a, 0xe8, 0x66, 0xa6, 0x00, 0xcd, 0xac, 0x2f, 0x7d, 0x53, 0x63, 0x97, 0xd0, 0x16, 0x2c, 0xee,
+	0x12, 0x9a, 0x5c, 0x41, 0xa0, 0x5b, 0x99, 0x9b, 0x06, 0x3d, 0xe1, 0x95, 0x79, 0x85, 0x18, 0xe2,
+	0x33, 0x58, 0x51, 0xbb, 0x9e, 0xba, 0x26, 0xc8, 0x18, 0xea, 0xff, 0x0b, 0x6e, 0x07, 0x8c, 0xed,
+	0x86, 0x5d, 0x42, 0x8f, 0xd5, 0xe9, 0x4c, 0xc0, 0xd3, 0x07, 0xcd, 0x01, 0xca, 0x48, 0x45, 0xcf,
+	0x21, 0x37, 0x9a, 0x91, 0x8f, 0x51, 0xbf, 0xa8, 0xa6, 0x1c, 0xdc, 0xcc, 0xd1, 0x88, 0x51, 0x76,
+	0xf9, 0x56, 0x9b, 0x39, 0x0e, 0x19, 0xee, 0x99, 0x49, 0xe5, 0x83, 0x5b, 0x79, 0x2a, 0x31, 0xd0,
+	0xa7, 0xd0, 0x7d, 0x3e, 0x3d, 0x89, 0x47, 0x91, 0x77, 0x42, 0x2e, 0xf3, 0xde, 0xe5, 0xb4, 0xeb,
+	0xf0, 0xf0, 0xb6, 0x4b, 0x1f, 0x5a, 0xec, 0x3a, 0x59, 0x0f, 0x50, 0x1c, 0xb5, 0x28, 0x15, 0x7e,
+	0x49, 0x6f, 0xb1, 0x0e, 0x93, 0x24, 0xd5, 0x3a, 0x72, 0x88, 0x73, 0x70, 0x2b, 0x4f, 0xa5, 0xcc,
+	0x8a, 0xe6, 0x09, 0x17, 0xbd, 0x23, 0x3a, 0x14, 0x52, 0xf1, 0x40, 0x39, 0xec, 0x78, 0x42, 0x67,
+	0x76, 0xe9, 0xa4, 0xc6, 0xff, 0xff, 0xf4, 0xd1, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x93, 0x87,
+	0x4f, 0xca, 0x55, 0x25, 0x00, 0x00,
 }
@@ -34,7 +34,7 @@ var TaskLogsRequest_Type_value = map[string]int32{
 func (x TaskLogsRequest_Type) String() string {
 	return proto.EnumName(TaskLogsRequest_Type_name, int32(x))
 }
-func (TaskLogsRequest_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{10, 0} }
+func (TaskLogsRequest_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor6, []int{11, 0} }
 
 type Empty struct {
 }
@@ -221,16 +221,43 @@ func (m *NetworkUsage) GetRxDropped() uint64 {
 	return 0
 }
 
+type StorageUsage struct {
+	// Bytes is the size of the container's writable layer.
+	Bytes uint64 `protobuf:"varint,1,opt,name=bytes" json:"bytes,omitempty"`
+	// Quota is the storage size purchased with the deal, zero means no limit.
+	Quota uint64 `protobuf:"varint,2,opt,name=quota" json:"quota,omitempty"`
+}
+
+func (m *StorageUsage) Reset()                    { *m = StorageUsage{} }
+func (m *StorageUsage) String() string            { return proto.CompactTextString(m) }
+func (*StorageUsage) ProtoMessage()               {}
+func (*StorageUsage) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{8} }
+
+func (m *StorageUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *StorageUsage) GetQuota() uint64 {
+	if m != nil {
+		return m.Quota
+	}
+	return 0
+}
+
 type ResourceUsage struct {
 	Cpu     *CPUUsage                `protobuf:"bytes,1,opt,name=cpu" json:"cpu,omitempty"`
 	Memory  *MemoryUsage             `protobuf:"bytes,2,opt,name=memory" json:"memory,omitempty"`
 	Network map[string]*NetworkUsage `protobuf:"bytes,3,rep,name=network" json:"network,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Storage *StorageUsage            `protobuf:"bytes,4,opt,name=storage" json:"storage,omitempty"`
 }
 
 func (m *ResourceUsage) Reset()                    { *m = ResourceUsage{} }
 func (m *ResourceUsage) String() string            { return proto.CompactTextString(m) }
 func (*ResourceUsage) ProtoMessage()               {}
-func (*ResourceUsage) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{8} }
+func (*ResourceUsage) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{9} }
 
 func (m *ResourceUsage) GetCpu() *CPUUsage {
 	if m != nil {
@@ -253,6 +280,13 @@ func (m *ResourceUsage) GetNetwork() map[string]*NetworkUsage {
 	return nil
 }
 
+func (m *ResourceUsage) GetStorage() *StorageUsage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
 type ContainerRestartPolicy struct {
 	Name              string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	MaximumRetryCount uint32 `protobuf:"varint,2,opt,name=maximumRetryCount" json:"maximumRetryCount,omitempty"`
@@ -261,7 +295,7 @@ type ContainerRestartPolicy struct {
 func (m *ContainerRestartPolicy) Reset()                    { *m = ContainerRestartPolicy{} }
 func (m *ContainerRestartPolicy) String() string            { return proto.CompactTextString(m) }
 func (*ContainerRestartPolicy) ProtoMessage()               {}
-func (*ContainerRestartPolicy) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{9} }
+func (*ContainerRestartPolicy) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
 
 func (m *ContainerRestartPolicy) GetName() string {
 	if m != nil {
@@ -291,7 +325,7 @@ type TaskLogsRequest struct {
 func (m *TaskLogsRequest) Reset()                    { *m = TaskLogsRequest{} }
 func (m *TaskLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*TaskLogsRequest) ProtoMessage()               {}
-func (*TaskLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
+func (*TaskLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
 
 func (m *TaskLogsRequest) GetType() TaskLogsRequest_Type {
 	if m != nil {
@@ -356,7 +390,7 @@ type TaskLogsChunk struct {
 func (m *TaskLogsChunk) Reset()                    { *m = TaskLogsChunk{} }
 func (m *TaskLogsChunk) String() string            { return proto.CompactTextString(m) }
 func (*TaskLogsChunk) ProtoMessage()               {}
-func (*TaskLogsChunk) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
+func (*TaskLogsChunk) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{12} }
 
 func (m *TaskLogsChunk) GetData() []byte {
 	if m != nil {
@@ -375,7 +409,7 @@ type TaskResourceRequirements struct {
 func (m *TaskResourceRequirements) Reset()                    { *m = TaskResourceRequirements{} }
 func (m *TaskResourceRequirements) String() string            { return proto.CompactTextString(m) }
 func (*TaskResourceRequirements) ProtoMessage()               {}
-func (*TaskResourceRequirements) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{12} }
+func (*TaskResourceRequirements) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{13} }
 
 func (m *TaskResourceRequirements) GetCPUCores() uint64 {
 	if m != nil {
@@ -398,7 +432,7 @@ type Chunk struct {
 func (m *Chunk) Reset()                    { *m = Chunk{} }
 func (m *Chunk) String() string            { return proto.CompactTextString(m) }
 func (*Chunk) ProtoMessage()               {}
-func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{13} }
+func (*Chunk) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{14} }
 
 func (m *Chunk) GetChunk() []byte {
 	if m != nil {
@@ -414,7 +448,7 @@ type Progress struct {
 func (m *Progress) Reset()                    { *m = Progress{} }
 func (m *Progress) String() string            { return proto.CompactTextString(m) }
 func (*Progress) ProtoMessage()               {}
-func (*Progress) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{14} }
+func (*Progress) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{15} }
 
 func (m *Progress) GetSize() int64 {
 	if m != nil {
@@ -430,7 +464,7 @@ type Duration struct {
 func (m *Duration) Reset()                    { *m = Duration{} }
 func (m *Duration) String() string            { return proto.CompactTextString(m) }
 func (*Duration) ProtoMessage()               {}
-func (*Duration) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{15} }
+func (*Duration) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{16} }
 
 func (m *Duration) GetNanoseconds() int64 {
 	if m != nil {
@@ -446,7 +480,7 @@ type EthAddress struct {
 func (m *EthAddress) Reset()                    { *m = EthAddress{} }
 func (m *EthAddress) String() string            { return proto.CompactTextString(m) }
 func (*EthAddress) ProtoMessage()               {}
-func (*EthAddress) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{16} }
+func (*EthAddress) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{17} }
 
 func (m *EthAddress) GetAddress() []byte {
 	if m != nil {
@@ -462,7 +496,7 @@ type DataSize struct {
 func (m *DataSize) Reset()                    { *m = DataSize{} }
 func (m *DataSize) String() string            { return proto.CompactTextString(m) }
 func (*DataSize) ProtoMessage()               {}
-func (*DataSize) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{17} }
+func (*DataSize) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{18} }
 
 func (m *DataSize) GetBytes() uint64 {
 	if m != nil {
@@ -478,7 +512,7 @@ type DataSizeRate struct {
 func (m *DataSizeRate) Reset()                    { *m = DataSizeRate{} }
 func (m *DataSizeRate) String() string            { return proto.CompactTextString(m) }
 func (*DataSizeRate) ProtoMessage()               {}
-func (*DataSizeRate) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{18} }
+func (*DataSizeRate) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{19} }
 
 func (m *DataSizeRate) GetBitsPerSecond() uint64 {
 	if m != nil {
@@ -494,7 +528,7 @@ type Price struct {
 func (m *Price) Reset()                    { *m = Price{} }
 func (m *Price) String() string            { return proto.CompactTextString(m) }
 func (*Price) ProtoMessage()               {}
-func (*Price) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{19} }
+func (*Price) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{20} }
 
 func (m *Price) GetPerSecond() *BigInt {
 	if m != nil {
@@ -512,6 +546,7 @@ func init() {
 	proto.RegisterType((*CPUUsage)(nil), "sonm.CPUUsage")
 	proto.RegisterType((*MemoryUsage)(nil), "sonm.MemoryUsage")
 	proto.RegisterType((*NetworkUsage)(nil), "sonm.NetworkUsage")
+	proto.RegisterType((*StorageUsage)(nil), "sonm.StorageUsage")
 	proto.RegisterType((*ResourceUsage)(nil), "sonm.ResourceUsage")
 	proto.RegisterType((*ContainerRestartPolicy)(nil), "sonm.ContainerRestartPolicy")
 	proto.RegisterType((*TaskLogsRequest)(nil), "sonm.TaskLogsRequest")
@@ -530,56 +565,58 @@ func init() {
 func init() { proto.RegisterFile("insonmnia.proto", fileDescriptor6) }
 
 var fileDescriptor6 = []byte{
-	// 806 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcb, 0x6e, 0x1b, 0x37,
-	0x14, 0xad, 0x46, 0x0f, 0x8f, 0xae, 0x65, 0x5b, 0x21, 0x8c, 0x40, 0x10, 0xda, 0x42, 0x60, 0x83,
-	0xc2, 0x2e, 0x02, 0x2d, 0x9c, 0x2e, 0x8a, 0x2c, 0x0a, 0xd4, 0x92, 0x8a, 0x1a, 0x68, 0x12, 0x81,
-	0x96, 0x37, 0xdd, 0x51, 0x33, 0x84, 0x4c, 0x68, 0x86, 0x9c, 0x92, 0x54, 0xa3, 0xc9, 0xef, 0xf4,
-	0xe7, 0xba, 0xee, 0x17, 0x14, 0x7c, 0x8d, 0xa4, 0x04, 0xe8, 0xee, 0x9e, 0x7b, 0x0e, 0x79, 0xc9,
-	0xfb, 0x82, 0x2b, 0x2e, 0xb4, 0x14, 0xa5, 0xe0, 0x74, 0x5a, 0x29, 0x69, 0x24, 0xea, 0x58, 0x38,
-	0x1e, 0xac, 0xf9, 0x86, 0x0b, 0xe3, 0x7d, 0x63, 0x94, 0xd1, 0x8a, 0xae, 0x79, 0xc1, 0x0d, 0x67,
-	0x3a, 0xf8, 0xae, 0x0c, 0x2f, 0x99, 0x36, 0xb4, 0xac, 0xbc, 0x03, 0x9f, 0x41, 0x77, 0x51, 0x56,
-	0xa6, 0xc6, 0xd7, 0x90, 0x3c, 0xcc, 0xd1, 0x25, 0x24, 0x3c, 0x1f, 0xb5, 0x26, 0xad, 0x9b, 0x3e,
-	0x49, 0x78, 0x8e, 0x6f, 0xa1, 0xbb, 0x30, 0xcf, 0x0f, 0x73, 0x34, 0x69, 0x88, 0xf3, 0xbb, 0xe1,
-	0xd4, 0x46, 0x9b, 0x2e, 0xcc, 0xf3, 0x2f, 0x79, 0xae, 0x98, 0xd6, 0x4e, 0xfa, 0x33, 0xf4, 0x56,
-	0x54, 0x6f, 0xbf, 0xbc, 0x04, 0xbd, 0x82, 0x5e, 0xce, 0x68, 0xf1, 0x30, 0x1f, 0x25, 0xee, 0xfc,
-	0xc0, 0x9f, 0xbf, 0xe7, 0x9b, 0x07, 0x61, 0x48, 0xe0, 0xf0, 0x37, 0xd0, 0x9d, 0xc9, 0x9d, 0x30,
-	0xe8, 0x1a, 0xba, 0x99, 0x35, 0xdc, 0x0d, 0x1d, 0xe2, 0x01, 0x9e, 0x40, 0x3a, 0x5b, 0x3e, 0x3d,
-	0x69, 0xba, 0x61, 0x56, 0x61, 0xa4, 0xa1, 0x45, 0x54, 0x38, 0x80, 0x6f, 0xe1, 0xfc, 0x1d, 0x2b,
-	0xa5, 0xaa, 0xbd, 0x68, 0x0c, 0x69, 0x49, 0xf7, 0xce, 0x0e, 0xba, 0x06, 0xe3, 0x7f, 0x5b, 0x30,
-	0x78, 0xcf, 0xcc, 0x47, 0xa9, 0xb6, 0x5e, 0x3c, 0x82, 0x33, 0xb3, 0xbf, 0xaf, 0x0d, 0xd3, 0x41,
-	0x1b, 0xa1, 0x65, 0x54, 0x60, 0x12, 0xcf, 0x04, 0x88, 0xbe, 0x86, 0xbe, 0xd9, 0x2f, 0x69, 0xb6,
-	0x65, 0x46, 0x8f, 0xda, 0x8e, 0x3b, 0x38, 0x2c, 0xab, 0x1a, 0xb6, 0xe3, 0xd9, 0xc6, 0x61, 0x1f,
-	0x67, 0xf6, 0x0b, 0xa5, 0xa4, 0xd2, 0xa3, 0xae, 0x7f, 0x5c, 0xc4, 0x96, 0x53, 0x91, 0xeb, 0x79,
-	0x2e, 0x62, 0x1f, 0x73, 0xae, 0x64, 0x55, 0xb1, 0x7c, 0x74, 0x16, 0x63, 0x06, 0x87, 0x8f, 0x19,
-	0xd9, 0x34, 0xc6, 0x0c, 0x0e, 0xfc, 0x4f, 0x0b, 0x2e, 0x08, 0xd3, 0x72, 0xa7, 0x32, 0xe6, 0x7f,
-	0x3d, 0x81, 0x76, 0x56, 0xed, 0x42, 0x55, 0x2f, 0x7d, 0x55, 0x62, 0x92, 0x89, 0xa5, 0xd0, 0x2d,
-	0xf4, 0x4a, 0x97, 0xd3, 0x50, 0xba, 0x17, 0x5e, 0x74, 0x94, 0x67, 0x12, 0x04, 0xe8, 0x2d, 0x9c,
-	0x09, 0x9f, 0xd2, 0x51, 0x7b, 0xd2, 0xbe, 0x39, 0xbf, 0x9b, 0x78, 0xed, 0x49, 0xc8, 0x69, 0xc8,
-	0xfa, 0x42, 0x18, 0x55, 0x93, 0x78, 0x60, 0xfc, 0xbe, 0x29, 0x87, 0x23, 0xd0, 0x10, 0xda, 0x5b,
-	0x56, 0x87, 0x16, 0xb2, 0x26, 0xba, 0x81, 0xee, 0x5f, 0xb4, 0xd8, 0xb1, 0xf0, 0x0e, 0xe4, 0xef,
-	0x3e, 0xae, 0x21, 0xf1, 0x82, 0xb7, 0xc9, 0x4f, 0x2d, 0xfc, 0x07, 0xbc, 0x9c, 0x49, 0x61, 0x28,
-	0x17, 0x4c, 0x11, 0xdb, 0xef, 0xca, 0x2c, 0x65, 0xc1, 0xb3, 0x1a, 0x21, 0xe8, 0x08, 0x5a, 0xb2,
-	0x70, 0xb5, 0xb3, 0xd1, 0x6b, 0x78, 0x51, 0xd2, 0x3d, 0x2f, 0x77, 0x25, 0x61, 0x46, 0xd5, 0xae,
-	0x0b, 0x5d, 0x9c, 0x0b, 0xf2, 0x25, 0x81, 0xff, 0x4e, 0xe0, 0xca, 0x36, 0xfa, 0xef, 0x72, 0xa3,
-	0x09, 0xfb, 0x73, 0xc7, 0xb4, 0x41, 0x53, 0xe8, 0x98, 0xba, 0xf2, 0xb7, 0x5e, 0xde, 0x8d, 0xfd,
-	0xe3, 0x3e, 0x13, 0x4d, 0x57, 0x75, 0xc5, 0x88, 0xd3, 0x85, 0x09, 0x49, 0x9a, 0x09, 0xb9, 0x86,
-	0xae, 0xe6, 0x22, 0x63, 0xae, 0x8d, 0xfa, 0xc4, 0x03, 0xf4, 0x0a, 0x2e, 0x68, 0x9e, 0xaf, 0xe2,
-	0xc4, 0xfa, 0x36, 0x4a, 0xc9, 0xa9, 0x13, 0xbd, 0x84, 0xde, 0xaf, 0xb2, 0x28, 0xe4, 0x47, 0xd7,
-	0x48, 0x29, 0x09, 0xc8, 0xfe, 0x74, 0x45, 0x79, 0xe1, 0x5a, 0xa8, 0x4f, 0x9c, 0x6d, 0x9b, 0x79,
-	0xce, 0x0c, 0xe5, 0x85, 0x76, 0xcd, 0x93, 0x92, 0x08, 0x8f, 0x66, 0x34, 0xfd, 0x9f, 0x19, 0xbd,
-	0x81, 0x8e, 0xfd, 0x05, 0x02, 0xe8, 0x3d, 0xae, 0xe6, 0x1f, 0x9e, 0x56, 0xc3, 0xaf, 0x82, 0xbd,
-	0x20, 0x64, 0xd8, 0x42, 0x29, 0x74, 0xee, 0x3f, 0xac, 0x7e, 0x1b, 0x26, 0xf8, 0x3b, 0xb8, 0x88,
-	0xff, 0x9f, 0x3d, 0xef, 0xc4, 0xd6, 0x3e, 0x27, 0xa7, 0x86, 0xba, 0x14, 0x0d, 0x88, 0xb3, 0xf1,
-	0x0a, 0x46, 0x56, 0x14, 0x3b, 0xc4, 0x26, 0x8a, 0x2b, 0x56, 0x32, 0xe1, 0x27, 0x64, 0xb6, 0x7c,
-	0x9a, 0x49, 0xd5, 0x8c, 0x64, 0x83, 0x6d, 0x9f, 0x97, 0x74, 0xff, 0xee, 0xd0, 0x98, 0x6d, 0x72,
-	0x70, 0xb8, 0x45, 0xe2, 0x42, 0xda, 0x45, 0x62, 0x8d, 0x10, 0xd3, 0x03, 0xfc, 0x2d, 0xa4, 0x4b,
-	0x25, 0x37, 0x76, 0x6f, 0xd9, 0x47, 0x69, 0xfe, 0xc9, 0xd7, 0xad, 0x4d, 0x9c, 0x8d, 0x5f, 0x43,
-	0x3a, 0xdf, 0x29, 0x6a, 0xb8, 0x14, 0x68, 0x02, 0xe7, 0x82, 0x0a, 0xa9, 0x59, 0x26, 0x45, 0xae,
-	0x83, 0xec, 0xd8, 0x85, 0xbf, 0x07, 0x38, 0xec, 0x41, 0x9b, 0x5f, 0xea, 0xcd, 0x10, 0x33, 0x42,
-	0xbb, 0xbe, 0xe6, 0xd4, 0xd0, 0x47, 0xfe, 0xc9, 0xad, 0xaf, 0xf5, 0xd1, 0xaa, 0xf1, 0x00, 0xff,
-	0x08, 0x83, 0xa8, 0x20, 0xd4, 0xb8, 0xea, 0xaf, 0xb9, 0xd1, 0x4b, 0xa6, 0x1e, 0x5d, 0xac, 0xa0,
-	0x3e, 0x75, 0xe2, 0x37, 0xd0, 0x5d, 0x2a, 0x9e, 0x31, 0xf4, 0x03, 0xf4, 0xab, 0x13, 0xe9, 0xe7,
-	0x35, 0x3c, 0xd0, 0xeb, 0x9e, 0xdb, 0xfd, 0x6f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x55, 0xb2,
-	0x34, 0x2f, 0x47, 0x06, 0x00, 0x00,
+	// 844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0x9e, 0xe5, 0x3f, 0xf9, 0xd8, 0x69, 0x5c, 0x22, 0x28, 0x04, 0x63, 0x1b, 0x0c, 0xae, 0x18,
+	0x92, 0x21, 0xf0, 0x45, 0xba, 0x8b, 0x21, 0x17, 0x03, 0x16, 0xdb, 0xc3, 0x02, 0xac, 0xad, 0xc1,
+	0x38, 0x37, 0xbb, 0xa3, 0x25, 0xc2, 0x21, 0x2c, 0x91, 0x2a, 0x49, 0xad, 0x56, 0x1f, 0x64, 0x2f,
+	0xb0, 0x37, 0xdc, 0x13, 0x0c, 0xfc, 0x91, 0xed, 0xb4, 0xd8, 0xee, 0xce, 0x77, 0xbe, 0x4f, 0x3c,
+	0x3c, 0x3c, 0x3f, 0x82, 0x73, 0x2e, 0xb4, 0x14, 0x85, 0xe0, 0x74, 0x56, 0x2a, 0x69, 0x24, 0xea,
+	0x58, 0x38, 0x19, 0x6d, 0xf8, 0x96, 0x0b, 0xe3, 0x7d, 0x13, 0x94, 0xd2, 0x92, 0x6e, 0x78, 0xce,
+	0x0d, 0x67, 0x3a, 0xf8, 0xce, 0x0d, 0x2f, 0x98, 0x36, 0xb4, 0x28, 0xbd, 0x03, 0xf7, 0xa1, 0xbb,
+	0x2c, 0x4a, 0x53, 0xe3, 0x0b, 0x88, 0xee, 0x17, 0xe8, 0x05, 0x44, 0x3c, 0x4b, 0x5a, 0xd3, 0xd6,
+	0xe5, 0x80, 0x44, 0x3c, 0xc3, 0x57, 0xd0, 0x5d, 0x9a, 0xa7, 0xfb, 0x05, 0x9a, 0x1e, 0x88, 0xe1,
+	0xcd, 0x78, 0x66, 0xa3, 0xcd, 0x96, 0xe6, 0xe9, 0x97, 0x2c, 0x53, 0x4c, 0x6b, 0x27, 0xfd, 0x19,
+	0x7a, 0x6b, 0xaa, 0x77, 0x5f, 0x1e, 0x82, 0x5e, 0x43, 0x2f, 0x63, 0x34, 0xbf, 0x5f, 0x24, 0x91,
+	0xfb, 0x7e, 0xe4, 0xbf, 0xbf, 0xe3, 0xdb, 0x7b, 0x61, 0x48, 0xe0, 0xf0, 0x37, 0xd0, 0x9d, 0xcb,
+	0x4a, 0x18, 0x74, 0x01, 0xdd, 0xd4, 0x1a, 0xee, 0x84, 0x0e, 0xf1, 0x00, 0x4f, 0x21, 0x9e, 0xaf,
+	0x1e, 0x1f, 0x35, 0xdd, 0x32, 0xab, 0x30, 0xd2, 0xd0, 0xbc, 0x51, 0x38, 0x80, 0xaf, 0x60, 0xf8,
+	0x96, 0x15, 0x52, 0xd5, 0x5e, 0x34, 0x81, 0xb8, 0xa0, 0x7b, 0x67, 0x07, 0xdd, 0x01, 0xe3, 0x7f,
+	0x5a, 0x30, 0x7a, 0xc7, 0xcc, 0x47, 0xa9, 0x76, 0x5e, 0x9c, 0x40, 0xdf, 0xec, 0xef, 0x6a, 0xc3,
+	0x74, 0xd0, 0x36, 0xd0, 0x32, 0x2a, 0x30, 0x91, 0x67, 0x02, 0x44, 0x5f, 0xc3, 0xc0, 0xec, 0x57,
+	0x34, 0xdd, 0x31, 0xa3, 0x93, 0xb6, 0xe3, 0x8e, 0x0e, 0xcb, 0xaa, 0x03, 0xdb, 0xf1, 0xec, 0xc1,
+	0x61, 0x2f, 0x67, 0xf6, 0x4b, 0xa5, 0xa4, 0xd2, 0x49, 0xd7, 0x5f, 0xae, 0xc1, 0x96, 0x53, 0x0d,
+	0xd7, 0xf3, 0x5c, 0x83, 0x7d, 0xcc, 0x85, 0x92, 0x65, 0xc9, 0xb2, 0xa4, 0xdf, 0xc4, 0x0c, 0x0e,
+	0x1f, 0xb3, 0x61, 0xe3, 0x26, 0x66, 0x70, 0xe0, 0x5b, 0x18, 0x3d, 0x18, 0xa9, 0xe8, 0x96, 0x1d,
+	0x5e, 0x71, 0x73, 0x92, 0xb1, 0x07, 0xd6, 0xfb, 0xa1, 0x92, 0x86, 0x86, 0x6c, 0x3d, 0xc0, 0x7f,
+	0x45, 0x70, 0x46, 0x98, 0x96, 0x95, 0x4a, 0xc3, 0xd7, 0x53, 0x68, 0xa7, 0x65, 0x15, 0x3a, 0xe2,
+	0x85, 0xaf, 0x68, 0x53, 0x20, 0x62, 0x29, 0x74, 0x05, 0xbd, 0xc2, 0xd5, 0x23, 0x94, 0xfd, 0xa5,
+	0x17, 0x9d, 0xd4, 0x88, 0x04, 0x01, 0xba, 0x85, 0xbe, 0xf0, 0xe5, 0x48, 0xda, 0xd3, 0xf6, 0xe5,
+	0xf0, 0x66, 0xea, 0xb5, 0xcf, 0x42, 0xce, 0x42, 0xc5, 0x96, 0xc2, 0xa8, 0x9a, 0x34, 0x1f, 0xa0,
+	0x6b, 0xe8, 0x6b, 0x9f, 0x96, 0x7b, 0xe6, 0xe1, 0x0d, 0xf2, 0xdf, 0x9e, 0xe6, 0x4a, 0x1a, 0xc9,
+	0xe4, 0x1d, 0x8c, 0x4e, 0x8f, 0x41, 0x63, 0x68, 0xef, 0x58, 0x1d, 0x9a, 0xd5, 0x9a, 0xe8, 0x12,
+	0xba, 0x7f, 0xd2, 0xbc, 0x62, 0x49, 0x74, 0x7a, 0xda, 0x69, 0xb7, 0x10, 0x2f, 0xb8, 0x8d, 0x7e,
+	0x6a, 0xe1, 0x3f, 0xe0, 0xd5, 0x5c, 0x0a, 0x43, 0xb9, 0x60, 0x8a, 0xd8, 0xc9, 0x52, 0x66, 0x25,
+	0x73, 0x9e, 0xd6, 0x08, 0x41, 0x47, 0xd0, 0x82, 0x85, 0xa3, 0x9d, 0x8d, 0xae, 0xe1, 0x65, 0x41,
+	0xf7, 0xbc, 0xa8, 0x0a, 0xc2, 0x8c, 0xaa, 0x5d, 0xbf, 0xbb, 0x38, 0x67, 0xe4, 0x4b, 0x02, 0xff,
+	0x1d, 0xc1, 0xb9, 0x1d, 0xa9, 0xdf, 0xe5, 0x56, 0x13, 0xf6, 0xa1, 0x62, 0xda, 0xa0, 0x19, 0x74,
+	0x4c, 0x5d, 0xfa, 0x53, 0x5f, 0xdc, 0x4c, 0xfc, 0xe5, 0x3e, 0x13, 0xcd, 0xd6, 0x75, 0xc9, 0x88,
+	0xd3, 0x85, 0x59, 0x8c, 0x0e, 0xb3, 0x78, 0x01, 0x5d, 0xcd, 0x45, 0xca, 0x5c, 0xc3, 0x0e, 0x88,
+	0x07, 0xe8, 0x35, 0x9c, 0xd1, 0x2c, 0x5b, 0x37, 0xbb, 0xc1, 0x37, 0x6c, 0x4c, 0x9e, 0x3b, 0xd1,
+	0x2b, 0xe8, 0xfd, 0x2a, 0xf3, 0x5c, 0x7e, 0x74, 0x2d, 0x1b, 0x93, 0x80, 0x6c, 0xa6, 0x6b, 0xca,
+	0x73, 0xd7, 0xac, 0x03, 0xe2, 0x6c, 0x3b, 0x36, 0x0b, 0x66, 0x28, 0xcf, 0xb5, 0x6b, 0xd3, 0x98,
+	0x34, 0xf0, 0x64, 0x1b, 0xc4, 0xff, 0xb3, 0x0d, 0x2e, 0xa1, 0x63, 0xb3, 0x40, 0x00, 0xbd, 0x87,
+	0xf5, 0xe2, 0xfd, 0xe3, 0x7a, 0xfc, 0x55, 0xb0, 0x97, 0x84, 0x8c, 0x5b, 0x28, 0x86, 0xce, 0xdd,
+	0xfb, 0xf5, 0x6f, 0xe3, 0x08, 0x7f, 0x07, 0x67, 0x4d, 0xfe, 0xf3, 0xa7, 0x4a, 0xec, 0xec, 0x75,
+	0x32, 0x6a, 0xa8, 0x7b, 0xa2, 0x11, 0x71, 0x36, 0x5e, 0x43, 0x62, 0x45, 0x4d, 0x3f, 0xd9, 0x87,
+	0xe2, 0x8a, 0x15, 0x4c, 0xf8, 0x59, 0x9c, 0xaf, 0x1e, 0xe7, 0x52, 0x1d, 0x46, 0xe1, 0x80, 0xed,
+	0x44, 0x15, 0x74, 0xff, 0xf6, 0xd8, 0xc6, 0x6d, 0x72, 0x74, 0xb8, 0x95, 0xe5, 0x42, 0xda, 0x95,
+	0x65, 0x8d, 0x10, 0xd3, 0x03, 0xfc, 0x2d, 0xc4, 0x2b, 0x25, 0xb7, 0x76, 0x43, 0xda, 0x4b, 0x69,
+	0xfe, 0xc9, 0xd7, 0xad, 0x4d, 0x9c, 0x8d, 0xaf, 0x21, 0x5e, 0x54, 0x8a, 0x1a, 0x2e, 0x05, 0x9a,
+	0xc2, 0x50, 0x50, 0x21, 0x35, 0x4b, 0xa5, 0xc8, 0x74, 0x90, 0x9d, 0xba, 0xf0, 0xf7, 0x00, 0xc7,
+	0x8d, 0x6b, 0xdf, 0x97, 0x7a, 0x33, 0xc4, 0x6c, 0xa0, 0x5d, 0x94, 0x0b, 0x6a, 0xe8, 0x03, 0xff,
+	0xf4, 0x1f, 0x23, 0x8e, 0x7f, 0x84, 0x51, 0xa3, 0x20, 0xd4, 0xb8, 0xea, 0x6f, 0xb8, 0xd1, 0x2b,
+	0xa6, 0x1e, 0x5c, 0xac, 0xa0, 0x7e, 0xee, 0xc4, 0x6f, 0xa0, 0xbb, 0x52, 0x3c, 0x65, 0xe8, 0x07,
+	0x18, 0x94, 0xcf, 0xa4, 0x9f, 0xd7, 0xf0, 0x48, 0x6f, 0x7a, 0xee, 0x2f, 0xf3, 0xe6, 0xdf, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xc6, 0x37, 0x29, 0x6f, 0xb1, 0x06, 0x00, 0x00,
 }
@@ -11,6 +11,8 @@ import (
 const (
 	MinNumBenchmarks = 12
 	MinDealDuration  = time.Minute * 10
+	// MaxOrdersPerRequest limits the number of orders placed at once.
+	MaxOrdersPerRequest = 100
 )
 
 func (m *IdentityLevel) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -36,6 +38,26 @@ func (m *BidOrder) Validate() error {
 	return nil
 }
 
+func (m *CreateOrdersRequest) Validate() error {
+	count := m.GetCount()
+	if count == 0 {
+		count = 1
+	}
+
+	// The count is checked first, so that the product can not overflow.
+	if count > MaxOrdersPerRequest || uint64(len(m.GetOrders()))*count > MaxOrdersPerRequest {
+		return fmt.Errorf("at most %d orders can be placed at once", MaxOrdersPerRequest)
+	}
+
+	for _, order := range m.GetOrders() {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Benchmarks) Validate() error {
 	if len(m.Values) < MinNumBenchmarks {
 		return fmt.Errorf("expected at least %d benchmarks, got %d", MinNumBenchmarks, len(m.Values))
@@ -167,6 +167,97 @@ func (m *GetOrdersReply) GetOrders() []*Order {
 	return nil
 }
 
+type CreateOrdersRequest struct {
+	Orders []*BidOrder `protobuf:"bytes,1,rep,name=orders" json:"orders,omitempty"`
+	// Count is the number of replicas of each order, 1 if not set.
+	Count uint64 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *CreateOrdersRequest) Reset()                    { *m = CreateOrdersRequest{} }
+func (m *CreateOrdersRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateOrdersRequest) ProtoMessage()               {}
+func (*CreateOrdersRequest) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
+
+func (m *CreateOrdersRequest) GetOrders() []*BidOrder {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *CreateOrdersRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type OrderIDs struct {
+	Ids []*BigInt `protobuf:"bytes,1,rep,name=ids" json:"ids,omitempty"`
+}
+
+func (m *OrderIDs) Reset()                    { *m = OrderIDs{} }
+func (m *OrderIDs) String() string            { return proto.CompactTextString(m) }
+func (*OrderIDs) ProtoMessage()               {}
+func (*OrderIDs) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
+
+func (m *OrderIDs) GetIds() []*BigInt {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type OrderResult struct {
+	Id *BigInt `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Order is set for successfully created orders.
+	Order *Order `protobuf:"bytes,2,opt,name=order" json:"order,omitempty"`
+	// Error describes why the order could not be created or canceled.
+	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *OrderResult) Reset()                    { *m = OrderResult{} }
+func (m *OrderResult) String() string            { return proto.CompactTextString(m) }
+func (*OrderResult) ProtoMessage()               {}
+func (*OrderResult) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
+
+func (m *OrderResult) GetId() *BigInt {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *OrderResult) GetOrder() *Order {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (m *OrderResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type OrderResultsReply struct {
+	Results []*OrderResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *OrderResultsReply) Reset()                    { *m = OrderResultsReply{} }
+func (m *OrderResultsReply) String() string            { return proto.CompactTextString(m) }
+func (*OrderResultsReply) ProtoMessage()               {}
+func (*OrderResultsReply) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{4} }
+
+func (m *OrderResultsReply) GetResults() []*OrderResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 type Benchmarks struct {
 	Values []uint64 `protobuf:"varint,1,rep,packed,name=values" json:"values,omitempty"`
 }
@@ -174,7 +265,7 @@ type Benchmarks struct {
 func (m *Benchmarks) Reset()                    { *m = Benchmarks{} }
 func (m *Benchmarks) String() string            { return proto.CompactTextString(m) }
 func (*Benchmarks) ProtoMessage()               {}
-func (*Benchmarks) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
+func (*Benchmarks) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{5} }
 
 func (m *Benchmarks) GetValues() []uint64 {
 	if m != nil {
@@ -204,7 +295,7 @@ type Deal struct {
 func (m *Deal) Reset()                    { *m = Deal{} }
 func (m *Deal) String() string            { return proto.CompactTextString(m) }
 func (*Deal) ProtoMessage()               {}
-func (*Deal) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
+func (*Deal) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{6} }
 
 func (m *Deal) GetId() *BigInt {
 	if m != nil {
@@ -331,7 +422,7 @@ type Order struct {
 func (m *Order) Reset()                    { *m = Order{} }
 func (m *Order) String() string            { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()               {}
-func (*Order) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{3} }
+func (*Order) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{7} }
 
 func (m *Order) GetId() *BigInt {
 	if m != nil {
@@ -440,7 +531,7 @@ type BidNetwork struct {
 func (m *BidNetwork) Reset()                    { *m = BidNetwork{} }
 func (m *BidNetwork) String() string            { return proto.CompactTextString(m) }
 func (*BidNetwork) ProtoMessage()               {}
-func (*BidNetwork) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{4} }
+func (*BidNetwork) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{8} }
 
 func (m *BidNetwork) GetOverlay() bool {
 	if m != nil {
@@ -471,7 +562,7 @@ type BidResources struct {
 func (m *BidResources) Reset()                    { *m = BidResources{} }
 func (m *BidResources) String() string            { return proto.CompactTextString(m) }
 func (*BidResources) ProtoMessage()               {}
-func (*BidResources) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{5} }
+func (*BidResources) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{9} }
 
 func (m *BidResources) GetNetwork() *BidNetwork {
 	if m != nil {
@@ -501,7 +592,7 @@ type BidOrder struct {
 func (m *BidOrder) Reset()                    { *m = BidOrder{} }
 func (m *BidOrder) String() string            { return proto.CompactTextString(m) }
 func (*BidOrder) ProtoMessage()               {}
-func (*BidOrder) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{6} }
+func (*BidOrder) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{10} }
 
 func (m *BidOrder) GetID() string {
 	if m != nil {
@@ -561,6 +652,10 @@ func (m *BidOrder) GetResources() *BidResources {
 
 func init() {
 	proto.RegisterType((*GetOrdersReply)(nil), "sonm.GetOrdersReply")
+	proto.RegisterType((*CreateOrdersRequest)(nil), "sonm.CreateOrdersRequest")
+	proto.RegisterType((*OrderIDs)(nil), "sonm.OrderIDs")
+	proto.RegisterType((*OrderResult)(nil), "sonm.OrderResult")
+	proto.RegisterType((*OrderResultsReply)(nil), "sonm.OrderResultsReply")
 	proto.RegisterType((*Benchmarks)(nil), "sonm.Benchmarks")
 	proto.RegisterType((*Deal)(nil), "sonm.Deal")
 	proto.RegisterType((*Order)(nil), "sonm.Order")
@@ -597,6 +692,14 @@ type MarketClient interface {
 	GetOrderByID(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Order, error)
 	// CancelOrder removes active order from the Marketplace.
 	CancelOrder(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
+	// CreateOrders places several orders on the Marketplace at once,
+	// without waiting for each other's transactions.
+	CreateOrders(ctx context.Context, in *CreateOrdersRequest, opts ...grpc.CallOption) (*OrderResultsReply, error)
+	// CancelOrders removes the given active orders from the Marketplace.
+	CancelOrders(ctx context.Context, in *OrderIDs, opts ...grpc.CallOption) (*OrderResultsReply, error)
+	// PurgeOrders removes all active BID orders of the account from the
+	// Marketplace.
+	PurgeOrders(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OrderResultsReply, error)
 }
 
 type marketClient struct {
@@ -643,6 +746,33 @@ func (c *marketClient) CancelOrder(ctx context.Context, in *ID, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *marketClient) CreateOrders(ctx context.Context, in *CreateOrdersRequest, opts ...grpc.CallOption) (*OrderResultsReply, error) {
+	out := new(OrderResultsReply)
+	err := grpc.Invoke(ctx, "/sonm.Market/CreateOrders", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) CancelOrders(ctx context.Context, in *OrderIDs, opts ...grpc.CallOption) (*OrderResultsReply, error) {
+	out := new(OrderResultsReply)
+	err := grpc.Invoke(ctx, "/sonm.Market/CancelOrders", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) PurgeOrders(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*OrderResultsReply, error) {
+	out := new(OrderResultsReply)
+	err := grpc.Invoke(ctx, "/sonm.Market/PurgeOrders", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Market service
 
 type MarketServer interface {
@@ -658,6 +788,14 @@ type MarketServer interface {
 	GetOrderByID(context.Context, *ID) (*Order, error)
 	// CancelOrder removes active order from the Marketplace.
 	CancelOrder(context.Context, *ID) (*Empty, error)
+	// CreateOrders places several orders on the Marketplace at once,
+	// without waiting for each other's transactions.
+	CreateOrders(context.Context, *CreateOrdersRequest) (*OrderResultsReply, error)
+	// CancelOrders removes the given active orders from the Marketplace.
+	CancelOrders(context.Context, *OrderIDs) (*OrderResultsReply, error)
+	// PurgeOrders removes all active BID orders of the account from the
+	// Marketplace.
+	PurgeOrders(context.Context, *Empty) (*OrderResultsReply, error)
 }
 
 func RegisterMarketServer(s *grpc.Server, srv MarketServer) {
@@ -736,6 +874,60 @@ func _Market_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Market_CreateOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).CreateOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Market/CreateOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).CreateOrders(ctx, req.(*CreateOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_CancelOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderIDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).CancelOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Market/CancelOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).CancelOrders(ctx, req.(*OrderIDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_PurgeOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).PurgeOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Market/PurgeOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).PurgeOrders(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Market_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.Market",
 	HandlerType: (*MarketServer)(nil),
@@ -756,6 +948,18 @@ var _Market_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _Market_CancelOrder_Handler,
 		},
+		{
+			MethodName: "CreateOrders",
+			Handler:    _Market_CreateOrders_Handler,
+		},
+		{
+			MethodName: "CancelOrders",
+			Handler:    _Market_CancelOrders_Handler,
+		},
+		{
+			MethodName: "PurgeOrders",
+			Handler:    _Market_PurgeOrders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "marketplace.proto",
@@ -846,6 +1050,63 @@ var _Market_CancelOrderCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.ID"),
 }
 
+var _Market_CreateOrdersCmd = &cobra.Command{
+	Use:   "createOrders",
+	Short: "Make the CreateOrders method call, input-type: sonm.CreateOrdersRequest output-type: sonm.OrderResultsReply",
+	RunE: grpccmd.RunE(
+		"CreateOrders",
+		"sonm.CreateOrdersRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewMarketClient(cc)
+		},
+	),
+}
+
+var _Market_CreateOrdersCmd_gen = &cobra.Command{
+	Use:   "createOrders-gen",
+	Short: "Generate JSON for method call of CreateOrders (input-type: sonm.CreateOrdersRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.CreateOrdersRequest"),
+}
+
+var _Market_CancelOrdersCmd = &cobra.Command{
+	Use:   "cancelOrders",
+	Short: "Make the CancelOrders method call, input-type: sonm.OrderIDs output-type: sonm.OrderResultsReply",
+	RunE: grpccmd.RunE(
+		"CancelOrders",
+		"sonm.OrderIDs",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewMarketClient(cc)
+		},
+	),
+}
+
+var _Market_CancelOrdersCmd_gen = &cobra.Command{
+	Use:   "cancelOrders-gen",
+	Short: "Generate JSON for method call of CancelOrders (input-type: sonm.OrderIDs)",
+	RunE:  grpccmd.TypeToJson("sonm.OrderIDs"),
+}
+
+var _Market_PurgeOrdersCmd = &cobra.Command{
+	Use:   "purgeOrders",
+	Short: "Make the PurgeOrders method call, input-type: sonm.Empty output-type: sonm.OrderResultsReply",
+	RunE: grpccmd.RunE(
+		"PurgeOrders",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewMarketClient(cc)
+		},
+	),
+}
+
+var _Market_PurgeOrdersCmd_gen = &cobra.Command{
+	Use:   "purgeOrders-gen",
+	Short: "Generate JSON for method call of PurgeOrders (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_MarketCmd)
@@ -858,6 +1119,12 @@ func init() {
 		_Market_GetOrderByIDCmd_gen,
 		_Market_CancelOrderCmd,
 		_Market_CancelOrderCmd_gen,
+		_Market_CreateOrdersCmd,
+		_Market_CreateOrdersCmd_gen,
+		_Market_CancelOrdersCmd,
+		_Market_CancelOrdersCmd_gen,
+		_Market_PurgeOrdersCmd,
+		_Market_PurgeOrdersCmd_gen,
 	)
 }
 
@@ -866,75 +1133,83 @@ func init() {
 func init() { proto.RegisterFile("marketplace.proto", fileDescriptor7) }
 
 var fileDescriptor7 = []byte{
-	// 1111 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdf, 0x8f, 0xdb, 0x44,
-	0x10, 0x8e, 0x73, 0xb9, 0xfc, 0x98, 0x24, 0x4e, 0x6e, 0xef, 0x84, 0xac, 0xa8, 0x0f, 0x87, 0xa9,
-	0xca, 0x11, 0x95, 0x6b, 0x75, 0x2d, 0x52, 0x41, 0x42, 0x22, 0x89, 0x5d, 0x64, 0x7a, 0x4d, 0x8e,
-	0x4d, 0x0e, 0xd4, 0x17, 0xd0, 0x26, 0xde, 0xe6, 0x56, 0x71, 0xec, 0x60, 0xaf, 0x0f, 0x85, 0x37,
-	0x9e, 0xf9, 0x8b, 0x90, 0xe0, 0x8d, 0x3f, 0x0c, 0xed, 0xfa, 0x47, 0x36, 0xb9, 0x04, 0xfa, 0xe6,
-	0xf9, 0xe6, 0x9b, 0xf1, 0xec, 0xec, 0xb7, 0xb3, 0x0b, 0x27, 0x4b, 0x12, 0x2e, 0x28, 0x5f, 0x79,
-	0x64, 0x46, 0x2f, 0x57, 0x61, 0xc0, 0x03, 0x54, 0x8a, 0x02, 0x7f, 0xd9, 0x69, 0x4c, 0xd9, 0x9c,
-	0xf9, 0x3c, 0xc1, 0x3a, 0x2d, 0xe6, 0x0b, 0xd4, 0x67, 0x24, 0x03, 0x38, 0x5b, 0xd2, 0x88, 0x93,
-	0xe5, 0x2a, 0x01, 0xcc, 0x2f, 0x40, 0xff, 0x96, 0xf2, 0x51, 0xe8, 0xd2, 0x30, 0xc2, 0x74, 0xe5,
-	0xad, 0xd1, 0x27, 0x50, 0x0e, 0xa4, 0x69, 0x68, 0xe7, 0x47, 0x17, 0xf5, 0xab, 0xfa, 0xa5, 0x48,
-	0x71, 0x29, 0x29, 0x38, 0x75, 0x99, 0x8f, 0x01, 0xfa, 0xd4, 0x9f, 0xdd, 0x89, 0x32, 0x22, 0xf4,
-	0x11, 0x94, 0xef, 0x89, 0x17, 0xd3, 0x24, 0xa4, 0x84, 0x53, 0xcb, 0xfc, 0xe3, 0x18, 0x4a, 0x16,
-	0x25, 0x1e, 0x7a, 0x04, 0x45, 0xe6, 0x1a, 0xda, 0xb9, 0x76, 0x51, 0xbf, 0x6a, 0x24, 0xf9, 0xfa,
-	0x6c, 0xee, 0xf8, 0x1c, 0x17, 0x99, 0x8b, 0x9e, 0x03, 0x4c, 0xf3, 0x64, 0x46, 0x51, 0xb2, 0xda,
-	0x29, 0x2b, 0xc7, 0xb1, 0xc2, 0x11, 0x11, 0x51, 0xbc, 0x5a, 0x79, 0x8c, 0x86, 0x8e, 0x65, 0x1c,
-	0xa9, 0x11, 0x36, 0xbf, 0xeb, 0xb9, 0x6e, 0x48, 0xa3, 0x08, 0x2b, 0x1c, 0x11, 0x31, 0x0b, 0xfc,
-	0x28, 0x5e, 0xca, 0x88, 0xd2, 0xa1, 0x88, 0x0d, 0x07, 0x3d, 0x85, 0xea, 0x92, 0x44, 0x5c, 0xf2,
-	0x8f, 0x0f, 0xf0, 0x73, 0x06, 0x32, 0xe1, 0x98, 0x44, 0x0b, 0xc7, 0x32, 0xca, 0x7b, 0x16, 0x99,
-	0xb8, 0x04, 0x67, 0xca, 0x5c, 0xc7, 0x32, 0x2a, 0xfb, 0x38, 0xd2, 0x85, 0x3a, 0x50, 0x75, 0xe3,
-	0x90, 0x70, 0x16, 0xf8, 0x46, 0xf5, 0x5c, 0xbb, 0x28, 0xe1, 0xdc, 0x16, 0xf1, 0xab, 0x90, 0xcd,
-	0xa8, 0x51, 0xdb, 0x17, 0x2f, 0x5d, 0xe8, 0x73, 0xa8, 0x45, 0x9c, 0x84, 0x7c, 0xc2, 0x96, 0xd4,
-	0x00, 0xc9, 0x6b, 0x25, 0xbc, 0x49, 0xb6, 0xf3, 0x78, 0xc3, 0x40, 0x9f, 0x41, 0x85, 0xfa, 0xae,
-	0x24, 0xd7, 0xf7, 0x93, 0x33, 0x3f, 0xba, 0x80, 0x72, 0xc4, 0x09, 0x8f, 0x23, 0xa3, 0x71, 0xae,
-	0x5d, 0xe8, 0x59, 0x37, 0xc4, 0xfe, 0x8e, 0x25, 0x8e, 0x53, 0x3f, 0x7a, 0x09, 0xfa, 0xd4, 0x0b,
-	0x66, 0x0b, 0xea, 0xf6, 0x89, 0x47, 0xfc, 0x19, 0x35, 0x9a, 0x7b, 0x0a, 0xde, 0xe1, 0xa0, 0x4b,
-	0xa8, 0xf3, 0x80, 0x13, 0xef, 0x86, 0xac, 0x83, 0x98, 0x1b, 0xfa, 0x9e, 0x10, 0x95, 0x80, 0x9e,
-	0x01, 0x78, 0x24, 0xe2, 0x7d, 0xe6, 0x79, 0x93, 0xb1, 0xd1, 0xda, 0x5f, 0xbd, 0x42, 0x31, 0xff,
-	0x2a, 0xc1, 0xb1, 0x54, 0xf1, 0xff, 0xc8, 0xf1, 0x31, 0x94, 0x5d, 0x4a, 0x3c, 0xc7, 0x4a, 0xa5,
-	0xb8, 0xcd, 0x48, 0x7d, 0xa2, 0xd1, 0xf2, 0x2c, 0x4c, 0xd6, 0x2b, 0x2a, 0x15, 0xa8, 0x67, 0x7f,
-	0x1f, 0x65, 0x30, 0xde, 0x30, 0xd0, 0x0b, 0xa8, 0x4b, 0x23, 0x69, 0x95, 0x14, 0xa0, 0x7e, 0x75,
-	0xa2, 0x04, 0xa4, 0x3d, 0x54, 0x59, 0x42, 0x82, 0x24, 0xe6, 0x77, 0xc1, 0x7f, 0x4a, 0x30, 0x63,
-	0xa0, 0x57, 0xa0, 0xcf, 0x82, 0xd8, 0xe7, 0x34, 0x5c, 0x91, 0x90, 0xaf, 0x73, 0x2d, 0x3e, 0x8c,
-	0xd9, 0xe1, 0x6d, 0x89, 0xae, 0x72, 0x48, 0x74, 0xd5, 0xc3, 0xa2, 0xeb, 0x40, 0xd5, 0xa7, 0xfc,
-	0xbd, 0x47, 0xe6, 0x91, 0xd4, 0x66, 0x09, 0xe7, 0x36, 0xfa, 0x12, 0x9a, 0xcc, 0xa5, 0x3e, 0x67,
-	0x7c, 0x7d, 0x4d, 0xef, 0xa9, 0x27, 0x45, 0xa9, 0x5f, 0x9d, 0x26, 0x79, 0x1c, 0xd5, 0x85, 0xb7,
-	0x99, 0xe8, 0x11, 0xd4, 0xa6, 0x1e, 0x99, 0x2d, 0x3c, 0x16, 0x71, 0x29, 0xcf, 0x1a, 0xde, 0x00,
-	0xa8, 0x0d, 0x47, 0x9c, 0xcc, 0xa5, 0x18, 0x1b, 0x58, 0x7c, 0xee, 0xcc, 0x91, 0xe6, 0x07, 0xcc,
-	0x91, 0x2e, 0xd4, 0xde, 0x87, 0xc1, 0x6f, 0xd4, 0x1f, 0xc7, 0xcb, 0xbd, 0x8a, 0xdb, 0xb8, 0xcd,
-	0x9f, 0x00, 0xfa, 0xcc, 0x1d, 0x52, 0xfe, 0x6b, 0x10, 0x2e, 0x90, 0x01, 0x95, 0xe0, 0x9e, 0x86,
-	0x1e, 0x59, 0x4b, 0x1d, 0x55, 0x71, 0x66, 0x8a, 0x66, 0x04, 0x31, 0x9f, 0x06, 0xb1, 0xef, 0x4a,
-	0x01, 0x55, 0x71, 0x6e, 0x0b, 0x1f, 0xf3, 0x67, 0xc1, 0x92, 0xf9, 0x73, 0xa9, 0x99, 0x2a, 0xce,
-	0x6d, 0xf3, 0x6f, 0x0d, 0x1a, 0x7d, 0xe6, 0x62, 0x1a, 0x05, 0x71, 0x38, 0xa3, 0xa2, 0xb8, 0x8a,
-	0x9f, 0xfc, 0x2d, 0x95, 0x6a, 0xb6, 0x96, 0xbc, 0x0a, 0x9c, 0x11, 0x50, 0x7f, 0x67, 0x84, 0x8a,
-	0xc1, 0x6d, 0xe6, 0xf4, 0x3c, 0xa7, 0xd2, 0x07, 0xdb, 0xe7, 0xe1, 0x5a, 0x6d, 0x46, 0xe7, 0x6b,
-	0x68, 0xed, 0xb8, 0x45, 0x8f, 0x17, 0x34, 0x59, 0x61, 0x0d, 0x8b, 0x4f, 0x74, 0x06, 0xc7, 0x72,
-	0xb8, 0xcb, 0xa5, 0x95, 0x70, 0x62, 0x7c, 0x55, 0x7c, 0xa5, 0x99, 0xff, 0x14, 0xa1, 0xda, 0x67,
-	0x6e, 0x72, 0xc2, 0x74, 0x28, 0x3a, 0x56, 0x1a, 0x57, 0x74, 0x2c, 0xd4, 0x55, 0x14, 0x96, 0x9c,
-	0x2a, 0x3d, 0x1d, 0x1f, 0x29, 0xaa, 0x28, 0xee, 0xe3, 0x4c, 0x71, 0xc9, 0x5c, 0x4f, 0xef, 0x9f,
-	0x1b, 0x01, 0x65, 0x82, 0xbb, 0x54, 0x95, 0x71, 0x68, 0x98, 0x2b, 0x5a, 0x79, 0x06, 0xd5, 0x4c,
-	0x5a, 0xf2, 0x20, 0x1d, 0xd0, 0x5f, 0x4e, 0xca, 0xc4, 0x55, 0x4e, 0x16, 0x2e, 0xc4, 0xf5, 0x12,
-	0x1a, 0x03, 0xe5, 0xd4, 0xa4, 0x33, 0xfc, 0xe1, 0x5f, 0xb7, 0x58, 0xe8, 0x39, 0xd4, 0xc2, 0xac,
-	0xf9, 0xe9, 0x09, 0x42, 0x0f, 0xb7, 0x05, 0x6f, 0x48, 0xdd, 0x27, 0x50, 0xcb, 0x07, 0x08, 0xaa,
-	0xc0, 0x51, 0x6f, 0xf8, 0xae, 0x5d, 0x10, 0x1f, 0x7d, 0xc7, 0x6a, 0x6b, 0x12, 0x19, 0xbf, 0x69,
-	0x17, 0xbb, 0xaf, 0xa1, 0xae, 0xcc, 0x0d, 0x74, 0x02, 0xcd, 0x11, 0xb6, 0x6c, 0xfc, 0xf3, 0xed,
-	0xf0, 0xcd, 0x70, 0xf4, 0xe3, 0xb0, 0x5d, 0x40, 0x08, 0xf4, 0x04, 0x72, 0x86, 0xbd, 0xc1, 0xc4,
-	0xf9, 0xc1, 0x6e, 0x6b, 0xa8, 0x0d, 0x8d, 0x04, 0x4b, 0x91, 0x62, 0xf7, 0x1b, 0x68, 0x6e, 0x35,
-	0x01, 0x35, 0xa1, 0xd6, 0x1b, 0x8e, 0x86, 0xef, 0xde, 0x8e, 0x6e, 0xc7, 0xed, 0x82, 0x88, 0xb8,
-	0x19, 0xdb, 0xb7, 0x56, 0x86, 0x68, 0x48, 0x07, 0x70, 0x2c, 0x7b, 0x38, 0x71, 0x5e, 0x3b, 0xb6,
-	0xd5, 0x2e, 0x76, 0xfb, 0x00, 0x9b, 0x4b, 0x40, 0xf0, 0x2d, 0xbb, 0x77, 0xad, 0xd4, 0x71, 0x02,
-	0x4d, 0x89, 0xf4, 0x06, 0x03, 0xfb, 0x66, 0x62, 0x8b, 0x55, 0xb4, 0xa0, 0x2e, 0xa1, 0xc1, 0xf5,
-	0x68, 0x2c, 0x73, 0xfc, 0xae, 0xc1, 0xe9, 0xe0, 0x8e, 0xf8, 0x73, 0x8a, 0xe9, 0x2f, 0x31, 0x8d,
-	0x78, 0x9a, 0xed, 0x14, 0x5a, 0xd8, 0xfe, 0xfe, 0xd6, 0x1e, 0x4f, 0x94, 0x84, 0x0a, 0x38, 0xc0,
-	0x76, 0x2f, 0x49, 0x79, 0x06, 0xed, 0x1c, 0xec, 0x0d, 0x07, 0xf6, 0xb5, 0xc8, 0xab, 0xa2, 0xd8,
-	0xfe, 0xce, 0x1e, 0x08, 0xee, 0x91, 0x8a, 0xe6, 0x45, 0x95, 0xae, 0xfe, 0xd4, 0xa0, 0xfc, 0x56,
-	0x3e, 0xab, 0xc4, 0xb6, 0xe5, 0xaf, 0x22, 0x94, 0x0a, 0x50, 0xee, 0x6b, 0xe7, 0x2c, 0x31, 0xb6,
-	0xdf, 0x4c, 0x66, 0x01, 0x3d, 0x85, 0xfa, 0x20, 0xa4, 0x84, 0xd3, 0x54, 0xff, 0xf9, 0x26, 0x4b,
-	0xbb, 0xa3, 0x3e, 0xa2, 0xcc, 0x02, 0xfa, 0x14, 0x1a, 0x59, 0x86, 0xbe, 0x18, 0xc0, 0xd5, 0x54,
-	0x8d, 0xd6, 0x2e, 0xf1, 0x09, 0xd4, 0x07, 0xe2, 0x76, 0xf4, 0x92, 0xb4, 0x0f, 0x78, 0xf6, 0x72,
-	0xc5, 0xd7, 0x66, 0x61, 0x5a, 0x96, 0xaf, 0xb9, 0x17, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xfd,
-	0xdf, 0x9c, 0x1c, 0x18, 0x0a, 0x00, 0x00,
+	// 1244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5d, 0x6f, 0xdb, 0x36,
+	0x17, 0x8e, 0xfc, 0xed, 0x63, 0x5b, 0x71, 0x98, 0xe2, 0x7d, 0x35, 0xa3, 0x18, 0x52, 0xad, 0xe8,
+	0x32, 0xaf, 0x4b, 0x8b, 0x7e, 0x00, 0xed, 0x80, 0x01, 0xb5, 0x2d, 0x75, 0xd0, 0x9a, 0xda, 0x19,
+	0xed, 0x6c, 0xe8, 0xcd, 0x06, 0xda, 0x62, 0x1d, 0x21, 0xb2, 0xe4, 0x49, 0x54, 0x07, 0xef, 0x6e,
+	0xd7, 0xfb, 0x4b, 0xdb, 0xdd, 0xfe, 0xd0, 0xfe, 0xc1, 0x40, 0x4a, 0x94, 0x69, 0xc7, 0x4e, 0x77,
+	0xa7, 0xf3, 0x9c, 0xe7, 0x1c, 0x92, 0x47, 0x0f, 0x0f, 0x0f, 0x1c, 0x2d, 0x48, 0x74, 0x4d, 0xd9,
+	0xd2, 0x27, 0x33, 0x7a, 0xb6, 0x8c, 0x42, 0x16, 0xa2, 0x52, 0x1c, 0x06, 0x8b, 0x4e, 0x73, 0xea,
+	0xcd, 0xbd, 0x80, 0xa5, 0x58, 0xe7, 0xd0, 0x0b, 0x38, 0x1a, 0x78, 0x44, 0x02, 0xcc, 0x5b, 0xd0,
+	0x98, 0x91, 0xc5, 0x32, 0x05, 0xcc, 0xe7, 0xa0, 0x7f, 0x4b, 0xd9, 0x28, 0x72, 0x69, 0x14, 0x63,
+	0xba, 0xf4, 0x57, 0xe8, 0x33, 0xa8, 0x84, 0xc2, 0x34, 0xb4, 0x93, 0xe2, 0x69, 0xe3, 0x49, 0xe3,
+	0x8c, 0xa7, 0x38, 0x13, 0x14, 0x9c, 0xb9, 0xcc, 0x31, 0x1c, 0x0f, 0x22, 0x4a, 0x18, 0x95, 0x91,
+	0xbf, 0x24, 0x34, 0x66, 0xe8, 0xc1, 0x56, 0xac, 0x9e, 0xc6, 0xf6, 0x3d, 0x77, 0x23, 0x1c, 0xdd,
+	0x81, 0xf2, 0x2c, 0x4c, 0x02, 0x66, 0x14, 0x4e, 0xb4, 0xd3, 0x12, 0x4e, 0x0d, 0xb3, 0x0b, 0x35,
+	0x41, 0x73, 0xac, 0x18, 0x7d, 0x0a, 0x45, 0xcf, 0x95, 0x69, 0x9a, 0x32, 0xcd, 0xdc, 0x09, 0x18,
+	0xe6, 0x0e, 0xd3, 0x85, 0x46, 0x9a, 0x92, 0xc6, 0x89, 0xcf, 0xd0, 0x5d, 0x28, 0x78, 0xae, 0xa1,
+	0x9d, 0x68, 0x37, 0xd8, 0x05, 0xcf, 0x45, 0xf7, 0xa0, 0x2c, 0x16, 0x16, 0xcb, 0x6d, 0x9d, 0x28,
+	0xf5, 0xf0, 0x1d, 0xd1, 0x28, 0x0a, 0x23, 0xa3, 0x78, 0xa2, 0x9d, 0xd6, 0x71, 0x6a, 0x98, 0xaf,
+	0xe0, 0x48, 0x59, 0x25, 0x2b, 0xd0, 0x97, 0x50, 0x8d, 0x52, 0x3b, 0xdb, 0xde, 0x91, 0x9a, 0x4f,
+	0x78, 0xb0, 0x64, 0x98, 0xf7, 0x01, 0xfa, 0x34, 0x98, 0x5d, 0xf1, 0xff, 0x15, 0xa3, 0xff, 0x41,
+	0xe5, 0x03, 0xf1, 0x13, 0x9a, 0x46, 0x96, 0x70, 0x66, 0x99, 0x7f, 0x94, 0xa1, 0x64, 0x51, 0xe2,
+	0x7f, 0xe4, 0x1c, 0x8f, 0x01, 0xa6, 0x79, 0xb2, 0xec, 0x30, 0xed, 0x8c, 0x95, 0xe3, 0x58, 0xe1,
+	0xf0, 0x88, 0x38, 0x59, 0x2e, 0x7d, 0x8f, 0x57, 0xd5, 0x28, 0xaa, 0x11, 0x36, 0xbb, 0xea, 0xb9,
+	0x6e, 0x44, 0xe3, 0x18, 0x2b, 0x1c, 0x1e, 0x31, 0x0b, 0x83, 0x38, 0x59, 0x88, 0x88, 0xd2, 0xbe,
+	0x88, 0x35, 0x07, 0x3d, 0x84, 0xda, 0x82, 0xc4, 0x4c, 0xf0, 0xcb, 0x7b, 0xf8, 0x39, 0x03, 0x99,
+	0x50, 0x26, 0xf1, 0xb5, 0x63, 0x19, 0x95, 0x1d, 0x87, 0x4c, 0x5d, 0x9c, 0x33, 0xf5, 0x5c, 0xc7,
+	0x32, 0xaa, 0xbb, 0x38, 0xc2, 0x85, 0x3a, 0x50, 0x73, 0x93, 0x88, 0x30, 0x2f, 0x0c, 0x8c, 0x9a,
+	0x50, 0x51, 0x6e, 0xf3, 0xf8, 0x65, 0xe4, 0xcd, 0xa8, 0x51, 0xdf, 0x15, 0x2f, 0x5c, 0xe8, 0x2b,
+	0xa8, 0xc7, 0x8c, 0x44, 0x6c, 0xe2, 0x2d, 0xa8, 0x01, 0x82, 0x77, 0x98, 0xf2, 0x26, 0xf2, 0x8a,
+	0xe0, 0x35, 0x03, 0x7d, 0x01, 0x55, 0x1a, 0xb8, 0x82, 0xdc, 0xd8, 0x4d, 0x96, 0x7e, 0x74, 0x0a,
+	0x95, 0x98, 0x11, 0x96, 0xc4, 0x46, 0xf3, 0x44, 0x3b, 0xd5, 0x65, 0x35, 0xf8, 0xff, 0x1d, 0x0b,
+	0x1c, 0x67, 0x7e, 0xf4, 0x0c, 0xf4, 0xa9, 0x1f, 0xce, 0xae, 0xa9, 0xdb, 0x27, 0x3e, 0x09, 0x66,
+	0xd4, 0x68, 0xed, 0xd8, 0xf0, 0x16, 0x07, 0x9d, 0x41, 0x83, 0x85, 0x8c, 0xf8, 0x17, 0x64, 0x15,
+	0x26, 0xcc, 0xd0, 0x77, 0x84, 0xa8, 0x04, 0xf4, 0x08, 0xc0, 0x27, 0x31, 0xeb, 0x7b, 0xbe, 0x3f,
+	0x19, 0x1b, 0x87, 0xbb, 0x77, 0xaf, 0x50, 0xcc, 0x3f, 0x4b, 0x50, 0x16, 0x62, 0xfe, 0x88, 0x1c,
+	0xef, 0x43, 0xc5, 0xa5, 0xc4, 0x77, 0x2c, 0xa3, 0xb0, 0x83, 0x91, 0xf9, 0x78, 0xa1, 0xc5, 0x15,
+	0x9b, 0xac, 0x96, 0x54, 0x28, 0x50, 0x97, 0xab, 0x8f, 0x24, 0x8c, 0xd7, 0x0c, 0xf4, 0x14, 0x1a,
+	0xc2, 0x48, 0x4b, 0x25, 0x04, 0xa8, 0x6f, 0xdc, 0xb0, 0xac, 0x86, 0x2a, 0x8b, 0x4b, 0x90, 0x24,
+	0xec, 0x2a, 0xbc, 0x55, 0x82, 0x92, 0x81, 0x5e, 0x80, 0x2e, 0x1a, 0x0e, 0x8d, 0x96, 0x24, 0x62,
+	0xab, 0x5c, 0x8b, 0x37, 0x63, 0xb6, 0x78, 0x1b, 0xa2, 0xab, 0xee, 0x13, 0x5d, 0x6d, 0xbf, 0xe8,
+	0x3a, 0x50, 0x0b, 0x28, 0x7b, 0xef, 0x93, 0x79, 0x2c, 0xb4, 0x59, 0xc2, 0xb9, 0x8d, 0x5e, 0x42,
+	0xcb, 0x73, 0x69, 0xc0, 0x3c, 0xb6, 0x3a, 0xa7, 0x1f, 0xa8, 0x2f, 0x44, 0xa9, 0x3f, 0x39, 0x4e,
+	0xf3, 0x38, 0xaa, 0x0b, 0x6f, 0x32, 0xd1, 0x5d, 0xa8, 0x4f, 0x7d, 0x32, 0xbb, 0xf6, 0xbd, 0x98,
+	0x09, 0x79, 0xd6, 0xf1, 0x1a, 0x40, 0x6d, 0x28, 0x32, 0x32, 0x17, 0x62, 0x6c, 0x62, 0xfe, 0xb9,
+	0xd5, 0x47, 0x5a, 0xff, 0xa1, 0x8f, 0x74, 0xa1, 0xfe, 0x3e, 0x0a, 0x7f, 0xa3, 0xc1, 0x38, 0x59,
+	0xec, 0x54, 0xdc, 0xda, 0x6d, 0xfe, 0x04, 0xd0, 0xf7, 0xdc, 0x21, 0x65, 0xbf, 0x86, 0xd1, 0x35,
+	0x32, 0xa0, 0x1a, 0x7e, 0xa0, 0x91, 0x4f, 0x56, 0x42, 0x47, 0x35, 0x2c, 0x4d, 0x5e, 0x8c, 0x30,
+	0x61, 0xd3, 0x30, 0x09, 0x5c, 0x21, 0xa0, 0x1a, 0xce, 0x6d, 0xee, 0xf3, 0x82, 0x59, 0xb8, 0xf0,
+	0x82, 0xb9, 0xd0, 0x4c, 0x0d, 0xe7, 0xb6, 0xf9, 0x97, 0x06, 0xcd, 0xbe, 0xe7, 0x62, 0x1a, 0x87,
+	0x49, 0x34, 0xa3, 0x7c, 0x73, 0xd5, 0x20, 0x5d, 0xcd, 0xd0, 0x36, 0xce, 0x92, 0xef, 0x02, 0x4b,
+	0x02, 0xea, 0x6f, 0xb5, 0x50, 0xde, 0xbf, 0xcd, 0x9c, 0x9e, 0xe7, 0x54, 0xea, 0x60, 0x07, 0x2c,
+	0x5a, 0xa9, 0xc5, 0xe8, 0x7c, 0x03, 0x87, 0x5b, 0x6e, 0x5e, 0xe3, 0x6b, 0x9a, 0x9e, 0xb0, 0x8e,
+	0xf9, 0x27, 0x7f, 0x50, 0x44, 0x73, 0x97, 0x4f, 0x9c, 0x30, 0xbe, 0x2e, 0xbc, 0xd0, 0xcc, 0xbf,
+	0x0b, 0x50, 0x93, 0x2f, 0x22, 0xd2, 0xa1, 0xe0, 0x58, 0x59, 0x5c, 0xc1, 0xb1, 0x50, 0x57, 0x51,
+	0x58, 0x7a, 0xab, 0xb2, 0x37, 0xd4, 0xca, 0x50, 0x45, 0x71, 0xf7, 0xa4, 0xe2, 0x8a, 0xea, 0xb3,
+	0x76, 0xc1, 0x21, 0x29, 0xb8, 0x33, 0x55, 0x19, 0xfb, 0x9a, 0xf9, 0x9a, 0x82, 0x1e, 0x41, 0x4d,
+	0x4a, 0xcb, 0x28, 0xef, 0xd7, 0x5f, 0x4e, 0x92, 0xe2, 0xaa, 0xa4, 0x07, 0xe7, 0xe2, 0x7a, 0x06,
+	0xcd, 0x81, 0x72, 0x6b, 0x8c, 0xea, 0x9e, 0x55, 0x37, 0x58, 0xe8, 0x31, 0xd4, 0x23, 0x59, 0xfc,
+	0xec, 0x06, 0xa1, 0x9b, 0xbf, 0x05, 0xaf, 0x49, 0xdd, 0x07, 0x50, 0xcf, 0x1b, 0x08, 0xaa, 0x42,
+	0xb1, 0x37, 0x7c, 0xd7, 0x3e, 0xe0, 0x1f, 0x7d, 0xc7, 0x6a, 0x6b, 0x02, 0x19, 0xbf, 0x69, 0x17,
+	0xba, 0xaf, 0xb3, 0x49, 0x21, 0x6b, 0x15, 0x47, 0xd0, 0x1a, 0x61, 0xcb, 0xc6, 0x3f, 0x5f, 0x0e,
+	0xdf, 0x0c, 0x47, 0x3f, 0x0e, 0xdb, 0x07, 0x08, 0x81, 0x9e, 0x42, 0xce, 0xb0, 0x37, 0x98, 0x38,
+	0x3f, 0xd8, 0x6d, 0x0d, 0xb5, 0xa1, 0x99, 0x62, 0x19, 0x52, 0xe8, 0xbe, 0x82, 0xd6, 0x46, 0x11,
+	0x50, 0x0b, 0xea, 0xbd, 0xe1, 0x68, 0xf8, 0xee, 0xed, 0xe8, 0x72, 0xdc, 0x3e, 0xe0, 0x11, 0x17,
+	0x63, 0xfb, 0xd2, 0x92, 0x88, 0x86, 0x74, 0x00, 0xc7, 0xb2, 0x87, 0x13, 0xe7, 0xb5, 0x63, 0x5b,
+	0xed, 0x42, 0xb7, 0x0f, 0xb0, 0x7e, 0x04, 0x38, 0xdf, 0xb2, 0x7b, 0xe7, 0xca, 0x3e, 0x8e, 0xa0,
+	0x25, 0x90, 0xde, 0x60, 0x60, 0x5f, 0x4c, 0x6c, 0x7e, 0x8a, 0x43, 0x68, 0x08, 0x68, 0x70, 0x3e,
+	0x1a, 0x8b, 0x1c, 0xbf, 0x6b, 0x70, 0x3c, 0xb8, 0x22, 0xc1, 0x9c, 0x66, 0x33, 0x57, 0x96, 0xed,
+	0x18, 0x0e, 0xb1, 0xfd, 0xfd, 0xa5, 0x3d, 0x9e, 0x28, 0x09, 0x15, 0x70, 0x80, 0xed, 0x5e, 0x9a,
+	0xf2, 0x0e, 0xb4, 0x73, 0xb0, 0x37, 0x1c, 0xd8, 0xe7, 0x3c, 0xaf, 0x8a, 0x62, 0xfb, 0x3b, 0x7b,
+	0xc0, 0xb9, 0x45, 0x15, 0xcd, 0x37, 0x55, 0x7a, 0xf2, 0x4f, 0x01, 0x2a, 0x6f, 0xc5, 0xfc, 0xc9,
+	0x7f, 0x5b, 0x3e, 0x3e, 0xa2, 0x4c, 0x80, 0xe2, 0xbf, 0x76, 0xee, 0xa4, 0xc6, 0xe6, 0x70, 0x69,
+	0x1e, 0xa0, 0x87, 0xd0, 0x50, 0x26, 0x47, 0xb4, 0x35, 0x21, 0x76, 0xd4, 0xd9, 0xcc, 0x3c, 0x40,
+	0x9f, 0x43, 0x53, 0x66, 0xe8, 0xf3, 0x06, 0x5c, 0xcb, 0xd4, 0x68, 0x6d, 0x13, 0x1f, 0x40, 0x63,
+	0xc0, 0x5f, 0x47, 0x3f, 0x4d, 0x7b, 0x83, 0x67, 0x2f, 0x96, 0x8c, 0x2f, 0x6f, 0x41, 0x53, 0x59,
+	0x3e, 0x46, 0x9f, 0x64, 0x7b, 0xbe, 0x39, 0xcc, 0x76, 0xfe, 0x7f, 0x63, 0xac, 0xcb, 0x0f, 0xf1,
+	0x12, 0x9a, 0xca, 0x6a, 0xb1, 0x3c, 0x85, 0x9c, 0x5e, 0x6f, 0x0b, 0x7d, 0x0e, 0x8d, 0x8b, 0x24,
+	0x9a, 0xd3, 0xcd, 0x9a, 0x89, 0xed, 0xdd, 0x12, 0x36, 0xad, 0x88, 0x71, 0xfd, 0xe9, 0xbf, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0xae, 0x17, 0x3d, 0xc7, 0xf9, 0x0b, 0x00, 0x00,
 }
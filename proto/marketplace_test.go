@@ -1,6 +1,7 @@
 package sonm
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -29,3 +30,13 @@ func TestBidOrderValidate(t *testing.T) {
 	err = bid.Validate()
 	require.NoError(t, err)
 }
+
+func TestCreateOrdersRequestValidate(t *testing.T) {
+	orders := []*BidOrder{{}, {}}
+
+	require.NoError(t, (&CreateOrdersRequest{Orders: orders}).Validate())
+	require.NoError(t, (&CreateOrdersRequest{Orders: orders, Count: MaxOrdersPerRequest / 2}).Validate())
+	require.Error(t, (&CreateOrdersRequest{Orders: orders, Count: MaxOrdersPerRequest/2 + 1}).Validate())
+	require.Error(t, (&CreateOrdersRequest{Orders: orders, Count: math.MaxUint64}).Validate())
+	require.Error(t, (&CreateOrdersRequest{Orders: []*BidOrder{{Tag: "this-string-is-too-long-for-tag-value"}}}).Validate())
+}
@@ -25,6 +25,75 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type TokenTransferDirection int32
+
+const (
+	TokenTransferDirection_DEPOSIT    TokenTransferDirection = 0
+	TokenTransferDirection_WITHDRAWAL TokenTransferDirection = 1
+)
+
+var TokenTransferDirection_name = map[int32]string{
+	0: "DEPOSIT",
+	1: "WITHDRAWAL",
+}
+var TokenTransferDirection_value = map[string]int32{
+	"DEPOSIT":    0,
+	"WITHDRAWAL": 1,
+}
+
+func (x TokenTransferDirection) String() string {
+	return proto.EnumName(TokenTransferDirection_name, int32(x))
+}
+func (TokenTransferDirection) EnumDescriptor() ([]byte, []int) { return fileDescriptor9, []int{0} }
+
+type TokenTransferStatus int32
+
+const (
+	TokenTransferStatus_TRANSFER_UNKNOWN TokenTransferStatus = 0
+	// Tokens are paid in to the Gatekeeper, but not paid out
+	// on the other chain yet.
+	TokenTransferStatus_TRANSFER_PENDING TokenTransferStatus = 1
+	// Tokens are paid out on the other chain.
+	TokenTransferStatus_TRANSFER_COMPLETED TokenTransferStatus = 2
+)
+
+var TokenTransferStatus_name = map[int32]string{
+	0: "TRANSFER_UNKNOWN",
+	1: "TRANSFER_PENDING",
+	2: "TRANSFER_COMPLETED",
+}
+var TokenTransferStatus_value = map[string]int32{
+	"TRANSFER_UNKNOWN":   0,
+	"TRANSFER_PENDING":   1,
+	"TRANSFER_COMPLETED": 2,
+}
+
+func (x TokenTransferStatus) String() string {
+	return proto.EnumName(TokenTransferStatus_name, int32(x))
+}
+func (TokenTransferStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor9, []int{1} }
+
+type TokenChain int32
+
+const (
+	TokenChain_SIDE_CHAIN TokenChain = 0
+	TokenChain_LIVE_CHAIN TokenChain = 1
+)
+
+var TokenChain_name = map[int32]string{
+	0: "SIDE_CHAIN",
+	1: "LIVE_CHAIN",
+}
+var TokenChain_value = map[string]int32{
+	"SIDE_CHAIN": 0,
+	"LIVE_CHAIN": 1,
+}
+
+func (x TokenChain) String() string {
+	return proto.EnumName(TokenChain_name, int32(x))
+}
+func (TokenChain) EnumDescriptor() ([]byte, []int) { return fileDescriptor9, []int{2} }
+
 type JoinNetworkRequest struct {
 	TaskID    *TaskID `protobuf:"bytes,1,opt,name=taskID" json:"taskID,omitempty"`
 	NetworkID string  `protobuf:"bytes,2,opt,name=NetworkID" json:"NetworkID,omitempty"`
@@ -105,6 +174,154 @@ func (m *DealsReply) GetDeal() []*Deal {
 	return nil
 }
 
+type DealSpending struct {
+	DealID *BigInt `protobuf:"bytes,1,opt,name=dealID" json:"dealID,omitempty"`
+	// Price is the deal price in USD per second.
+	Price          *BigInt    `protobuf:"bytes,2,opt,name=price" json:"price,omitempty"`
+	BlockedBalance *BigInt    `protobuf:"bytes,3,opt,name=blockedBalance" json:"blockedBalance,omitempty"`
+	TotalPayout    *BigInt    `protobuf:"bytes,4,opt,name=totalPayout" json:"totalPayout,omitempty"`
+	LastBillTS     *Timestamp `protobuf:"bytes,5,opt,name=lastBillTS" json:"lastBillTS,omitempty"`
+	// Accrued is the amount of SNM earned by the supplier since the last bill.
+	Accrued *BigInt `protobuf:"bytes,6,opt,name=accrued" json:"accrued,omitempty"`
+	// NextBillTS is the expected time of the next bill.
+	NextBillTS *Timestamp `protobuf:"bytes,7,opt,name=nextBillTS" json:"nextBillTS,omitempty"`
+	// PaidUntil is the time the blocked balance is exhausted at.
+	PaidUntil *Timestamp `protobuf:"bytes,8,opt,name=paidUntil" json:"paidUntil,omitempty"`
+	// Forecast is the amount the deal is expected to have spent in total
+	// by the end of the accounting horizon.
+	Forecast *BigInt `protobuf:"bytes,9,opt,name=forecast" json:"forecast,omitempty"`
+	// OverBudget is set when the deal would overdraw the configured budget.
+	OverBudget bool `protobuf:"varint,10,opt,name=overBudget" json:"overBudget,omitempty"`
+}
+
+func (m *DealSpending) Reset()                    { *m = DealSpending{} }
+func (m *DealSpending) String() string            { return proto.CompactTextString(m) }
+func (*DealSpending) ProtoMessage()               {}
+func (*DealSpending) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{4} }
+
+func (m *DealSpending) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *DealSpending) GetPrice() *BigInt {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *DealSpending) GetBlockedBalance() *BigInt {
+	if m != nil {
+		return m.BlockedBalance
+	}
+	return nil
+}
+
+func (m *DealSpending) GetTotalPayout() *BigInt {
+	if m != nil {
+		return m.TotalPayout
+	}
+	return nil
+}
+
+func (m *DealSpending) GetLastBillTS() *Timestamp {
+	if m != nil {
+		return m.LastBillTS
+	}
+	return nil
+}
+
+func (m *DealSpending) GetAccrued() *BigInt {
+	if m != nil {
+		return m.Accrued
+	}
+	return nil
+}
+
+func (m *DealSpending) GetNextBillTS() *Timestamp {
+	if m != nil {
+		return m.NextBillTS
+	}
+	return nil
+}
+
+func (m *DealSpending) GetPaidUntil() *Timestamp {
+	if m != nil {
+		return m.PaidUntil
+	}
+	return nil
+}
+
+func (m *DealSpending) GetForecast() *BigInt {
+	if m != nil {
+		return m.Forecast
+	}
+	return nil
+}
+
+func (m *DealSpending) GetOverBudget() bool {
+	if m != nil {
+		return m.OverBudget
+	}
+	return false
+}
+
+type SpendingReply struct {
+	Deals []*DealSpending `protobuf:"bytes,1,rep,name=deals" json:"deals,omitempty"`
+	// Balance is the side-chain balance of the node's account.
+	Balance *BigInt `protobuf:"bytes,2,opt,name=balance" json:"balance,omitempty"`
+	// Budget is the configured spending limit, zero means no limit.
+	Budget *BigInt `protobuf:"bytes,3,opt,name=budget" json:"budget,omitempty"`
+	// Spent is the sum of payouts and accrued amounts of all deals.
+	Spent *BigInt `protobuf:"bytes,4,opt,name=spent" json:"spent,omitempty"`
+	// Exhaustion is the time the balance is expected to run out at.
+	// It is not set if the deals are fully prepaid.
+	Exhaustion *Timestamp `protobuf:"bytes,5,opt,name=exhaustion" json:"exhaustion,omitempty"`
+}
+
+func (m *SpendingReply) Reset()                    { *m = SpendingReply{} }
+func (m *SpendingReply) String() string            { return proto.CompactTextString(m) }
+func (*SpendingReply) ProtoMessage()               {}
+func (*SpendingReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{5} }
+
+func (m *SpendingReply) GetDeals() []*DealSpending {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+func (m *SpendingReply) GetBalance() *BigInt {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *SpendingReply) GetBudget() *BigInt {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+func (m *SpendingReply) GetSpent() *BigInt {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *SpendingReply) GetExhaustion() *Timestamp {
+	if m != nil {
+		return m.Exhaustion
+	}
+	return nil
+}
+
 type OpenDealRequest struct {
 	BidID *BigInt `protobuf:"bytes,1,opt,name=bidID" json:"bidID,omitempty"`
 	AskID *BigInt `protobuf:"bytes,2,opt,name=askID" json:"askID,omitempty"`
@@ -113,7 +330,7 @@ type OpenDealRequest struct {
 func (m *OpenDealRequest) Reset()                    { *m = OpenDealRequest{} }
 func (m *OpenDealRequest) String() string            { return proto.CompactTextString(m) }
 func (*OpenDealRequest) ProtoMessage()               {}
-func (*OpenDealRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{4} }
+func (*OpenDealRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{6} }
 
 func (m *OpenDealRequest) GetBidID() *BigInt {
 	if m != nil {
@@ -137,7 +354,7 @@ type WorkerRemoveRequest struct {
 func (m *WorkerRemoveRequest) Reset()                    { *m = WorkerRemoveRequest{} }
 func (m *WorkerRemoveRequest) String() string            { return proto.CompactTextString(m) }
 func (*WorkerRemoveRequest) ProtoMessage()               {}
-func (*WorkerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{5} }
+func (*WorkerRemoveRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{7} }
 
 func (m *WorkerRemoveRequest) GetMaster() *EthAddress {
 	if m != nil {
@@ -160,7 +377,7 @@ type WorkerListReply struct {
 func (m *WorkerListReply) Reset()                    { *m = WorkerListReply{} }
 func (m *WorkerListReply) String() string            { return proto.CompactTextString(m) }
 func (*WorkerListReply) ProtoMessage()               {}
-func (*WorkerListReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{6} }
+func (*WorkerListReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{8} }
 
 func (m *WorkerListReply) GetWorkers() []*DWHWorker {
 	if m != nil {
@@ -177,7 +394,7 @@ type BalanceReply struct {
 func (m *BalanceReply) Reset()                    { *m = BalanceReply{} }
 func (m *BalanceReply) String() string            { return proto.CompactTextString(m) }
 func (*BalanceReply) ProtoMessage()               {}
-func (*BalanceReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{7} }
+func (*BalanceReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{9} }
 
 func (m *BalanceReply) GetLiveBalance() *BigInt {
 	if m != nil {
@@ -193,15 +410,309 @@ func (m *BalanceReply) GetSideBalance() *BigInt {
 	return nil
 }
 
+type TokenTransfer struct {
+	Direction TokenTransferDirection `protobuf:"varint,1,opt,name=direction,enum=sonm.TokenTransferDirection" json:"direction,omitempty"`
+	Amount    *BigInt                `protobuf:"bytes,2,opt,name=amount" json:"amount,omitempty"`
+	// TxNumber is the number of the transfer assigned by the Gatekeeper.
+	TxNumber  *BigInt             `protobuf:"bytes,3,opt,name=txNumber" json:"txNumber,omitempty"`
+	Status    TokenTransferStatus `protobuf:"varint,4,opt,name=status,enum=sonm.TokenTransferStatus" json:"status,omitempty"`
+	CreatedAt *Timestamp          `protobuf:"bytes,5,opt,name=createdAt" json:"createdAt,omitempty"`
+}
+
+func (m *TokenTransfer) Reset()                    { *m = TokenTransfer{} }
+func (m *TokenTransfer) String() string            { return proto.CompactTextString(m) }
+func (*TokenTransfer) ProtoMessage()               {}
+func (*TokenTransfer) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{10} }
+
+func (m *TokenTransfer) GetDirection() TokenTransferDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return TokenTransferDirection_DEPOSIT
+}
+
+func (m *TokenTransfer) GetAmount() *BigInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *TokenTransfer) GetTxNumber() *BigInt {
+	if m != nil {
+		return m.TxNumber
+	}
+	return nil
+}
+
+func (m *TokenTransfer) GetStatus() TokenTransferStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TokenTransferStatus_TRANSFER_UNKNOWN
+}
+
+func (m *TokenTransfer) GetCreatedAt() *Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type TokenTransfersReply struct {
+	Transfers []*TokenTransfer `protobuf:"bytes,1,rep,name=transfers" json:"transfers,omitempty"`
+}
+
+func (m *TokenTransfersReply) Reset()                    { *m = TokenTransfersReply{} }
+func (m *TokenTransfersReply) String() string            { return proto.CompactTextString(m) }
+func (*TokenTransfersReply) ProtoMessage()               {}
+func (*TokenTransfersReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{11} }
+
+func (m *TokenTransfersReply) GetTransfers() []*TokenTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+type TokenTransferRequest struct {
+	Chain  TokenChain  `protobuf:"varint,1,opt,name=chain,enum=sonm.TokenChain" json:"chain,omitempty"`
+	To     *EthAddress `protobuf:"bytes,2,opt,name=to" json:"to,omitempty"`
+	Amount *BigInt     `protobuf:"bytes,3,opt,name=amount" json:"amount,omitempty"`
+}
+
+func (m *TokenTransferRequest) Reset()                    { *m = TokenTransferRequest{} }
+func (m *TokenTransferRequest) String() string            { return proto.CompactTextString(m) }
+func (*TokenTransferRequest) ProtoMessage()               {}
+func (*TokenTransferRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{12} }
+
+func (m *TokenTransferRequest) GetChain() TokenChain {
+	if m != nil {
+		return m.Chain
+	}
+	return TokenChain_SIDE_CHAIN
+}
+
+func (m *TokenTransferRequest) GetTo() *EthAddress {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *TokenTransferRequest) GetAmount() *BigInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type TokenApproveRequest struct {
+	Chain   TokenChain  `protobuf:"varint,1,opt,name=chain,enum=sonm.TokenChain" json:"chain,omitempty"`
+	Spender *EthAddress `protobuf:"bytes,2,opt,name=spender" json:"spender,omitempty"`
+	Amount  *BigInt     `protobuf:"bytes,3,opt,name=amount" json:"amount,omitempty"`
+}
+
+func (m *TokenApproveRequest) Reset()                    { *m = TokenApproveRequest{} }
+func (m *TokenApproveRequest) String() string            { return proto.CompactTextString(m) }
+func (*TokenApproveRequest) ProtoMessage()               {}
+func (*TokenApproveRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{13} }
+
+func (m *TokenApproveRequest) GetChain() TokenChain {
+	if m != nil {
+		return m.Chain
+	}
+	return TokenChain_SIDE_CHAIN
+}
+
+func (m *TokenApproveRequest) GetSpender() *EthAddress {
+	if m != nil {
+		return m.Spender
+	}
+	return nil
+}
+
+func (m *TokenApproveRequest) GetAmount() *BigInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type TokenAllowanceRequest struct {
+	Chain TokenChain `protobuf:"varint,1,opt,name=chain,enum=sonm.TokenChain" json:"chain,omitempty"`
+	// Owner is the account tokens are spent from. Defaults to the
+	// node's account.
+	Owner   *EthAddress `protobuf:"bytes,2,opt,name=owner" json:"owner,omitempty"`
+	Spender *EthAddress `protobuf:"bytes,3,opt,name=spender" json:"spender,omitempty"`
+}
+
+func (m *TokenAllowanceRequest) Reset()                    { *m = TokenAllowanceRequest{} }
+func (m *TokenAllowanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*TokenAllowanceRequest) ProtoMessage()               {}
+func (*TokenAllowanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{14} }
+
+func (m *TokenAllowanceRequest) GetChain() TokenChain {
+	if m != nil {
+		return m.Chain
+	}
+	return TokenChain_SIDE_CHAIN
+}
+
+func (m *TokenAllowanceRequest) GetOwner() *EthAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *TokenAllowanceRequest) GetSpender() *EthAddress {
+	if m != nil {
+		return m.Spender
+	}
+	return nil
+}
+
+type TokenAllowanceReply struct {
+	Allowance *BigInt `protobuf:"bytes,1,opt,name=allowance" json:"allowance,omitempty"`
+}
+
+func (m *TokenAllowanceReply) Reset()                    { *m = TokenAllowanceReply{} }
+func (m *TokenAllowanceReply) String() string            { return proto.CompactTextString(m) }
+func (*TokenAllowanceReply) ProtoMessage()               {}
+func (*TokenAllowanceReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{15} }
+
+func (m *TokenAllowanceReply) GetAllowance() *BigInt {
+	if m != nil {
+		return m.Allowance
+	}
+	return nil
+}
+
+type TokenTransactionReply struct {
+	// Hash is the hash of the confirmed transaction.
+	Hash string `protobuf:"bytes,1,opt,name=hash" json:"hash,omitempty"`
+}
+
+func (m *TokenTransactionReply) Reset()                    { *m = TokenTransactionReply{} }
+func (m *TokenTransactionReply) String() string            { return proto.CompactTextString(m) }
+func (*TokenTransactionReply) ProtoMessage()               {}
+func (*TokenTransactionReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{16} }
+
+func (m *TokenTransactionReply) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type PendingTransaction struct {
+	// Chain is the name of the chain the transaction is sent to.
+	Chain string `protobuf:"bytes,1,opt,name=chain" json:"chain,omitempty"`
+	// Hash is the hash of the latest sent version of the transaction.
+	Hash     string      `protobuf:"bytes,2,opt,name=hash" json:"hash,omitempty"`
+	From     *EthAddress `protobuf:"bytes,3,opt,name=from" json:"from,omitempty"`
+	Nonce    uint64      `protobuf:"varint,4,opt,name=nonce" json:"nonce,omitempty"`
+	GasPrice *BigInt     `protobuf:"bytes,5,opt,name=gasPrice" json:"gasPrice,omitempty"`
+	SentAt   *Timestamp  `protobuf:"bytes,6,opt,name=sentAt" json:"sentAt,omitempty"`
+	// Replacements is the number of times the transaction has been re-sent
+	// with a higher gas price.
+	Replacements uint64 `protobuf:"varint,7,opt,name=replacements" json:"replacements,omitempty"`
+}
+
+func (m *PendingTransaction) Reset()                    { *m = PendingTransaction{} }
+func (m *PendingTransaction) String() string            { return proto.CompactTextString(m) }
+func (*PendingTransaction) ProtoMessage()               {}
+func (*PendingTransaction) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{17} }
+
+func (m *PendingTransaction) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *PendingTransaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *PendingTransaction) GetFrom() *EthAddress {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *PendingTransaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *PendingTransaction) GetGasPrice() *BigInt {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
+func (m *PendingTransaction) GetSentAt() *Timestamp {
+	if m != nil {
+		return m.SentAt
+	}
+	return nil
+}
+
+func (m *PendingTransaction) GetReplacements() uint64 {
+	if m != nil {
+		return m.Replacements
+	}
+	return 0
+}
+
+type PendingTransactionsReply struct {
+	Transactions []*PendingTransaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *PendingTransactionsReply) Reset()                    { *m = PendingTransactionsReply{} }
+func (m *PendingTransactionsReply) String() string            { return proto.CompactTextString(m) }
+func (*PendingTransactionsReply) ProtoMessage()               {}
+func (*PendingTransactionsReply) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{18} }
+
+func (m *PendingTransactionsReply) GetTransactions() []*PendingTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*JoinNetworkRequest)(nil), "sonm.JoinNetworkRequest")
 	proto.RegisterType((*TaskListRequest)(nil), "sonm.TaskListRequest")
 	proto.RegisterType((*DealFinishRequest)(nil), "sonm.DealFinishRequest")
 	proto.RegisterType((*DealsReply)(nil), "sonm.DealsReply")
+	proto.RegisterType((*DealSpending)(nil), "sonm.DealSpending")
+	proto.RegisterType((*SpendingReply)(nil), "sonm.SpendingReply")
 	proto.RegisterType((*OpenDealRequest)(nil), "sonm.OpenDealRequest")
 	proto.RegisterType((*WorkerRemoveRequest)(nil), "sonm.WorkerRemoveRequest")
 	proto.RegisterType((*WorkerListReply)(nil), "sonm.WorkerListReply")
 	proto.RegisterType((*BalanceReply)(nil), "sonm.BalanceReply")
+	proto.RegisterType((*TokenTransfer)(nil), "sonm.TokenTransfer")
+	proto.RegisterType((*TokenTransfersReply)(nil), "sonm.TokenTransfersReply")
+	proto.RegisterType((*TokenTransferRequest)(nil), "sonm.TokenTransferRequest")
+	proto.RegisterType((*TokenApproveRequest)(nil), "sonm.TokenApproveRequest")
+	proto.RegisterType((*TokenAllowanceRequest)(nil), "sonm.TokenAllowanceRequest")
+	proto.RegisterType((*TokenAllowanceReply)(nil), "sonm.TokenAllowanceReply")
+	proto.RegisterType((*TokenTransactionReply)(nil), "sonm.TokenTransactionReply")
+	proto.RegisterType((*PendingTransaction)(nil), "sonm.PendingTransaction")
+	proto.RegisterType((*PendingTransactionsReply)(nil), "sonm.PendingTransactionsReply")
+	proto.RegisterEnum("sonm.TokenTransferDirection", TokenTransferDirection_name, TokenTransferDirection_value)
+	proto.RegisterEnum("sonm.TokenTransferStatus", TokenTransferStatus_name, TokenTransferStatus_value)
+	proto.RegisterEnum("sonm.TokenChain", TokenChain_name, TokenChain_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -219,6 +730,9 @@ type TaskManagementClient interface {
 	List(ctx context.Context, in *TaskListRequest, opts ...grpc.CallOption) (*TaskListReply, error)
 	// PushTask pushes image to Worker
 	PushTask(ctx context.Context, opts ...grpc.CallOption) (TaskManagement_PushTaskClient, error)
+	// ImageLayers reports which of the given image layers the Worker
+	// already has, so they can be omitted when pushing an image
+	ImageLayers(ctx context.Context, in *ImageLayersRequest, opts ...grpc.CallOption) (*ImageLayersReply, error)
 	// Start starts a task on given resource
 	Start(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskReply, error)
 	// JoinNetwork provides network specs to join specified task
@@ -231,6 +745,19 @@ type TaskManagementClient interface {
 	Stop(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*Empty, error)
 	// PullTask pulls task image back
 	PullTask(ctx context.Context, in *PullTaskRequest, opts ...grpc.CallOption) (TaskManagement_PullTaskClient, error)
+	// Exec executes a command inside the given task's container
+	Exec(ctx context.Context, opts ...grpc.CallOption) (TaskManagement_ExecClient, error)
+	// CopyTo uploads a tar archive into the given task's container
+	CopyTo(ctx context.Context, opts ...grpc.CallOption) (TaskManagement_CopyToClient, error)
+	// CopyFrom downloads a path from the given task's container as a tar archive
+	CopyFrom(ctx context.Context, in *CopyFromRequest, opts ...grpc.CallOption) (TaskManagement_CopyFromClient, error)
+	// Watch streams lifecycle events of tasks of the given deal
+	Watch(ctx context.Context, in *WatchTasksRequest, opts ...grpc.CallOption) (TaskManagement_WatchClient, error)
+	// Commit snapshots the given task, optionally pushing the snapshot to
+	// a registry
+	Commit(ctx context.Context, in *CommitTaskRequest, opts ...grpc.CallOption) (*TaskSnapshot, error)
+	// Snapshots lists snapshots of tasks of the given deal
+	Snapshots(ctx context.Context, in *TaskSnapshotsRequest, opts ...grpc.CallOption) (*TaskSnapshotsReply, error)
 }
 
 type taskManagementClient struct {
@@ -281,6 +808,15 @@ func (x *taskManagementPushTaskClient) Recv() (*Progress, error) {
 	return m, nil
 }
 
+func (c *taskManagementClient) ImageLayers(ctx context.Context, in *ImageLayersRequest, opts ...grpc.CallOption) (*ImageLayersReply, error) {
+	out := new(ImageLayersReply)
+	err := grpc.Invoke(ctx, "/sonm.TaskManagement/ImageLayers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskManagementClient) Start(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskReply, error) {
 	out := new(StartTaskReply)
 	err := grpc.Invoke(ctx, "/sonm.TaskManagement/Start", in, out, c.cc, opts...)
@@ -381,13 +917,163 @@ func (x *taskManagementPullTaskClient) Recv() (*Chunk, error) {
 	return m, nil
 }
 
-// Server API for TaskManagement service
+func (c *taskManagementClient) Exec(ctx context.Context, opts ...grpc.CallOption) (TaskManagement_ExecClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TaskManagement_serviceDesc.Streams[3], c.cc, "/sonm.TaskManagement/Exec", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskManagementExecClient{stream}
+	return x, nil
+}
+
+type TaskManagement_ExecClient interface {
+	Send(*ExecRequest) error
+	Recv() (*ExecReply, error)
+	grpc.ClientStream
+}
+
+type taskManagementExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskManagementExecClient) Send(m *ExecRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *taskManagementExecClient) Recv() (*ExecReply, error) {
+	m := new(ExecReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskManagementClient) CopyTo(ctx context.Context, opts ...grpc.CallOption) (TaskManagement_CopyToClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TaskManagement_serviceDesc.Streams[4], c.cc, "/sonm.TaskManagement/CopyTo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskManagementCopyToClient{stream}
+	return x, nil
+}
+
+type TaskManagement_CopyToClient interface {
+	Send(*CopyToRequest) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type taskManagementCopyToClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskManagementCopyToClient) Send(m *CopyToRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *taskManagementCopyToClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskManagementClient) CopyFrom(ctx context.Context, in *CopyFromRequest, opts ...grpc.CallOption) (TaskManagement_CopyFromClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TaskManagement_serviceDesc.Streams[5], c.cc, "/sonm.TaskManagement/CopyFrom", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskManagementCopyFromClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaskManagement_CopyFromClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type taskManagementCopyFromClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskManagementCopyFromClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskManagementClient) Watch(ctx context.Context, in *WatchTasksRequest, opts ...grpc.CallOption) (TaskManagement_WatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_TaskManagement_serviceDesc.Streams[6], c.cc, "/sonm.TaskManagement/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskManagementWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaskManagement_WatchClient interface {
+	Recv() (*TaskEvent, error)
+	grpc.ClientStream
+}
+
+type taskManagementWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskManagementWatchClient) Recv() (*TaskEvent, error) {
+	m := new(TaskEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskManagementClient) Commit(ctx context.Context, in *CommitTaskRequest, opts ...grpc.CallOption) (*TaskSnapshot, error) {
+	out := new(TaskSnapshot)
+	err := grpc.Invoke(ctx, "/sonm.TaskManagement/Commit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskManagementClient) Snapshots(ctx context.Context, in *TaskSnapshotsRequest, opts ...grpc.CallOption) (*TaskSnapshotsReply, error) {
+	out := new(TaskSnapshotsReply)
+	err := grpc.Invoke(ctx, "/sonm.TaskManagement/Snapshots", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for TaskManagement service
 
 type TaskManagementServer interface {
 	// List produces a list of all tasks running on different SONM nodes
 	List(context.Context, *TaskListRequest) (*TaskListReply, error)
 	// PushTask pushes image to Worker
 	PushTask(TaskManagement_PushTaskServer) error
+	// ImageLayers reports which of the given image layers the Worker
+	// already has, so they can be omitted when pushing an image
+	ImageLayers(context.Context, *ImageLayersRequest) (*ImageLayersReply, error)
 	// Start starts a task on given resource
 	Start(context.Context, *StartTaskRequest) (*StartTaskReply, error)
 	// JoinNetwork provides network specs to join specified task
@@ -400,6 +1086,19 @@ type TaskManagementServer interface {
 	Stop(context.Context, *TaskID) (*Empty, error)
 	// PullTask pulls task image back
 	PullTask(*PullTaskRequest, TaskManagement_PullTaskServer) error
+	// Exec executes a command inside the given task's container
+	Exec(TaskManagement_ExecServer) error
+	// CopyTo uploads a tar archive into the given task's container
+	CopyTo(TaskManagement_CopyToServer) error
+	// CopyFrom downloads a path from the given task's container as a tar archive
+	CopyFrom(*CopyFromRequest, TaskManagement_CopyFromServer) error
+	// Watch streams lifecycle events of tasks of the given deal
+	Watch(*WatchTasksRequest, TaskManagement_WatchServer) error
+	// Commit snapshots the given task, optionally pushing the snapshot to
+	// a registry
+	Commit(context.Context, *CommitTaskRequest) (*TaskSnapshot, error)
+	// Snapshots lists snapshots of tasks of the given deal
+	Snapshots(context.Context, *TaskSnapshotsRequest) (*TaskSnapshotsReply, error)
 }
 
 func RegisterTaskManagementServer(s *grpc.Server, srv TaskManagementServer) {
@@ -450,6 +1149,24 @@ func (x *taskManagementPushTaskServer) Recv() (*Chunk, error) {
 	return m, nil
 }
 
+func _TaskManagement_ImageLayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageLayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagementServer).ImageLayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TaskManagement/ImageLayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagementServer).ImageLayers(ctx, req.(*ImageLayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskManagement_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartTaskRequest)
 	if err := dec(in); err != nil {
@@ -564,6 +1281,136 @@ func (x *taskManagementPullTaskServer) Send(m *Chunk) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TaskManagement_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TaskManagementServer).Exec(&taskManagementExecServer{stream})
+}
+
+type TaskManagement_ExecServer interface {
+	Send(*ExecReply) error
+	Recv() (*ExecRequest, error)
+	grpc.ServerStream
+}
+
+type taskManagementExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskManagementExecServer) Send(m *ExecReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *taskManagementExecServer) Recv() (*ExecRequest, error) {
+	m := new(ExecRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TaskManagement_CopyTo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TaskManagementServer).CopyTo(&taskManagementCopyToServer{stream})
+}
+
+type TaskManagement_CopyToServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*CopyToRequest, error)
+	grpc.ServerStream
+}
+
+type taskManagementCopyToServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskManagementCopyToServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *taskManagementCopyToServer) Recv() (*CopyToRequest, error) {
+	m := new(CopyToRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TaskManagement_CopyFrom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyFromRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskManagementServer).CopyFrom(m, &taskManagementCopyFromServer{stream})
+}
+
+type TaskManagement_CopyFromServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type taskManagementCopyFromServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskManagementCopyFromServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TaskManagement_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskManagementServer).Watch(m, &taskManagementWatchServer{stream})
+}
+
+type TaskManagement_WatchServer interface {
+	Send(*TaskEvent) error
+	grpc.ServerStream
+}
+
+type taskManagementWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskManagementWatchServer) Send(m *TaskEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TaskManagement_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagementServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TaskManagement/Commit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagementServer).Commit(ctx, req.(*CommitTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskManagement_Snapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskManagementServer).Snapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TaskManagement/Snapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskManagementServer).Snapshots(ctx, req.(*TaskSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TaskManagement_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.TaskManagement",
 	HandlerType: (*TaskManagementServer)(nil),
@@ -572,6 +1419,10 @@ var _TaskManagement_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _TaskManagement_List_Handler,
 		},
+		{
+			MethodName: "ImageLayers",
+			Handler:    _TaskManagement_ImageLayers_Handler,
+		},
 		{
 			MethodName: "Start",
 			Handler:    _TaskManagement_Start_Handler,
@@ -588,6 +1439,14 @@ var _TaskManagement_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Stop",
 			Handler:    _TaskManagement_Stop_Handler,
 		},
+		{
+			MethodName: "Commit",
+			Handler:    _TaskManagement_Commit_Handler,
+		},
+		{
+			MethodName: "Snapshots",
+			Handler:    _TaskManagement_Snapshots_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -606,6 +1465,27 @@ var _TaskManagement_serviceDesc = grpc.ServiceDesc{
 			Handler:       _TaskManagement_PullTask_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Exec",
+			Handler:       _TaskManagement_Exec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyTo",
+			Handler:       _TaskManagement_CopyTo_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyFrom",
+			Handler:       _TaskManagement_CopyFrom_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _TaskManagement_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "node.proto",
 }
@@ -621,6 +1501,17 @@ type DealManagementClient interface {
 	Finish(ctx context.Context, in *DealFinishRequest, opts ...grpc.CallOption) (*Empty, error)
 	// Open tries to open deal between two orders
 	Open(ctx context.Context, in *OpenDealRequest, opts ...grpc.CallOption) (*Deal, error)
+	// ChangeRequestsList produces a list of change requests of the deal with given ID.
+	ChangeRequestsList(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*DealChangeRequestsReply, error)
+	// CreateChangeRequest requests to change price and duration of the deal.
+	CreateChangeRequest(ctx context.Context, in *DealChangeRequest, opts ...grpc.CallOption) (*BigInt, error)
+	// ApproveChangeRequest accepts the counterparty's change request with given ID.
+	ApproveChangeRequest(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*Empty, error)
+	// CancelChangeRequest cancels own change request or rejects the counterparty's one.
+	CancelChangeRequest(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*Empty, error)
+	// Spending shows payouts and predicted spendings of deals
+	// where the node's account is the consumer.
+	Spending(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SpendingReply, error)
 }
 
 type dealManagementClient struct {
@@ -667,29 +1558,85 @@ func (c *dealManagementClient) Open(ctx context.Context, in *OpenDealRequest, op
 	return out, nil
 }
 
-// Server API for DealManagement service
-
-type DealManagementServer interface {
-	// List produces a list of all deals made by client with given ID
-	List(context.Context, *Count) (*DealsReply, error)
-	// Status produces a detailed info about deal with given ID.
-	Status(context.Context, *ID) (*DealInfoReply, error)
-	// Finish finishes a deal with given ID
-	Finish(context.Context, *DealFinishRequest) (*Empty, error)
-	// Open tries to open deal between two orders
-	Open(context.Context, *OpenDealRequest) (*Deal, error)
+func (c *dealManagementClient) ChangeRequestsList(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*DealChangeRequestsReply, error) {
+	out := new(DealChangeRequestsReply)
+	err := grpc.Invoke(ctx, "/sonm.DealManagement/ChangeRequestsList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterDealManagementServer(s *grpc.Server, srv DealManagementServer) {
-	s.RegisterService(&_DealManagement_serviceDesc, srv)
+func (c *dealManagementClient) CreateChangeRequest(ctx context.Context, in *DealChangeRequest, opts ...grpc.CallOption) (*BigInt, error) {
+	out := new(BigInt)
+	err := grpc.Invoke(ctx, "/sonm.DealManagement/CreateChangeRequest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _DealManagement_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Count)
-	if err := dec(in); err != nil {
+func (c *dealManagementClient) ApproveChangeRequest(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.DealManagement/ApproveChangeRequest", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
+	return out, nil
+}
+
+func (c *dealManagementClient) CancelChangeRequest(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.DealManagement/CancelChangeRequest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dealManagementClient) Spending(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SpendingReply, error) {
+	out := new(SpendingReply)
+	err := grpc.Invoke(ctx, "/sonm.DealManagement/Spending", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DealManagement service
+
+type DealManagementServer interface {
+	// List produces a list of all deals made by client with given ID
+	List(context.Context, *Count) (*DealsReply, error)
+	// Status produces a detailed info about deal with given ID.
+	Status(context.Context, *ID) (*DealInfoReply, error)
+	// Finish finishes a deal with given ID
+	Finish(context.Context, *DealFinishRequest) (*Empty, error)
+	// Open tries to open deal between two orders
+	Open(context.Context, *OpenDealRequest) (*Deal, error)
+	// ChangeRequestsList produces a list of change requests of the deal with given ID.
+	ChangeRequestsList(context.Context, *BigInt) (*DealChangeRequestsReply, error)
+	// CreateChangeRequest requests to change price and duration of the deal.
+	CreateChangeRequest(context.Context, *DealChangeRequest) (*BigInt, error)
+	// ApproveChangeRequest accepts the counterparty's change request with given ID.
+	ApproveChangeRequest(context.Context, *BigInt) (*Empty, error)
+	// CancelChangeRequest cancels own change request or rejects the counterparty's one.
+	CancelChangeRequest(context.Context, *BigInt) (*Empty, error)
+	// Spending shows payouts and predicted spendings of deals
+	// where the node's account is the consumer.
+	Spending(context.Context, *Empty) (*SpendingReply, error)
+}
+
+func RegisterDealManagementServer(s *grpc.Server, srv DealManagementServer) {
+	s.RegisterService(&_DealManagement_serviceDesc, srv)
+}
+
+func _DealManagement_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Count)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
 		return srv.(DealManagementServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
@@ -756,6 +1703,96 @@ func _DealManagement_Open_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DealManagement_ChangeRequestsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigInt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DealManagementServer).ChangeRequestsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DealManagement/ChangeRequestsList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DealManagementServer).ChangeRequestsList(ctx, req.(*BigInt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DealManagement_CreateChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DealChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DealManagementServer).CreateChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DealManagement/CreateChangeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DealManagementServer).CreateChangeRequest(ctx, req.(*DealChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DealManagement_ApproveChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigInt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DealManagementServer).ApproveChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DealManagement/ApproveChangeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DealManagementServer).ApproveChangeRequest(ctx, req.(*BigInt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DealManagement_CancelChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigInt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DealManagementServer).CancelChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DealManagement/CancelChangeRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DealManagementServer).CancelChangeRequest(ctx, req.(*BigInt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DealManagement_Spending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DealManagementServer).Spending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.DealManagement/Spending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DealManagementServer).Spending(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DealManagement_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.DealManagement",
 	HandlerType: (*DealManagementServer)(nil),
@@ -776,6 +1813,26 @@ var _DealManagement_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Open",
 			Handler:    _DealManagement_Open_Handler,
 		},
+		{
+			MethodName: "ChangeRequestsList",
+			Handler:    _DealManagement_ChangeRequestsList_Handler,
+		},
+		{
+			MethodName: "CreateChangeRequest",
+			Handler:    _DealManagement_CreateChangeRequest_Handler,
+		},
+		{
+			MethodName: "ApproveChangeRequest",
+			Handler:    _DealManagement_ApproveChangeRequest_Handler,
+		},
+		{
+			MethodName: "CancelChangeRequest",
+			Handler:    _DealManagement_CancelChangeRequest_Handler,
+		},
+		{
+			MethodName: "Spending",
+			Handler:    _DealManagement_Spending_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "node.proto",
@@ -927,95 +1984,385 @@ type TokenManagementClient interface {
 	TestTokens(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	// Balance provide account balance for live- and side- chains.
 	Balance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BalanceReply, error)
+	// Deposit transfers the given amount of SNM from the live-chain
+	// to the side-chain via the Gatekeeper.
+	Deposit(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransfer, error)
+	// Withdraw transfers the given amount of SNM from the side-chain
+	// to the live-chain via the Gatekeeper.
+	Withdraw(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransfer, error)
+	// Transfers lists deposits and withdrawals of the node's account
+	// with their actual status.
+	Transfers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TokenTransfersReply, error)
+	// Transfer sends tokens to the given address on the selected chain
+	// and waits for the transaction to be confirmed.
+	Transfer(ctx context.Context, in *TokenTransferRequest, opts ...grpc.CallOption) (*TokenTransactionReply, error)
+	// Approve allows the spender to withdraw tokens from the node's
+	// account on the selected chain and waits for the transaction
+	// to be confirmed.
+	Approve(ctx context.Context, in *TokenApproveRequest, opts ...grpc.CallOption) (*TokenTransactionReply, error)
+	// Allowance returns the amount of tokens the spender is allowed
+	// to withdraw from the owner's account on the selected chain.
+	Allowance(ctx context.Context, in *TokenAllowanceRequest, opts ...grpc.CallOption) (*TokenAllowanceReply, error)
 }
 
 type tokenManagementClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewTokenManagementClient(cc *grpc.ClientConn) TokenManagementClient {
-	return &tokenManagementClient{cc}
+func NewTokenManagementClient(cc *grpc.ClientConn) TokenManagementClient {
+	return &tokenManagementClient{cc}
+}
+
+func (c *tokenManagementClient) TestTokens(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/TestTokens", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) Balance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BalanceReply, error) {
+	out := new(BalanceReply)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Balance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) Deposit(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransfer, error) {
+	out := new(TokenTransfer)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Deposit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) Withdraw(ctx context.Context, in *BigInt, opts ...grpc.CallOption) (*TokenTransfer, error) {
+	out := new(TokenTransfer)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Withdraw", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) Transfers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*TokenTransfersReply, error) {
+	out := new(TokenTransfersReply)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Transfers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) Transfer(ctx context.Context, in *TokenTransferRequest, opts ...grpc.CallOption) (*TokenTransactionReply, error) {
+	out := new(TokenTransactionReply)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Transfer", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) Approve(ctx context.Context, in *TokenApproveRequest, opts ...grpc.CallOption) (*TokenTransactionReply, error) {
+	out := new(TokenTransactionReply)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Approve", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenManagementClient) Allowance(ctx context.Context, in *TokenAllowanceRequest, opts ...grpc.CallOption) (*TokenAllowanceReply, error) {
+	out := new(TokenAllowanceReply)
+	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Allowance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for TokenManagement service
+
+type TokenManagementServer interface {
+	// TestTokens increases balance for some amount of test tokens
+	// into live-chian ethereum network.
+	TestTokens(context.Context, *Empty) (*Empty, error)
+	// Balance provide account balance for live- and side- chains.
+	Balance(context.Context, *Empty) (*BalanceReply, error)
+	// Deposit transfers the given amount of SNM from the live-chain
+	// to the side-chain via the Gatekeeper.
+	Deposit(context.Context, *BigInt) (*TokenTransfer, error)
+	// Withdraw transfers the given amount of SNM from the side-chain
+	// to the live-chain via the Gatekeeper.
+	Withdraw(context.Context, *BigInt) (*TokenTransfer, error)
+	// Transfers lists deposits and withdrawals of the node's account
+	// with their actual status.
+	Transfers(context.Context, *Empty) (*TokenTransfersReply, error)
+	// Transfer sends tokens to the given address on the selected chain
+	// and waits for the transaction to be confirmed.
+	Transfer(context.Context, *TokenTransferRequest) (*TokenTransactionReply, error)
+	// Approve allows the spender to withdraw tokens from the node's
+	// account on the selected chain and waits for the transaction
+	// to be confirmed.
+	Approve(context.Context, *TokenApproveRequest) (*TokenTransactionReply, error)
+	// Allowance returns the amount of tokens the spender is allowed
+	// to withdraw from the owner's account on the selected chain.
+	Allowance(context.Context, *TokenAllowanceRequest) (*TokenAllowanceReply, error)
+}
+
+func RegisterTokenManagementServer(s *grpc.Server, srv TokenManagementServer) {
+	s.RegisterService(&_TokenManagement_serviceDesc, srv)
+}
+
+func _TokenManagement_TestTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).TestTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/TestTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).TestTokens(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Balance(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigInt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Deposit(ctx, req.(*BigInt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigInt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Withdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Withdraw(ctx, req.(*BigInt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Transfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Transfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Transfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Transfers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Transfer(ctx, req.(*TokenTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Approve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Approve(ctx, req.(*TokenApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenManagement_Allowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenManagementServer).Allowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.TokenManagement/Allowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenManagementServer).Allowance(ctx, req.(*TokenAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TokenManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sonm.TokenManagement",
+	HandlerType: (*TokenManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TestTokens",
+			Handler:    _TokenManagement_TestTokens_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _TokenManagement_Balance_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _TokenManagement_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _TokenManagement_Withdraw_Handler,
+		},
+		{
+			MethodName: "Transfers",
+			Handler:    _TokenManagement_Transfers_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _TokenManagement_Transfer_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _TokenManagement_Approve_Handler,
+		},
+		{
+			MethodName: "Allowance",
+			Handler:    _TokenManagement_Allowance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "node.proto",
+}
+
+// Client API for TransactionManagement service
+
+type TransactionManagementClient interface {
+	// Pending returns transactions sent by the node, but not mined yet.
+	Pending(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PendingTransactionsReply, error)
+}
+
+type transactionManagementClient struct {
+	cc *grpc.ClientConn
 }
 
-func (c *tokenManagementClient) TestTokens(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := grpc.Invoke(ctx, "/sonm.TokenManagement/TestTokens", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewTransactionManagementClient(cc *grpc.ClientConn) TransactionManagementClient {
+	return &transactionManagementClient{cc}
 }
 
-func (c *tokenManagementClient) Balance(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*BalanceReply, error) {
-	out := new(BalanceReply)
-	err := grpc.Invoke(ctx, "/sonm.TokenManagement/Balance", in, out, c.cc, opts...)
+func (c *transactionManagementClient) Pending(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PendingTransactionsReply, error) {
+	out := new(PendingTransactionsReply)
+	err := grpc.Invoke(ctx, "/sonm.TransactionManagement/Pending", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for TokenManagement service
-
-type TokenManagementServer interface {
-	// TestTokens increases balance for some amount of test tokens
-	// into live-chian ethereum network.
-	TestTokens(context.Context, *Empty) (*Empty, error)
-	// Balance provide account balance for live- and side- chains.
-	Balance(context.Context, *Empty) (*BalanceReply, error)
-}
+// Server API for TransactionManagement service
 
-func RegisterTokenManagementServer(s *grpc.Server, srv TokenManagementServer) {
-	s.RegisterService(&_TokenManagement_serviceDesc, srv)
+type TransactionManagementServer interface {
+	// Pending returns transactions sent by the node, but not mined yet.
+	Pending(context.Context, *Empty) (*PendingTransactionsReply, error)
 }
 
-func _TokenManagement_TestTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TokenManagementServer).TestTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sonm.TokenManagement/TestTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TokenManagementServer).TestTokens(ctx, req.(*Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterTransactionManagementServer(s *grpc.Server, srv TransactionManagementServer) {
+	s.RegisterService(&_TransactionManagement_serviceDesc, srv)
 }
 
-func _TokenManagement_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _TransactionManagement_Pending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TokenManagementServer).Balance(ctx, in)
+		return srv.(TransactionManagementServer).Pending(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sonm.TokenManagement/Balance",
+		FullMethod: "/sonm.TransactionManagement/Pending",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TokenManagementServer).Balance(ctx, req.(*Empty))
+		return srv.(TransactionManagementServer).Pending(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _TokenManagement_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sonm.TokenManagement",
-	HandlerType: (*TokenManagementServer)(nil),
+var _TransactionManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sonm.TransactionManagement",
+	HandlerType: (*TransactionManagementServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "TestTokens",
-			Handler:    _TokenManagement_TestTokens_Handler,
-		},
-		{
-			MethodName: "Balance",
-			Handler:    _TokenManagement_Balance_Handler,
+			MethodName: "Pending",
+			Handler:    _TransactionManagement_Pending_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1170,6 +2517,25 @@ var _TaskManagement_PushTaskCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.Chunk"),
 }
 
+var _TaskManagement_ImageLayersCmd = &cobra.Command{
+	Use:   "imageLayers",
+	Short: "Make the ImageLayers method call, input-type: sonm.ImageLayersRequest output-type: sonm.ImageLayersReply",
+	RunE: grpccmd.RunE(
+		"ImageLayers",
+		"sonm.ImageLayersRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_ImageLayersCmd_gen = &cobra.Command{
+	Use:   "imageLayers-gen",
+	Short: "Generate JSON for method call of ImageLayers (input-type: sonm.ImageLayersRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.ImageLayersRequest"),
+}
+
 var _TaskManagement_StartCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Make the Start method call, input-type: sonm.StartTaskRequest output-type: sonm.StartTaskReply",
@@ -1284,6 +2650,120 @@ var _TaskManagement_PullTaskCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.PullTaskRequest"),
 }
 
+var _TaskManagement_ExecCmd = &cobra.Command{
+	Use:   "exec",
+	Short: "Make the Exec method call, input-type: sonm.ExecRequest output-type: sonm.ExecReply",
+	RunE: grpccmd.RunE(
+		"Exec",
+		"sonm.ExecRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_ExecCmd_gen = &cobra.Command{
+	Use:   "exec-gen",
+	Short: "Generate JSON for method call of Exec (input-type: sonm.ExecRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.ExecRequest"),
+}
+
+var _TaskManagement_CopyToCmd = &cobra.Command{
+	Use:   "copyTo",
+	Short: "Make the CopyTo method call, input-type: sonm.CopyToRequest output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"CopyTo",
+		"sonm.CopyToRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_CopyToCmd_gen = &cobra.Command{
+	Use:   "copyTo-gen",
+	Short: "Generate JSON for method call of CopyTo (input-type: sonm.CopyToRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.CopyToRequest"),
+}
+
+var _TaskManagement_CopyFromCmd = &cobra.Command{
+	Use:   "copyFrom",
+	Short: "Make the CopyFrom method call, input-type: sonm.CopyFromRequest output-type: sonm.Chunk",
+	RunE: grpccmd.RunE(
+		"CopyFrom",
+		"sonm.CopyFromRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_CopyFromCmd_gen = &cobra.Command{
+	Use:   "copyFrom-gen",
+	Short: "Generate JSON for method call of CopyFrom (input-type: sonm.CopyFromRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.CopyFromRequest"),
+}
+
+var _TaskManagement_WatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Make the Watch method call, input-type: sonm.WatchTasksRequest output-type: sonm.TaskEvent",
+	RunE: grpccmd.RunE(
+		"Watch",
+		"sonm.WatchTasksRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_WatchCmd_gen = &cobra.Command{
+	Use:   "watch-gen",
+	Short: "Generate JSON for method call of Watch (input-type: sonm.WatchTasksRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.WatchTasksRequest"),
+}
+
+var _TaskManagement_CommitCmd = &cobra.Command{
+	Use:   "commit",
+	Short: "Make the Commit method call, input-type: sonm.CommitTaskRequest output-type: sonm.TaskSnapshot",
+	RunE: grpccmd.RunE(
+		"Commit",
+		"sonm.CommitTaskRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_CommitCmd_gen = &cobra.Command{
+	Use:   "commit-gen",
+	Short: "Generate JSON for method call of Commit (input-type: sonm.CommitTaskRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.CommitTaskRequest"),
+}
+
+var _TaskManagement_SnapshotsCmd = &cobra.Command{
+	Use:   "snapshots",
+	Short: "Make the Snapshots method call, input-type: sonm.TaskSnapshotsRequest output-type: sonm.TaskSnapshotsReply",
+	RunE: grpccmd.RunE(
+		"Snapshots",
+		"sonm.TaskSnapshotsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTaskManagementClient(cc)
+		},
+	),
+}
+
+var _TaskManagement_SnapshotsCmd_gen = &cobra.Command{
+	Use:   "snapshots-gen",
+	Short: "Generate JSON for method call of Snapshots (input-type: sonm.TaskSnapshotsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.TaskSnapshotsRequest"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_TaskManagementCmd)
@@ -1292,6 +2772,8 @@ func init() {
 		_TaskManagement_ListCmd_gen,
 		_TaskManagement_PushTaskCmd,
 		_TaskManagement_PushTaskCmd_gen,
+		_TaskManagement_ImageLayersCmd,
+		_TaskManagement_ImageLayersCmd_gen,
 		_TaskManagement_StartCmd,
 		_TaskManagement_StartCmd_gen,
 		_TaskManagement_JoinNetworkCmd,
@@ -1304,6 +2786,18 @@ func init() {
 		_TaskManagement_StopCmd_gen,
 		_TaskManagement_PullTaskCmd,
 		_TaskManagement_PullTaskCmd_gen,
+		_TaskManagement_ExecCmd,
+		_TaskManagement_ExecCmd_gen,
+		_TaskManagement_CopyToCmd,
+		_TaskManagement_CopyToCmd_gen,
+		_TaskManagement_CopyFromCmd,
+		_TaskManagement_CopyFromCmd_gen,
+		_TaskManagement_WatchCmd,
+		_TaskManagement_WatchCmd_gen,
+		_TaskManagement_CommitCmd,
+		_TaskManagement_CommitCmd_gen,
+		_TaskManagement_SnapshotsCmd,
+		_TaskManagement_SnapshotsCmd_gen,
 	)
 }
 
@@ -1389,6 +2883,101 @@ var _DealManagement_OpenCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.OpenDealRequest"),
 }
 
+var _DealManagement_ChangeRequestsListCmd = &cobra.Command{
+	Use:   "changeRequestsList",
+	Short: "Make the ChangeRequestsList method call, input-type: sonm.BigInt output-type: sonm.DealChangeRequestsReply",
+	RunE: grpccmd.RunE(
+		"ChangeRequestsList",
+		"sonm.BigInt",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDealManagementClient(cc)
+		},
+	),
+}
+
+var _DealManagement_ChangeRequestsListCmd_gen = &cobra.Command{
+	Use:   "changeRequestsList-gen",
+	Short: "Generate JSON for method call of ChangeRequestsList (input-type: sonm.BigInt)",
+	RunE:  grpccmd.TypeToJson("sonm.BigInt"),
+}
+
+var _DealManagement_CreateChangeRequestCmd = &cobra.Command{
+	Use:   "createChangeRequest",
+	Short: "Make the CreateChangeRequest method call, input-type: sonm.DealChangeRequest output-type: sonm.BigInt",
+	RunE: grpccmd.RunE(
+		"CreateChangeRequest",
+		"sonm.DealChangeRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDealManagementClient(cc)
+		},
+	),
+}
+
+var _DealManagement_CreateChangeRequestCmd_gen = &cobra.Command{
+	Use:   "createChangeRequest-gen",
+	Short: "Generate JSON for method call of CreateChangeRequest (input-type: sonm.DealChangeRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.DealChangeRequest"),
+}
+
+var _DealManagement_ApproveChangeRequestCmd = &cobra.Command{
+	Use:   "approveChangeRequest",
+	Short: "Make the ApproveChangeRequest method call, input-type: sonm.BigInt output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"ApproveChangeRequest",
+		"sonm.BigInt",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDealManagementClient(cc)
+		},
+	),
+}
+
+var _DealManagement_ApproveChangeRequestCmd_gen = &cobra.Command{
+	Use:   "approveChangeRequest-gen",
+	Short: "Generate JSON for method call of ApproveChangeRequest (input-type: sonm.BigInt)",
+	RunE:  grpccmd.TypeToJson("sonm.BigInt"),
+}
+
+var _DealManagement_CancelChangeRequestCmd = &cobra.Command{
+	Use:   "cancelChangeRequest",
+	Short: "Make the CancelChangeRequest method call, input-type: sonm.BigInt output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"CancelChangeRequest",
+		"sonm.BigInt",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDealManagementClient(cc)
+		},
+	),
+}
+
+var _DealManagement_CancelChangeRequestCmd_gen = &cobra.Command{
+	Use:   "cancelChangeRequest-gen",
+	Short: "Generate JSON for method call of CancelChangeRequest (input-type: sonm.BigInt)",
+	RunE:  grpccmd.TypeToJson("sonm.BigInt"),
+}
+
+var _DealManagement_SpendingCmd = &cobra.Command{
+	Use:   "spending",
+	Short: "Make the Spending method call, input-type: sonm.Empty output-type: sonm.SpendingReply",
+	RunE: grpccmd.RunE(
+		"Spending",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewDealManagementClient(cc)
+		},
+	),
+}
+
+var _DealManagement_SpendingCmd_gen = &cobra.Command{
+	Use:   "spending-gen",
+	Short: "Generate JSON for method call of Spending (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_DealManagementCmd)
@@ -1401,6 +2990,16 @@ func init() {
 		_DealManagement_FinishCmd_gen,
 		_DealManagement_OpenCmd,
 		_DealManagement_OpenCmd_gen,
+		_DealManagement_ChangeRequestsListCmd,
+		_DealManagement_ChangeRequestsListCmd_gen,
+		_DealManagement_CreateChangeRequestCmd,
+		_DealManagement_CreateChangeRequestCmd_gen,
+		_DealManagement_ApproveChangeRequestCmd,
+		_DealManagement_ApproveChangeRequestCmd_gen,
+		_DealManagement_CancelChangeRequestCmd,
+		_DealManagement_CancelChangeRequestCmd_gen,
+		_DealManagement_SpendingCmd,
+		_DealManagement_SpendingCmd_gen,
 	)
 }
 
@@ -1524,6 +3123,120 @@ var _TokenManagement_BalanceCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.Empty"),
 }
 
+var _TokenManagement_DepositCmd = &cobra.Command{
+	Use:   "deposit",
+	Short: "Make the Deposit method call, input-type: sonm.BigInt output-type: sonm.TokenTransfer",
+	RunE: grpccmd.RunE(
+		"Deposit",
+		"sonm.BigInt",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_DepositCmd_gen = &cobra.Command{
+	Use:   "deposit-gen",
+	Short: "Generate JSON for method call of Deposit (input-type: sonm.BigInt)",
+	RunE:  grpccmd.TypeToJson("sonm.BigInt"),
+}
+
+var _TokenManagement_WithdrawCmd = &cobra.Command{
+	Use:   "withdraw",
+	Short: "Make the Withdraw method call, input-type: sonm.BigInt output-type: sonm.TokenTransfer",
+	RunE: grpccmd.RunE(
+		"Withdraw",
+		"sonm.BigInt",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_WithdrawCmd_gen = &cobra.Command{
+	Use:   "withdraw-gen",
+	Short: "Generate JSON for method call of Withdraw (input-type: sonm.BigInt)",
+	RunE:  grpccmd.TypeToJson("sonm.BigInt"),
+}
+
+var _TokenManagement_TransfersCmd = &cobra.Command{
+	Use:   "transfers",
+	Short: "Make the Transfers method call, input-type: sonm.Empty output-type: sonm.TokenTransfersReply",
+	RunE: grpccmd.RunE(
+		"Transfers",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_TransfersCmd_gen = &cobra.Command{
+	Use:   "transfers-gen",
+	Short: "Generate JSON for method call of Transfers (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
+var _TokenManagement_TransferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Make the Transfer method call, input-type: sonm.TokenTransferRequest output-type: sonm.TokenTransactionReply",
+	RunE: grpccmd.RunE(
+		"Transfer",
+		"sonm.TokenTransferRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_TransferCmd_gen = &cobra.Command{
+	Use:   "transfer-gen",
+	Short: "Generate JSON for method call of Transfer (input-type: sonm.TokenTransferRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.TokenTransferRequest"),
+}
+
+var _TokenManagement_ApproveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Make the Approve method call, input-type: sonm.TokenApproveRequest output-type: sonm.TokenTransactionReply",
+	RunE: grpccmd.RunE(
+		"Approve",
+		"sonm.TokenApproveRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_ApproveCmd_gen = &cobra.Command{
+	Use:   "approve-gen",
+	Short: "Generate JSON for method call of Approve (input-type: sonm.TokenApproveRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.TokenApproveRequest"),
+}
+
+var _TokenManagement_AllowanceCmd = &cobra.Command{
+	Use:   "allowance",
+	Short: "Make the Allowance method call, input-type: sonm.TokenAllowanceRequest output-type: sonm.TokenAllowanceReply",
+	RunE: grpccmd.RunE(
+		"Allowance",
+		"sonm.TokenAllowanceRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTokenManagementClient(cc)
+		},
+	),
+}
+
+var _TokenManagement_AllowanceCmd_gen = &cobra.Command{
+	Use:   "allowance-gen",
+	Short: "Generate JSON for method call of Allowance (input-type: sonm.TokenAllowanceRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.TokenAllowanceRequest"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_TokenManagementCmd)
@@ -1532,6 +3245,52 @@ func init() {
 		_TokenManagement_TestTokensCmd_gen,
 		_TokenManagement_BalanceCmd,
 		_TokenManagement_BalanceCmd_gen,
+		_TokenManagement_DepositCmd,
+		_TokenManagement_DepositCmd_gen,
+		_TokenManagement_WithdrawCmd,
+		_TokenManagement_WithdrawCmd_gen,
+		_TokenManagement_TransfersCmd,
+		_TokenManagement_TransfersCmd_gen,
+		_TokenManagement_TransferCmd,
+		_TokenManagement_TransferCmd_gen,
+		_TokenManagement_ApproveCmd,
+		_TokenManagement_ApproveCmd_gen,
+		_TokenManagement_AllowanceCmd,
+		_TokenManagement_AllowanceCmd_gen,
+	)
+}
+
+// TransactionManagement
+var _TransactionManagementCmd = &cobra.Command{
+	Use:   "transactionManagement [method]",
+	Short: "Subcommand for the TransactionManagement service.",
+}
+
+var _TransactionManagement_PendingCmd = &cobra.Command{
+	Use:   "pending",
+	Short: "Make the Pending method call, input-type: sonm.Empty output-type: sonm.PendingTransactionsReply",
+	RunE: grpccmd.RunE(
+		"Pending",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewTransactionManagementClient(cc)
+		},
+	),
+}
+
+var _TransactionManagement_PendingCmd_gen = &cobra.Command{
+	Use:   "pending-gen",
+	Short: "Generate JSON for method call of Pending (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
+// Register commands with the root command and service command
+func init() {
+	grpccmd.RegisterServiceCmd(_TransactionManagementCmd)
+	_TransactionManagementCmd.AddCommand(
+		_TransactionManagement_PendingCmd,
+		_TransactionManagement_PendingCmd_gen,
 	)
 }
 
@@ -1595,52 +3354,115 @@ func init() {
 func init() { proto.RegisterFile("node.proto", fileDescriptor9) }
 
 var fileDescriptor9 = []byte{
-	// 752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x5d, 0x6f, 0xda, 0x48,
-	0x14, 0xc5, 0x84, 0x90, 0x70, 0x61, 0x21, 0x99, 0xec, 0x07, 0x6b, 0x45, 0xab, 0xc8, 0xbb, 0xda,
-	0x12, 0x35, 0xa5, 0xc8, 0xad, 0x9a, 0x3c, 0xe4, 0xa5, 0x09, 0xa9, 0x4a, 0x95, 0xb4, 0x91, 0x41,
-	0x4a, 0xf3, 0x38, 0xc1, 0x13, 0x18, 0x61, 0x66, 0x5c, 0xcf, 0x90, 0x28, 0x7f, 0xaa, 0xcf, 0x7d,
-	0xef, 0x1f, 0xab, 0xc6, 0x33, 0x86, 0xc1, 0x50, 0xf5, 0x91, 0x73, 0xcf, 0xfd, 0x38, 0xf7, 0xfa,
-	0x0c, 0x00, 0x8c, 0x87, 0xa4, 0x1d, 0x27, 0x5c, 0x72, 0x54, 0x12, 0x9c, 0x4d, 0xdd, 0xda, 0x1d,
-	0x1d, 0x51, 0x26, 0x35, 0xe6, 0x36, 0x86, 0x9c, 0x49, 0x4c, 0x19, 0x49, 0x0c, 0x50, 0x09, 0x1f,
-	0xc7, 0x59, 0x8c, 0x32, 0x95, 0xc1, 0x28, 0x36, 0xc0, 0xee, 0x14, 0x27, 0x13, 0x22, 0xe3, 0x08,
-	0x0f, 0x4d, 0x4d, 0xb7, 0xf6, 0xc8, 0x93, 0x49, 0x96, 0xec, 0x7d, 0x06, 0xf4, 0x81, 0x53, 0xf6,
-	0x91, 0x48, 0x05, 0x07, 0xe4, 0xcb, 0x8c, 0x08, 0x89, 0xfe, 0x83, 0xb2, 0xc4, 0x62, 0xd2, 0xeb,
-	0x36, 0x9d, 0x03, 0xa7, 0x55, 0xf5, 0x6b, 0x6d, 0x55, 0xb6, 0x3d, 0x48, 0xb1, 0xc0, 0xc4, 0xd0,
-	0x3e, 0x54, 0x4c, 0x5e, 0xaf, 0xdb, 0x2c, 0x1e, 0x38, 0xad, 0x4a, 0xb0, 0x00, 0xbc, 0x63, 0x68,
-	0x28, 0xfe, 0x25, 0x15, 0xd2, 0x2a, 0x1b, 0x12, 0x1c, 0xe5, 0xcb, 0x9e, 0xd1, 0x51, 0x8f, 0xc9,
-	0xc0, 0xc4, 0xbc, 0x5b, 0xd8, 0xed, 0x12, 0x1c, 0xbd, 0xa3, 0x8c, 0x8a, 0x71, 0x96, 0xba, 0x0f,
-	0x45, 0x1a, 0xae, 0x4d, 0x2b, 0xd2, 0x10, 0xfd, 0x0f, 0x75, 0x1c, 0x86, 0x03, 0x7e, 0x16, 0xe1,
-	0xe1, 0x24, 0xa2, 0x42, 0xa6, 0xe3, 0x6c, 0x07, 0x39, 0xd4, 0x3b, 0x02, 0x50, 0xa5, 0x45, 0x40,
-	0xe2, 0xe8, 0x09, 0xfd, 0x03, 0x25, 0xd5, 0xb2, 0xe9, 0x1c, 0x6c, 0xb4, 0xaa, 0x3e, 0xe8, 0xaa,
-	0x2a, 0x1e, 0xa4, 0xb8, 0x77, 0x0b, 0x8d, 0x4f, 0x31, 0x61, 0x29, 0x62, 0xc6, 0xf0, 0x60, 0xf3,
-	0x8e, 0x86, 0x3f, 0x11, 0xa0, 0x43, 0x8a, 0xa3, 0x77, 0x57, 0x5c, 0xc7, 0x49, 0x43, 0x1e, 0x85,
-	0xbd, 0x9b, 0xf4, 0x0c, 0x01, 0x99, 0xf2, 0x07, 0x92, 0x95, 0x6f, 0x41, 0x79, 0x8a, 0x85, 0x24,
-	0x89, 0xa9, 0xbf, 0xa3, 0x73, 0x2f, 0xe4, 0xf8, 0x6d, 0x18, 0x26, 0x44, 0x88, 0xc0, 0xc4, 0x15,
-	0x53, 0xdf, 0xd1, 0x74, 0x59, 0xc3, 0xd4, 0x71, 0xef, 0x14, 0x1a, 0xba, 0x95, 0xbe, 0x84, 0x12,
-	0x7e, 0x08, 0x5b, 0x3a, 0x28, 0x8c, 0xf6, 0x86, 0xd1, 0x7e, 0xf3, 0xde, 0x4c, 0x95, 0xc5, 0x3d,
-	0x06, 0xb5, 0x33, 0x1c, 0x61, 0x36, 0x24, 0x3a, 0xb5, 0x0d, 0xd5, 0x88, 0x3e, 0x10, 0x83, 0xad,
-	0x5d, 0x83, 0x4d, 0x50, 0x7c, 0x41, 0xc3, 0x39, 0x7f, 0xdd, 0x4a, 0x6c, 0x82, 0xff, 0x75, 0x03,
-	0xea, 0xea, 0xb3, 0xb9, 0xc2, 0x0c, 0x8f, 0xc8, 0x94, 0x30, 0x89, 0x5e, 0x43, 0x49, 0x8d, 0x8e,
-	0xfe, 0x58, 0x7c, 0x84, 0xd6, 0x47, 0xe5, 0xee, 0xe5, 0xe1, 0x38, 0x7a, 0xf2, 0x0a, 0xe8, 0x05,
-	0x6c, 0x5f, 0xcf, 0xc4, 0x58, 0xc1, 0xa8, 0xaa, 0x29, 0xe7, 0xe3, 0x19, 0x9b, 0xb8, 0x75, 0xfd,
-	0xe3, 0x3a, 0xe1, 0x23, 0xb5, 0x27, 0xaf, 0xd0, 0x72, 0x3a, 0x0e, 0x3a, 0x86, 0xcd, 0xbe, 0xc4,
-	0x89, 0x44, 0x7f, 0xea, 0x70, 0xfa, 0x43, 0x25, 0x67, 0x6d, 0x7e, 0x5f, 0xc1, 0x75, 0x9f, 0x53,
-	0xa8, 0x5a, 0x06, 0x42, 0x4d, 0x4d, 0x5b, 0xf5, 0x94, 0xbb, 0xab, 0x23, 0x06, 0xed, 0xc7, 0x64,
-	0xe8, 0x15, 0xd0, 0x4b, 0x28, 0xf7, 0x25, 0x96, 0x33, 0x81, 0x96, 0x2c, 0xe6, 0x5a, 0x5a, 0x75,
-	0x3c, 0x6b, 0xf7, 0x06, 0x4a, 0x97, 0x7c, 0x24, 0x96, 0x96, 0xc1, 0x47, 0x62, 0xdd, 0x32, 0xf8,
-	0x48, 0xa4, 0x8a, 0xbd, 0x42, 0xc7, 0x41, 0xff, 0x42, 0xa9, 0x2f, 0x79, 0x9c, 0x6b, 0x63, 0x16,
-	0x73, 0x31, 0x8d, 0xa5, 0x2a, 0xee, 0xab, 0x9d, 0x45, 0x51, 0xba, 0x33, 0xd3, 0x20, 0xfb, 0x9d,
-	0x35, 0xb0, 0x57, 0xa9, 0x0a, 0xfb, 0xdf, 0x1d, 0xa8, 0x2b, 0x87, 0x58, 0x07, 0x7b, 0x66, 0x0e,
-	0x96, 0x71, 0xf9, 0x8c, 0x49, 0x77, 0x67, 0x61, 0xaf, 0xb9, 0x98, 0xc3, 0xb9, 0xfa, 0x6d, 0x1d,
-	0xed, 0x75, 0x33, 0x05, 0x8a, 0xd7, 0x63, 0xf7, 0x3c, 0xa3, 0x76, 0xa0, 0xac, 0x1f, 0x04, 0xf4,
-	0xd7, 0x82, 0xb0, 0xf4, 0x44, 0xe4, 0xc5, 0x3c, 0x87, 0x92, 0x72, 0x6f, 0x26, 0x24, 0xe7, 0x64,
-	0xd7, 0xb2, 0xbb, 0x57, 0xf0, 0xbf, 0x39, 0xb0, 0x73, 0x95, 0x3a, 0xcb, 0xd2, 0x71, 0x02, 0x55,
-	0x6d, 0x07, 0x91, 0xca, 0x59, 0xb1, 0x58, 0x76, 0xa5, 0x9c, 0xbd, 0xd2, 0x69, 0x7f, 0xd3, 0xe0,
-	0x39, 0x67, 0xf7, 0x34, 0x99, 0xae, 0xc9, 0xcd, 0x4d, 0x7b, 0x02, 0x35, 0xfb, 0x41, 0x40, 0x7f,
-	0xdb, 0xa5, 0x97, 0x1e, 0x89, 0x5c, 0xa6, 0x4f, 0xa1, 0x31, 0xe0, 0x13, 0xc2, 0xac, 0xc1, 0x5b,
-	0x00, 0x03, 0x22, 0x64, 0x0a, 0x0b, 0x64, 0xf3, 0xf3, 0x6d, 0x8f, 0x60, 0x2b, 0x73, 0xea, 0x12,
-	0x0d, 0x19, 0x87, 0x5a, 0xd6, 0xf7, 0x0a, 0xfe, 0x18, 0x2a, 0xf3, 0xb7, 0x14, 0x75, 0xcc, 0x95,
-	0x57, 0xa5, 0x19, 0xab, 0xcc, 0xa9, 0xd6, 0xb9, 0x8d, 0xba, 0x5f, 0xad, 0xe3, 0xae, 0x9c, 0xfe,
-	0x3b, 0xbd, 0xfa, 0x11, 0x00, 0x00, 0xff, 0xff, 0x03, 0xb4, 0x8b, 0x98, 0x0d, 0x07, 0x00, 0x00,
+	// 1753 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xdd, 0x72, 0xdb, 0xc6,
+	0x15, 0x26, 0x28, 0xfe, 0x88, 0x87, 0xb2, 0x44, 0x2f, 0x25, 0x87, 0x66, 0x5d, 0x8f, 0x07, 0xcd,
+	0x38, 0x8c, 0xe2, 0x28, 0x0e, 0x13, 0xd7, 0x99, 0x8e, 0xa7, 0x33, 0x14, 0x49, 0xd7, 0x6c, 0x65,
+	0x9a, 0x05, 0x99, 0x2a, 0xbe, 0xca, 0xac, 0x88, 0x15, 0x89, 0x11, 0x08, 0xa0, 0xd8, 0xa5, 0x65,
+	0xbd, 0x40, 0x6f, 0x7b, 0xd1, 0x97, 0xe9, 0x45, 0x6f, 0xfa, 0x0e, 0xbd, 0xec, 0x4d, 0x1f, 0xa1,
+	0x0f, 0xd0, 0x99, 0xce, 0xfe, 0x11, 0x0b, 0x08, 0x8c, 0x9d, 0x3b, 0xee, 0xf9, 0xbe, 0xf3, 0x83,
+	0xf3, 0xb3, 0x38, 0x20, 0x40, 0x10, 0xba, 0xe4, 0x24, 0x8a, 0x43, 0x16, 0xa2, 0x12, 0x0d, 0x83,
+	0x55, 0x7b, 0xef, 0xc2, 0x5b, 0x78, 0x01, 0x93, 0xb2, 0xf6, 0xc1, 0x3c, 0x0c, 0x18, 0xf6, 0x02,
+	0x12, 0x2b, 0x41, 0xcd, 0xbd, 0x5e, 0x6a, 0xcc, 0x0b, 0xb8, 0x46, 0xe0, 0x61, 0x25, 0xb8, 0xbb,
+	0xc2, 0xf1, 0x15, 0x61, 0x91, 0x8f, 0xe7, 0x44, 0x73, 0x98, 0xb7, 0x22, 0x94, 0xe1, 0x55, 0xa4,
+	0x04, 0x7b, 0xd7, 0x61, 0x7c, 0xa5, 0xad, 0xd9, 0x3f, 0x00, 0xfa, 0x7d, 0xe8, 0x05, 0x63, 0xc2,
+	0xb8, 0xd8, 0x21, 0x7f, 0x5e, 0x13, 0xca, 0xd0, 0xa7, 0x50, 0x61, 0x98, 0x5e, 0x8d, 0x06, 0x2d,
+	0xeb, 0x91, 0xd5, 0xa9, 0x77, 0xf7, 0x4e, 0xb8, 0x9f, 0x93, 0x99, 0x90, 0x39, 0x0a, 0x43, 0x0f,
+	0xa0, 0xa6, 0xf4, 0x46, 0x83, 0x56, 0xf1, 0x91, 0xd5, 0xa9, 0x39, 0x89, 0xc0, 0x7e, 0x0e, 0x07,
+	0x9c, 0x7f, 0xe6, 0x51, 0x66, 0x98, 0x75, 0x09, 0xf6, 0xb3, 0x66, 0x4f, 0xbd, 0xc5, 0x28, 0x60,
+	0x8e, 0xc2, 0xec, 0xb7, 0x70, 0x77, 0x40, 0xb0, 0xff, 0xd2, 0x0b, 0x3c, 0xba, 0xd4, 0xaa, 0x0f,
+	0xa0, 0xe8, 0xb9, 0xb9, 0x6a, 0x45, 0xcf, 0x45, 0x8f, 0x61, 0x1f, 0xbb, 0xee, 0x2c, 0x3c, 0xf5,
+	0xf1, 0xfc, 0xca, 0xf7, 0x28, 0x13, 0xe1, 0xec, 0x3a, 0x19, 0xa9, 0xfd, 0x04, 0x80, 0x9b, 0xa6,
+	0x0e, 0x89, 0xfc, 0x1b, 0xf4, 0x10, 0x4a, 0xdc, 0x65, 0xcb, 0x7a, 0xb4, 0xd3, 0xa9, 0x77, 0x41,
+	0x5a, 0xe5, 0xb8, 0x23, 0xe4, 0xf6, 0x3f, 0x77, 0x60, 0x8f, 0x1f, 0xa7, 0x11, 0x09, 0x5c, 0x2f,
+	0x58, 0x7c, 0x5c, 0xfc, 0xc8, 0x86, 0x72, 0x14, 0x7b, 0x73, 0xd2, 0x2a, 0xe6, 0x90, 0x24, 0x84,
+	0xbe, 0x85, 0xfd, 0x0b, 0x3f, 0x9c, 0x5f, 0x11, 0xf7, 0x14, 0xfb, 0x38, 0x98, 0x93, 0xd6, 0x4e,
+	0x0e, 0x39, 0xc3, 0x41, 0x27, 0x50, 0x67, 0x21, 0xc3, 0xfe, 0x04, 0xdf, 0x84, 0x6b, 0xd6, 0x2a,
+	0xe5, 0xa8, 0x98, 0x04, 0xf4, 0x15, 0x80, 0x8f, 0x29, 0x3b, 0xf5, 0x7c, 0x7f, 0x36, 0x6d, 0x95,
+	0x05, 0xfd, 0x40, 0x95, 0x52, 0x77, 0x85, 0x63, 0x50, 0xd0, 0x63, 0xa8, 0xe2, 0xf9, 0x3c, 0x5e,
+	0x13, 0xb7, 0x55, 0xc9, 0x31, 0xae, 0x41, 0x6e, 0x38, 0x20, 0xef, 0xb5, 0xe1, 0xea, 0x16, 0xc3,
+	0x09, 0x05, 0x7d, 0x09, 0xb5, 0x08, 0x7b, 0xee, 0xf7, 0x01, 0xf3, 0xfc, 0xd6, 0x6e, 0x3e, 0x3f,
+	0x61, 0xa0, 0x0e, 0xec, 0x5e, 0x86, 0x31, 0x99, 0x63, 0xca, 0x5a, 0xb5, 0x9c, 0x40, 0x36, 0x28,
+	0x7a, 0x08, 0x10, 0xbe, 0x23, 0xf1, 0xe9, 0xda, 0x5d, 0x10, 0xd6, 0x02, 0x51, 0x75, 0x43, 0x62,
+	0xff, 0xdb, 0x82, 0x3b, 0xba, 0x7e, 0xb2, 0xea, 0x1d, 0x28, 0xf3, 0x42, 0x51, 0x55, 0x76, 0x94,
+	0x94, 0x7d, 0xc3, 0x93, 0x04, 0x9e, 0x8d, 0x0b, 0x55, 0x9d, 0xbc, 0x52, 0x6a, 0x90, 0xb7, 0xc5,
+	0x85, 0xf4, 0x9f, 0x57, 0x44, 0x85, 0xf1, 0xb6, 0xa0, 0x11, 0x09, 0xf2, 0xcb, 0x26, 0x21, 0x9e,
+	0x57, 0xf2, 0x7e, 0x89, 0xd7, 0x94, 0x79, 0x61, 0xb0, 0xb5, 0x60, 0x09, 0xc5, 0x7e, 0x0b, 0x07,
+	0x6f, 0x22, 0x12, 0x88, 0xa6, 0x55, 0x93, 0x62, 0x43, 0xf9, 0xc2, 0x73, 0xb7, 0xf4, 0xa8, 0x84,
+	0x38, 0x47, 0x8e, 0x77, 0x6e, 0x8b, 0x0a, 0xc8, 0xf6, 0xa0, 0x79, 0x2e, 0x6e, 0x0a, 0x87, 0xac,
+	0xc2, 0x77, 0x44, 0x9b, 0xef, 0x40, 0x65, 0x85, 0x29, 0x23, 0xb1, 0xb2, 0xdf, 0x90, 0xba, 0x43,
+	0xb6, 0xec, 0xb9, 0x6e, 0x4c, 0x28, 0x75, 0x14, 0xce, 0x99, 0xf2, 0xaa, 0x69, 0x15, 0xb7, 0x31,
+	0x25, 0x6e, 0xbf, 0x80, 0x03, 0xe9, 0x4a, 0x5e, 0x16, 0xbc, 0x4a, 0x9f, 0x43, 0x55, 0x82, 0xba,
+	0x4e, 0x2a, 0x0d, 0x83, 0xf3, 0x57, 0x2a, 0x2a, 0x8d, 0xdb, 0x01, 0xec, 0xa9, 0x01, 0x91, 0xaa,
+	0x27, 0x50, 0xf7, 0xbd, 0x77, 0x44, 0x0f, 0x56, 0x5e, 0x1a, 0x4c, 0x02, 0xe7, 0x53, 0xcf, 0xdd,
+	0xf0, 0xf3, 0x52, 0x62, 0x12, 0xec, 0xff, 0x59, 0x70, 0x67, 0x16, 0x5e, 0x91, 0x60, 0x16, 0xe3,
+	0x80, 0x5e, 0x92, 0x18, 0xfd, 0x06, 0x6a, 0xae, 0x17, 0x93, 0xb9, 0xa8, 0x1a, 0xf7, 0xb7, 0xdf,
+	0x7d, 0xa0, 0xaa, 0x66, 0xf2, 0x06, 0x9a, 0xe3, 0x24, 0x74, 0xde, 0x3c, 0x78, 0x15, 0xae, 0x03,
+	0x96, 0xeb, 0x58, 0x61, 0x7c, 0x20, 0xd8, 0xfb, 0xf1, 0x7a, 0x75, 0x41, 0xe2, 0xdc, 0x26, 0xdb,
+	0xa0, 0xe8, 0x6b, 0xa8, 0x50, 0x86, 0xd9, 0x9a, 0x8a, 0x3e, 0xdb, 0xef, 0xde, 0xcf, 0x09, 0x64,
+	0x2a, 0x08, 0x8e, 0x22, 0xf2, 0xe1, 0x9c, 0xc7, 0x04, 0x33, 0xe2, 0xf6, 0xd8, 0xb6, 0xa6, 0x4b,
+	0x18, 0xf6, 0x2b, 0x68, 0xa6, 0xac, 0xa9, 0xdb, 0xf4, 0x6b, 0xa8, 0x31, 0x2d, 0x51, 0x35, 0x6b,
+	0xe6, 0xf8, 0x76, 0x12, 0x96, 0xfd, 0x17, 0x0b, 0x0e, 0xd3, 0xa0, 0x6a, 0xb2, 0xc7, 0x50, 0x9e,
+	0x2f, 0xb1, 0xa7, 0x93, 0xd9, 0x30, 0xec, 0xf4, 0xb9, 0xdc, 0x91, 0x30, 0x7a, 0x04, 0x45, 0x16,
+	0x6e, 0x6d, 0xaf, 0x22, 0x0b, 0x8d, 0xf4, 0xee, 0x6c, 0x4f, 0xaf, 0xfd, 0x57, 0x4b, 0x3d, 0x53,
+	0x2f, 0x8a, 0x62, 0xa3, 0xd9, 0x3f, 0x36, 0x8e, 0x63, 0xa8, 0xf2, 0x01, 0x76, 0x7f, 0xa2, 0xd7,
+	0x35, 0xe1, 0x23, 0x23, 0xfa, 0x9b, 0x05, 0x47, 0x32, 0x22, 0xdf, 0x0f, 0xaf, 0x65, 0x73, 0xff,
+	0xbc, 0x98, 0x1e, 0x43, 0x39, 0xbc, 0x0e, 0x7e, 0x22, 0x22, 0x09, 0x9b, 0xb1, 0xef, 0x7c, 0x20,
+	0x76, 0xbb, 0x07, 0xcd, 0x6c, 0x50, 0xbc, 0xf4, 0xc7, 0x50, 0xc3, 0x5a, 0x92, 0x3b, 0x6f, 0x09,
+	0x6c, 0x7f, 0x01, 0x47, 0x49, 0xc9, 0xb1, 0x1c, 0x07, 0x61, 0x04, 0x41, 0x69, 0x89, 0xe9, 0x52,
+	0xe8, 0xd7, 0x1c, 0xf1, 0xdb, 0xfe, 0xaf, 0x05, 0x68, 0x22, 0x2f, 0x65, 0x83, 0x8f, 0x0e, 0xcd,
+	0x14, 0xd4, 0xf4, 0x03, 0x6b, 0x03, 0xc5, 0xc4, 0x00, 0xfa, 0x14, 0x4a, 0x97, 0x71, 0xb8, 0xda,
+	0xfa, 0x64, 0x02, 0xe5, 0xf6, 0x82, 0x90, 0xc7, 0xce, 0x47, 0xa6, 0xe4, 0xc8, 0x03, 0x9f, 0xb9,
+	0x05, 0xa6, 0x13, 0xf1, 0x2a, 0x2f, 0xe7, 0xcd, 0x9c, 0x46, 0xd1, 0x67, 0x50, 0xa1, 0x24, 0x60,
+	0x3d, 0xd6, 0xaa, 0xe4, 0x4f, 0x8f, 0x82, 0x91, 0x0d, 0x7b, 0x31, 0x11, 0xdb, 0xd9, 0x8a, 0x04,
+	0x8c, 0x8a, 0x37, 0x67, 0xc9, 0x49, 0xc9, 0xec, 0x1f, 0xa0, 0x75, 0xfb, 0x91, 0xd5, 0x8c, 0xbd,
+	0x80, 0x3d, 0x66, 0x08, 0xd5, 0x98, 0xb5, 0xa4, 0xbb, 0xdb, 0x5a, 0x4e, 0x8a, 0x7d, 0xfc, 0x0c,
+	0xee, 0xe5, 0xdf, 0x47, 0xa8, 0x0e, 0xd5, 0xc1, 0x70, 0xf2, 0x66, 0x3a, 0x9a, 0x35, 0x0a, 0x68,
+	0x1f, 0xe0, 0x7c, 0x34, 0x7b, 0x35, 0x70, 0x7a, 0xe7, 0xbd, 0xb3, 0x86, 0x75, 0xfc, 0x16, 0x9a,
+	0x39, 0xb7, 0x07, 0x3a, 0x84, 0xc6, 0xcc, 0xe9, 0x8d, 0xa7, 0x2f, 0x87, 0xce, 0x8f, 0xdf, 0x8f,
+	0xff, 0x30, 0x7e, 0x73, 0x3e, 0x6e, 0x14, 0x52, 0xd2, 0xc9, 0x70, 0x3c, 0x18, 0x8d, 0x7f, 0xd7,
+	0xb0, 0xd0, 0x3d, 0x40, 0x1b, 0x69, 0xff, 0xcd, 0xeb, 0xc9, 0xd9, 0x70, 0x36, 0x1c, 0x34, 0x8a,
+	0xc7, 0x4f, 0x00, 0x92, 0xc6, 0xe5, 0x8e, 0xa7, 0xa3, 0xc1, 0xf0, 0xc7, 0xfe, 0xab, 0xde, 0x68,
+	0x2c, 0x03, 0x39, 0x1b, 0xfd, 0x49, 0x9f, 0xad, 0xee, 0x3f, 0x2a, 0xb0, 0xcf, 0x57, 0xca, 0xd7,
+	0x38, 0xc0, 0x0b, 0x91, 0x2d, 0xf4, 0x2d, 0x94, 0xf8, 0x3b, 0x03, 0x1d, 0x25, 0x0b, 0xaa, 0xb1,
+	0x70, 0xb6, 0x9b, 0x59, 0x71, 0xe4, 0xdf, 0xd8, 0x05, 0xf4, 0x25, 0xec, 0x4e, 0xd6, 0x74, 0xc9,
+	0xc5, 0xa8, 0x2e, 0x29, 0xfd, 0xe5, 0x3a, 0xb8, 0x6a, 0xef, 0xab, 0x4c, 0xc6, 0xe1, 0x82, 0xb7,
+	0x87, 0x5d, 0xe8, 0x58, 0x4f, 0x2d, 0xd4, 0x83, 0xfa, 0x68, 0x85, 0x17, 0xe4, 0x0c, 0xdf, 0x90,
+	0x98, 0x22, 0x95, 0x6e, 0x43, 0xa4, 0xdd, 0xdd, 0xcb, 0x41, 0xa4, 0xc7, 0xe7, 0x50, 0x9e, 0x32,
+	0x1c, 0x33, 0xa4, 0x28, 0xe2, 0xc0, 0xfd, 0x6b, 0xd5, 0xc3, 0x5b, 0x72, 0xa9, 0xf8, 0x02, 0xea,
+	0xc6, 0x7e, 0xae, 0x7d, 0xdf, 0x5e, 0xd9, 0xdb, 0x77, 0x25, 0xa2, 0xa4, 0xd3, 0x88, 0xcc, 0xed,
+	0x02, 0xfa, 0x0a, 0x2a, 0xaa, 0x5a, 0xa9, 0x0d, 0xbe, 0x6d, 0xa4, 0x4b, 0xe2, 0xda, 0xdd, 0xaf,
+	0xa1, 0x74, 0x16, 0x2e, 0x68, 0x2a, 0x9f, 0xe1, 0x82, 0xe6, 0xe5, 0x33, 0x5c, 0x50, 0x91, 0x34,
+	0xbb, 0xf0, 0xd4, 0x42, 0xbf, 0x82, 0xd2, 0x94, 0x85, 0x51, 0xc6, 0x8d, 0xca, 0xed, 0x70, 0x15,
+	0x31, 0x6e, 0xbc, 0xcb, 0xd3, 0xee, 0xfb, 0x22, 0xed, 0xca, 0x81, 0x3e, 0x6b, 0x07, 0x66, 0x35,
+	0x84, 0xe1, 0xa7, 0x50, 0x1a, 0xbe, 0x27, 0x73, 0xa4, 0x1e, 0x8f, 0xff, 0xd6, 0xdc, 0x03, 0x53,
+	0x24, 0xc2, 0x17, 0xd5, 0x3a, 0x81, 0x4a, 0x3f, 0x8c, 0x6e, 0x66, 0x21, 0x52, 0xd1, 0xca, 0x53,
+	0xc6, 0x83, 0x8a, 0xa9, 0x63, 0xf1, 0xa8, 0x38, 0xe3, 0x25, 0xbf, 0x08, 0x8e, 0x12, 0x0d, 0x7e,
+	0xde, 0x1a, 0xd5, 0x33, 0x28, 0x9f, 0x63, 0x36, 0x5f, 0xa2, 0x4f, 0x24, 0x22, 0x0e, 0xfc, 0x39,
+	0x68, 0x26, 0x38, 0x2e, 0x1b, 0xbe, 0x23, 0x01, 0x13, 0x6a, 0xcf, 0x79, 0x68, 0xab, 0x95, 0xc7,
+	0xb4, 0x9e, 0x3c, 0x99, 0x09, 0x40, 0x46, 0x65, 0x02, 0x1c, 0xd1, 0x65, 0xc8, 0xec, 0x02, 0xea,
+	0x43, 0x4d, 0x9f, 0x28, 0x6a, 0xdf, 0xa6, 0x6c, 0xdc, 0xb6, 0x72, 0x31, 0x91, 0x9c, 0xee, 0x7f,
+	0x76, 0x60, 0x9f, 0x2f, 0x8a, 0xc6, 0xf8, 0x7c, 0xa6, 0xc6, 0x47, 0x3f, 0x20, 0x7f, 0xf5, 0xb4,
+	0x1b, 0xc9, 0x46, 0xbc, 0xe9, 0x8b, 0xcf, 0x37, 0x8d, 0xb4, 0xab, 0x7a, 0x7c, 0xd0, 0x6e, 0x26,
+	0xbc, 0x51, 0x70, 0x19, 0x6a, 0xea, 0x53, 0xa8, 0xc8, 0x4f, 0x37, 0xf4, 0x49, 0x42, 0x48, 0x7d,
+	0xcc, 0x65, 0xfb, 0xe2, 0x0b, 0x28, 0xf1, 0x25, 0x56, 0x67, 0x3f, 0xb3, 0xd0, 0xb6, 0x8d, 0x0f,
+	0x33, 0x91, 0x0a, 0xd4, 0x5f, 0xe2, 0x60, 0xa1, 0xdf, 0x87, 0x54, 0x3c, 0x40, 0xea, 0x66, 0x6e,
+	0xff, 0x32, 0xd1, 0x48, 0x73, 0x75, 0x8c, 0xbf, 0x85, 0x66, 0x5f, 0xec, 0x33, 0x29, 0xd8, 0x0c,
+	0x38, 0x05, 0xb4, 0x53, 0xe6, 0xed, 0x02, 0xfa, 0x06, 0x0e, 0xd5, 0xa6, 0x90, 0x36, 0x90, 0x0e,
+	0xe3, 0x56, 0xfb, 0x37, 0xfb, 0xfc, 0x15, 0xe8, 0xff, 0x0c, 0x9d, 0x13, 0xd8, 0xdd, 0x7c, 0x7d,
+	0x9a, 0x90, 0x4e, 0x7e, 0xea, 0xd3, 0xc6, 0x2e, 0x74, 0xff, 0x6e, 0x41, 0xe3, 0xb5, 0x58, 0xbf,
+	0x8d, 0x2a, 0x7f, 0x07, 0x75, 0xb9, 0x33, 0xcb, 0x5c, 0xdd, 0x7a, 0x0b, 0xea, 0xeb, 0x20, 0xb3,
+	0x83, 0x8b, 0x5a, 0xde, 0x91, 0xc2, 0x7e, 0x18, 0x5c, 0x7a, 0xf1, 0x2a, 0x47, 0x37, 0x13, 0xf0,
+	0x77, 0xb0, 0x67, 0x7e, 0x35, 0xa0, 0xfb, 0xa6, 0xe9, 0xd4, 0x97, 0x44, 0x46, 0xb3, 0xfb, 0xaf,
+	0x1d, 0x38, 0x10, 0x2f, 0x03, 0x23, 0xf2, 0x0e, 0xc0, 0x8c, 0x50, 0x26, 0xc4, 0x34, 0x9d, 0x80,
+	0x8c, 0xdf, 0x27, 0x50, 0xd5, 0xfb, 0x7c, 0x8a, 0xa6, 0xe6, 0xc9, 0xfc, 0x40, 0x10, 0x69, 0xad,
+	0x0e, 0x48, 0x14, 0x52, 0x2f, 0x9b, 0xfe, 0xbc, 0x8d, 0x55, 0xdc, 0xa3, 0xbb, 0xe7, 0x1e, 0x5b,
+	0xba, 0x31, 0xbe, 0xfe, 0x38, 0x85, 0x67, 0x50, 0xd3, 0xa7, 0x4c, 0xdc, 0x79, 0xfb, 0xf8, 0xa6,
+	0x2f, 0x87, 0xb0, 0xab, 0x65, 0xa8, 0x9d, 0x43, 0xd4, 0xa9, 0xfb, 0x45, 0x16, 0x33, 0x16, 0x29,
+	0x31, 0x23, 0x55, 0xd5, 0x9e, 0xc8, 0x74, 0x97, 0x5e, 0x6e, 0x3f, 0x64, 0x64, 0x08, 0xb5, 0xcd,
+	0x9a, 0x87, 0x4c, 0x6e, 0x76, 0x23, 0x6d, 0xdf, 0xcf, 0x07, 0x65, 0x47, 0xfe, 0x11, 0x8e, 0x0c,
+	0xe3, 0xa9, 0xae, 0xac, 0x4e, 0xf2, 0x3a, 0xfb, 0xe1, 0xb6, 0x6d, 0x66, 0x73, 0x91, 0x2d, 0xa1,
+	0xb6, 0xf9, 0x4b, 0x87, 0xbf, 0x20, 0xb6, 0x74, 0xb5, 0x7a, 0xa5, 0x6e, 0xa8, 0xc6, 0x5d, 0xa6,
+	0x9a, 0xf3, 0x43, 0xdd, 0x7c, 0x51, 0x11, 0x7f, 0x92, 0x7d, 0xf3, 0xff, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0xa2, 0x69, 0x9f, 0x8e, 0xa5, 0x13, 0x00, 0x00,
 }
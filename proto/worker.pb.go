@@ -25,15 +25,58 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type TaskEvent_Type int32
+
+const (
+	TaskEvent_UNKNOWN    TaskEvent_Type = 0
+	TaskEvent_SPOOLING   TaskEvent_Type = 1
+	TaskEvent_SPAWNING   TaskEvent_Type = 2
+	TaskEvent_RUNNING    TaskEvent_Type = 3
+	TaskEvent_FINISHED   TaskEvent_Type = 4
+	TaskEvent_BROKEN     TaskEvent_Type = 5
+	TaskEvent_OOM_KILLED TaskEvent_Type = 6
+	TaskEvent_RESTARTED  TaskEvent_Type = 7
+	TaskEvent_UNHEALTHY  TaskEvent_Type = 8
+)
+
+var TaskEvent_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "SPOOLING",
+	2: "SPAWNING",
+	3: "RUNNING",
+	4: "FINISHED",
+	5: "BROKEN",
+	6: "OOM_KILLED",
+	7: "RESTARTED",
+	8: "UNHEALTHY",
+}
+var TaskEvent_Type_value = map[string]int32{
+	"UNKNOWN":    0,
+	"SPOOLING":   1,
+	"SPAWNING":   2,
+	"RUNNING":    3,
+	"FINISHED":   4,
+	"BROKEN":     5,
+	"OOM_KILLED": 6,
+	"RESTARTED":  7,
+	"UNHEALTHY":  8,
+}
+
+func (x TaskEvent_Type) String() string {
+	return proto.EnumName(TaskEvent_Type_name, int32(x))
+}
+func (TaskEvent_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor14, []int{16, 0} }
+
 type TaskStatusReply_Status int32
 
 const (
-	TaskStatusReply_UNKNOWN  TaskStatusReply_Status = 0
-	TaskStatusReply_SPOOLING TaskStatusReply_Status = 1
-	TaskStatusReply_SPAWNING TaskStatusReply_Status = 2
-	TaskStatusReply_RUNNING  TaskStatusReply_Status = 3
-	TaskStatusReply_FINISHED TaskStatusReply_Status = 4
-	TaskStatusReply_BROKEN   TaskStatusReply_Status = 5
+	TaskStatusReply_UNKNOWN   TaskStatusReply_Status = 0
+	TaskStatusReply_SPOOLING  TaskStatusReply_Status = 1
+	TaskStatusReply_SPAWNING  TaskStatusReply_Status = 2
+	TaskStatusReply_RUNNING   TaskStatusReply_Status = 3
+	TaskStatusReply_FINISHED  TaskStatusReply_Status = 4
+	TaskStatusReply_BROKEN    TaskStatusReply_Status = 5
+	TaskStatusReply_UNHEALTHY TaskStatusReply_Status = 6
 )
 
 var TaskStatusReply_Status_name = map[int32]string{
@@ -43,20 +86,22 @@ var TaskStatusReply_Status_name = map[int32]string{
 	3: "RUNNING",
 	4: "FINISHED",
 	5: "BROKEN",
+	6: "UNHEALTHY",
 }
 var TaskStatusReply_Status_value = map[string]int32{
-	"UNKNOWN":  0,
-	"SPOOLING": 1,
-	"SPAWNING": 2,
-	"RUNNING":  3,
-	"FINISHED": 4,
-	"BROKEN":   5,
+	"UNKNOWN":   0,
+	"SPOOLING":  1,
+	"SPAWNING":  2,
+	"RUNNING":   3,
+	"FINISHED":  4,
+	"BROKEN":    5,
+	"UNHEALTHY": 6,
 }
 
 func (x TaskStatusReply_Status) String() string {
 	return proto.EnumName(TaskStatusReply_Status_name, int32(x))
 }
-func (TaskStatusReply_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor14, []int{9, 0} }
+func (TaskStatusReply_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor14, []int{18, 0} }
 
 type StartTaskRequest struct {
 	// Deal points to the deal associated with workers where the task should be
@@ -296,6 +341,14 @@ func (m *DevicesReply) GetStorage() *Storage {
 type PullTaskRequest struct {
 	DealId string `protobuf:"bytes,1,opt,name=dealId" json:"dealId,omitempty"`
 	TaskId string `protobuf:"bytes,2,opt,name=taskId" json:"taskId,omitempty"`
+	// Offset allows to resume the interrupted pull.
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+	// Digest of the archive being resumed. The pull fails if the archive has
+	// changed since then.
+	Digest string `protobuf:"bytes,4,opt,name=digest" json:"digest,omitempty"`
+	// Snapshot is the serial number of the task's snapshot to pull instead
+	// of the image committed on stop.
+	Snapshot uint64 `protobuf:"varint,5,opt,name=snapshot" json:"snapshot,omitempty"`
 }
 
 func (m *PullTaskRequest) Reset()                    { *m = PullTaskRequest{} }
@@ -317,6 +370,358 @@ func (m *PullTaskRequest) GetTaskId() string {
 	return ""
 }
 
+func (m *PullTaskRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PullTaskRequest) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *PullTaskRequest) GetSnapshot() uint64 {
+	if m != nil {
+		return m.Snapshot
+	}
+	return 0
+}
+
+type ImageLayersRequest struct {
+	DealId string `protobuf:"bytes,1,opt,name=dealId" json:"dealId,omitempty"`
+	// ChainIDs are chain IDs of the image layers.
+	ChainIDs []string `protobuf:"bytes,2,rep,name=chainIDs" json:"chainIDs,omitempty"`
+}
+
+func (m *ImageLayersRequest) Reset()                    { *m = ImageLayersRequest{} }
+func (m *ImageLayersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageLayersRequest) ProtoMessage()               {}
+func (*ImageLayersRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{8} }
+
+func (m *ImageLayersRequest) GetDealId() string {
+	if m != nil {
+		return m.DealId
+	}
+	return ""
+}
+
+func (m *ImageLayersRequest) GetChainIDs() []string {
+	if m != nil {
+		return m.ChainIDs
+	}
+	return nil
+}
+
+type ImageLayersReply struct {
+	// ChainIDs are chain IDs of the requested layers the Worker already has.
+	ChainIDs []string `protobuf:"bytes,1,rep,name=chainIDs" json:"chainIDs,omitempty"`
+}
+
+func (m *ImageLayersReply) Reset()                    { *m = ImageLayersReply{} }
+func (m *ImageLayersReply) String() string            { return proto.CompactTextString(m) }
+func (*ImageLayersReply) ProtoMessage()               {}
+func (*ImageLayersReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{9} }
+
+func (m *ImageLayersReply) GetChainIDs() []string {
+	if m != nil {
+		return m.ChainIDs
+	}
+	return nil
+}
+
+type TerminalSize struct {
+	Width  uint32 `protobuf:"varint,1,opt,name=width" json:"width,omitempty"`
+	Height uint32 `protobuf:"varint,2,opt,name=height" json:"height,omitempty"`
+}
+
+func (m *TerminalSize) Reset()                    { *m = TerminalSize{} }
+func (m *TerminalSize) String() string            { return proto.CompactTextString(m) }
+func (*TerminalSize) ProtoMessage()               {}
+func (*TerminalSize) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{10} }
+
+func (m *TerminalSize) GetWidth() uint32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *TerminalSize) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ExecRequest struct {
+	Id     string            `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	DealID *BigInt           `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	Cmd    []string          `protobuf:"bytes,3,rep,name=cmd" json:"cmd,omitempty"`
+	Env    map[string]string `protobuf:"bytes,4,rep,name=env" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Tty specifies whether a pseudo-terminal should be allocated. In this
+	// case stdout and stderr are merged.
+	Tty   bool          `protobuf:"varint,5,opt,name=tty" json:"tty,omitempty"`
+	Stdin []byte        `protobuf:"bytes,6,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Size  *TerminalSize `protobuf:"bytes,7,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *ExecRequest) Reset()                    { *m = ExecRequest{} }
+func (m *ExecRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExecRequest) ProtoMessage()               {}
+func (*ExecRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{11} }
+
+func (m *ExecRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExecRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *ExecRequest) GetCmd() []string {
+	if m != nil {
+		return m.Cmd
+	}
+	return nil
+}
+
+func (m *ExecRequest) GetEnv() map[string]string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *ExecRequest) GetTty() bool {
+	if m != nil {
+		return m.Tty
+	}
+	return false
+}
+
+func (m *ExecRequest) GetStdin() []byte {
+	if m != nil {
+		return m.Stdin
+	}
+	return nil
+}
+
+func (m *ExecRequest) GetSize() *TerminalSize {
+	if m != nil {
+		return m.Size
+	}
+	return nil
+}
+
+type ExecReply struct {
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	// Exited is set in the last message only, after the command has finished.
+	Exited   bool  `protobuf:"varint,3,opt,name=exited" json:"exited,omitempty"`
+	ExitCode int32 `protobuf:"varint,4,opt,name=exitCode" json:"exitCode,omitempty"`
+}
+
+func (m *ExecReply) Reset()                    { *m = ExecReply{} }
+func (m *ExecReply) String() string            { return proto.CompactTextString(m) }
+func (*ExecReply) ProtoMessage()               {}
+func (*ExecReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{12} }
+
+func (m *ExecReply) GetStdout() []byte {
+	if m != nil {
+		return m.Stdout
+	}
+	return nil
+}
+
+func (m *ExecReply) GetStderr() []byte {
+	if m != nil {
+		return m.Stderr
+	}
+	return nil
+}
+
+func (m *ExecReply) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+func (m *ExecReply) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+type CopyToRequest struct {
+	Id     string  `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	DealID *BigInt `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	Path   string  `protobuf:"bytes,3,opt,name=path" json:"path,omitempty"`
+	// Chunk is a part of the tar archive to be extracted.
+	Chunk []byte `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (m *CopyToRequest) Reset()                    { *m = CopyToRequest{} }
+func (m *CopyToRequest) String() string            { return proto.CompactTextString(m) }
+func (*CopyToRequest) ProtoMessage()               {}
+func (*CopyToRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{13} }
+
+func (m *CopyToRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CopyToRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *CopyToRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CopyToRequest) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+type CopyFromRequest struct {
+	Id     string  `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	DealID *BigInt `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	Path   string  `protobuf:"bytes,3,opt,name=path" json:"path,omitempty"`
+}
+
+func (m *CopyFromRequest) Reset()                    { *m = CopyFromRequest{} }
+func (m *CopyFromRequest) String() string            { return proto.CompactTextString(m) }
+func (*CopyFromRequest) ProtoMessage()               {}
+func (*CopyFromRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{14} }
+
+func (m *CopyFromRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CopyFromRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *CopyFromRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type WatchTasksRequest struct {
+	// DealID limits events to tasks of the given deal. Required unless the
+	// request is made by the Worker's owner.
+	DealID *BigInt `protobuf:"bytes,1,opt,name=dealID" json:"dealID,omitempty"`
+	// Id limits events to the given task.
+	Id string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *WatchTasksRequest) Reset()                    { *m = WatchTasksRequest{} }
+func (m *WatchTasksRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchTasksRequest) ProtoMessage()               {}
+func (*WatchTasksRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{15} }
+
+func (m *WatchTasksRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *WatchTasksRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type TaskEvent struct {
+	Id        string         `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	DealID    *BigInt        `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	Type      TaskEvent_Type `protobuf:"varint,3,opt,name=type,enum=sonm.TaskEvent_Type" json:"type,omitempty"`
+	Timestamp *Timestamp     `protobuf:"bytes,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	// ExitCode describes the exit code of the container's last run. Makes
+	// sense only for FINISHED, BROKEN, OOM_KILLED and RESTARTED events.
+	ExitCode     int32  `protobuf:"varint,5,opt,name=exitCode" json:"exitCode,omitempty"`
+	RestartCount uint32 `protobuf:"varint,6,opt,name=restartCount" json:"restartCount,omitempty"`
+}
+
+func (m *TaskEvent) Reset()                    { *m = TaskEvent{} }
+func (m *TaskEvent) String() string            { return proto.CompactTextString(m) }
+func (*TaskEvent) ProtoMessage()               {}
+func (*TaskEvent) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{16} }
+
+func (m *TaskEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TaskEvent) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *TaskEvent) GetType() TaskEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return TaskEvent_UNKNOWN
+}
+
+func (m *TaskEvent) GetTimestamp() *Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *TaskEvent) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *TaskEvent) GetRestartCount() uint32 {
+	if m != nil {
+		return m.RestartCount
+	}
+	return 0
+}
+
 type DealInfoReply struct {
 	Deal *Deal `protobuf:"bytes,1,opt,name=deal" json:"deal,omitempty"`
 	// List of currently running tasks.
@@ -331,7 +736,7 @@ type DealInfoReply struct {
 func (m *DealInfoReply) Reset()                    { *m = DealInfoReply{} }
 func (m *DealInfoReply) String() string            { return proto.CompactTextString(m) }
 func (*DealInfoReply) ProtoMessage()               {}
-func (*DealInfoReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{8} }
+func (*DealInfoReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{17} }
 
 func (m *DealInfoReply) GetDeal() *Deal {
 	if m != nil {
@@ -368,12 +773,17 @@ type TaskStatusReply struct {
 	Uptime             uint64                 `protobuf:"varint,4,opt,name=uptime" json:"uptime,omitempty"`
 	Usage              *ResourceUsage         `protobuf:"bytes,5,opt,name=usage" json:"usage,omitempty"`
 	AllocatedResources *AskPlanResources      `protobuf:"bytes,6,opt,name=allocatedResources" json:"allocatedResources,omitempty"`
+	// RestartCount describes how many times the task's container has been
+	// restarted according to its restart policy.
+	RestartCount uint32 `protobuf:"varint,7,opt,name=restartCount" json:"restartCount,omitempty"`
+	// ExitCode describes the exit code of the container's last run.
+	ExitCode int32 `protobuf:"varint,8,opt,name=exitCode" json:"exitCode,omitempty"`
 }
 
 func (m *TaskStatusReply) Reset()                    { *m = TaskStatusReply{} }
 func (m *TaskStatusReply) String() string            { return proto.CompactTextString(m) }
 func (*TaskStatusReply) ProtoMessage()               {}
-func (*TaskStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{9} }
+func (*TaskStatusReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{18} }
 
 func (m *TaskStatusReply) GetStatus() TaskStatusReply_Status {
 	if m != nil {
@@ -417,6 +827,20 @@ func (m *TaskStatusReply) GetAllocatedResources() *AskPlanResources {
 	return nil
 }
 
+func (m *TaskStatusReply) GetRestartCount() uint32 {
+	if m != nil {
+		return m.RestartCount
+	}
+	return 0
+}
+
+func (m *TaskStatusReply) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
 type StatusMapReply struct {
 	Statuses map[string]*TaskStatusReply `protobuf:"bytes,1,rep,name=statuses" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
@@ -424,7 +848,7 @@ type StatusMapReply struct {
 func (m *StatusMapReply) Reset()                    { *m = StatusMapReply{} }
 func (m *StatusMapReply) String() string            { return proto.CompactTextString(m) }
 func (*StatusMapReply) ProtoMessage()               {}
-func (*StatusMapReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{10} }
+func (*StatusMapReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{19} }
 
 func (m *StatusMapReply) GetStatuses() map[string]*TaskStatusReply {
 	if m != nil {
@@ -433,6 +857,245 @@ func (m *StatusMapReply) GetStatuses() map[string]*TaskStatusReply {
 	return nil
 }
 
+type CommitTaskRequest struct {
+	// Id is the task ID.
+	Id     string  `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	DealID *BigInt `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	// Registry to push the snapshot to, e.g. "registry.example.com/user".
+	// The snapshot is kept on the Worker only when empty.
+	Registry string `protobuf:"bytes,3,opt,name=registry" json:"registry,omitempty"`
+	// Auth describes authentication info used for the registry.
+	Auth string `protobuf:"bytes,4,opt,name=auth" json:"auth,omitempty"`
+}
+
+func (m *CommitTaskRequest) Reset()                    { *m = CommitTaskRequest{} }
+func (m *CommitTaskRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommitTaskRequest) ProtoMessage()               {}
+func (*CommitTaskRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{20} }
+
+func (m *CommitTaskRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommitTaskRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *CommitTaskRequest) GetRegistry() string {
+	if m != nil {
+		return m.Registry
+	}
+	return ""
+}
+
+func (m *CommitTaskRequest) GetAuth() string {
+	if m != nil {
+		return m.Auth
+	}
+	return ""
+}
+
+type TaskSnapshot struct {
+	TaskID string  `protobuf:"bytes,1,opt,name=taskID" json:"taskID,omitempty"`
+	DealID *BigInt `protobuf:"bytes,2,opt,name=dealID" json:"dealID,omitempty"`
+	// Serial is the snapshot's number within the task, starting from 1.
+	Serial uint64 `protobuf:"varint,3,opt,name=serial" json:"serial,omitempty"`
+	// Name is the image name the snapshot is tagged with.
+	Name      string     `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	ImageID   string     `protobuf:"bytes,5,opt,name=imageID" json:"imageID,omitempty"`
+	CreatedAt *Timestamp `protobuf:"bytes,6,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Size is the image size in bytes.
+	Size uint64 `protobuf:"varint,7,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *TaskSnapshot) Reset()                    { *m = TaskSnapshot{} }
+func (m *TaskSnapshot) String() string            { return proto.CompactTextString(m) }
+func (*TaskSnapshot) ProtoMessage()               {}
+func (*TaskSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{21} }
+
+func (m *TaskSnapshot) GetTaskID() string {
+	if m != nil {
+		return m.TaskID
+	}
+	return ""
+}
+
+func (m *TaskSnapshot) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+func (m *TaskSnapshot) GetSerial() uint64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *TaskSnapshot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TaskSnapshot) GetImageID() string {
+	if m != nil {
+		return m.ImageID
+	}
+	return ""
+}
+
+func (m *TaskSnapshot) GetCreatedAt() *Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *TaskSnapshot) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type TaskSnapshotsRequest struct {
+	DealID *BigInt `protobuf:"bytes,1,opt,name=dealID" json:"dealID,omitempty"`
+}
+
+func (m *TaskSnapshotsRequest) Reset()                    { *m = TaskSnapshotsRequest{} }
+func (m *TaskSnapshotsRequest) String() string            { return proto.CompactTextString(m) }
+func (*TaskSnapshotsRequest) ProtoMessage()               {}
+func (*TaskSnapshotsRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{22} }
+
+func (m *TaskSnapshotsRequest) GetDealID() *BigInt {
+	if m != nil {
+		return m.DealID
+	}
+	return nil
+}
+
+type TaskSnapshotsReply struct {
+	Snapshots []*TaskSnapshot `protobuf:"bytes,1,rep,name=snapshots" json:"snapshots,omitempty"`
+}
+
+func (m *TaskSnapshotsReply) Reset()                    { *m = TaskSnapshotsReply{} }
+func (m *TaskSnapshotsReply) String() string            { return proto.CompactTextString(m) }
+func (*TaskSnapshotsReply) ProtoMessage()               {}
+func (*TaskSnapshotsReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{23} }
+
+func (m *TaskSnapshotsReply) GetSnapshots() []*TaskSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type WhitelistRecord struct {
+	AllowedHashes []string `protobuf:"bytes,1,rep,name=allowedHashes" json:"allowedHashes,omitempty"`
+}
+
+func (m *WhitelistRecord) Reset()                    { *m = WhitelistRecord{} }
+func (m *WhitelistRecord) String() string            { return proto.CompactTextString(m) }
+func (*WhitelistRecord) ProtoMessage()               {}
+func (*WhitelistRecord) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{24} }
+
+func (m *WhitelistRecord) GetAllowedHashes() []string {
+	if m != nil {
+		return m.AllowedHashes
+	}
+	return nil
+}
+
+type WhitelistReply struct {
+	Enabled bool   `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	Url     string `protobuf:"bytes,2,opt,name=url" json:"url,omitempty"`
+	// Signer is the address the whitelist must be signed with. Empty if
+	// unsigned whitelists are accepted.
+	Signer              string   `protobuf:"bytes,3,opt,name=signer" json:"signer,omitempty"`
+	PrivilegedAddresses []string `protobuf:"bytes,4,rep,name=privilegedAddresses" json:"privilegedAddresses,omitempty"`
+	// Serial is the serial number of the effective whitelist document.
+	Serial uint64 `protobuf:"varint,5,opt,name=serial" json:"serial,omitempty"`
+	// UpdatedAt is the time the effective whitelist document has been
+	// fetched at.
+	UpdatedAt *Timestamp `protobuf:"bytes,6,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// Records maps image names to allowed digests.
+	Records map[string]*WhitelistRecord `protobuf:"bytes,7,rep,name=records" json:"records,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// LastError describes why the latest whitelist update has failed, if
+	// so.
+	LastError string `protobuf:"bytes,8,opt,name=lastError" json:"lastError,omitempty"`
+}
+
+func (m *WhitelistReply) Reset()                    { *m = WhitelistReply{} }
+func (m *WhitelistReply) String() string            { return proto.CompactTextString(m) }
+func (*WhitelistReply) ProtoMessage()               {}
+func (*WhitelistReply) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{25} }
+
+func (m *WhitelistReply) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *WhitelistReply) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WhitelistReply) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *WhitelistReply) GetPrivilegedAddresses() []string {
+	if m != nil {
+		return m.PrivilegedAddresses
+	}
+	return nil
+}
+
+func (m *WhitelistReply) GetSerial() uint64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *WhitelistReply) GetUpdatedAt() *Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *WhitelistReply) GetRecords() map[string]*WhitelistRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *WhitelistReply) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StartTaskRequest)(nil), "sonm.StartTaskRequest")
 	proto.RegisterType((*WorkerJoinNetworkRequest)(nil), "sonm.WorkerJoinNetworkRequest")
@@ -442,9 +1105,25 @@ func init() {
 	proto.RegisterType((*TaskListReply)(nil), "sonm.TaskListReply")
 	proto.RegisterType((*DevicesReply)(nil), "sonm.DevicesReply")
 	proto.RegisterType((*PullTaskRequest)(nil), "sonm.PullTaskRequest")
+	proto.RegisterType((*ImageLayersRequest)(nil), "sonm.ImageLayersRequest")
+	proto.RegisterType((*ImageLayersReply)(nil), "sonm.ImageLayersReply")
+	proto.RegisterType((*TerminalSize)(nil), "sonm.TerminalSize")
+	proto.RegisterType((*ExecRequest)(nil), "sonm.ExecRequest")
+	proto.RegisterType((*ExecReply)(nil), "sonm.ExecReply")
+	proto.RegisterType((*CopyToRequest)(nil), "sonm.CopyToRequest")
+	proto.RegisterType((*CopyFromRequest)(nil), "sonm.CopyFromRequest")
+	proto.RegisterType((*WatchTasksRequest)(nil), "sonm.WatchTasksRequest")
+	proto.RegisterType((*TaskEvent)(nil), "sonm.TaskEvent")
 	proto.RegisterType((*DealInfoReply)(nil), "sonm.DealInfoReply")
 	proto.RegisterType((*TaskStatusReply)(nil), "sonm.TaskStatusReply")
 	proto.RegisterType((*StatusMapReply)(nil), "sonm.StatusMapReply")
+	proto.RegisterType((*CommitTaskRequest)(nil), "sonm.CommitTaskRequest")
+	proto.RegisterType((*TaskSnapshot)(nil), "sonm.TaskSnapshot")
+	proto.RegisterType((*TaskSnapshotsRequest)(nil), "sonm.TaskSnapshotsRequest")
+	proto.RegisterType((*TaskSnapshotsReply)(nil), "sonm.TaskSnapshotsReply")
+	proto.RegisterType((*WhitelistRecord)(nil), "sonm.WhitelistRecord")
+	proto.RegisterType((*WhitelistReply)(nil), "sonm.WhitelistReply")
+	proto.RegisterEnum("sonm.TaskEvent_Type", TaskEvent_Type_name, TaskEvent_Type_value)
 	proto.RegisterEnum("sonm.TaskStatusReply_Status", TaskStatusReply_Status_name, TaskStatusReply_Status_value)
 }
 
@@ -479,6 +1158,8 @@ type WorkerManagementClient interface {
 	RemoveAskPlan(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error)
 	// PurgeAskPlans removes all ask-plans
 	PurgeAskPlans(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// Whitelist shows the effective image whitelist policy.
+	Whitelist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WhitelistReply, error)
 }
 
 type workerManagementClient struct {
@@ -561,6 +1242,15 @@ func (c *workerManagementClient) PurgeAskPlans(ctx context.Context, in *Empty, o
 	return out, nil
 }
 
+func (c *workerManagementClient) Whitelist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*WhitelistReply, error) {
+	out := new(WhitelistReply)
+	err := grpc.Invoke(ctx, "/sonm.WorkerManagement/Whitelist", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for WorkerManagement service
 
 type WorkerManagementServer interface {
@@ -584,6 +1274,8 @@ type WorkerManagementServer interface {
 	RemoveAskPlan(context.Context, *ID) (*Empty, error)
 	// PurgeAskPlans removes all ask-plans
 	PurgeAskPlans(context.Context, *Empty) (*Empty, error)
+	// Whitelist shows the effective image whitelist policy.
+	Whitelist(context.Context, *Empty) (*WhitelistReply, error)
 }
 
 func RegisterWorkerManagementServer(s *grpc.Server, srv WorkerManagementServer) {
@@ -734,6 +1426,24 @@ func _WorkerManagement_PurgeAskPlans_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkerManagement_Whitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerManagementServer).Whitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.WorkerManagement/Whitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerManagementServer).Whitelist(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkerManagement_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.WorkerManagement",
 	HandlerType: (*WorkerManagementServer)(nil),
@@ -770,6 +1480,10 @@ var _WorkerManagement_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeAskPlans",
 			Handler:    _WorkerManagement_PurgeAskPlans_Handler,
 		},
+		{
+			MethodName: "Whitelist",
+			Handler:    _WorkerManagement_Whitelist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "worker.proto",
@@ -778,7 +1492,15 @@ var _WorkerManagement_serviceDesc = grpc.ServiceDesc{
 // Client API for Worker service
 
 type WorkerClient interface {
+	// PushTask loads an image archive streamed by chunks.
+	// If "digest" header is specified the archive is verified and can be
+	// resumed from the offset returned in the response header.
 	PushTask(ctx context.Context, opts ...grpc.CallOption) (Worker_PushTaskClient, error)
+	// ImageLayers returns which of the given image layers are already loaded.
+	ImageLayers(ctx context.Context, in *ImageLayersRequest, opts ...grpc.CallOption) (*ImageLayersReply, error)
+	// PullTask streams the task's image archive. The response header carries
+	// size and digest of the archive as well as the offset the streaming
+	// starts at.
 	PullTask(ctx context.Context, in *PullTaskRequest, opts ...grpc.CallOption) (Worker_PullTaskClient, error)
 	// StartTask schedules the task associcated with a deal.
 	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskReply, error)
@@ -786,6 +1508,27 @@ type WorkerClient interface {
 	TaskStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskStatusReply, error)
 	JoinNetwork(ctx context.Context, in *WorkerJoinNetworkRequest, opts ...grpc.CallOption) (*NetworkSpec, error)
 	TaskLogs(ctx context.Context, in *TaskLogsRequest, opts ...grpc.CallOption) (Worker_TaskLogsClient, error)
+	// Exec executes a command inside the task's container.
+	// The first request must specify the task, the deal and the command to
+	// execute, the following ones may carry stdin data or terminal resize
+	// events.
+	Exec(ctx context.Context, opts ...grpc.CallOption) (Worker_ExecClient, error)
+	// CopyTo uploads a tar archive into the task's container and extracts it
+	// at the given path.
+	// The first request must specify the task, the deal and the path.
+	CopyTo(ctx context.Context, opts ...grpc.CallOption) (Worker_CopyToClient, error)
+	// CopyFrom downloads the given path from the task's container as a tar
+	// archive.
+	CopyFrom(ctx context.Context, in *CopyFromRequest, opts ...grpc.CallOption) (Worker_CopyFromClient, error)
+	// WatchTasks streams task lifecycle events, optionally filtered by deal
+	// or task.
+	WatchTasks(ctx context.Context, in *WatchTasksRequest, opts ...grpc.CallOption) (Worker_WatchTasksClient, error)
+	// CommitTask snapshots the running task into an image tagged as
+	// "<deal>_<task>_<n>", where "n" is the snapshot's serial number within
+	// the task. The snapshot is pushed to the registry if one is specified.
+	CommitTask(ctx context.Context, in *CommitTaskRequest, opts ...grpc.CallOption) (*TaskSnapshot, error)
+	// TaskSnapshots lists snapshots of tasks of the given deal.
+	TaskSnapshots(ctx context.Context, in *TaskSnapshotsRequest, opts ...grpc.CallOption) (*TaskSnapshotsReply, error)
 	// Note: currently used for testing pusposes.
 	GetDealInfo(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DealInfoReply, error)
 }
@@ -829,6 +1572,15 @@ func (x *workerPushTaskClient) Recv() (*Progress, error) {
 	return m, nil
 }
 
+func (c *workerClient) ImageLayers(ctx context.Context, in *ImageLayersRequest, opts ...grpc.CallOption) (*ImageLayersReply, error) {
+	out := new(ImageLayersReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/ImageLayers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workerClient) PullTask(ctx context.Context, in *PullTaskRequest, opts ...grpc.CallOption) (Worker_PullTaskClient, error) {
 	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[1], c.cc, "/sonm.Worker/PullTask", opts...)
 	if err != nil {
@@ -867,42 +1619,171 @@ func (c *workerClient) StartTask(ctx context.Context, in *StartTaskRequest, opts
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *workerClient) StopTask(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/sonm.Worker/StopTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) TaskStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskStatusReply, error) {
+	out := new(TaskStatusReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/TaskStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) JoinNetwork(ctx context.Context, in *WorkerJoinNetworkRequest, opts ...grpc.CallOption) (*NetworkSpec, error) {
+	out := new(NetworkSpec)
+	err := grpc.Invoke(ctx, "/sonm.Worker/JoinNetwork", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) TaskLogs(ctx context.Context, in *TaskLogsRequest, opts ...grpc.CallOption) (Worker_TaskLogsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[2], c.cc, "/sonm.Worker/TaskLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerTaskLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Worker_TaskLogsClient interface {
+	Recv() (*TaskLogsChunk, error)
+	grpc.ClientStream
+}
+
+type workerTaskLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerTaskLogsClient) Recv() (*TaskLogsChunk, error) {
+	m := new(TaskLogsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workerClient) Exec(ctx context.Context, opts ...grpc.CallOption) (Worker_ExecClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[3], c.cc, "/sonm.Worker/Exec", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerExecClient{stream}
+	return x, nil
+}
+
+type Worker_ExecClient interface {
+	Send(*ExecRequest) error
+	Recv() (*ExecReply, error)
+	grpc.ClientStream
+}
+
+type workerExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerExecClient) Send(m *ExecRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerExecClient) Recv() (*ExecReply, error) {
+	m := new(ExecReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workerClient) CopyTo(ctx context.Context, opts ...grpc.CallOption) (Worker_CopyToClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[4], c.cc, "/sonm.Worker/CopyTo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerCopyToClient{stream}
+	return x, nil
+}
+
+type Worker_CopyToClient interface {
+	Send(*CopyToRequest) error
+	CloseAndRecv() (*Empty, error)
+	grpc.ClientStream
+}
+
+type workerCopyToClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerCopyToClient) Send(m *CopyToRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerCopyToClient) CloseAndRecv() (*Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (c *workerClient) StopTask(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := grpc.Invoke(ctx, "/sonm.Worker/StopTask", in, out, c.cc, opts...)
+func (c *workerClient) CopyFrom(ctx context.Context, in *CopyFromRequest, opts ...grpc.CallOption) (Worker_CopyFromClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[5], c.cc, "/sonm.Worker/CopyFrom", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *workerClient) TaskStatus(ctx context.Context, in *ID, opts ...grpc.CallOption) (*TaskStatusReply, error) {
-	out := new(TaskStatusReply)
-	err := grpc.Invoke(ctx, "/sonm.Worker/TaskStatus", in, out, c.cc, opts...)
-	if err != nil {
+	x := &workerCopyFromClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *workerClient) JoinNetwork(ctx context.Context, in *WorkerJoinNetworkRequest, opts ...grpc.CallOption) (*NetworkSpec, error) {
-	out := new(NetworkSpec)
-	err := grpc.Invoke(ctx, "/sonm.Worker/JoinNetwork", in, out, c.cc, opts...)
-	if err != nil {
+type Worker_CopyFromClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type workerCopyFromClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerCopyFromClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *workerClient) TaskLogs(ctx context.Context, in *TaskLogsRequest, opts ...grpc.CallOption) (Worker_TaskLogsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[2], c.cc, "/sonm.Worker/TaskLogs", opts...)
+func (c *workerClient) WatchTasks(ctx context.Context, in *WatchTasksRequest, opts ...grpc.CallOption) (Worker_WatchTasksClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Worker_serviceDesc.Streams[6], c.cc, "/sonm.Worker/WatchTasks", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &workerTaskLogsClient{stream}
+	x := &workerWatchTasksClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -912,23 +1793,41 @@ func (c *workerClient) TaskLogs(ctx context.Context, in *TaskLogsRequest, opts .
 	return x, nil
 }
 
-type Worker_TaskLogsClient interface {
-	Recv() (*TaskLogsChunk, error)
+type Worker_WatchTasksClient interface {
+	Recv() (*TaskEvent, error)
 	grpc.ClientStream
 }
 
-type workerTaskLogsClient struct {
+type workerWatchTasksClient struct {
 	grpc.ClientStream
 }
 
-func (x *workerTaskLogsClient) Recv() (*TaskLogsChunk, error) {
-	m := new(TaskLogsChunk)
+func (x *workerWatchTasksClient) Recv() (*TaskEvent, error) {
+	m := new(TaskEvent)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
+func (c *workerClient) CommitTask(ctx context.Context, in *CommitTaskRequest, opts ...grpc.CallOption) (*TaskSnapshot, error) {
+	out := new(TaskSnapshot)
+	err := grpc.Invoke(ctx, "/sonm.Worker/CommitTask", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) TaskSnapshots(ctx context.Context, in *TaskSnapshotsRequest, opts ...grpc.CallOption) (*TaskSnapshotsReply, error) {
+	out := new(TaskSnapshotsReply)
+	err := grpc.Invoke(ctx, "/sonm.Worker/TaskSnapshots", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workerClient) GetDealInfo(ctx context.Context, in *ID, opts ...grpc.CallOption) (*DealInfoReply, error) {
 	out := new(DealInfoReply)
 	err := grpc.Invoke(ctx, "/sonm.Worker/GetDealInfo", in, out, c.cc, opts...)
@@ -941,7 +1840,15 @@ func (c *workerClient) GetDealInfo(ctx context.Context, in *ID, opts ...grpc.Cal
 // Server API for Worker service
 
 type WorkerServer interface {
+	// PushTask loads an image archive streamed by chunks.
+	// If "digest" header is specified the archive is verified and can be
+	// resumed from the offset returned in the response header.
 	PushTask(Worker_PushTaskServer) error
+	// ImageLayers returns which of the given image layers are already loaded.
+	ImageLayers(context.Context, *ImageLayersRequest) (*ImageLayersReply, error)
+	// PullTask streams the task's image archive. The response header carries
+	// size and digest of the archive as well as the offset the streaming
+	// starts at.
 	PullTask(*PullTaskRequest, Worker_PullTaskServer) error
 	// StartTask schedules the task associcated with a deal.
 	StartTask(context.Context, *StartTaskRequest) (*StartTaskReply, error)
@@ -949,6 +1856,27 @@ type WorkerServer interface {
 	TaskStatus(context.Context, *ID) (*TaskStatusReply, error)
 	JoinNetwork(context.Context, *WorkerJoinNetworkRequest) (*NetworkSpec, error)
 	TaskLogs(*TaskLogsRequest, Worker_TaskLogsServer) error
+	// Exec executes a command inside the task's container.
+	// The first request must specify the task, the deal and the command to
+	// execute, the following ones may carry stdin data or terminal resize
+	// events.
+	Exec(Worker_ExecServer) error
+	// CopyTo uploads a tar archive into the task's container and extracts it
+	// at the given path.
+	// The first request must specify the task, the deal and the path.
+	CopyTo(Worker_CopyToServer) error
+	// CopyFrom downloads the given path from the task's container as a tar
+	// archive.
+	CopyFrom(*CopyFromRequest, Worker_CopyFromServer) error
+	// WatchTasks streams task lifecycle events, optionally filtered by deal
+	// or task.
+	WatchTasks(*WatchTasksRequest, Worker_WatchTasksServer) error
+	// CommitTask snapshots the running task into an image tagged as
+	// "<deal>_<task>_<n>", where "n" is the snapshot's serial number within
+	// the task. The snapshot is pushed to the registry if one is specified.
+	CommitTask(context.Context, *CommitTaskRequest) (*TaskSnapshot, error)
+	// TaskSnapshots lists snapshots of tasks of the given deal.
+	TaskSnapshots(context.Context, *TaskSnapshotsRequest) (*TaskSnapshotsReply, error)
 	// Note: currently used for testing pusposes.
 	GetDealInfo(context.Context, *ID) (*DealInfoReply, error)
 }
@@ -983,6 +1911,24 @@ func (x *workerPushTaskServer) Recv() (*Chunk, error) {
 	return m, nil
 }
 
+func _Worker_ImageLayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageLayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ImageLayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/ImageLayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ImageLayers(ctx, req.(*ImageLayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Worker_PullTask_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PullTaskRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1097,6 +2043,136 @@ func (x *workerTaskLogsServer) Send(m *TaskLogsChunk) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Worker_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).Exec(&workerExecServer{stream})
+}
+
+type Worker_ExecServer interface {
+	Send(*ExecReply) error
+	Recv() (*ExecRequest, error)
+	grpc.ServerStream
+}
+
+type workerExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerExecServer) Send(m *ExecReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerExecServer) Recv() (*ExecRequest, error) {
+	m := new(ExecRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Worker_CopyTo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).CopyTo(&workerCopyToServer{stream})
+}
+
+type Worker_CopyToServer interface {
+	SendAndClose(*Empty) error
+	Recv() (*CopyToRequest, error)
+	grpc.ServerStream
+}
+
+type workerCopyToServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerCopyToServer) SendAndClose(m *Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerCopyToServer) Recv() (*CopyToRequest, error) {
+	m := new(CopyToRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Worker_CopyFrom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyFromRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).CopyFrom(m, &workerCopyFromServer{stream})
+}
+
+type Worker_CopyFromServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type workerCopyFromServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerCopyFromServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Worker_WatchTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkerServer).WatchTasks(m, &workerWatchTasksServer{stream})
+}
+
+type Worker_WatchTasksServer interface {
+	Send(*TaskEvent) error
+	grpc.ServerStream
+}
+
+type workerWatchTasksServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerWatchTasksServer) Send(m *TaskEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Worker_CommitTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).CommitTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/CommitTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).CommitTask(ctx, req.(*CommitTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_TaskSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).TaskSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sonm.Worker/TaskSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).TaskSnapshots(ctx, req.(*TaskSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Worker_GetDealInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
@@ -1119,6 +2195,10 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sonm.Worker",
 	HandlerType: (*WorkerServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ImageLayers",
+			Handler:    _Worker_ImageLayers_Handler,
+		},
 		{
 			MethodName: "StartTask",
 			Handler:    _Worker_StartTask_Handler,
@@ -1135,6 +2215,14 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "JoinNetwork",
 			Handler:    _Worker_JoinNetwork_Handler,
 		},
+		{
+			MethodName: "CommitTask",
+			Handler:    _Worker_CommitTask_Handler,
+		},
+		{
+			MethodName: "TaskSnapshots",
+			Handler:    _Worker_TaskSnapshots_Handler,
+		},
 		{
 			MethodName: "GetDealInfo",
 			Handler:    _Worker_GetDealInfo_Handler,
@@ -1157,6 +2245,27 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Worker_TaskLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Exec",
+			Handler:       _Worker_Exec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyTo",
+			Handler:       _Worker_CopyTo_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "CopyFrom",
+			Handler:       _Worker_CopyFrom_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTasks",
+			Handler:       _Worker_WatchTasks_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "worker.proto",
 }
@@ -1322,6 +2431,25 @@ var _WorkerManagement_PurgeAskPlansCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.Empty"),
 }
 
+var _WorkerManagement_WhitelistCmd = &cobra.Command{
+	Use:   "whitelist",
+	Short: "Make the Whitelist method call, input-type: sonm.Empty output-type: sonm.WhitelistReply",
+	RunE: grpccmd.RunE(
+		"Whitelist",
+		"sonm.Empty",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerManagementClient(cc)
+		},
+	),
+}
+
+var _WorkerManagement_WhitelistCmd_gen = &cobra.Command{
+	Use:   "whitelist-gen",
+	Short: "Generate JSON for method call of Whitelist (input-type: sonm.Empty)",
+	RunE:  grpccmd.TypeToJson("sonm.Empty"),
+}
+
 // Register commands with the root command and service command
 func init() {
 	grpccmd.RegisterServiceCmd(_WorkerManagementCmd)
@@ -1342,6 +2470,8 @@ func init() {
 		_WorkerManagement_RemoveAskPlanCmd_gen,
 		_WorkerManagement_PurgeAskPlansCmd,
 		_WorkerManagement_PurgeAskPlansCmd_gen,
+		_WorkerManagement_WhitelistCmd,
+		_WorkerManagement_WhitelistCmd_gen,
 	)
 }
 
@@ -1370,6 +2500,25 @@ var _Worker_PushTaskCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.Chunk"),
 }
 
+var _Worker_ImageLayersCmd = &cobra.Command{
+	Use:   "imageLayers",
+	Short: "Make the ImageLayers method call, input-type: sonm.ImageLayersRequest output-type: sonm.ImageLayersReply",
+	RunE: grpccmd.RunE(
+		"ImageLayers",
+		"sonm.ImageLayersRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_ImageLayersCmd_gen = &cobra.Command{
+	Use:   "imageLayers-gen",
+	Short: "Generate JSON for method call of ImageLayers (input-type: sonm.ImageLayersRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.ImageLayersRequest"),
+}
+
 var _Worker_PullTaskCmd = &cobra.Command{
 	Use:   "pullTask",
 	Short: "Make the PullTask method call, input-type: sonm.PullTaskRequest output-type: sonm.Chunk",
@@ -1484,6 +2633,120 @@ var _Worker_TaskLogsCmd_gen = &cobra.Command{
 	RunE:  grpccmd.TypeToJson("sonm.TaskLogsRequest"),
 }
 
+var _Worker_ExecCmd = &cobra.Command{
+	Use:   "exec",
+	Short: "Make the Exec method call, input-type: sonm.ExecRequest output-type: sonm.ExecReply",
+	RunE: grpccmd.RunE(
+		"Exec",
+		"sonm.ExecRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_ExecCmd_gen = &cobra.Command{
+	Use:   "exec-gen",
+	Short: "Generate JSON for method call of Exec (input-type: sonm.ExecRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.ExecRequest"),
+}
+
+var _Worker_CopyToCmd = &cobra.Command{
+	Use:   "copyTo",
+	Short: "Make the CopyTo method call, input-type: sonm.CopyToRequest output-type: sonm.Empty",
+	RunE: grpccmd.RunE(
+		"CopyTo",
+		"sonm.CopyToRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_CopyToCmd_gen = &cobra.Command{
+	Use:   "copyTo-gen",
+	Short: "Generate JSON for method call of CopyTo (input-type: sonm.CopyToRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.CopyToRequest"),
+}
+
+var _Worker_CopyFromCmd = &cobra.Command{
+	Use:   "copyFrom",
+	Short: "Make the CopyFrom method call, input-type: sonm.CopyFromRequest output-type: sonm.Chunk",
+	RunE: grpccmd.RunE(
+		"CopyFrom",
+		"sonm.CopyFromRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_CopyFromCmd_gen = &cobra.Command{
+	Use:   "copyFrom-gen",
+	Short: "Generate JSON for method call of CopyFrom (input-type: sonm.CopyFromRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.CopyFromRequest"),
+}
+
+var _Worker_WatchTasksCmd = &cobra.Command{
+	Use:   "watchTasks",
+	Short: "Make the WatchTasks method call, input-type: sonm.WatchTasksRequest output-type: sonm.TaskEvent",
+	RunE: grpccmd.RunE(
+		"WatchTasks",
+		"sonm.WatchTasksRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_WatchTasksCmd_gen = &cobra.Command{
+	Use:   "watchTasks-gen",
+	Short: "Generate JSON for method call of WatchTasks (input-type: sonm.WatchTasksRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.WatchTasksRequest"),
+}
+
+var _Worker_CommitTaskCmd = &cobra.Command{
+	Use:   "commitTask",
+	Short: "Make the CommitTask method call, input-type: sonm.CommitTaskRequest output-type: sonm.TaskSnapshot",
+	RunE: grpccmd.RunE(
+		"CommitTask",
+		"sonm.CommitTaskRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_CommitTaskCmd_gen = &cobra.Command{
+	Use:   "commitTask-gen",
+	Short: "Generate JSON for method call of CommitTask (input-type: sonm.CommitTaskRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.CommitTaskRequest"),
+}
+
+var _Worker_TaskSnapshotsCmd = &cobra.Command{
+	Use:   "taskSnapshots",
+	Short: "Make the TaskSnapshots method call, input-type: sonm.TaskSnapshotsRequest output-type: sonm.TaskSnapshotsReply",
+	RunE: grpccmd.RunE(
+		"TaskSnapshots",
+		"sonm.TaskSnapshotsRequest",
+		func(c io.Closer) interface{} {
+			cc := c.(*grpc.ClientConn)
+			return NewWorkerClient(cc)
+		},
+	),
+}
+
+var _Worker_TaskSnapshotsCmd_gen = &cobra.Command{
+	Use:   "taskSnapshots-gen",
+	Short: "Generate JSON for method call of TaskSnapshots (input-type: sonm.TaskSnapshotsRequest)",
+	RunE:  grpccmd.TypeToJson("sonm.TaskSnapshotsRequest"),
+}
+
 var _Worker_GetDealInfoCmd = &cobra.Command{
 	Use:   "getDealInfo",
 	Short: "Make the GetDealInfo method call, input-type: sonm.ID output-type: sonm.DealInfoReply",
@@ -1509,6 +2772,8 @@ func init() {
 	_WorkerCmd.AddCommand(
 		_Worker_PushTaskCmd,
 		_Worker_PushTaskCmd_gen,
+		_Worker_ImageLayersCmd,
+		_Worker_ImageLayersCmd_gen,
 		_Worker_PullTaskCmd,
 		_Worker_PullTaskCmd_gen,
 		_Worker_StartTaskCmd,
@@ -1521,6 +2786,18 @@ func init() {
 		_Worker_JoinNetworkCmd_gen,
 		_Worker_TaskLogsCmd,
 		_Worker_TaskLogsCmd_gen,
+		_Worker_ExecCmd,
+		_Worker_ExecCmd_gen,
+		_Worker_CopyToCmd,
+		_Worker_CopyToCmd_gen,
+		_Worker_CopyFromCmd,
+		_Worker_CopyFromCmd_gen,
+		_Worker_WatchTasksCmd,
+		_Worker_WatchTasksCmd_gen,
+		_Worker_CommitTaskCmd,
+		_Worker_CommitTaskCmd_gen,
+		_Worker_TaskSnapshotsCmd,
+		_Worker_TaskSnapshotsCmd_gen,
 		_Worker_GetDealInfoCmd,
 		_Worker_GetDealInfoCmd_gen,
 	)
@@ -1531,80 +2808,136 @@ func init() {
 func init() { proto.RegisterFile("worker.proto", fileDescriptor14) }
 
 var fileDescriptor14 = []byte{
-	// 1195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xde, 0xb5, 0x1d, 0x7f, 0x1c, 0xc7, 0x89, 0x3b, 0xe9, 0x1b, 0x59, 0xfb, 0xb6, 0x55, 0xd9,
-	0x0a, 0x61, 0x5a, 0x6a, 0x8a, 0xe9, 0x05, 0x6a, 0x01, 0xc9, 0x8d, 0xd3, 0xd4, 0x6d, 0xe3, 0xac,
-	0xc6, 0x58, 0xe1, 0x0e, 0x4d, 0xed, 0x89, 0xb3, 0xf2, 0x7a, 0x76, 0xd9, 0x99, 0x0d, 0x0a, 0x3f,
-	0x83, 0x1b, 0x24, 0xae, 0xb9, 0xe6, 0x9e, 0x9f, 0x00, 0xf7, 0x5c, 0xf2, 0x57, 0x10, 0x9a, 0x9d,
-	0xd9, 0xaf, 0x74, 0x23, 0x40, 0xea, 0xdd, 0x9e, 0xe7, 0x3c, 0x67, 0xf6, 0xcc, 0x99, 0x73, 0x9e,
-	0x19, 0xd8, 0xfe, 0xce, 0x0f, 0xd7, 0x34, 0x1c, 0x04, 0xa1, 0x2f, 0x7c, 0x54, 0xe3, 0x3e, 0xdb,
-	0x58, 0x3b, 0x84, 0xaf, 0xbf, 0x09, 0x3c, 0xc2, 0x14, 0x6a, 0xa1, 0x05, 0x09, 0xc8, 0x1b, 0xd7,
-	0x73, 0x85, 0x4b, 0xb9, 0xc6, 0x76, 0x17, 0x3e, 0x13, 0xc4, 0x65, 0x49, 0xa8, 0xb5, 0xeb, 0x32,
-	0x19, 0xcc, 0x5c, 0xa2, 0x81, 0x1b, 0x1b, 0x12, 0xae, 0xa9, 0x08, 0x3c, 0xb2, 0xa0, 0x1a, 0x6a,
-	0x31, 0x2a, 0xd4, 0xa7, 0xfd, 0xa3, 0x09, 0xdd, 0x99, 0x20, 0xa1, 0xf8, 0x8a, 0xf0, 0x35, 0xa6,
-	0xdf, 0x46, 0x94, 0x0b, 0x74, 0x07, 0x6a, 0x4b, 0x4a, 0xbc, 0x9e, 0x79, 0xd7, 0xec, 0xb7, 0x87,
-	0x30, 0x90, 0x0b, 0x0e, 0xc6, 0x94, 0x78, 0x38, 0xc6, 0xd1, 0x43, 0x68, 0xa5, 0xbf, 0xed, 0x55,
-	0x62, 0xd2, 0xae, 0x22, 0x1d, 0x24, 0x30, 0xce, 0x18, 0xe8, 0x31, 0xb4, 0x42, 0xca, 0xfd, 0x28,
-	0x5c, 0x50, 0xde, 0xab, 0xc6, 0xf4, 0x7d, 0x45, 0x1f, 0xf1, 0xb5, 0xe3, 0x11, 0x86, 0x13, 0x2f,
-	0xce, 0x88, 0xb6, 0x03, 0xbd, 0xd3, 0xb8, 0x26, 0x2f, 0x7d, 0x97, 0x4d, 0xa9, 0x90, 0x05, 0x4a,
-	0x12, 0xdc, 0x87, 0xba, 0x20, 0x7c, 0x3d, 0x19, 0xc7, 0x29, 0xb6, 0xb0, 0xb6, 0xd0, 0x2d, 0x90,
-	0x5b, 0x93, 0xcc, 0xc9, 0x38, 0x4e, 0xac, 0x85, 0x33, 0xc0, 0xfe, 0xdd, 0x84, 0x9d, 0xdc, 0x5e,
-	0x03, 0xef, 0x12, 0xed, 0x40, 0xc5, 0x5d, 0xea, 0x45, 0x2a, 0xee, 0x12, 0x3d, 0x85, 0x46, 0xe0,
-	0x87, 0xe2, 0x98, 0x04, 0xbd, 0xca, 0xdd, 0x6a, 0xbf, 0x3d, 0x7c, 0x4f, 0x25, 0x5a, 0x0c, 0x1b,
-	0x38, 0x8a, 0x73, 0xc8, 0x44, 0x78, 0x89, 0x93, 0x08, 0x74, 0x07, 0x20, 0xfd, 0x99, 0xdc, 0x68,
-	0xb5, 0xdf, 0xc2, 0x39, 0xc4, 0x7a, 0x05, 0xdb, 0xf9, 0x40, 0xd4, 0x85, 0xea, 0x9a, 0x5e, 0xea,
-	0xbf, 0xcb, 0x4f, 0xf4, 0x3e, 0x6c, 0x5d, 0x10, 0x2f, 0xa2, 0xc5, 0xa2, 0x1e, 0xb2, 0x65, 0xe0,
-	0xbb, 0x4c, 0x70, 0xac, 0xbc, 0x4f, 0x2a, 0x9f, 0x99, 0xf6, 0x9f, 0x26, 0xb4, 0x67, 0x82, 0x88,
-	0x88, 0xab, 0x9d, 0xec, 0x43, 0x3d, 0x0a, 0x84, 0xbb, 0xa1, 0xf1, 0x7a, 0x35, 0xac, 0x2d, 0xd4,
-	0x83, 0xc6, 0x05, 0x0d, 0xb9, 0xeb, 0x33, 0x5d, 0x90, 0xc4, 0x44, 0x16, 0x34, 0x03, 0x8f, 0x88,
-	0x33, 0x3f, 0xdc, 0xc4, 0xa7, 0xd2, 0xc2, 0xa9, 0x2d, 0xa3, 0xa8, 0x38, 0x1f, 0x2d, 0x97, 0x61,
-	0xaf, 0xa6, 0xa2, 0xb4, 0x29, 0x4b, 0x2c, 0x8b, 0x7d, 0xe0, 0x47, 0x4c, 0xf4, 0xb6, 0xee, 0x9a,
-	0xfd, 0x0e, 0xce, 0x00, 0xe9, 0x1d, 0x9f, 0xbe, 0x50, 0x79, 0xf5, 0xea, 0xea, 0x00, 0x52, 0x00,
-	0xdd, 0x87, 0x6e, 0x48, 0xd9, 0x92, 0x7e, 0x7f, 0xe1, 0x47, 0x5c, 0x93, 0x1a, 0x31, 0xe9, 0x2d,
-	0xdc, 0xfe, 0xc9, 0x84, 0x8e, 0x6e, 0x0f, 0xbd, 0xc3, 0x2f, 0xa0, 0x49, 0x34, 0xd0, 0x33, 0xf3,
-	0x87, 0x53, 0xa0, 0xa5, 0x96, 0x3a, 0x9c, 0x34, 0xc4, 0x7a, 0x99, 0xad, 0x77, 0x5d, 0xf9, 0xef,
-	0x15, 0xcb, 0xdf, 0x29, 0x36, 0x69, 0xae, 0xf8, 0x3f, 0x98, 0xd0, 0x91, 0xdd, 0xf0, 0xda, 0xe5,
-	0x42, 0x25, 0xf7, 0x09, 0xd4, 0x5c, 0x76, 0xe6, 0xeb, 0xc4, 0x6e, 0xab, 0xc8, 0x02, 0x65, 0x30,
-	0x61, 0x67, 0xbe, 0x4a, 0x2a, 0xa6, 0x5a, 0x53, 0x68, 0xa5, 0x50, 0x49, 0x32, 0x0f, 0x8a, 0xc9,
-	0xfc, 0x2f, 0x5b, 0x32, 0x77, 0xec, 0xf9, 0xa4, 0x7e, 0x35, 0x61, 0x7b, 0x4c, 0x2f, 0x5c, 0x39,
-	0x47, 0x71, 0x4e, 0xff, 0x87, 0xea, 0x81, 0x33, 0xd7, 0x53, 0xdc, 0xd2, 0x03, 0xea, 0xcc, 0xb1,
-	0x44, 0xd1, 0x6d, 0xa8, 0x1d, 0x39, 0x73, 0xae, 0xdb, 0x5c, 0x7b, 0x8f, 0x9c, 0x39, 0x8e, 0x61,
-	0x19, 0x8b, 0x47, 0xc7, 0x7a, 0x5a, 0xb5, 0x17, 0x8f, 0x8e, 0xb1, 0x44, 0xd1, 0x07, 0xd0, 0xd0,
-	0x6d, 0x1d, 0x77, 0x47, 0x5a, 0xa9, 0x64, 0x4a, 0x13, 0xaf, 0x24, 0x72, 0xe1, 0x87, 0x64, 0x45,
-	0xe3, 0x56, 0x49, 0x89, 0x33, 0x05, 0xe2, 0xc4, 0x6b, 0x8f, 0x60, 0xd7, 0x89, 0x3c, 0x2f, 0x2f,
-	0x42, 0xfb, 0x50, 0x97, 0x62, 0x33, 0x49, 0xc6, 0x53, 0x5b, 0xe9, 0xec, 0x2f, 0x75, 0x3f, 0x6b,
-	0xcb, 0xfe, 0xcd, 0x84, 0x8e, 0xd4, 0x28, 0x59, 0x53, 0xb5, 0xff, 0x7f, 0x92, 0xb1, 0x01, 0x34,
-	0xc2, 0x88, 0x31, 0x97, 0xad, 0x74, 0x8d, 0x6f, 0xa6, 0xc3, 0x2e, 0x22, 0x7e, 0x4c, 0x02, 0x55,
-	0xe2, 0x84, 0x84, 0x86, 0x52, 0xf6, 0x36, 0x81, 0x47, 0x05, 0x5d, 0xea, 0xca, 0x94, 0x47, 0x64,
-	0xb4, 0xa2, 0xf6, 0xd5, 0xfe, 0xad, 0xf6, 0xfd, 0x51, 0x85, 0xdd, 0x2b, 0x27, 0x8d, 0x1e, 0x43,
-	0x9d, 0xab, 0x91, 0x91, 0xfb, 0xd9, 0x19, 0xde, 0x2a, 0x6d, 0x08, 0x9d, 0x0a, 0xd6, 0x5c, 0x39,
-	0x90, 0xee, 0x86, 0xac, 0xe8, 0x94, 0x6c, 0x68, 0xa2, 0x88, 0x29, 0x80, 0x3e, 0xcf, 0xe4, 0xae,
-	0x1a, 0xf7, 0x81, 0x5d, 0xbe, 0x68, 0xb9, 0xde, 0x65, 0x92, 0x53, 0x2b, 0x48, 0xce, 0x87, 0xb0,
-	0x15, 0xf1, 0xec, 0xcc, 0xf7, 0x74, 0xf7, 0xe8, 0xdd, 0xcd, 0xa5, 0x0b, 0x2b, 0x06, 0x7a, 0x0e,
-	0x88, 0x78, 0x9e, 0xbf, 0x20, 0x82, 0x2e, 0xd3, 0x4a, 0xc4, 0xc2, 0x71, 0x7d, 0x9d, 0x4a, 0x22,
-	0xde, 0xad, 0xb4, 0x7e, 0x0d, 0x75, 0x2d, 0x58, 0x6d, 0x68, 0xcc, 0xa7, 0xaf, 0xa6, 0x27, 0xa7,
-	0xd3, 0xae, 0x81, 0xb6, 0xa1, 0x39, 0x73, 0x4e, 0x4e, 0x5e, 0x4f, 0xa6, 0x47, 0x5d, 0x53, 0x59,
-	0xa3, 0xd3, 0xa9, 0xb4, 0x2a, 0x92, 0x88, 0xe7, 0xd3, 0xd8, 0xa8, 0x4a, 0xd7, 0xf3, 0xc9, 0x74,
-	0x32, 0x7b, 0x71, 0x38, 0xee, 0xd6, 0x10, 0x40, 0xfd, 0x19, 0x3e, 0x79, 0x75, 0x38, 0xed, 0x6e,
-	0xd9, 0x3f, 0xab, 0x1b, 0x28, 0xd7, 0x2b, 0xe8, 0x4b, 0x68, 0xaa, 0xa3, 0xa2, 0x89, 0xaa, 0xd9,
-	0x65, 0x3d, 0xa5, 0x4d, 0x9a, 0xc8, 0x5a, 0x12, 0x63, 0x61, 0xe8, 0x14, 0x5c, 0xef, 0x40, 0x49,
-	0x86, 0x7f, 0x55, 0xa0, 0xab, 0xee, 0xde, 0x63, 0xc2, 0xc8, 0x8a, 0x6e, 0x28, 0x13, 0xe8, 0x7e,
-	0x56, 0x15, 0x5d, 0xbb, 0x4d, 0x20, 0x2e, 0xad, 0x1b, 0xf9, 0x6c, 0xe3, 0x95, 0x6c, 0x03, 0x7d,
-	0x04, 0x0d, 0xad, 0x44, 0x45, 0x32, 0x4a, 0x66, 0x30, 0x53, 0x29, 0xdb, 0x40, 0x8f, 0xa0, 0xfd,
-	0x3c, 0xa4, 0xf4, 0x3f, 0x44, 0x3c, 0x80, 0x2d, 0x99, 0xfe, 0x15, 0xee, 0x5e, 0x89, 0xea, 0xda,
-	0x06, 0x1a, 0x40, 0x33, 0x11, 0xfe, 0x52, 0x7e, 0xe1, 0xfa, 0xb0, 0x0d, 0x74, 0x1f, 0x3a, 0x07,
-	0x21, 0x25, 0x82, 0x6a, 0x07, 0x2a, 0xde, 0x03, 0x56, 0x53, 0x99, 0x93, 0xb1, 0x6d, 0xa0, 0x3e,
-	0x74, 0x30, 0xdd, 0xf8, 0x17, 0x29, 0x37, 0x75, 0x5a, 0xf9, 0x5f, 0xc5, 0x29, 0x77, 0x9c, 0x28,
-	0x5c, 0xd1, 0xf2, 0x54, 0x8a, 0xe4, 0xe1, 0x2f, 0x55, 0xa8, 0xab, 0x03, 0x40, 0x0f, 0xa1, 0xe9,
-	0x44, 0xfc, 0x5c, 0x6e, 0x2a, 0x09, 0x39, 0x38, 0x8f, 0xd8, 0xda, 0xda, 0x51, 0x86, 0x13, 0xfa,
-	0xab, 0x90, 0x72, 0x6e, 0x1b, 0x7d, 0xf3, 0x91, 0x89, 0x86, 0x92, 0xae, 0x84, 0x14, 0xe9, 0x83,
-	0xbe, 0x22, 0xac, 0x56, 0x7e, 0x15, 0xdb, 0x78, 0x64, 0xa2, 0xa7, 0xd0, 0x4a, 0xdf, 0x37, 0x68,
-	0xff, 0xad, 0x07, 0x8f, 0x8a, 0xba, 0x59, 0xf6, 0x10, 0xb2, 0x0d, 0x74, 0x0f, 0x9a, 0x33, 0xe1,
-	0x07, 0x71, 0xec, 0xb5, 0x9b, 0xff, 0x18, 0x20, 0x6b, 0xb7, 0x1c, 0xad, 0xbc, 0x15, 0x6d, 0x03,
-	0x3d, 0x83, 0x76, 0xee, 0xd9, 0x87, 0xee, 0x28, 0xde, 0x75, 0xef, 0xc1, 0xa4, 0x09, 0x35, 0x3a,
-	0x0b, 0xe8, 0xc2, 0x36, 0xd0, 0x13, 0x68, 0xc6, 0xad, 0xe0, 0xaf, 0x38, 0xca, 0xfd, 0x48, 0xda,
-	0x49, 0xdc, 0x5e, 0x11, 0xce, 0x4a, 0x32, 0x80, 0xf6, 0x11, 0x15, 0xc9, 0x75, 0x92, 0xcb, 0x78,
-	0x2f, 0xbb, 0x45, 0xd2, 0x8b, 0xc6, 0x36, 0xde, 0xd4, 0xe3, 0xd7, 0xf4, 0xa7, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xa5, 0xdc, 0x04, 0x2b, 0xc7, 0x0b, 0x00, 0x00,
+	// 2089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x72, 0x23, 0x49,
+	0x11, 0x56, 0x4b, 0x2d, 0x59, 0x9d, 0x92, 0x6c, 0xb9, 0x6c, 0x8c, 0xa2, 0xd9, 0x9d, 0x18, 0x7a,
+	0xf9, 0x31, 0xb3, 0x3b, 0xc2, 0x98, 0x0d, 0x20, 0x66, 0x07, 0x22, 0x3c, 0x96, 0x6c, 0x6b, 0xc7,
+	0x96, 0x45, 0xd9, 0x0e, 0x07, 0xc1, 0x61, 0xa3, 0x46, 0x2a, 0x4b, 0x1d, 0x6e, 0x75, 0x37, 0xdd,
+	0x25, 0xcf, 0x6a, 0xce, 0xdc, 0x08, 0x2e, 0x5c, 0x88, 0xe0, 0xbc, 0x0f, 0xc0, 0x95, 0x47, 0x58,
+	0xee, 0x1c, 0x39, 0xf0, 0x1c, 0x5c, 0x88, 0xfa, 0xeb, 0x1f, 0xb9, 0x67, 0x67, 0x76, 0x98, 0x5b,
+	0x67, 0x56, 0x66, 0x55, 0x56, 0xe6, 0x97, 0x59, 0x99, 0x0d, 0xcd, 0x97, 0x41, 0x74, 0x4b, 0xa3,
+	0x6e, 0x18, 0x05, 0x2c, 0x40, 0x66, 0x1c, 0xf8, 0x73, 0x7b, 0x9d, 0xc4, 0xb7, 0x5f, 0x84, 0x1e,
+	0xf1, 0x25, 0xd7, 0x6e, 0xbe, 0x70, 0xa7, 0xae, 0xcf, 0x14, 0x85, 0xc6, 0x24, 0x24, 0x2f, 0x5c,
+	0xcf, 0x65, 0x2e, 0x8d, 0x15, 0x6f, 0x63, 0x1c, 0xf8, 0x8c, 0xb8, 0xbe, 0xde, 0xc8, 0xde, 0x70,
+	0x7d, 0xbe, 0x95, 0xef, 0x12, 0xc5, 0xd8, 0x9c, 0x93, 0xe8, 0x96, 0xb2, 0xd0, 0x23, 0x63, 0xaa,
+	0x58, 0x96, 0x4f, 0xf5, 0x9e, 0x1b, 0xcc, 0x9d, 0xd3, 0x98, 0x91, 0x79, 0x28, 0x19, 0xce, 0x5f,
+	0x0d, 0x68, 0x5f, 0x30, 0x12, 0xb1, 0x4b, 0x12, 0xdf, 0x62, 0xfa, 0x87, 0x05, 0x8d, 0x19, 0x7a,
+	0x00, 0xe6, 0x84, 0x12, 0xaf, 0x63, 0x3c, 0x34, 0x76, 0x1b, 0xfb, 0xd0, 0xe5, 0x27, 0x74, 0x7b,
+	0x94, 0x78, 0x58, 0xf0, 0xd1, 0x63, 0xb0, 0x12, 0x3b, 0x3a, 0x65, 0x21, 0xb4, 0x21, 0x85, 0x0e,
+	0x35, 0x1b, 0xa7, 0x12, 0xe8, 0x53, 0xb0, 0x22, 0x1a, 0x07, 0x8b, 0x68, 0x4c, 0xe3, 0x4e, 0x45,
+	0x88, 0xef, 0x48, 0xf1, 0x83, 0xf8, 0x76, 0xe4, 0x11, 0x1f, 0xeb, 0x55, 0x9c, 0x0a, 0x3a, 0x23,
+	0xe8, 0x5c, 0x0b, 0x97, 0x7d, 0x1e, 0xb8, 0xfe, 0x90, 0x32, 0xee, 0x3f, 0x6d, 0xe0, 0x0e, 0xd4,
+	0x18, 0x89, 0x6f, 0x07, 0x3d, 0x61, 0xa2, 0x85, 0x15, 0x85, 0x3e, 0x00, 0xcb, 0x97, 0x92, 0x83,
+	0x9e, 0x30, 0xcc, 0xc2, 0x29, 0xc3, 0xf9, 0xa7, 0x01, 0xeb, 0x99, 0xbb, 0x86, 0xde, 0x12, 0xad,
+	0x43, 0xd9, 0x9d, 0xa8, 0x4d, 0xca, 0xee, 0x04, 0x7d, 0x06, 0x6b, 0x61, 0x10, 0xb1, 0x33, 0x12,
+	0x76, 0xca, 0x0f, 0x2b, 0xbb, 0x8d, 0xfd, 0xef, 0x4b, 0x43, 0xf3, 0x6a, 0xdd, 0x91, 0x94, 0xe9,
+	0xfb, 0x2c, 0x5a, 0x62, 0xad, 0x81, 0x1e, 0x00, 0x24, 0x87, 0xf1, 0x8b, 0x56, 0x76, 0x2d, 0x9c,
+	0xe1, 0xd8, 0xcf, 0xa1, 0x99, 0x55, 0x44, 0x6d, 0xa8, 0xdc, 0xd2, 0xa5, 0x3a, 0x9d, 0x7f, 0xa2,
+	0x1f, 0x42, 0xf5, 0x8e, 0x78, 0x0b, 0x9a, 0x77, 0x6a, 0xdf, 0x9f, 0x84, 0x81, 0xeb, 0xb3, 0x18,
+	0xcb, 0xd5, 0x27, 0xe5, 0x5f, 0x19, 0xce, 0xbf, 0x0d, 0x68, 0x5c, 0x30, 0xc2, 0x16, 0xb1, 0xbc,
+	0xc9, 0x0e, 0xd4, 0x16, 0x21, 0x8f, 0xae, 0xd8, 0xcf, 0xc4, 0x8a, 0x42, 0x1d, 0x58, 0xbb, 0xa3,
+	0x51, 0xec, 0x06, 0xbe, 0x72, 0x88, 0x26, 0x91, 0x0d, 0xf5, 0xd0, 0x23, 0xec, 0x26, 0x88, 0xe6,
+	0x22, 0x2a, 0x16, 0x4e, 0x68, 0xae, 0x45, 0xd9, 0xec, 0x60, 0x32, 0x89, 0x3a, 0xa6, 0xd4, 0x52,
+	0x24, 0x77, 0x31, 0x77, 0xf6, 0x61, 0xb0, 0xf0, 0x59, 0xa7, 0xfa, 0xd0, 0xd8, 0x6d, 0xe1, 0x94,
+	0xc1, 0x57, 0x7b, 0xd7, 0x27, 0xd2, 0xae, 0x4e, 0x4d, 0x06, 0x20, 0x61, 0xa0, 0x47, 0xd0, 0x8e,
+	0xa8, 0x3f, 0xa1, 0xaf, 0xee, 0x82, 0x45, 0xac, 0x84, 0xd6, 0x84, 0xd0, 0x3d, 0xbe, 0xf3, 0x37,
+	0x03, 0x5a, 0x0a, 0x1e, 0xea, 0x86, 0xbf, 0x86, 0x3a, 0x51, 0x8c, 0x8e, 0x91, 0x0d, 0x4e, 0x4e,
+	0x2c, 0xa1, 0x64, 0x70, 0x12, 0x15, 0xfb, 0x73, 0x68, 0xe5, 0x96, 0x0a, 0xdc, 0xff, 0x51, 0xde,
+	0xfd, 0xad, 0x3c, 0x48, 0x33, 0xce, 0xff, 0x8b, 0x01, 0x2d, 0x8e, 0x86, 0x53, 0x37, 0x66, 0xd2,
+	0xb8, 0x9f, 0x81, 0xe9, 0xfa, 0x37, 0x81, 0x32, 0xec, 0x43, 0xa9, 0x99, 0x13, 0xe9, 0x0e, 0xfc,
+	0x9b, 0x40, 0x1a, 0x25, 0x44, 0xed, 0x21, 0x58, 0x09, 0xab, 0xc0, 0x98, 0x8f, 0xf3, 0xc6, 0x7c,
+	0x27, 0xdd, 0x32, 0x13, 0xf6, 0xac, 0x51, 0xff, 0x30, 0xa0, 0xd9, 0xa3, 0x77, 0xee, 0x98, 0xca,
+	0x35, 0xf4, 0x3d, 0xa8, 0x1c, 0x8e, 0xae, 0x54, 0x16, 0x5b, 0x2a, 0x41, 0x47, 0x57, 0x98, 0x73,
+	0xd1, 0x87, 0x60, 0x1e, 0x8f, 0xae, 0x62, 0x05, 0x73, 0xb5, 0x7a, 0x3c, 0xba, 0xc2, 0x82, 0xcd,
+	0x75, 0xf1, 0xc1, 0x99, 0xca, 0x56, 0xb5, 0x8a, 0x0f, 0xce, 0x30, 0xe7, 0xa2, 0x1f, 0xc3, 0x9a,
+	0x82, 0x75, 0xc7, 0xcc, 0x7a, 0x4a, 0x67, 0xa9, 0x5e, 0xe5, 0x82, 0x31, 0x0b, 0x22, 0x32, 0xa5,
+	0x9d, 0x6a, 0x56, 0xf0, 0x42, 0x32, 0xb1, 0x5e, 0x75, 0xfe, 0x6c, 0xc0, 0xc6, 0x68, 0xe1, 0x79,
+	0xd9, 0x2a, 0xb4, 0x03, 0x35, 0x5e, 0x6d, 0x06, 0x3a, 0x3f, 0x15, 0x95, 0x24, 0xff, 0x44, 0x01,
+	0x5a, 0x51, 0x9c, 0x1f, 0xdc, 0xdc, 0xc4, 0x94, 0x09, 0xab, 0x2b, 0x58, 0x51, 0x62, 0x1f, 0x77,
+	0x4a, 0x63, 0xa6, 0xa0, 0xac, 0x28, 0x8e, 0xff, 0xd8, 0x27, 0x61, 0x3c, 0x0b, 0x24, 0x90, 0x4d,
+	0x9c, 0xd0, 0xce, 0x09, 0xa0, 0xc1, 0x9c, 0x4c, 0xe9, 0x29, 0x59, 0xd2, 0x28, 0x7e, 0x93, 0x45,
+	0x36, 0xd4, 0xc7, 0x33, 0xe2, 0xfa, 0x83, 0x9e, 0xf4, 0xa7, 0x85, 0x13, 0xda, 0xe9, 0x42, 0x3b,
+	0xb7, 0x13, 0x0f, 0x4c, 0x56, 0xde, 0x58, 0x91, 0x7f, 0x0a, 0xcd, 0x4b, 0x1a, 0xcd, 0x5d, 0x9f,
+	0x78, 0x17, 0xee, 0x2b, 0x8a, 0xb6, 0xa1, 0xfa, 0xd2, 0x9d, 0xb0, 0x99, 0x38, 0xb2, 0x85, 0x25,
+	0xc1, 0x2d, 0x99, 0x51, 0x77, 0x3a, 0x63, 0xc2, 0x07, 0x2d, 0xac, 0x28, 0xe7, 0x4f, 0x65, 0x68,
+	0xf4, 0xbf, 0xa4, 0x63, 0x6d, 0xf1, 0x6a, 0x7d, 0xfb, 0x81, 0xba, 0x41, 0x4f, 0xa1, 0xaa, 0x29,
+	0xe3, 0xf1, 0xcc, 0x9d, 0x0e, 0x7c, 0xa6, 0xee, 0xd3, 0xe3, 0x60, 0x1c, 0xcf, 0x27, 0xaa, 0x82,
+	0xf1, 0x4f, 0xf4, 0x09, 0x54, 0xa8, 0x7f, 0xd7, 0x31, 0x05, 0x58, 0x6c, 0x55, 0x96, 0xd2, 0x73,
+	0xba, 0x7d, 0xff, 0x4e, 0x42, 0x9b, 0x8b, 0x71, 0x7d, 0xc6, 0x96, 0xc2, 0xa9, 0x75, 0xcc, 0x3f,
+	0xf9, 0x2d, 0x62, 0x36, 0x71, 0x7d, 0x51, 0x13, 0x9a, 0x58, 0x12, 0xe8, 0x47, 0x60, 0xc6, 0xee,
+	0x2b, 0x2a, 0x6a, 0x40, 0x63, 0x1f, 0x29, 0x84, 0x67, 0x6e, 0x8f, 0xc5, 0xba, 0xfd, 0x0b, 0xa8,
+	0xeb, 0x03, 0x0a, 0x12, 0x65, 0x3b, 0x9b, 0x28, 0x56, 0x36, 0x23, 0x02, 0xb0, 0xa4, 0x91, 0xaa,
+	0x40, 0xc6, 0x6c, 0x12, 0x2c, 0x98, 0xd0, 0x6d, 0x62, 0x45, 0x29, 0x3e, 0x8d, 0xe4, 0x4b, 0x26,
+	0xf9, 0x34, 0x8a, 0x38, 0x9f, 0x7e, 0xe9, 0x32, 0x3a, 0x11, 0x70, 0xaa, 0x63, 0x45, 0xf1, 0xe0,
+	0xf1, 0xaf, 0xc3, 0x60, 0x42, 0x05, 0xa0, 0xaa, 0x38, 0xa1, 0x9d, 0x00, 0x5a, 0x87, 0x41, 0xb8,
+	0xbc, 0x0c, 0xfe, 0x3f, 0xff, 0x23, 0x30, 0x43, 0xc2, 0x66, 0xaa, 0x2a, 0x8b, 0x6f, 0x7e, 0xcb,
+	0xf1, 0x6c, 0xe1, 0xcb, 0x8c, 0x6b, 0x62, 0x49, 0x38, 0xbf, 0x87, 0x0d, 0x7e, 0xe0, 0x51, 0x14,
+	0xcc, 0xdf, 0xfb, 0x91, 0xce, 0x00, 0x36, 0xaf, 0x09, 0x1b, 0xcf, 0x78, 0x52, 0x26, 0x39, 0x90,
+	0x6e, 0x67, 0x7c, 0xc3, 0x76, 0xd2, 0x88, 0xb2, 0x36, 0xc2, 0xf9, 0x4f, 0x19, 0x2c, 0xbe, 0x4d,
+	0xff, 0x8e, 0xfa, 0xef, 0x6a, 0xe2, 0x2e, 0x98, 0x6c, 0x19, 0x52, 0x61, 0xe2, 0xfa, 0xfe, 0x76,
+	0x5a, 0x0f, 0xc5, 0xa6, 0xdd, 0xcb, 0x65, 0x48, 0xb1, 0x90, 0xe0, 0xfd, 0x49, 0xd2, 0xe7, 0x74,
+	0xcc, 0xec, 0x53, 0x7a, 0xa9, 0xd9, 0x38, 0x95, 0xc8, 0x45, 0xb4, 0x9a, 0x8f, 0x28, 0x72, 0xa0,
+	0x19, 0x71, 0xb1, 0x88, 0xc9, 0x17, 0xaf, 0x26, 0xd2, 0x2d, 0xc7, 0x73, 0xfe, 0x68, 0x80, 0xc9,
+	0x4f, 0x47, 0x0d, 0x58, 0xbb, 0x1a, 0x3e, 0x1f, 0x9e, 0x5f, 0x0f, 0xdb, 0x25, 0xd4, 0x84, 0xfa,
+	0xc5, 0xe8, 0xfc, 0xfc, 0x74, 0x30, 0x3c, 0x6e, 0x1b, 0x92, 0x3a, 0xb8, 0x1e, 0x72, 0xaa, 0xcc,
+	0x05, 0xf1, 0xd5, 0x50, 0x10, 0x15, 0xbe, 0x74, 0x34, 0x18, 0x0e, 0x2e, 0x4e, 0xfa, 0xbd, 0xb6,
+	0x89, 0x00, 0x6a, 0xcf, 0xf0, 0xf9, 0xf3, 0xfe, 0xb0, 0x5d, 0x45, 0xeb, 0x00, 0xe7, 0xe7, 0x67,
+	0x5f, 0x3c, 0x1f, 0x9c, 0x9e, 0xf6, 0x7b, 0xed, 0x1a, 0x6a, 0x81, 0x85, 0xfb, 0x17, 0x97, 0x07,
+	0xf8, 0xb2, 0xdf, 0x6b, 0xaf, 0x71, 0xf2, 0x6a, 0x78, 0xd2, 0x3f, 0x38, 0xbd, 0x3c, 0xf9, 0x5d,
+	0xbb, 0xee, 0x7c, 0x6d, 0x40, 0x8b, 0x37, 0x69, 0xfc, 0x51, 0x91, 0x90, 0x7f, 0x53, 0x1f, 0xd7,
+	0x85, 0xb5, 0x68, 0xe1, 0xfb, 0xae, 0x3f, 0x55, 0x8e, 0xdf, 0x4e, 0xba, 0x1d, 0xb6, 0x88, 0xcf,
+	0x48, 0x28, 0xdf, 0x18, 0x2d, 0x84, 0xf6, 0x79, 0xdf, 0x37, 0x0f, 0x3d, 0xaa, 0xb3, 0xe2, 0x75,
+	0x1a, 0xa9, 0x58, 0xbe, 0xf9, 0x33, 0xdf, 0xb6, 0xf9, 0xfb, 0xca, 0x84, 0x8d, 0x95, 0xa7, 0x0e,
+	0x7d, 0xca, 0x13, 0x95, 0x93, 0xe2, 0x3e, 0xeb, 0xfb, 0x1f, 0x14, 0xbe, 0x88, 0xca, 0x14, 0xac,
+	0x64, 0x79, 0x47, 0xe2, 0xf2, 0xfa, 0x3b, 0x24, 0x73, 0x5d, 0x21, 0x52, 0x06, 0x7a, 0x9a, 0xf6,
+	0x7b, 0x15, 0x51, 0xdb, 0x9c, 0xe2, 0x4d, 0x8b, 0x1b, 0xbe, 0xb4, 0xe7, 0x32, 0x73, 0x3d, 0xd7,
+	0x4f, 0xa0, 0xba, 0x88, 0xd3, 0x47, 0x6f, 0x4b, 0x3d, 0x9f, 0xea, 0x76, 0x57, 0x7c, 0x09, 0x4b,
+	0x09, 0x74, 0x04, 0x88, 0x78, 0x5e, 0x30, 0x26, 0x8c, 0x4e, 0x12, 0x4f, 0x74, 0x6a, 0xdf, 0xe8,
+	0xa7, 0x02, 0x8d, 0x7b, 0x38, 0x5d, 0xbb, 0x8f, 0xd3, 0x1c, 0xce, 0xeb, 0x79, 0x9c, 0xbf, 0xdf,
+	0xde, 0x74, 0x0a, 0x35, 0xd5, 0xf1, 0xbd, 0xef, 0x8c, 0xc8, 0x41, 0xbe, 0xe6, 0x7c, 0x25, 0x3b,
+	0xfa, 0x0c, 0xf4, 0xd0, 0x6f, 0xa0, 0x2e, 0x23, 0x4f, 0x75, 0x97, 0xe8, 0x14, 0x41, 0x54, 0x91,
+	0x54, 0xb7, 0x89, 0x5a, 0xc7, 0xc6, 0xd0, 0xca, 0x2d, 0xbd, 0x8f, 0xce, 0x6c, 0x09, 0x9b, 0x87,
+	0xc1, 0x7c, 0xee, 0xe6, 0x86, 0xac, 0x77, 0x2b, 0x82, 0x36, 0xd4, 0x23, 0x3a, 0x75, 0x63, 0x16,
+	0x2d, 0x75, 0xd3, 0xae, 0x69, 0x5e, 0xc3, 0xc9, 0x82, 0xcd, 0x54, 0x9b, 0x23, 0xbe, 0x9d, 0x7f,
+	0x19, 0xd0, 0x14, 0x96, 0xa9, 0xce, 0xe6, 0xb5, 0xa3, 0xd3, 0xdb, 0x1d, 0xcf, 0x1f, 0x4b, 0x1a,
+	0xb9, 0xc4, 0x13, 0x87, 0x9b, 0x58, 0x51, 0xfc, 0x68, 0x9f, 0xa8, 0x3c, 0xb0, 0xb0, 0xf8, 0xe6,
+	0x33, 0x84, 0x48, 0xb4, 0x41, 0x4f, 0xe4, 0x81, 0x85, 0x35, 0x29, 0xe6, 0xc7, 0x88, 0x72, 0x00,
+	0x1f, 0xb0, 0x4e, 0x2d, 0x0b, 0xa7, 0x4c, 0x7d, 0x4e, 0x24, 0xf8, 0xe6, 0x49, 0x9b, 0x60, 0xca,
+	0x96, 0xc0, 0x79, 0x0a, 0xdb, 0xd9, 0x6b, 0x7d, 0xbb, 0xe7, 0xc9, 0x39, 0x02, 0xb4, 0xa2, 0xcd,
+	0xa1, 0xb3, 0x07, 0x96, 0x6e, 0x00, 0x35, 0x76, 0x50, 0x26, 0xb6, 0x6a, 0x09, 0xa7, 0x42, 0xce,
+	0x2f, 0x61, 0xe3, 0x7a, 0xe6, 0x32, 0xea, 0x89, 0x26, 0x7f, 0x1c, 0x44, 0x3c, 0x8c, 0x2d, 0x9e,
+	0x9e, 0x2f, 0xe9, 0xe4, 0x84, 0xc4, 0x33, 0xaa, 0x1b, 0xbc, 0x3c, 0xd3, 0xf9, 0x6f, 0x19, 0xd6,
+	0x33, 0x9a, 0xfc, 0x74, 0x3e, 0x72, 0xf9, 0xe4, 0x85, 0x47, 0x25, 0x28, 0xea, 0x58, 0x93, 0x1c,
+	0x81, 0x8b, 0xc8, 0x53, 0xc5, 0x8b, 0x7f, 0x8a, 0x30, 0xb8, 0x53, 0x3e, 0x7d, 0x4b, 0x0c, 0x28,
+	0x0a, 0xed, 0xc1, 0x56, 0x18, 0xb9, 0x77, 0xae, 0x47, 0xa7, 0x74, 0xc2, 0xc7, 0x35, 0x1a, 0xc7,
+	0xa2, 0xec, 0x72, 0x13, 0x8a, 0x96, 0x32, 0x01, 0xad, 0xe6, 0x02, 0xfa, 0x18, 0xac, 0x45, 0x38,
+	0x79, 0x43, 0x88, 0x12, 0x09, 0x3e, 0x37, 0x47, 0xe2, 0xfe, 0x7c, 0xa0, 0xcb, 0x8c, 0x66, 0xf9,
+	0x3b, 0x76, 0xa5, 0x8f, 0x54, 0xce, 0x69, 0x0d, 0x5e, 0xa2, 0x3d, 0x12, 0xb3, 0x7e, 0x14, 0x05,
+	0x91, 0x28, 0x4c, 0x16, 0x4e, 0x19, 0xf6, 0x6f, 0xa1, 0x99, 0x55, 0x7b, 0xeb, 0x7c, 0x5c, 0x09,
+	0x4c, 0x26, 0x1f, 0xf7, 0xff, 0x5e, 0x81, 0xb6, 0xfc, 0xb7, 0x70, 0x46, 0x7c, 0x32, 0xa5, 0x73,
+	0xde, 0x94, 0x3c, 0x4a, 0x8b, 0x96, 0x2a, 0x6d, 0xf3, 0x90, 0x2d, 0xed, 0xcd, 0x6c, 0xf5, 0x10,
+	0xb7, 0x70, 0x4a, 0xe8, 0x13, 0x58, 0x53, 0x93, 0x56, 0x5e, 0x18, 0xe9, 0x27, 0x36, 0x9d, 0xc2,
+	0x9c, 0x12, 0xda, 0x83, 0xc6, 0x51, 0x44, 0xe9, 0xb7, 0xd0, 0xf8, 0x18, 0xaa, 0xa2, 0xe9, 0xca,
+	0xcb, 0x6e, 0x15, 0x4c, 0x95, 0x4e, 0x09, 0x75, 0xa1, 0xae, 0x07, 0xdb, 0x42, 0xf9, 0xdc, 0x78,
+	0xec, 0x94, 0xd0, 0x23, 0x68, 0x1d, 0x8a, 0xdc, 0x52, 0x0b, 0x28, 0x3f, 0xe7, 0xda, 0x75, 0x49,
+	0x0e, 0x7a, 0x4e, 0x09, 0xed, 0x42, 0x0b, 0xd3, 0x79, 0x70, 0x97, 0xc8, 0x26, 0x8b, 0x76, 0xf6,
+	0x28, 0x61, 0x72, 0x6b, 0xb4, 0x88, 0xa6, 0xb4, 0xd8, 0x94, 0x15, 0xe1, 0x3d, 0xb0, 0x92, 0xf0,
+	0xe4, 0x05, 0xb7, 0x8b, 0x70, 0xe3, 0x94, 0xf6, 0xbf, 0xae, 0x41, 0x4d, 0x86, 0x0c, 0x3d, 0x86,
+	0xfa, 0x68, 0x11, 0x8b, 0xae, 0x54, 0xeb, 0x1e, 0xf2, 0x66, 0xd8, 0x5e, 0x97, 0xc4, 0x28, 0x0a,
+	0xa6, 0x1c, 0xdd, 0x4e, 0x69, 0xd7, 0xd8, 0x33, 0xd0, 0x01, 0x34, 0x32, 0x03, 0x18, 0xea, 0xa8,
+	0x0b, 0xdc, 0x9b, 0xee, 0xec, 0x9d, 0x82, 0x15, 0xe9, 0xb1, 0x7d, 0x7e, 0xa2, 0x1c, 0x4e, 0x91,
+	0x42, 0xd7, 0xca, 0xb0, 0x6a, 0x67, 0x0d, 0x71, 0x4a, 0x7b, 0x06, 0xfa, 0x0c, 0xac, 0xe4, 0xa7,
+	0x11, 0xda, 0xb9, 0xf7, 0x17, 0x49, 0x6a, 0x6d, 0x17, 0xfd, 0x5d, 0x72, 0x4a, 0xe8, 0x23, 0xa8,
+	0x5f, 0xb0, 0x20, 0x14, 0xba, 0xaf, 0xf5, 0xf8, 0x4f, 0x01, 0xd2, 0x37, 0x27, 0x23, 0x56, 0xfc,
+	0x1e, 0x39, 0x25, 0xf4, 0x0c, 0x1a, 0x99, 0x7f, 0x69, 0xe8, 0x81, 0x72, 0xf5, 0x6b, 0x7e, 0xb2,
+	0x69, 0xe4, 0x2b, 0xee, 0x45, 0x48, 0xc7, 0x4e, 0x09, 0x3d, 0x81, 0xba, 0xc0, 0x5f, 0x30, 0x8d,
+	0x51, 0xe6, 0x20, 0x4e, 0x6b, 0xbd, 0xad, 0x3c, 0x3b, 0x75, 0xc9, 0x1e, 0x98, 0x7c, 0x1c, 0x43,
+	0x9b, 0xf7, 0xe6, 0x47, 0x7b, 0x23, 0xcb, 0x12, 0xd6, 0x8a, 0xd8, 0x75, 0xa1, 0x26, 0xe7, 0x29,
+	0xb4, 0xa5, 0xff, 0x2f, 0x66, 0xa6, 0xab, 0x15, 0x87, 0xec, 0x1a, 0x3c, 0x50, 0x7a, 0x1c, 0xd2,
+	0xd6, 0xad, 0x8c, 0x47, 0xf7, 0x03, 0xf5, 0x04, 0x20, 0x9d, 0x72, 0xd0, 0x77, 0x95, 0x53, 0x56,
+	0xe7, 0x1e, 0x6d, 0x61, 0x32, 0x6f, 0xa8, 0x20, 0x43, 0xfa, 0xb0, 0x6b, 0xdd, 0x7b, 0x4f, 0xbd,
+	0x5d, 0xf0, 0x8a, 0x38, 0x25, 0x74, 0x0c, 0xad, 0x2c, 0x27, 0x46, 0xf6, 0x7d, 0xb1, 0xe4, 0xf8,
+	0x4e, 0xe1, 0x9a, 0x2e, 0x00, 0x8d, 0x63, 0xca, 0x74, 0xeb, 0x9f, 0x41, 0xc2, 0x56, 0xda, 0xf1,
+	0x27, 0x43, 0x81, 0x53, 0x7a, 0x51, 0x13, 0xbf, 0x7e, 0x7f, 0xfe, 0xbf, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x0a, 0xc7, 0xd4, 0x58, 0x93, 0x16, 0x00, 0x00,
 }
@@ -0,0 +1,191 @@
+// Package xdocker contains helpers for dealing with Docker image archives
+// produced by "docker save".
+package xdocker
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	manifestFileName = "manifest.json"
+	// maxMetadataSize limits the size of JSON files read from an archive.
+	maxMetadataSize = 16 * 1024 * 1024
+)
+
+var (
+	// ErrNotImageArchive is returned when the archive has no manifest, i.e.
+	// it is not produced by "docker save".
+	ErrNotImageArchive = errors.New("not an image archive")
+
+	digestRe = regexp.MustCompile("^sha256:[a-f0-9]{64}$")
+)
+
+type manifestItem struct {
+	Config   string
+	RepoTags []string
+	Layers   []string
+}
+
+type imageConfig struct {
+	RootFS struct {
+		DiffIDs []string `json:"diff_ids"`
+	} `json:"rootfs"`
+}
+
+// Layer describes a layer of an image within an archive.
+type Layer struct {
+	// Path is the path of the layer tarball within the archive.
+	Path string
+	// ChainID identifies the layer together with all its parents.
+	ChainID string
+}
+
+// ReadLayers returns layers of all images within the archive read from the
+// given reader, from the base ones.
+func ReadLayers(rd io.Reader) ([]Layer, error) {
+	files := map[string][]byte{}
+
+	tr := tar.NewReader(rd)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Clean(header.Name)
+		if header.Typeflag != tar.TypeReg || strings.Contains(name, "/") || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		if header.Size > maxMetadataSize {
+			return nil, fmt.Errorf("%s is too large: %d bytes", name, header.Size)
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		files[name] = data
+	}
+
+	data, ok := files[manifestFileName]
+	if !ok {
+		return nil, ErrNotImageArchive
+	}
+
+	var manifest []manifestItem
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %v", err)
+	}
+
+	var layers []Layer
+	for _, item := range manifest {
+		data, ok := files[path.Clean(item.Config)]
+		if !ok {
+			return nil, fmt.Errorf("image config %s not found", item.Config)
+		}
+
+		var config imageConfig
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("failed to decode image config %s: %v", item.Config, err)
+		}
+
+		if len(config.RootFS.DiffIDs) != len(item.Layers) {
+			return nil, fmt.Errorf("image config %s describes %d layers, but manifest has %d",
+				item.Config, len(config.RootFS.DiffIDs), len(item.Layers))
+		}
+
+		for idx, chainID := range ChainIDs(config.RootFS.DiffIDs) {
+			layers = append(layers, Layer{Path: path.Clean(item.Layers[idx]), ChainID: chainID})
+		}
+	}
+
+	return layers, nil
+}
+
+// ChainIDs calculates chain IDs of layers with the given diff IDs, from the
+// base one, the same way Docker does.
+func ChainIDs(diffIDs []string) []string {
+	chainIDs := make([]string, 0, len(diffIDs))
+	for idx, diffID := range diffIDs {
+		if idx == 0 {
+			chainIDs = append(chainIDs, diffID)
+			continue
+		}
+
+		hash := sha256.Sum256([]byte(chainIDs[idx-1] + " " + diffID))
+		chainIDs = append(chainIDs, "sha256:"+hex.EncodeToString(hash[:]))
+	}
+
+	return chainIDs
+}
+
+// StripLayers copies the archive from the reader to the writer omitting
+// tarballs of the given layers.
+//
+// Docker loads such archives fine as long as it already has the omitted
+// layers, so it allows to avoid transferring them.
+func StripLayers(rd io.Reader, wr io.Writer, layers []Layer) error {
+	skip := map[string]bool{}
+	for _, layer := range layers {
+		skip[layer.Path] = true
+	}
+
+	tr := tar.NewReader(rd)
+	tw := tar.NewWriter(wr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if skip[path.Clean(header.Name)] {
+			continue
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// Digest calculates the digest of the content read from the given reader in
+// "sha256:<hex>" format.
+func Digest(rd io.Reader) (string, error) {
+	hash := sha256.New()
+	if _, err := io.Copy(hash, rd); err != nil {
+		return "", err
+	}
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// ValidateDigest checks that the digest is in "sha256:<hex>" format.
+func ValidateDigest(digest string) error {
+	if !digestRe.MatchString(digest) {
+		return fmt.Errorf("invalid digest \"%s\"", digest)
+	}
+
+	return nil
+}
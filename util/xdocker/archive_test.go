@@ -0,0 +1,106 @@
+package xdocker
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	diffID0 = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	diffID1 = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+func makeArchive(t *testing.T, files map[string]string, order []string) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, name := range order {
+		content := files[name]
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := io.WriteString(tw, content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	return buf.Bytes()
+}
+
+func imageArchive(t *testing.T) []byte {
+	files := map[string]string{
+		"a/layer.tar":   "layer a",
+		"a/json":        "{}",
+		"b/layer.tar":   "layer b",
+		"b/json":        "{}",
+		"config.json":   `{"rootfs": {"type": "layers", "diff_ids": ["` + diffID0 + `", "` + diffID1 + `"]}}`,
+		"manifest.json": `[{"Config": "config.json", "RepoTags": ["test:latest"], "Layers": ["a/layer.tar", "b/layer.tar"]}]`,
+	}
+
+	return makeArchive(t, files, []string{"a/layer.tar", "a/json", "b/layer.tar", "b/json", "config.json", "manifest.json"})
+}
+
+func TestChainIDs(t *testing.T) {
+	chainIDs := ChainIDs([]string{diffID0, diffID1})
+
+	require.Len(t, chainIDs, 2)
+	assert.Equal(t, diffID0, chainIDs[0])
+	assert.Equal(t, "sha256:9932074217c35353d2e03a3f5a86549f7c67bfeb0ba53e23d69f4d4c8f7958f5", chainIDs[1])
+}
+
+func TestReadLayers(t *testing.T) {
+	layers, err := ReadLayers(bytes.NewReader(imageArchive(t)))
+	require.NoError(t, err)
+
+	chainIDs := ChainIDs([]string{diffID0, diffID1})
+	assert.Equal(t, []Layer{
+		{Path: "a/layer.tar", ChainID: chainIDs[0]},
+		{Path: "b/layer.tar", ChainID: chainIDs[1]},
+	}, layers)
+}
+
+func TestReadLayersNotImageArchive(t *testing.T) {
+	archive := makeArchive(t, map[string]string{"etc/hosts": "127.0.0.1 localhost"}, []string{"etc/hosts"})
+
+	_, err := ReadLayers(bytes.NewReader(archive))
+	assert.Equal(t, ErrNotImageArchive, err)
+}
+
+func TestStripLayers(t *testing.T) {
+	layers, err := ReadLayers(bytes.NewReader(imageArchive(t)))
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, StripLayers(bytes.NewReader(imageArchive(t)), buf, layers[:1]))
+
+	var names []string
+	tr := tar.NewReader(buf)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, header.Name)
+	}
+
+	assert.Equal(t, []string{"a/json", "b/layer.tar", "b/json", "config.json", "manifest.json"}, names)
+}
+
+func TestDigest(t *testing.T) {
+	digest, err := Digest(strings.NewReader("content"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "sha256:ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73", digest)
+	assert.NoError(t, ValidateDigest(digest))
+	assert.Error(t, ValidateDigest("sha256:123"))
+	assert.Error(t, ValidateDigest("md5:ed7002b439e9ac845f22357d822bac14"))
+}
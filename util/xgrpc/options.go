@@ -160,7 +160,10 @@ func hex(v interface{}) string {
 	return fmt.Sprintf("%x", v)
 }
 
-func authUnaryInterceptor(router *auth.AuthRouter) grpc.UnaryServerInterceptor {
+// AuthUnaryInterceptor returns an unary interceptor that authorizes requests
+// using the given router. Useful for non-gRPC transports, like REST, serving
+// the same API.
+func AuthUnaryInterceptor(router *auth.AuthRouter) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		if err := router.Authorize(ctx, auth.Event(info.FullMethod), req); err != nil {
 			return nil, err
@@ -171,7 +174,7 @@ func authUnaryInterceptor(router *auth.AuthRouter) grpc.UnaryServerInterceptor {
 
 func AuthorizationInterceptor(router *auth.AuthRouter) ServerOption {
 	return func(o *options) {
-		o.interceptors.u = append(o.interceptors.u, authUnaryInterceptor(router))
+		o.interceptors.u = append(o.interceptors.u, AuthUnaryInterceptor(router))
 		// TODO: Stream interceptors.
 		// o.interceptors.s = append(o.interceptors.s, authStreamInterceptor(router))
 	}